@@ -0,0 +1,201 @@
+// Package container はサーバーが使うリポジトリ・ユースケース・ハンドラーを組み立てる
+// echoとginのルーター、gRPCのサーバーは同じものを使い、フレームワークが違っても同じ処理を行う
+package container
+
+import (
+	"net"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"gorm.io/gorm"
+
+	"household-account-backend/adapter/controller/echo/handler"
+	"household-account-backend/adapter/controller/echo/presenter"
+	"household-account-backend/adapter/controller/graphql"
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/pkg/config"
+	"household-account-backend/pkg/jwtkeys"
+	"household-account-backend/pkg/logger"
+	"household-account-backend/usecase"
+)
+
+// Container はルーターがミドルウェアとハンドラーの登録に使う値
+type Container struct {
+	Config *config.Config
+	// Swagger はリクエストの検証に使うOpenAPIの定義
+	Swagger    *openapi3.T
+	KeyManager *jwtkeys.KeyManager
+	// TrustedProxies はクライアントのIPアドレスをX-Forwarded-Forから取得してよいプロキシの範囲
+	TrustedProxies []*net.IPNet
+
+	PersonalAccessTokenUseCase usecase.PersonalAccessTokenUseCase
+	SessionUseCase             usecase.SessionUseCase
+	IdempotencyUseCase         usecase.IdempotencyUseCase
+	// RateLimitStore はルーターごとに持ち、レート制限はグループごとに別のバケットで数える
+	RateLimitStore gateway.RateLimitStore
+
+	// HealthUseCase はOpenAPIの定義の外のヘルスチェックで使う
+	HealthUseCase usecase.HealthUseCase
+
+	// CategoryUseCase などはgRPCのサービスがRESTのハンドラーと同じものを使う
+	CategoryUseCase       usecase.CategoryUseCase
+	TransactionUseCase    usecase.TransactionUseCase
+	MonthlySummaryUseCase usecase.MonthlySummaryUseCase
+
+	// Server はOpenAPIの定義の全ての操作を実装したハンドラー
+	Server  *handler.Server
+	GraphQL *graphql.Executor
+}
+
+// New は設定からリポジトリ・ユースケース・ハンドラーまでを組み立てる
+func New(db *gorm.DB, configs *config.Config) (*Container, error) {
+	swagger, err := presenter.GetSwagger()
+	if err != nil {
+		return nil, err
+	}
+
+	keyManager, err := newKeyManager(configs)
+	if err != nil {
+		return nil, err
+	}
+	trustedProxies, err := configs.TrustedProxyNetworks()
+	if err != nil {
+		return nil, err
+	}
+	jwksHandler := handler.NewJWKSHandler(keyManager)
+
+	userRepository := gateway.NewUserRepository(db)
+	userTokenRepository := gateway.NewUserTokenRepository(db)
+	mailSender := gateway.NewLogMailSender()
+	twoFactorRepository := gateway.NewTwoFactorRepository(db)
+	loginAttemptStore := gateway.NewLoginAttemptRepository(db)
+	if configs.LoginAttemptStore == "memory" {
+		loginAttemptStore = gateway.NewInMemoryLoginAttemptStore()
+	}
+	loginAttemptUseCase := usecase.NewLoginAttemptUseCase(loginAttemptStore, usecase.LoginAttemptConfig{
+		MaxAccountFailures: configs.LoginMaxAccountFailures,
+		MaxIPFailures:      configs.LoginMaxIPFailures,
+		LockoutDuration:    configs.LoginLockoutDuration,
+		BaseDelay:          configs.LoginBaseDelay,
+		MaxDelay:           configs.LoginMaxDelay,
+		FailureWindow:      configs.LoginFailureWindow,
+	})
+	twoFactorUseCase := usecase.NewTwoFactorUseCase(userRepository, twoFactorRepository, loginAttemptUseCase, configs.TOTPIssuer)
+	twoFactorHandler := handler.NewTwoFactorHandler(twoFactorUseCase)
+	userUseCase := usecase.NewUserUseCase(userRepository, userTokenRepository, mailSender, twoFactorUseCase, loginAttemptUseCase, keyManager, usecase.UserTokenConfig{
+		EmailVerificationTTL: configs.EmailVerificationTokenTTL,
+		PasswordResetTTL:     configs.PasswordResetTokenTTL,
+		LoginChallengeTTL:    configs.LoginChallengeTTL,
+		AccountUnlockTTL:     configs.AccountUnlockTokenTTL,
+		FrontendURL:          configs.FrontendURL,
+	})
+	userHandler := handler.NewUserHandler(userUseCase)
+
+	var oidcProviders []gateway.OIDCProvider
+	for _, config := range configs.OIDCProviders {
+		redirectURL := configs.OIDCRedirectBaseURL + "/api/v1/auth/oidc/" + config.Name + "/callback"
+		oidcProviders = append(oidcProviders, gateway.NewOIDCProvider(config.Name, config.Issuer, config.ClientID, config.ClientSecret, redirectURL, config.Scopes))
+	}
+	userIdentityRepository := gateway.NewUserIdentityRepository(db)
+	oidcUseCase := usecase.NewOIDCUseCase(oidcProviders, userIdentityRepository, userRepository, userUseCase, configs.OIDCStateTTL)
+	oidcHandler := handler.NewOIDCHandler(oidcUseCase, configs.FrontendURL)
+
+	outboxRepository := gateway.NewOutboxRepository(db)
+	eventHub := gateway.NewInMemoryEventHub(configs.EventStreamBufferSize)
+	eventPublisher := usecase.NewOutboxEventPublisher(eventHub)
+	eventStreamUseCase := usecase.NewEventStreamUseCase(outboxRepository, eventHub)
+	eventStreamHandler := handler.NewEventStreamHandler(eventStreamUseCase, configs.EventStreamHeartbeatInterval)
+
+	categoryRepository := gateway.NewCategoryRepository(db)
+	categoryUseCase := usecase.NewCategoryUseCase(categoryRepository, eventPublisher)
+	categoryHandler := handler.NewCategoryHandler(categoryUseCase)
+
+	transactionRepository := gateway.NewTransactionRepository(db)
+	transactionUseCase := usecase.NewTransactionUseCase(transactionRepository, categoryRepository, usecase.NewTransactionSignPolicy(configs.TransactionSignPolicy), eventPublisher)
+	transactionHandler := handler.NewTransactionHandler(transactionUseCase)
+
+	monthlySummaryRepository := gateway.NewMonthlySummaryRepository(db)
+	monthlySummaryUseCase := usecase.NewMonthlySummaryUseCase(monthlySummaryRepository, eventPublisher)
+	monthlySummaryHandler := handler.NewMonthlySummaryHandler(monthlySummaryUseCase)
+
+	webhookRepository := gateway.NewWebhookRepository(db)
+	webhookSender := gateway.NewHTTPWebhookSender(configs.WebhookTimeout)
+	webhookUseCase := usecase.NewWebhookUseCase(webhookRepository, outboxRepository, webhookSender, configs.WebhookMaxAttempts, configs.WebhookClaimTTL)
+	webhookHandler := handler.NewWebhookHandler(webhookUseCase)
+
+	idempotencyRepository := gateway.NewIdempotencyRepository(db)
+	idempotencyUseCase := usecase.NewIdempotencyUseCase(idempotencyRepository, configs.IdempotencyKeyTTL)
+
+	fileStorage := gateway.NewLocalFileStorage(configs.FileStorageDir)
+	dataExportUseCase := usecase.NewDataExportUseCase(gateway.NewDataExportRepository(db), userRepository, categoryRepository, transactionRepository, monthlySummaryRepository, fileStorage, usecase.DataExportConfig{
+		TTL:        configs.DataExportTTL,
+		StaleAfter: configs.DataExportStaleAfter,
+	})
+	accountDeletionUseCase := usecase.NewAccountDeletionUseCase(gateway.NewAccountDeletionRepository(db), userRepository, userTokenRepository, fileStorage, mailSender, usecase.AccountDeletionConfig{
+		GracePeriod:     configs.AccountDeletionGracePeriod,
+		ConfirmationTTL: configs.AccountDeletionTokenTTL,
+		FrontendURL:     configs.FrontendURL,
+	})
+	accountDataHandler := handler.NewAccountDataHandler(dataExportUseCase, accountDeletionUseCase)
+
+	personalAccessTokenRepository := gateway.NewPersonalAccessTokenRepository(db)
+	personalAccessTokenUseCase := usecase.NewPersonalAccessTokenUseCase(personalAccessTokenRepository)
+	personalAccessTokenHandler := handler.NewPersonalAccessTokenHandler(personalAccessTokenUseCase)
+	sessionUseCase := usecase.NewSessionUseCase(userRepository)
+
+	adminUseCase := usecase.NewAdminUseCase(gateway.NewAdminRepository(db), userRepository, keyManager, usecase.AdminConfig{
+		ImpersonationTTL: configs.AdminImpersonationTTL,
+	})
+	adminHandler := handler.NewAdminHandler(adminUseCase)
+
+	return &Container{
+		Config:                     configs,
+		Swagger:                    swagger,
+		KeyManager:                 keyManager,
+		TrustedProxies:             trustedProxies,
+		PersonalAccessTokenUseCase: personalAccessTokenUseCase,
+		SessionUseCase:             sessionUseCase,
+		IdempotencyUseCase:         idempotencyUseCase,
+		RateLimitStore:             gateway.NewInMemoryRateLimitStore(),
+		HealthUseCase:              usecase.NewHealthUseCase(gateway.NewHealthRepository(db)),
+		CategoryUseCase:            categoryUseCase,
+		TransactionUseCase:         transactionUseCase,
+		MonthlySummaryUseCase:      monthlySummaryUseCase,
+		Server: &handler.Server{
+			UserHandler:                userHandler,
+			TwoFactorHandler:           twoFactorHandler,
+			OIDCHandler:                oidcHandler,
+			PersonalAccessTokenHandler: personalAccessTokenHandler,
+			AccountDataHandler:         accountDataHandler,
+			CategoryHandler:            categoryHandler,
+			TransactionHandler:         transactionHandler,
+			MonthlySummaryHandler:      monthlySummaryHandler,
+			WebhookHandler:             webhookHandler,
+			EventStreamHandler:         eventStreamHandler,
+			AdminHandler:               adminHandler,
+			JWKSHandler:                jwksHandler,
+		},
+		GraphQL: graphql.NewExecutor(userUseCase, categoryUseCase, transactionUseCase, monthlySummaryUseCase),
+	}, nil
+}
+
+// 認証トークンの鍵を読み込む
+// 鍵ファイルを設定していない場合はSECRETを共通鍵として使い、JWKSでは公開鍵を返さない
+func newKeyManager(config *config.Config) (*jwtkeys.KeyManager, error) {
+	var keys []*jwtkeys.Key
+	for _, keyConfig := range config.JWTKeys {
+		key, err := jwtkeys.LoadKeyFile(keyConfig.ID, keyConfig.Path)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		logger.Warn("JWT_KEYS is not set; signing authentication tokens with SECRET (HS256)")
+		keys = append(keys, jwtkeys.NewHMACKey("default", []byte(config.Secret)))
+	}
+	return jwtkeys.NewKeyManager(keys, jwtkeys.Config{
+		SigningKeyID: config.JWTSigningKeyID,
+		Issuer:       config.JWTIssuer,
+		Audience:     config.JWTAudience,
+	})
+}
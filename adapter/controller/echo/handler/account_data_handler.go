@@ -0,0 +1,139 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"household-account-backend/adapter/controller/echo/presenter"
+	"household-account-backend/entity"
+	"household-account-backend/usecase"
+)
+
+// AccountDataHandler はデータのエクスポートとアカウントの削除を扱う
+type AccountDataHandler struct {
+	dataExportUseCase      usecase.DataExportUseCase
+	accountDeletionUseCase usecase.AccountDeletionUseCase
+}
+
+func NewAccountDataHandler(dataExportUseCase usecase.DataExportUseCase, accountDeletionUseCase usecase.AccountDeletionUseCase) *AccountDataHandler {
+	return &AccountDataHandler{
+		dataExportUseCase:      dataExportUseCase,
+		accountDeletionUseCase: accountDeletionUseCase,
+	}
+}
+
+func dataExportToResponse(export *entity.DataExport) *presenter.DataExportResponse {
+	response := &presenter.DataExportResponse{
+		Id:          export.ID,
+		Status:      presenter.DataExportRequestStatus(export.Status),
+		FileSize:    export.FileSize,
+		ExpiresAt:   export.ExpiresAt,
+		CreatedAt:   export.CreatedAt,
+		CompletedAt: export.CompletedAt,
+	}
+	if export.Error != "" {
+		response.Error = &export.Error
+	}
+	return response
+}
+
+func accountDeletionToResponse(deletion *entity.AccountDeletion) presenter.AccountDeletionResponseJSONResponse {
+	return presenter.AccountDeletionResponseJSONResponse{
+		Id:          deletion.ID,
+		Status:      presenter.AccountDeletionRequestStatus(deletion.Status),
+		ScheduledAt: deletion.ScheduledAt,
+		CreatedAt:   deletion.CreatedAt,
+		ConfirmedAt: deletion.ConfirmedAt,
+	}
+}
+
+func (h *AccountDataHandler) RequestDataExport(ctx context.Context, request presenter.RequestDataExportRequestObject) (presenter.RequestDataExportResponseObject, error) {
+	export, err := h.dataExportUseCase.RequestExport(currentUserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.RequestDataExport202JSONResponse{DataExportResponseJSONResponse: presenter.DataExportResponseJSONResponse(*dataExportToResponse(export))}, nil
+}
+
+func (h *AccountDataHandler) GetDataExports(ctx context.Context, request presenter.GetDataExportsRequestObject) (presenter.GetDataExportsResponseObject, error) {
+	exports, err := h.dataExportUseCase.GetExports(currentUserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	response := presenter.GetDataExports200JSONResponse{}
+	for _, export := range exports {
+		response = append(response, *dataExportToResponse(&export))
+	}
+	return response, nil
+}
+
+func (h *AccountDataHandler) GetDataExport(ctx context.Context, request presenter.GetDataExportRequestObject) (presenter.GetDataExportResponseObject, error) {
+	export, err := h.dataExportUseCase.GetExport(currentUserID(ctx), request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.GetDataExport200JSONResponse{DataExportResponseJSONResponse: presenter.DataExportResponseJSONResponse(*dataExportToResponse(export))}, nil
+}
+
+// DownloadDataExport はアーカイブを返す。ファイルはレスポンスの書き込み後に閉じる
+func (h *AccountDataHandler) DownloadDataExport(ctx context.Context, request presenter.DownloadDataExportRequestObject) (presenter.DownloadDataExportResponseObject, error) {
+	export, file, err := h.dataExportUseCase.OpenExport(currentUserID(ctx), request.Id)
+	if errors.Is(err, usecase.ErrExportExpired) {
+		return nil, echo.NewHTTPError(http.StatusGone, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	filename := fmt.Sprintf("household-account-export-%s.zip", export.CreatedAt.Format("20060102"))
+	return presenter.DownloadDataExport200ApplicationzipResponse{
+		Body:          file,
+		ContentLength: export.FileSize,
+		Headers: presenter.DownloadDataExport200ResponseHeaders{
+			CacheControl:       "no-store",
+			ContentDisposition: fmt.Sprintf(`attachment; filename="%s"`, filename),
+		},
+	}, nil
+}
+
+func (h *AccountDataHandler) DeleteCurrentUser(ctx context.Context, request presenter.DeleteCurrentUserRequestObject) (presenter.DeleteCurrentUserResponseObject, error) {
+	deletion, err := h.accountDeletionUseCase.RequestDeletion(currentUserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.DeleteCurrentUser202JSONResponse{AccountDeletionResponseJSONResponse: accountDeletionToResponse(deletion)}, nil
+}
+
+func (h *AccountDataHandler) ConfirmAccountDeletion(ctx context.Context, request presenter.ConfirmAccountDeletionRequestObject) (presenter.ConfirmAccountDeletionResponseObject, error) {
+	deletion, err := h.accountDeletionUseCase.ConfirmDeletion(request.Body.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.ConfirmAccountDeletion200JSONResponse{AccountDeletionResponseJSONResponse: accountDeletionToResponse(deletion)}, nil
+}
+
+func (h *AccountDataHandler) GetAccountDeletion(ctx context.Context, request presenter.GetAccountDeletionRequestObject) (presenter.GetAccountDeletionResponseObject, error) {
+	deletion, err := h.accountDeletionUseCase.GetDeletion(currentUserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.GetAccountDeletion200JSONResponse{AccountDeletionResponseJSONResponse: accountDeletionToResponse(deletion)}, nil
+}
+
+func (h *AccountDataHandler) CancelAccountDeletion(ctx context.Context, request presenter.CancelAccountDeletionRequestObject) (presenter.CancelAccountDeletionResponseObject, error) {
+	if err := h.accountDeletionUseCase.CancelDeletion(currentUserID(ctx)); err != nil {
+		return nil, err
+	}
+
+	return presenter.CancelAccountDeletion204Response{}, nil
+}
@@ -0,0 +1,176 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/oapi-codegen/runtime/types"
+
+	"household-account-backend/adapter/controller/echo/presenter"
+	"household-account-backend/entity"
+	"household-account-backend/pkg/logger"
+	"household-account-backend/usecase"
+)
+
+// AdminHandler は運用者向けのユーザー管理を扱う
+// ルーターで管理者のロールを要求するミドルウェアを設定する
+type AdminHandler struct {
+	adminUseCase usecase.AdminUseCase
+}
+
+func NewAdminHandler(adminUseCase usecase.AdminUseCase) *AdminHandler {
+	return &AdminHandler{
+		adminUseCase: adminUseCase,
+	}
+}
+
+func adminUserToResponse(user *entity.User) *presenter.AdminUserResponse {
+	return &presenter.AdminUserResponse{
+		Id:            user.ID,
+		Email:         types.Email(user.Email),
+		Name:          user.Name,
+		EmailVerified: user.EmailVerified(),
+		Role:          presenter.UserRole(user.Role),
+		Disabled:      user.Disabled(),
+		DisabledAt:    user.DisabledAt,
+		CreatedAt:     user.CreatedAt,
+	}
+}
+
+// adminActor は操作した管理者を監査ログに記録するために返す
+func adminActor(ctx context.Context) usecase.AdminActor {
+	return usecase.AdminActor{
+		UserID:    currentUserID(ctx),
+		IPAddress: clientIP(ctx),
+	}
+}
+
+// intValue は省略可能なパラメータの値を返す。指定がない場合は0を返す
+func intValue(value *int) int {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
+
+func (h *AdminHandler) AdminSearchUsers(ctx context.Context, request presenter.AdminSearchUsersRequestObject) (presenter.AdminSearchUsersResponseObject, error) {
+	query := usecase.UserSearchQuery{
+		Limit:  intValue(request.Params.Limit),
+		Offset: intValue(request.Params.Offset),
+	}
+	if request.Params.Q != nil {
+		query.Query = *request.Params.Q
+	}
+	if request.Params.Role != nil {
+		query.Role = string(*request.Params.Role)
+	}
+	if request.Params.Status != nil {
+		query.Status = string(*request.Params.Status)
+	}
+
+	users, total, err := h.adminUseCase.SearchUsers(query)
+	if err != nil {
+		return nil, err
+	}
+
+	response := presenter.AdminUsersResponseJSONResponse{Users: []presenter.AdminUserRequest{}, Total: total}
+	for _, user := range users {
+		response.Users = append(response.Users, *adminUserToResponse(&user))
+	}
+	return presenter.AdminSearchUsers200JSONResponse{AdminUsersResponseJSONResponse: response}, nil
+}
+
+func (h *AdminHandler) AdminGetUser(ctx context.Context, request presenter.AdminGetUserRequestObject) (presenter.AdminGetUserResponseObject, error) {
+	user, err := h.adminUseCase.GetUser(request.Id)
+	if err != nil {
+		return nil, err
+	}
+	return presenter.AdminGetUser200JSONResponse{AdminUserResponseJSONResponse: presenter.AdminUserResponseJSONResponse(*adminUserToResponse(user))}, nil
+}
+
+func (h *AdminHandler) AdminDisableUser(ctx context.Context, request presenter.AdminDisableUserRequestObject) (presenter.AdminDisableUserResponseObject, error) {
+	user, err := h.adminUseCase.DisableUser(adminActor(ctx), request.Id)
+	if err != nil {
+		return nil, err
+	}
+	return presenter.AdminDisableUser200JSONResponse{AdminUserResponseJSONResponse: presenter.AdminUserResponseJSONResponse(*adminUserToResponse(user))}, nil
+}
+
+func (h *AdminHandler) AdminEnableUser(ctx context.Context, request presenter.AdminEnableUserRequestObject) (presenter.AdminEnableUserResponseObject, error) {
+	user, err := h.adminUseCase.EnableUser(adminActor(ctx), request.Id)
+	if err != nil {
+		return nil, err
+	}
+	return presenter.AdminEnableUser200JSONResponse{AdminUserResponseJSONResponse: presenter.AdminUserResponseJSONResponse(*adminUserToResponse(user))}, nil
+}
+
+func (h *AdminHandler) AdminForceLogout(ctx context.Context, request presenter.AdminForceLogoutRequestObject) (presenter.AdminForceLogoutResponseObject, error) {
+	if err := h.adminUseCase.ForceLogout(adminActor(ctx), request.Id); err != nil {
+		return nil, err
+	}
+	return presenter.AdminForceLogout204Response{}, nil
+}
+
+func (h *AdminHandler) AdminUpdateUserRole(ctx context.Context, request presenter.AdminUpdateUserRoleRequestObject) (presenter.AdminUpdateUserRoleResponseObject, error) {
+	user, err := h.adminUseCase.UpdateRole(adminActor(ctx), request.Id, string(request.Body.Role))
+	if err != nil {
+		return nil, err
+	}
+	return presenter.AdminUpdateUserRole200JSONResponse{AdminUserResponseJSONResponse: presenter.AdminUserResponseJSONResponse(*adminUserToResponse(user))}, nil
+}
+
+func (h *AdminHandler) AdminImpersonateUser(ctx context.Context, request presenter.AdminImpersonateUserRequestObject) (presenter.AdminImpersonateUserResponseObject, error) {
+	actor := adminActor(ctx)
+	result, err := h.adminUseCase.Impersonate(actor, request.Id, request.Body.Reason)
+	if err != nil {
+		return nil, err
+	}
+	logger.Warn("administrator started impersonation", "admin_id", actor.UserID, "user_id", request.Id)
+
+	return presenter.AdminImpersonateUser200JSONResponse{ImpersonationResponseJSONResponse: presenter.ImpersonationResponseJSONResponse{
+		Body: presenter.ImpersonationRequest{
+			User:      *adminUserToResponse(result.User),
+			ExpiresAt: result.ExpiresAt,
+		},
+		Headers: presenter.ImpersonationResponseResponseHeaders{SetCookie: authCookieUntil(result.AuthToken, result.ExpiresAt).String()},
+	}}, nil
+}
+
+func (h *AdminHandler) AdminGetSystemStats(ctx context.Context, request presenter.AdminGetSystemStatsRequestObject) (presenter.AdminGetSystemStatsResponseObject, error) {
+	stats, err := h.adminUseCase.GetSystemStats()
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.AdminGetSystemStats200JSONResponse{SystemStatsResponseJSONResponse: presenter.SystemStatsResponseJSONResponse{
+		UserCount:               stats.UserCount,
+		DisabledUserCount:       stats.DisabledUserCount,
+		AdminCount:              stats.AdminCount,
+		NewUserCount:            stats.NewUserCount,
+		TransactionCount:        stats.TransactionCount,
+		TransactionAmount:       stats.TransactionAmount,
+		RecentTransactionCount:  stats.RecentTransactionCount,
+		RecentTransactionAmount: stats.RecentTransactionAmount,
+		Since:                   stats.Since,
+	}}, nil
+}
+
+func (h *AdminHandler) AdminGetAuditLogs(ctx context.Context, request presenter.AdminGetAuditLogsRequestObject) (presenter.AdminGetAuditLogsResponseObject, error) {
+	logs, err := h.adminUseCase.GetAuditLogs(intValue(request.Params.UserId), intValue(request.Params.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	response := presenter.AdminGetAuditLogs200JSONResponse{}
+	for _, log := range logs {
+		response = append(response, presenter.AdminAuditLogRequest{
+			Id:           log.ID,
+			AdminId:      log.AdminID,
+			Action:       presenter.AdminAuditLogRequestAction(log.Action),
+			TargetUserId: log.TargetUserID,
+			Detail:       log.Detail,
+			IpAddress:    log.IPAddress,
+			CreatedAt:    log.CreatedAt,
+		})
+	}
+	return response, nil
+}
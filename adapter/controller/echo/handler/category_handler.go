@@ -1,11 +1,7 @@
 package handler
 
 import (
-	"net/http"
-	"strconv"
-
-	"github.com/golang-jwt/jwt/v4"
-	"github.com/labstack/echo/v4"
+	"context"
 
 	"household-account-backend/adapter/controller/echo/presenter"
 	"household-account-backend/entity"
@@ -24,113 +20,89 @@ func NewCategoryHandler(categoryUseCase usecase.CategoryUseCase) *CategoryHandle
 
 func categoryToResponse(category *entity.Category) *presenter.CategoryResponse {
 	return &presenter.CategoryResponse{
-		Id:     category.ID,
-		Name:   category.Name,
-		Type:   presenter.CategoryRequestType(category.Type),
+		Id:      category.ID,
+		Name:    category.Name,
+		Type:    presenter.CategoryRequestType(category.Type),
+		Version: category.Version,
 	}
 }
 
-func (h *CategoryHandler) CreateCategory(c echo.Context) error {
-	user := c.Get("user").(*jwt.Token)
-	claims := user.Claims.(jwt.MapClaims)
-	userId := int(claims["user_id"].(float64))
-
-	var requestBody presenter.CreateCategoryJSONRequestBody
-	if err := c.Bind(&requestBody); err != nil {
-		return c.JSON(http.StatusBadRequest, &presenter.ErrorResponse{Message: "Invalid request body"})
+// categoryResponse はETagヘッダーにバージョンを設定したカテゴリーのレスポンスを返す
+func categoryResponse(category *entity.Category) presenter.CategoryResponseJSONResponse {
+	return presenter.CategoryResponseJSONResponse{
+		Body:    *categoryToResponse(category),
+		Headers: presenter.CategoryResponseResponseHeaders{ETag: etag(category.Version)},
 	}
+}
 
+func (h *CategoryHandler) CreateCategory(ctx context.Context, request presenter.CreateCategoryRequestObject) (presenter.CreateCategoryResponseObject, error) {
 	category := &entity.Category{
-		UserID: userId,
-		Name:   requestBody.Name,
-		Type:   string(requestBody.Type),
+		UserID: currentUserID(ctx),
+		Name:   request.Body.Name,
+		Type:   string(request.Body.Type),
 	}
-	
+
 	createdCategory, err := h.categoryUseCase.CreateCategory(category)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, &presenter.ErrorResponse{Message: err.Error()})
+		return nil, err
 	}
 
-	return c.JSON(http.StatusCreated, categoryToResponse(createdCategory))
+	return presenter.CreateCategory201JSONResponse{CategoryResponseJSONResponse: categoryResponse(createdCategory)}, nil
 }
 
-func (h *CategoryHandler) GetCategoriesByUserID(c echo.Context) error {
-	user := c.Get("user").(*jwt.Token)
-	claims := user.Claims.(jwt.MapClaims)
-	userId := int(claims["user_id"].(float64))
-
-	createdCategory, err := h.categoryUseCase.GetCategoriesByUserID(userId)
+func (h *CategoryHandler) GetCategories(ctx context.Context, request presenter.GetCategoriesRequestObject) (presenter.GetCategoriesResponseObject, error) {
+	categories, err := h.categoryUseCase.GetCategoriesByUserID(currentUserID(ctx))
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, &presenter.ErrorResponse{Message: err.Error()})
+		return nil, err
 	}
 
-	return c.JSON(http.StatusOK, createdCategory)
-}
-
-func (h *CategoryHandler) GetCategoryByID(c echo.Context) error {
-	user := c.Get("user").(*jwt.Token)
-	claims := user.Claims.(jwt.MapClaims)
-	userId := int(claims["user_id"].(float64))
-
-	idParam := c.Param("id")
-	categoryId, err := strconv.Atoi(idParam)
-	if err != nil {
-		return c.JSON(http.StatusBadRequest, &presenter.ErrorResponse{Message: "Invalid category ID"})
+	response := presenter.GetCategories200JSONResponse{}
+	for _, category := range categories {
+		response = append(response, *categoryToResponse(&category))
 	}
+	return response, nil
+}
 
-	category, err := h.categoryUseCase.GetCategoryByID(userId, categoryId)
+func (h *CategoryHandler) GetCategoryById(ctx context.Context, request presenter.GetCategoryByIdRequestObject) (presenter.GetCategoryByIdResponseObject, error) {
+	category, err := h.categoryUseCase.GetCategoryByID(currentUserID(ctx), request.Id)
 	if err != nil {
-		return c.JSON(http.StatusNotFound, &presenter.ErrorResponse{Message: err.Error()})
+		return nil, err
 	}
 
-	return c.JSON(http.StatusOK, categoryToResponse(category))
+	return presenter.GetCategoryById200JSONResponse{CategoryResponseJSONResponse: categoryResponse(category)}, nil
 }
 
-func (h *CategoryHandler) UpdateCategory(c echo.Context) error {
-	user := c.Get("user").(*jwt.Token)
-	claims := user.Claims.(jwt.MapClaims)
-	userId := int(claims["user_id"].(float64))
-
-	idParam := c.Param("id")
-	categoryId, err := strconv.Atoi(idParam)
+func (h *CategoryHandler) UpdateCategoryById(ctx context.Context, request presenter.UpdateCategoryByIdRequestObject) (presenter.UpdateCategoryByIdResponseObject, error) {
+	version, err := parseIfMatch(request.Params.IfMatch)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, &presenter.ErrorResponse{Message: "Invalid category ID"})
-	}
-
-	var requestBody presenter.UpdateCategoryByIdJSONRequestBody
-	if err := c.Bind(&requestBody); err != nil {
-		return c.JSON(http.StatusBadRequest, &presenter.ErrorResponse{Message: "Invalid request body"})
+		return nil, err
 	}
 
 	category := &entity.Category{
-		ID:   categoryId,
-		UserID: userId,
-		Name: requestBody.Name,
-		Type: string(requestBody.Type),
+		ID:      request.Id,
+		UserID:  currentUserID(ctx),
+		Name:    request.Body.Name,
+		Type:    string(request.Body.Type),
+		Version: version,
 	}
 
 	updatedCategory, err := h.categoryUseCase.UpdateCategory(category)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, &presenter.ErrorResponse{Message: err.Error()})
+		return nil, err
 	}
 
-	return c.JSON(http.StatusOK, categoryToResponse(updatedCategory))
+	return presenter.UpdateCategoryById200JSONResponse{CategoryResponseJSONResponse: categoryResponse(updatedCategory)}, nil
 }
 
-func (h *CategoryHandler) DeleteCategory(c echo.Context) error {
-	user := c.Get("user").(*jwt.Token)
-	claims := user.Claims.(jwt.MapClaims)
-	userId := int(claims["user_id"].(float64))
-
-	idParam := c.Param("id")
-	categoryId, err := strconv.Atoi(idParam)
+func (h *CategoryHandler) DeleteCategoryById(ctx context.Context, request presenter.DeleteCategoryByIdRequestObject) (presenter.DeleteCategoryByIdResponseObject, error) {
+	version, err := parseIfMatch(request.Params.IfMatch)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, &presenter.ErrorResponse{Message: "Invalid category ID"})
+		return nil, err
 	}
 
-	if err := h.categoryUseCase.DeleteCategory(userId, categoryId); err != nil {
-		return c.JSON(http.StatusInternalServerError, &presenter.ErrorResponse{Message: err.Error()})
+	if err := h.categoryUseCase.DeleteCategory(currentUserID(ctx), request.Id, version); err != nil {
+		return nil, err
 	}
 
-	return c.NoContent(http.StatusNoContent)
+	return presenter.DeleteCategoryById204Response{}, nil
 }
@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/labstack/echo/v4"
+
+	"household-account-backend/adapter/controller/echo/presenter"
+)
+
+// contextKey はハンドラーが参照するリクエストの情報をcontext.Contextに入れるキー
+type contextKey int
+
+const (
+	userIDContextKey contextKey = iota
+	clientIPContextKey
+	csrfTokenContextKey
+)
+
+// RequestInfo はミドルウェアが認証などで得た、ハンドラーが参照するリクエストの情報
+type RequestInfo struct {
+	ClientIP string
+	// UserID は認証したユーザーのID。認証していない場合は0
+	UserID    int
+	CSRFToken string
+}
+
+// NewRequestContext はハンドラーが受け取るcontext.ContextにRequestInfoを入れる
+// echo以外のサーバーからServerのハンドラーを呼ぶ場合も、この関数で同じ値を渡す
+func NewRequestContext(ctx context.Context, info RequestInfo) context.Context {
+	ctx = context.WithValue(ctx, clientIPContextKey, info.ClientIP)
+	if info.UserID != 0 {
+		ctx = context.WithValue(ctx, userIDContextKey, info.UserID)
+	}
+	if info.CSRFToken != "" {
+		ctx = context.WithValue(ctx, csrfTokenContextKey, info.CSRFToken)
+	}
+	return ctx
+}
+
+// RequestContextMiddleware はミドルウェアがechoのコンテキストに設定した値を、ハンドラーが受け取るcontext.Contextに移す
+// StrictServerInterfaceのハンドラーはechoのコンテキストを受け取らないため、認証したユーザーなどはこの値を参照する
+func RequestContextMiddleware(f presenter.StrictHandlerFunc, operationID string) presenter.StrictHandlerFunc {
+	return func(c echo.Context, request interface{}) (interface{}, error) {
+		info := RequestInfo{ClientIP: c.RealIP()}
+		info.UserID, _ = authenticatedUserID(c)
+		info.CSRFToken, _ = c.Get("csrf").(string)
+		c.SetRequest(c.Request().WithContext(NewRequestContext(c.Request().Context(), info)))
+		return f(c, request)
+	}
+}
+
+// authenticatedUserID はJWTミドルウェアが設定したトークンからユーザーのIDを返す
+func authenticatedUserID(c echo.Context) (int, bool) {
+	token, ok := c.Get("user").(*jwt.Token)
+	if !ok {
+		return 0, false
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return 0, false
+	}
+	userID, ok := claims["user_id"].(float64)
+	return int(userID), ok
+}
+
+// currentUserID は認証したユーザーのIDを返す。認証を要求しない操作では0になる
+func currentUserID(ctx context.Context) int {
+	userID, _ := ctx.Value(userIDContextKey).(int)
+	return userID
+}
+
+// clientIP はリクエストの送信元のIPアドレスを返す
+func clientIP(ctx context.Context) string {
+	ip, _ := ctx.Value(clientIPContextKey).(string)
+	return ip
+}
+
+// csrfToken はCSRFミドルウェアが発行したトークンを返す
+func csrfToken(ctx context.Context) string {
+	token, _ := ctx.Value(csrfTokenContextKey).(string)
+	return token
+}
@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"household-account-backend/adapter/controller/echo/presenter"
+	"household-account-backend/pkg/logger"
+	"household-account-backend/usecase"
+)
+
+const (
+	// ProblemContentType はRFC 7807のエラーレスポンスのContent-Type
+	ProblemContentType = "application/problem+json"
+	// problemTypeBlank は種類を個別に定義しないエラーのtype。titleはステータスの説明になる
+	problemTypeBlank = "about:blank"
+)
+
+// HTTPErrorHandler はハンドラーやミドルウェアが返したエラーをRFC 7807のproblem+jsonで返す
+// ユースケースのドメインエラーは種類に応じたステータスに変換する
+// それ以外のエラーは内部の情報を返さないよう詳細を含めずに500とし、ログに記録する
+func HTTPErrorHandler(err error, c echo.Context) {
+	if c.Response().Committed {
+		return
+	}
+
+	problem := NewProblem(err)
+	instance := c.Request().URL.Path
+	problem.Instance = &instance
+	if problem.Status >= http.StatusInternalServerError {
+		logger.Error("request failed", "method", c.Request().Method, "path", instance, "error", err.Error())
+	}
+
+	if c.Request().Method == http.MethodHead {
+		err = c.NoContent(problem.Status)
+	} else {
+		var body []byte
+		body, err = json.Marshal(problem)
+		if err == nil {
+			err = c.Blob(problem.Status, ProblemContentType, body)
+		}
+	}
+	if err != nil {
+		logger.Error("failed to write error response", "error", err.Error())
+	}
+}
+
+// NewProblem はエラーの種類からステータスと詳細を決める
+// ginのサーバーも同じハンドラーのエラーを同じレスポンスにするために使う
+func NewProblem(err error) *presenter.Problem {
+	var (
+		httpError    *echo.HTTPError
+		validation   *usecase.ValidationError
+		notFound     *usecase.NotFoundError
+		conflict     *usecase.ConflictError
+		unauthorized *usecase.UnauthorizedError
+		forbidden    *usecase.ForbiddenError
+	)
+
+	status := http.StatusInternalServerError
+	detail := ""
+	var fields []presenter.FieldError
+	switch {
+	case errors.As(err, &httpError):
+		status = httpError.Code
+		detail = httpErrorMessage(httpError)
+		if httpError.Internal != nil && status < http.StatusInternalServerError {
+			logger.Warn(httpError.Internal.Error())
+		}
+	case errors.Is(err, usecase.ErrVersionConflict):
+		// If-Matchで指定されたバージョンが最新でないため、前提条件の失敗として返す
+		status, detail = http.StatusPreconditionFailed, err.Error()
+	case errors.As(err, &validation):
+		status, detail = http.StatusUnprocessableEntity, validation.Message
+		for _, field := range validation.Fields {
+			fields = append(fields, presenter.FieldError{Field: field.Field, Message: field.Message})
+		}
+	case errors.As(err, &notFound):
+		status, detail = http.StatusNotFound, notFound.Message
+	case errors.As(err, &conflict):
+		status, detail = http.StatusConflict, conflict.Message
+	case errors.As(err, &unauthorized):
+		status, detail = http.StatusUnauthorized, unauthorized.Message
+	case errors.As(err, &forbidden):
+		status, detail = http.StatusForbidden, forbidden.Message
+	}
+
+	problem := &presenter.Problem{
+		Type:   problemTypeBlank,
+		Title:  http.StatusText(status),
+		Status: status,
+	}
+	if detail != "" {
+		problem.Detail = &detail
+	}
+	if len(fields) > 0 {
+		problem.Errors = &fields
+	}
+	return problem
+}
+
+func httpErrorMessage(httpError *echo.HTTPError) string {
+	if message, ok := httpError.Message.(string); ok {
+		return message
+	}
+	if httpError.Message == nil {
+		return ""
+	}
+	return fmt.Sprint(httpError.Message)
+}
@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+var (
+	errIfMatchRequired = echo.NewHTTPError(http.StatusPreconditionRequired, "If-Match header is required")
+	errInvalidIfMatch  = echo.NewHTTPError(http.StatusBadRequest, "invalid If-Match header")
+	errWeakIfMatch     = echo.NewHTTPError(http.StatusPreconditionFailed, "weak ETags never match If-Match")
+	// 一括操作ではIf-Matchの代わりに操作ごとのversionで前提となるバージョンを指定する
+	errBulkVersionRequired = echo.NewHTTPError(http.StatusBadRequest, "version is required for update and delete operations")
+)
+
+// etag はリソースのバージョンをETagヘッダーの値にする
+func etag(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
+
+// parseIfMatch はIf-Matchヘッダーの値から更新・削除の前提となるバージョンを取得する
+// "*" が指定された場合はバージョンを問わないため0を返す
+// If-Matchは強い比較のため、弱いETag(W/)は一致しないものとして412を返す
+func parseIfMatch(ifMatch string) (int, error) {
+	value := strings.TrimSpace(ifMatch)
+	if value == "" {
+		return 0, errIfMatchRequired
+	}
+	if value == "*" {
+		return 0, nil
+	}
+
+	if strings.HasPrefix(value, "W/") {
+		return 0, errWeakIfMatch
+	}
+	unquoted, err := strconv.Unquote(value)
+	if err != nil {
+		return 0, errInvalidIfMatch
+	}
+	version, err := strconv.Atoi(unquoted)
+	if err != nil || version <= 0 {
+		return 0, errInvalidIfMatch
+	}
+	return version, nil
+}
@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"household-account-backend/adapter/controller/echo/presenter"
+	"household-account-backend/entity"
+	"household-account-backend/usecase"
+)
+
+// eventStreamSentWindowSize は重複して送らないよう送信済みとして覚えておくイベント数
+const eventStreamSentWindowSize = 2 * usecase.MaxEventStreamReplay
+
+type EventStreamHandler struct {
+	eventStreamUseCase usecase.EventStreamUseCase
+	heartbeatInterval  time.Duration
+}
+
+func NewEventStreamHandler(eventStreamUseCase usecase.EventStreamUseCase, heartbeatInterval time.Duration) *EventStreamHandler {
+	return &EventStreamHandler{
+		eventStreamUseCase: eventStreamUseCase,
+		heartbeatInterval:  heartbeatInterval,
+	}
+}
+
+// StreamEvents はユーザーのデータ変更イベントをServer-Sent Eventsで配信する
+func (h *EventStreamHandler) StreamEvents(ctx context.Context, request presenter.StreamEventsRequestObject) (presenter.StreamEventsResponseObject, error) {
+	lastEventId := 0
+	if request.Params.LastEventID != nil {
+		lastEventId = *request.Params.LastEventID
+	}
+
+	subscription, err := h.eventStreamUseCase.Subscribe(currentUserID(ctx), lastEventId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &eventStreamResponse{
+		ctx:               ctx,
+		subscription:      subscription,
+		heartbeatInterval: h.heartbeatInterval,
+	}, nil
+}
+
+// eventStreamResponse は接続が切れるまでイベントを書き込み続ける
+// 生成されたレスポンスはボディを一度にコピーするため、イベントごとにフラッシュするよう独自に実装する
+type eventStreamResponse struct {
+	ctx               context.Context
+	subscription      *usecase.EventSubscription
+	heartbeatInterval time.Duration
+}
+
+func (response *eventStreamResponse) VisitStreamEventsResponse(w http.ResponseWriter) error {
+	subscription := response.subscription
+	defer subscription.Unsubscribe()
+
+	flush := func() {
+		if flusher, ok := w.(http.Flusher); ok {
+			flusher.Flush()
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	// リバースプロキシでのバッファリングを無効化する
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flush()
+
+	// IDはコミット順ではないため、IDの大小ではなく送信済みかどうかで重複を判定する
+	sent := newSentEventWindow(eventStreamSentWindowSize)
+	if subscription.Reset {
+		if _, err := fmt.Fprintf(w, "id: %d\nevent: reset\ndata: {}\n\n", subscription.ResetEventID); err != nil {
+			return nil
+		}
+	}
+	for _, event := range subscription.Replay {
+		if err := writeServerSentEvent(w, &event); err != nil {
+			return nil
+		}
+		sent.add(event.ID)
+	}
+	flush()
+
+	heartbeat := time.NewTicker(response.heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-response.ctx.Done():
+			return nil
+		case event, ok := <-subscription.Events:
+			if !ok {
+				// 配信が追いつかず購読が解除された。クライアントはLast-Event-IDで再接続する
+				return nil
+			}
+			// 再送済みのイベントは送らない
+			if !sent.add(event.ID) {
+				continue
+			}
+			if err := writeServerSentEvent(w, &event); err != nil {
+				return nil
+			}
+			flush()
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return nil
+			}
+			flush()
+		}
+	}
+}
+
+func writeServerSentEvent(w io.Writer, event *entity.OutboxEvent) error {
+	_, err := fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, event.Payload)
+	return err
+}
+
+// sentEventWindow は直近に送信したイベントのIDを上限の件数まで保持する
+type sentEventWindow struct {
+	ids   map[int]struct{}
+	order []int
+	size  int
+}
+
+func newSentEventWindow(size int) *sentEventWindow {
+	return &sentEventWindow{ids: make(map[int]struct{}, size), size: size}
+}
+
+// add はIDを送信済みとして記録する。既に送信済みの場合はfalseを返す
+func (window *sentEventWindow) add(id int) bool {
+	if _, ok := window.ids[id]; ok {
+		return false
+	}
+	if len(window.order) >= window.size {
+		delete(window.ids, window.order[0])
+		window.order = window.order[1:]
+	}
+	window.ids[id] = struct{}{}
+	window.order = append(window.order, id)
+	return true
+}
@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"household-account-backend/adapter/controller/graphql"
+)
+
+// GraphQLHandler はGraphQLのクエリを実行する
+// エラーはGraphQLの形式でレスポンスのerrorsに含めるため、クエリを実行できた場合は常に200を返す
+type GraphQLHandler struct {
+	executor *graphql.Executor
+}
+
+func NewGraphQLHandler(executor *graphql.Executor) *GraphQLHandler {
+	return &GraphQLHandler{executor: executor}
+}
+
+func (h *GraphQLHandler) Query(c echo.Context) error {
+	userID, ok := authenticatedUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Authentication required")
+	}
+
+	var request graphql.Request
+	if err := json.NewDecoder(c.Request().Body).Decode(&request); err != nil || request.Query == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "Request body must be a JSON object with a query")
+	}
+
+	return c.JSON(http.StatusOK, h.executor.Execute(c.Request().Context(), userID, request))
+}
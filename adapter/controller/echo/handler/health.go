@@ -4,10 +4,32 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+
+	"household-account-backend/usecase"
 )
 
+// Health はプロセスが動いているかを返す。依存するサービスは確認しない
 func Health(c echo.Context) error {
 	return c.JSON(http.StatusOK, &struct {
 		Status string `json:"status"`
 	}{Status: "ok"})
-}
\ No newline at end of file
+}
+
+type HealthHandler struct {
+	healthUseCase usecase.HealthUseCase
+}
+
+func NewHealthHandler(healthUseCase usecase.HealthUseCase) *HealthHandler {
+	return &HealthHandler{healthUseCase: healthUseCase}
+}
+
+// Ready はデータベースに接続できる場合に200、できない場合は503を返す
+// ロードバランサーは準備ができていないサーバーにリクエストを送らないようにする
+func (h *HealthHandler) Ready(c echo.Context) error {
+	readiness := h.healthUseCase.Readiness(c.Request().Context())
+	status := http.StatusOK
+	if !readiness.Ready() {
+		status = http.StatusServiceUnavailable
+	}
+	return c.JSON(status, readiness)
+}
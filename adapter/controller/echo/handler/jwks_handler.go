@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"context"
+
+	"household-account-backend/adapter/controller/echo/presenter"
+	"household-account-backend/pkg/jwtkeys"
+)
+
+type JWKSHandler struct {
+	keyManager *jwtkeys.KeyManager
+}
+
+func NewJWKSHandler(keyManager *jwtkeys.KeyManager) *JWKSHandler {
+	return &JWKSHandler{
+		keyManager: keyManager,
+	}
+}
+
+// GetJWKS は他のサービスが認証トークンを検証するための公開鍵を返す
+func (h *JWKSHandler) GetJWKS(ctx context.Context, request presenter.GetJWKSRequestObject) (presenter.GetJWKSResponseObject, error) {
+	response := presenter.JWKSResponse{Keys: []presenter.JSONWebKey{}}
+	for _, key := range h.keyManager.JWKS() {
+		jwk := presenter.JSONWebKey{
+			Kty: presenter.JSONWebKeyKty(key.Kty),
+			Kid: key.Kid,
+			Use: key.Use,
+			Alg: presenter.JSONWebKeyAlg(key.Alg),
+		}
+		if key.N != "" {
+			jwk.N = &key.N
+			jwk.E = &key.E
+		}
+		if key.X != "" {
+			jwk.Crv = &key.Crv
+			jwk.X = &key.X
+		}
+		response.Keys = append(response.Keys, jwk)
+	}
+
+	// 鍵のローテーション後も古い鍵をしばらく公開するため、短時間だけキャッシュさせる
+	return presenter.GetJWKS200JSONResponse{JWKSResponseJSONResponse: presenter.JWKSResponseJSONResponse{
+		Body:    response,
+		Headers: presenter.JWKSResponseResponseHeaders{CacheControl: "public, max-age=300"},
+	}}, nil
+}
@@ -1,15 +1,10 @@
 package handler
 
 import (
-	"net/http"
-	"strconv"
-
-	"github.com/golang-jwt/jwt/v4"
-	"github.com/labstack/echo/v4"
+	"context"
 
 	"household-account-backend/adapter/controller/echo/presenter"
 	"household-account-backend/entity"
-	"household-account-backend/pkg/logger"
 	"household-account-backend/usecase"
 )
 
@@ -25,125 +20,98 @@ func NewMonthlySummaryHandler(monthlySummaryUseCase usecase.MonthlySummaryUseCas
 
 func monthlySummaryToResponse(summary *entity.MonthlySummary) *presenter.MonthlySummaryResponse {
 	return &presenter.MonthlySummaryResponse{
-		Id:         summary.ID,
-		YearMonth:  summary.YearMonth,
-		Income:     float32(summary.Income),
-		Expense:    float32(summary.Expense),
-		Balance:    float32(summary.Balance),
+		Id:        summary.ID,
+		YearMonth: summary.YearMonth,
+		Income:    float32(summary.Income),
+		Expense:   float32(summary.Expense),
+		Balance:   float32(summary.Balance),
+		Version:   summary.Version,
 	}
 }
 
-func (h *MonthlySummaryHandler) CreateMonthlySummary(c echo.Context) error {
-	user := c.Get("user").(*jwt.Token)
-	claims := user.Claims.(jwt.MapClaims)
-	userId := int(claims["user_id"].(float64))
-
-	var requestBody presenter.CreateMonthlySummaryJSONRequestBody
-	if err := c.Bind(&requestBody); err != nil {
-		logger.Warn(err.Error())
-		return c.JSON(http.StatusBadRequest, &presenter.ErrorResponse{Message: err.Error()})
+// monthlySummaryResponse はETagヘッダーにバージョンを設定した月次集計のレスポンスを返す
+func monthlySummaryResponse(summary *entity.MonthlySummary) presenter.MonthlySummaryResponseJSONResponse {
+	return presenter.MonthlySummaryResponseJSONResponse{
+		Body:    *monthlySummaryToResponse(summary),
+		Headers: presenter.MonthlySummaryResponseResponseHeaders{ETag: etag(summary.Version)},
 	}
+}
 
+func (h *MonthlySummaryHandler) CreateMonthlySummary(ctx context.Context, request presenter.CreateMonthlySummaryRequestObject) (presenter.CreateMonthlySummaryResponseObject, error) {
 	// Balance = Income - Expense
 	// requestBody.Balance = requestBody.Income - requestBody.Expense
 	// usecaseで計算するように変更?
 	summary := &entity.MonthlySummary{
-		UserID:    userId,
-		YearMonth: requestBody.YearMonth,
-		Income:    float64(requestBody.Income),
-		Expense:   float64(requestBody.Expense),
-		Balance:   float64(requestBody.Balance),
+		UserID:    currentUserID(ctx),
+		YearMonth: request.Body.YearMonth,
+		Income:    float64(request.Body.Income),
+		Expense:   float64(request.Body.Expense),
+		Balance:   float64(request.Body.Balance),
 	}
 
 	createdSummary, err := h.monthlySummaryUseCase.CreateMonthlySummary(summary)
 	if err != nil {
-		logger.Error(err.Error())
-		return c.JSON(http.StatusInternalServerError, &presenter.ErrorResponse{Message: err.Error()})
+		return nil, err
 	}
 
-	return c.JSON(http.StatusCreated, monthlySummaryToResponse(createdSummary))
+	return presenter.CreateMonthlySummary201JSONResponse{MonthlySummaryResponseJSONResponse: monthlySummaryResponse(createdSummary)}, nil
 }
 
-func (h *MonthlySummaryHandler) GetMonthlySummaryByID(c echo.Context) error {
-	user := c.Get("user").(*jwt.Token)
-	claims := user.Claims.(jwt.MapClaims)
-	userId := int(claims["user_id"].(float64))
-
-	monthlySummaryId, err := strconv.Atoi(c.Param("id"))
-	if err != nil {
-		return c.JSON(http.StatusBadRequest, &presenter.ErrorResponse{Message: "Invalid ID"})
-	}
-
-	summary, err := h.monthlySummaryUseCase.GetMonthlySummaryByID(userId, monthlySummaryId)
+func (h *MonthlySummaryHandler) GetMonthlySummaryById(ctx context.Context, request presenter.GetMonthlySummaryByIdRequestObject) (presenter.GetMonthlySummaryByIdResponseObject, error) {
+	summary, err := h.monthlySummaryUseCase.GetMonthlySummaryByID(currentUserID(ctx), request.Id)
 	if err != nil {
-		logger.Error(err.Error())
-		return c.JSON(http.StatusNotFound, &presenter.ErrorResponse{Message: "Monthly summary not found"})
+		return nil, err
 	}
 
-	return c.JSON(http.StatusOK, monthlySummaryToResponse(summary))
+	return presenter.GetMonthlySummaryById200JSONResponse{MonthlySummaryResponseJSONResponse: monthlySummaryResponse(summary)}, nil
 }
 
-func (h *MonthlySummaryHandler) GetMonthlySummariesByUserID(c echo.Context) error {
-	user := c.Get("user").(*jwt.Token)
-	claims := user.Claims.(jwt.MapClaims)
-	userId := int(claims["user_id"].(float64))
-
-	summaries, err := h.monthlySummaryUseCase.GetMonthlySummariesByUserID(userId)
+func (h *MonthlySummaryHandler) GetMonthlySummaries(ctx context.Context, request presenter.GetMonthlySummariesRequestObject) (presenter.GetMonthlySummariesResponseObject, error) {
+	summaries, err := h.monthlySummaryUseCase.GetMonthlySummariesByUserID(currentUserID(ctx))
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, &presenter.ErrorResponse{Message: err.Error()})
+		return nil, err
 	}
 
-	return c.JSON(http.StatusOK, summaries)
+	response := presenter.GetMonthlySummaries200JSONResponse{}
+	for _, summary := range summaries {
+		response = append(response, *monthlySummaryToResponse(&summary))
+	}
+	return response, nil
 }
 
-func (h *MonthlySummaryHandler) UpdateMonthlySummary(c echo.Context) error {
-	user := c.Get("user").(*jwt.Token)
-	claims := user.Claims.(jwt.MapClaims)
-	userId := int(claims["user_id"].(float64))
-
-	id, err := strconv.Atoi(c.Param("id"))
+func (h *MonthlySummaryHandler) UpdateMonthlySummaryById(ctx context.Context, request presenter.UpdateMonthlySummaryByIdRequestObject) (presenter.UpdateMonthlySummaryByIdResponseObject, error) {
+	version, err := parseIfMatch(request.Params.IfMatch)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, &presenter.ErrorResponse{Message: "Invalid ID"})
-	}
-
-	var requestBody presenter.UpdateMonthlySummaryByIdJSONRequestBody
-	if err := c.Bind(&requestBody); err != nil {
-		logger.Warn(err.Error())
-		return c.JSON(http.StatusBadRequest, &presenter.ErrorResponse{Message: "Invalid request format"})
+		return nil, err
 	}
 
 	summary := &entity.MonthlySummary{
-		ID:        id,
-		UserID:    userId,
-		Income:    float64(requestBody.Income),
-		Expense:   float64(requestBody.Expense),
-		Balance:   float64(requestBody.Balance),
-		YearMonth: requestBody.YearMonth,
+		ID:        request.Id,
+		UserID:    currentUserID(ctx),
+		Income:    float64(request.Body.Income),
+		Expense:   float64(request.Body.Expense),
+		Balance:   float64(request.Body.Balance),
+		YearMonth: request.Body.YearMonth,
+		Version:   version,
 	}
 
 	updatedSummary, err := h.monthlySummaryUseCase.UpdateMonthlySummary(summary)
 	if err != nil {
-		logger.Error(err.Error())
-		return c.JSON(http.StatusInternalServerError, &presenter.ErrorResponse{Message: "Failed to update monthly summary"})
+		return nil, err
 	}
 
-	return c.JSON(http.StatusOK, monthlySummaryToResponse(updatedSummary))
+	return presenter.UpdateMonthlySummaryById200JSONResponse{MonthlySummaryResponseJSONResponse: monthlySummaryResponse(updatedSummary)}, nil
 }
 
-func (h *MonthlySummaryHandler) DeleteMonthlySummary(c echo.Context) error {
-	user := c.Get("user").(*jwt.Token)
-	claims := user.Claims.(jwt.MapClaims)
-	userId := int(claims["user_id"].(float64))
-
-	monthlySummaryId, err := strconv.Atoi(c.Param("id"))
+func (h *MonthlySummaryHandler) DeleteMonthlySummaryById(ctx context.Context, request presenter.DeleteMonthlySummaryByIdRequestObject) (presenter.DeleteMonthlySummaryByIdResponseObject, error) {
+	version, err := parseIfMatch(request.Params.IfMatch)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, &presenter.ErrorResponse{Message: "Invalid ID"})
+		return nil, err
 	}
 
-	if err := h.monthlySummaryUseCase.DeleteMonthlySummary(userId, monthlySummaryId); err != nil {
-		logger.Error(err.Error())
-		return c.JSON(http.StatusInternalServerError, &presenter.ErrorResponse{Message: "Failed to delete monthly summary"})
+	if err := h.monthlySummaryUseCase.DeleteMonthlySummary(currentUserID(ctx), request.Id, version); err != nil {
+		return nil, err
 	}
 
-	return c.NoContent(http.StatusNoContent)
+	return presenter.DeleteMonthlySummaryById204Response{}, nil
 }
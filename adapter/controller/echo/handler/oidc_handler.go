@@ -0,0 +1,183 @@
+package handler
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"household-account-backend/adapter/controller/echo/presenter"
+	"household-account-backend/pkg/logger"
+	"household-account-backend/usecase"
+)
+
+// oidcStateCookie は認可リクエストを開始したブラウザとコールバックを紐付けるCookie
+const oidcStateCookie = "oidc_state"
+
+type OIDCHandler struct {
+	oidcUseCase usecase.OIDCUseCase
+	frontendURL string
+}
+
+func NewOIDCHandler(oidcUseCase usecase.OIDCUseCase, frontendURL string) *OIDCHandler {
+	return &OIDCHandler{
+		oidcUseCase: oidcUseCase,
+		frontendURL: frontendURL,
+	}
+}
+
+// oidcCallbackResponse はフロントエンドへのリダイレクトを返す
+// 生成されたレスポンスはSet-Cookieを1つしか設定できないため、stateの削除と認証用Cookieを両方返すために独自に実装する
+type oidcCallbackResponse struct {
+	location string
+	cookies  []*http.Cookie
+}
+
+func (response oidcCallbackResponse) VisitHandleOIDCCallbackResponse(w http.ResponseWriter) error {
+	for _, cookie := range response.cookies {
+		http.SetCookie(w, cookie)
+	}
+	w.Header().Set("Location", response.location)
+	w.WriteHeader(http.StatusFound)
+	return nil
+}
+
+func (h *OIDCHandler) GetOIDCProviders(ctx context.Context, request presenter.GetOIDCProvidersRequestObject) (presenter.GetOIDCProvidersResponseObject, error) {
+	return presenter.GetOIDCProviders200JSONResponse{
+		OIDCProvidersResponseJSONResponse: presenter.OIDCProvidersResponseJSONResponse{Providers: h.oidcUseCase.Providers()},
+	}, nil
+}
+
+func (h *OIDCHandler) StartOIDCLogin(ctx context.Context, request presenter.StartOIDCLoginRequestObject) (presenter.StartOIDCLoginResponseObject, error) {
+	authorization, err := h.oidcUseCase.BeginAuthorization(ctx, request.Provider, 0)
+	if err != nil {
+		return nil, oidcAuthorizationError(err)
+	}
+
+	return presenter.StartOIDCLogin302Response{
+		Headers: presenter.StartOIDCLogin302ResponseHeaders{
+			Location:  authorization.URL,
+			SetCookie: newOIDCStateCookie(authorization.State, time.Now().Add(time.Hour)).String(),
+		},
+	}, nil
+}
+
+func (h *OIDCHandler) HandleOIDCCallback(ctx context.Context, request presenter.HandleOIDCCallbackRequestObject) (presenter.HandleOIDCCallbackResponseObject, error) {
+	provider := request.Provider
+	state := request.Params.State
+	// stateは1回だけ使えるよう、結果に関わらず削除する
+	clearState := newOIDCStateCookie("", time.Now().Add(-time.Hour))
+
+	// 他のブラウザで開始した認可リクエストのコールバックは受け付けない
+	if request.Params.OidcState == nil || state == "" || subtle.ConstantTimeCompare([]byte(*request.Params.OidcState), []byte(state)) != 1 {
+		return h.redirectToFrontend("/login", url.Values{"error": {"oidc_failed"}}, clearState), nil
+	}
+	if request.Params.Error != nil && *request.Params.Error != "" {
+		logger.Warn("oidc provider returned an error", "provider", provider, "error", *request.Params.Error)
+		return h.redirectToFrontend("/login", url.Values{"error": {"oidc_failed"}}, clearState), nil
+	}
+
+	code := ""
+	if request.Params.Code != nil {
+		code = *request.Params.Code
+	}
+	result, err := h.oidcUseCase.HandleCallback(ctx, provider, state, code)
+	if err != nil {
+		logger.Warn("oidc callback failed", "provider", provider, "error", err.Error())
+		return h.redirectToFrontend("/login", url.Values{"error": {oidcErrorCode(err)}}, clearState), nil
+	}
+
+	if result.Linked {
+		return h.redirectToFrontend("/settings", url.Values{"linked": {provider}}, clearState), nil
+	}
+	if result.Login.TOTPChallenge != "" {
+		return h.redirectToFrontend("/login/totp", url.Values{"challenge_token": {result.Login.TOTPChallenge}}, clearState), nil
+	}
+	return h.redirectToFrontend("/", nil, clearState, authCookie(result.Login.AuthToken)), nil
+}
+
+func (h *OIDCHandler) GetUserIdentities(ctx context.Context, request presenter.GetUserIdentitiesRequestObject) (presenter.GetUserIdentitiesResponseObject, error) {
+	identities, err := h.oidcUseCase.GetIdentities(currentUserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	response := presenter.GetUserIdentities200JSONResponse{}
+	for _, identity := range identities {
+		response = append(response, presenter.UserIdentityRequest{
+			Provider:  identity.Provider,
+			Email:     identity.Email,
+			CreatedAt: identity.CreatedAt,
+		})
+	}
+	return response, nil
+}
+
+func (h *OIDCHandler) LinkUserIdentity(ctx context.Context, request presenter.LinkUserIdentityRequestObject) (presenter.LinkUserIdentityResponseObject, error) {
+	authorization, err := h.oidcUseCase.BeginAuthorization(ctx, request.Provider, currentUserID(ctx))
+	if err != nil {
+		return nil, oidcAuthorizationError(err)
+	}
+
+	return presenter.LinkUserIdentity200JSONResponse{OIDCAuthorizationResponseJSONResponse: presenter.OIDCAuthorizationResponseJSONResponse{
+		Body:    presenter.OIDCAuthorizationRequest{AuthorizationUrl: authorization.URL},
+		Headers: presenter.OIDCAuthorizationResponseResponseHeaders{SetCookie: newOIDCStateCookie(authorization.State, time.Now().Add(time.Hour)).String()},
+	}}, nil
+}
+
+func (h *OIDCHandler) UnlinkUserIdentity(ctx context.Context, request presenter.UnlinkUserIdentityRequestObject) (presenter.UnlinkUserIdentityResponseObject, error) {
+	if err := h.oidcUseCase.UnlinkIdentity(currentUserID(ctx), request.Provider); err != nil {
+		return nil, err
+	}
+
+	return presenter.UnlinkUserIdentity204Response{}, nil
+}
+
+func (h *OIDCHandler) redirectToFrontend(path string, query url.Values, cookies ...*http.Cookie) oidcCallbackResponse {
+	location := h.frontendURL + path
+	if len(query) > 0 {
+		location += "?" + query.Encode()
+	}
+	return oidcCallbackResponse{location: location, cookies: cookies}
+}
+
+// oidcAuthorizationError はプロバイダの設定の取得に失敗した場合を502として返す
+func oidcAuthorizationError(err error) error {
+	if errors.Is(err, usecase.ErrUnknownOIDCProvider) {
+		return err
+	}
+	return echo.NewHTTPError(http.StatusBadGateway, "Failed to start authorization").SetInternal(err)
+}
+
+// oidcErrorCode はフロントエンドに渡すエラーコードを返す
+func oidcErrorCode(err error) string {
+	switch {
+	case errors.Is(err, usecase.ErrOIDCEmailNotVerified):
+		return "email_not_verified"
+	case errors.Is(err, usecase.ErrOIDCLinkRequired):
+		return "link_required"
+	case errors.Is(err, usecase.ErrIdentityAlreadyLinked):
+		return "already_linked"
+	case errors.Is(err, usecase.ErrAccountDisabled):
+		return "account_disabled"
+	}
+	return "oidc_failed"
+}
+
+func newOIDCStateCookie(state string, expires time.Time) *http.Cookie {
+	cookie := new(http.Cookie)
+	cookie.Name = oidcStateCookie
+	cookie.Value = state
+	cookie.Expires = expires
+	cookie.Path = "/api/v1/auth/oidc"
+	cookie.Domain = os.Getenv("API_DOMAIN")
+	cookie.HttpOnly = true
+	// プロバイダからのリダイレクト(トップレベルのGET)で送信されるようにLaxにする
+	cookie.SameSite = http.SameSiteLaxMode
+	return cookie
+}
@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"context"
+	"strings"
+
+	"household-account-backend/adapter/controller/echo/presenter"
+	"household-account-backend/entity"
+	"household-account-backend/usecase"
+)
+
+type PersonalAccessTokenHandler struct {
+	personalAccessTokenUseCase usecase.PersonalAccessTokenUseCase
+}
+
+func NewPersonalAccessTokenHandler(personalAccessTokenUseCase usecase.PersonalAccessTokenUseCase) *PersonalAccessTokenHandler {
+	return &PersonalAccessTokenHandler{
+		personalAccessTokenUseCase: personalAccessTokenUseCase,
+	}
+}
+
+func personalAccessTokenToResponse(token *entity.PersonalAccessToken) *presenter.PersonalAccessTokenResponse {
+	scopes := []presenter.TokenScope{}
+	for _, scope := range token.ScopeList() {
+		scopes = append(scopes, presenter.TokenScope(scope))
+	}
+	return &presenter.PersonalAccessTokenResponse{
+		Id:          token.ID,
+		Name:        token.Name,
+		TokenPrefix: token.TokenPrefix,
+		Scopes:      scopes,
+		ExpiresAt:   token.ExpiresAt,
+		LastUsedAt:  token.LastUsedAt,
+		CreatedAt:   token.CreatedAt,
+	}
+}
+
+func (h *PersonalAccessTokenHandler) GetPersonalAccessTokens(ctx context.Context, request presenter.GetPersonalAccessTokensRequestObject) (presenter.GetPersonalAccessTokensResponseObject, error) {
+	tokens, err := h.personalAccessTokenUseCase.GetTokensByUserID(currentUserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	response := presenter.GetPersonalAccessTokens200JSONResponse{}
+	for _, token := range tokens {
+		response = append(response, *personalAccessTokenToResponse(&token))
+	}
+	return response, nil
+}
+
+func (h *PersonalAccessTokenHandler) CreatePersonalAccessToken(ctx context.Context, request presenter.CreatePersonalAccessTokenRequestObject) (presenter.CreatePersonalAccessTokenResponseObject, error) {
+	scopes := make([]string, 0, len(request.Body.Scopes))
+	for _, scope := range request.Body.Scopes {
+		scopes = append(scopes, string(scope))
+	}
+	token := &entity.PersonalAccessToken{
+		UserID:    currentUserID(ctx),
+		Name:      request.Body.Name,
+		Scopes:    strings.Join(scopes, ","),
+		ExpiresAt: request.Body.ExpiresAt,
+	}
+
+	createdToken, plainToken, err := h.personalAccessTokenUseCase.CreateToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	// トークン本体は作成時のレスポンスでのみ返す
+	response := personalAccessTokenToResponse(createdToken)
+	response.Token = &plainToken
+	return presenter.CreatePersonalAccessToken201JSONResponse{PersonalAccessTokenResponseJSONResponse: presenter.PersonalAccessTokenResponseJSONResponse(*response)}, nil
+}
+
+func (h *PersonalAccessTokenHandler) DeletePersonalAccessToken(ctx context.Context, request presenter.DeletePersonalAccessTokenRequestObject) (presenter.DeletePersonalAccessTokenResponseObject, error) {
+	if err := h.personalAccessTokenUseCase.DeleteToken(currentUserID(ctx), request.Id); err != nil {
+		return nil, err
+	}
+
+	return presenter.DeletePersonalAccessToken204Response{}, nil
+}
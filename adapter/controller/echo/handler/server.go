@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"household-account-backend/adapter/controller/echo/presenter"
+)
+
+// Server はOpenAPIの定義の全ての操作を実装する
+// 各ハンドラーの操作のメソッドを埋め込みで公開し、定義と実装のずれはコンパイル時に検出する
+type Server struct {
+	*UserHandler
+	*TwoFactorHandler
+	*OIDCHandler
+	*PersonalAccessTokenHandler
+	*AccountDataHandler
+	*CategoryHandler
+	*TransactionHandler
+	*MonthlySummaryHandler
+	*WebhookHandler
+	*EventStreamHandler
+	*AdminHandler
+	*JWKSHandler
+}
+
+var _ presenter.StrictServerInterface = (*Server)(nil)
+
+// NewStrictHandler はServerを、パラメータとボディを定義の型に変換してから呼び出すハンドラーにする
+func NewStrictHandler(server *Server) presenter.ServerInterface {
+	return presenter.NewStrictHandler(server, []presenter.StrictMiddlewareFunc{RequestContextMiddleware})
+}
@@ -0,0 +1,217 @@
+package handler_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"household-account-backend/adapter/controller/echo/handler"
+	"household-account-backend/entity"
+	"household-account-backend/usecase"
+)
+
+type MockDataExportUseCase struct {
+	mock.Mock
+}
+
+func (m *MockDataExportUseCase) RequestExport(userID int) (*entity.DataExport, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.DataExport), args.Error(1)
+}
+
+func (m *MockDataExportUseCase) GetExports(userID int) ([]entity.DataExport, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.DataExport), args.Error(1)
+}
+
+func (m *MockDataExportUseCase) GetExport(userID int, exportID int) (*entity.DataExport, error) {
+	args := m.Called(userID, exportID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.DataExport), args.Error(1)
+}
+
+func (m *MockDataExportUseCase) OpenExport(userID int, exportID int) (*entity.DataExport, io.ReadCloser, error) {
+	args := m.Called(userID, exportID)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*entity.DataExport), args.Get(1).(io.ReadCloser), args.Error(2)
+}
+
+func (m *MockDataExportUseCase) ProcessPendingExports(limit int) (int, error) {
+	args := m.Called(limit)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockDataExportUseCase) PurgeExpiredExports(limit int) (int, error) {
+	args := m.Called(limit)
+	return args.Int(0), args.Error(1)
+}
+
+type MockAccountDeletionUseCase struct {
+	mock.Mock
+}
+
+func (m *MockAccountDeletionUseCase) RequestDeletion(userID int) (*entity.AccountDeletion, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.AccountDeletion), args.Error(1)
+}
+
+func (m *MockAccountDeletionUseCase) ConfirmDeletion(token string) (*entity.AccountDeletion, error) {
+	args := m.Called(token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.AccountDeletion), args.Error(1)
+}
+
+func (m *MockAccountDeletionUseCase) GetDeletion(userID int) (*entity.AccountDeletion, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.AccountDeletion), args.Error(1)
+}
+
+func (m *MockAccountDeletionUseCase) CancelDeletion(userID int) error {
+	args := m.Called(userID)
+	return args.Error(0)
+}
+
+func (m *MockAccountDeletionUseCase) ProcessDueDeletions(limit int) (int, error) {
+	args := m.Called(limit)
+	return args.Int(0), args.Error(1)
+}
+
+func newAccountDataContext(e *echo.Echo, method string, body string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, "/users", bytes.NewReader([]byte(body)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user", &jwt.Token{Claims: jwt.MapClaims{"user_id": float64(1)}})
+	return c, rec
+}
+
+func TestRequestDataExport_InProgress(t *testing.T) {
+	e := echo.New()
+	mockExportUseCase := new(MockDataExportUseCase)
+	h := handler.NewAccountDataHandler(mockExportUseCase, new(MockAccountDeletionUseCase))
+	w := strictServer(&handler.Server{AccountDataHandler: h})
+	c, rec := newAccountDataContext(e, http.MethodPost, "")
+
+	mockExportUseCase.On("RequestExport", 1).Return(nil, usecase.ErrExportInProgress)
+
+	handler.HTTPErrorHandler(w.RequestDataExport(c), c)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+}
+
+func TestDownloadDataExport(t *testing.T) {
+	e := echo.New()
+	mockExportUseCase := new(MockDataExportUseCase)
+	h := handler.NewAccountDataHandler(mockExportUseCase, new(MockAccountDeletionUseCase))
+	w := strictServer(&handler.Server{AccountDataHandler: h})
+	c, rec := newAccountDataContext(e, http.MethodGet, "")
+	c.SetParamNames("id")
+	c.SetParamValues("5")
+
+	export := &entity.DataExport{ID: 5, UserID: 1, Status: entity.DataExportStatusCompleted, FileSize: 3, CreatedAt: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)}
+	mockExportUseCase.On("OpenExport", 1, 5).Return(export, io.NopCloser(strings.NewReader("zip")), nil)
+
+	if assert.NoError(t, w.DownloadDataExport(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "application/zip", rec.Header().Get(echo.HeaderContentType))
+		assert.Equal(t, `attachment; filename="household-account-export-20261001.zip"`, rec.Header().Get(echo.HeaderContentDisposition))
+		assert.Equal(t, "zip", rec.Body.String())
+	}
+}
+
+func TestDownloadDataExport_Expired(t *testing.T) {
+	e := echo.New()
+	mockExportUseCase := new(MockDataExportUseCase)
+	h := handler.NewAccountDataHandler(mockExportUseCase, new(MockAccountDeletionUseCase))
+	w := strictServer(&handler.Server{AccountDataHandler: h})
+	c, rec := newAccountDataContext(e, http.MethodGet, "")
+	c.SetParamNames("id")
+	c.SetParamValues("5")
+
+	mockExportUseCase.On("OpenExport", 1, 5).Return(nil, nil, usecase.ErrExportExpired)
+
+	handler.HTTPErrorHandler(w.DownloadDataExport(c), c)
+	assert.Equal(t, http.StatusGone, rec.Code)
+}
+
+func TestRequestAccountDeletion(t *testing.T) {
+	e := echo.New()
+	mockDeletionUseCase := new(MockAccountDeletionUseCase)
+	h := handler.NewAccountDataHandler(new(MockDataExportUseCase), mockDeletionUseCase)
+	w := strictServer(&handler.Server{AccountDataHandler: h})
+	c, rec := newAccountDataContext(e, http.MethodDelete, "")
+
+	mockDeletionUseCase.On("RequestDeletion", 1).Return(&entity.AccountDeletion{ID: 1, UserID: 1, Email: "test@example.com", Status: entity.AccountDeletionStatusPending}, nil)
+
+	if assert.NoError(t, w.DeleteCurrentUser(c)) {
+		assert.Equal(t, http.StatusAccepted, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"status":"pending"`)
+		assert.NotContains(t, rec.Body.String(), "test@example.com")
+	}
+}
+
+func TestRequestAccountDeletion_AlreadyScheduled(t *testing.T) {
+	e := echo.New()
+	mockDeletionUseCase := new(MockAccountDeletionUseCase)
+	h := handler.NewAccountDataHandler(new(MockDataExportUseCase), mockDeletionUseCase)
+	w := strictServer(&handler.Server{AccountDataHandler: h})
+	c, rec := newAccountDataContext(e, http.MethodDelete, "")
+
+	mockDeletionUseCase.On("RequestDeletion", 1).Return(nil, usecase.ErrDeletionAlreadyScheduled)
+
+	handler.HTTPErrorHandler(w.DeleteCurrentUser(c), c)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+}
+
+func TestConfirmAccountDeletion_InvalidToken(t *testing.T) {
+	e := echo.New()
+	mockDeletionUseCase := new(MockAccountDeletionUseCase)
+	h := handler.NewAccountDataHandler(new(MockDataExportUseCase), mockDeletionUseCase)
+	w := strictServer(&handler.Server{AccountDataHandler: h})
+	c, rec := newAccountDataContext(e, http.MethodPost, `{"token":"invalid"}`)
+
+	mockDeletionUseCase.On("ConfirmDeletion", "invalid").Return(nil, usecase.ErrInvalidUserToken)
+
+	handler.HTTPErrorHandler(w.ConfirmAccountDeletion(c), c)
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
+
+func TestCancelAccountDeletion(t *testing.T) {
+	e := echo.New()
+	mockDeletionUseCase := new(MockAccountDeletionUseCase)
+	h := handler.NewAccountDataHandler(new(MockDataExportUseCase), mockDeletionUseCase)
+	w := strictServer(&handler.Server{AccountDataHandler: h})
+	c, rec := newAccountDataContext(e, http.MethodDelete, "")
+
+	mockDeletionUseCase.On("CancelDeletion", 1).Return(nil)
+
+	if assert.NoError(t, w.CancelAccountDeletion(c)) {
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	}
+}
@@ -0,0 +1,250 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"household-account-backend/adapter/controller/echo/handler"
+	"household-account-backend/adapter/controller/echo/middleware"
+	"household-account-backend/adapter/controller/echo/presenter"
+	"household-account-backend/entity"
+	"household-account-backend/usecase"
+)
+
+type MockAdminUseCase struct {
+	mock.Mock
+}
+
+func (m *MockAdminUseCase) SearchUsers(query usecase.UserSearchQuery) ([]entity.User, int64, error) {
+	args := m.Called(query)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]entity.User), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockAdminUseCase) GetUser(userID int) (*entity.User, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *MockAdminUseCase) DisableUser(actor usecase.AdminActor, userID int) (*entity.User, error) {
+	args := m.Called(actor, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *MockAdminUseCase) EnableUser(actor usecase.AdminActor, userID int) (*entity.User, error) {
+	args := m.Called(actor, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *MockAdminUseCase) ForceLogout(actor usecase.AdminActor, userID int) error {
+	args := m.Called(actor, userID)
+	return args.Error(0)
+}
+
+func (m *MockAdminUseCase) UpdateRole(actor usecase.AdminActor, userID int, role string) (*entity.User, error) {
+	args := m.Called(actor, userID, role)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *MockAdminUseCase) GetSystemStats() (*entity.SystemStats, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.SystemStats), args.Error(1)
+}
+
+func (m *MockAdminUseCase) Impersonate(actor usecase.AdminActor, userID int, reason string) (*usecase.ImpersonationResult, error) {
+	args := m.Called(actor, userID, reason)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecase.ImpersonationResult), args.Error(1)
+}
+
+func (m *MockAdminUseCase) GetAuditLogs(targetUserID int, limit int) ([]entity.AdminAuditLog, error) {
+	args := m.Called(targetUserID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.AdminAuditLog), args.Error(1)
+}
+
+func newAdminContext(e *echo.Echo, method string, target string, body string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, target, bytes.NewReader([]byte(body)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.RemoteAddr = "192.0.2.1:12345"
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user", &jwt.Token{Claims: jwt.MapClaims{"user_id": float64(1)}})
+	return c, rec
+}
+
+var testAdminActor = usecase.AdminActor{UserID: 1, IPAddress: "192.0.2.1"}
+
+func TestAdminSearchUsers(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockAdminUseCase)
+	h := handler.NewAdminHandler(mockUseCase)
+	w := strictServer(&handler.Server{AdminHandler: h})
+	c, rec := newAdminContext(e, http.MethodGet, "/admin/users?q=alice&status=disabled&limit=10", "")
+
+	disabledAt := time.Now()
+	mockUseCase.On("SearchUsers", usecase.UserSearchQuery{Query: "alice", Status: "disabled", Limit: 10}).
+		Return([]entity.User{{ID: 2, Email: "alice@example.com", Name: "alice", Role: entity.UserRoleUser, DisabledAt: &disabledAt}}, int64(1), nil)
+
+	if assert.NoError(t, w.AdminSearchUsers(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var response presenter.AdminUsersResponse
+		json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.Equal(t, int64(1), response.Total)
+		assert.True(t, response.Users[0].Disabled)
+	}
+}
+
+func TestAdminSearchUsers_InvalidLimit(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockAdminUseCase)
+	h := handler.NewAdminHandler(mockUseCase)
+	w := strictServer(&handler.Server{AdminHandler: h})
+	c, rec := newAdminContext(e, http.MethodGet, "/admin/users?limit=abc", "")
+
+	handler.HTTPErrorHandler(w.AdminSearchUsers(c), c)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockUseCase.AssertNotCalled(t, "SearchUsers", mock.Anything)
+}
+
+func TestAdminDisableUser_Self(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockAdminUseCase)
+	h := handler.NewAdminHandler(mockUseCase)
+	w := strictServer(&handler.Server{AdminHandler: h})
+	c, rec := newAdminContext(e, http.MethodPost, "/admin/users/1/disable", "")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	mockUseCase.On("DisableUser", testAdminActor, 1).Return(nil, usecase.ErrAdminSelfAction)
+
+	handler.HTTPErrorHandler(w.AdminDisableUser(c), c)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+}
+
+func TestAdminForceLogout_NotFound(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockAdminUseCase)
+	h := handler.NewAdminHandler(mockUseCase)
+	w := strictServer(&handler.Server{AdminHandler: h})
+	c, rec := newAdminContext(e, http.MethodPost, "/admin/users/99/logout", "")
+	c.SetParamNames("id")
+	c.SetParamValues("99")
+
+	mockUseCase.On("ForceLogout", testAdminActor, 99).Return(usecase.ErrUserNotFound)
+
+	handler.HTTPErrorHandler(w.AdminForceLogout(c), c)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestAdminActorIgnoresSpoofedClientIP(t *testing.T) {
+	e := echo.New()
+	e.IPExtractor = middleware.NewIPExtractor(nil)
+	mockUseCase := new(MockAdminUseCase)
+	h := handler.NewAdminHandler(mockUseCase)
+	w := strictServer(&handler.Server{AdminHandler: h})
+	c, rec := newAdminContext(e, http.MethodPost, "/admin/users/2/logout", "")
+	c.Request().Header.Set(echo.HeaderXForwardedFor, "203.0.113.1")
+	c.Request().Header.Set(echo.HeaderXRealIP, "203.0.113.2")
+	c.SetParamNames("id")
+	c.SetParamValues("2")
+
+	// 監査ログにはクライアントが付けたヘッダーではなく接続元のアドレスを記録する
+	mockUseCase.On("ForceLogout", testAdminActor, 2).Return(nil)
+
+	if assert.NoError(t, w.AdminForceLogout(c)) {
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		mockUseCase.AssertExpectations(t)
+	}
+}
+
+func TestAdminUpdateUserRole(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockAdminUseCase)
+	h := handler.NewAdminHandler(mockUseCase)
+	w := strictServer(&handler.Server{AdminHandler: h})
+	c, rec := newAdminContext(e, http.MethodPut, "/admin/users/2/role", `{"role":"admin"}`)
+	c.SetParamNames("id")
+	c.SetParamValues("2")
+
+	mockUseCase.On("UpdateRole", testAdminActor, 2, entity.UserRoleAdmin).
+		Return(&entity.User{ID: 2, Email: "alice@example.com", Role: entity.UserRoleAdmin}, nil)
+
+	if assert.NoError(t, w.AdminUpdateUserRole(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"role":"admin"`)
+	}
+}
+
+func TestAdminImpersonateUser(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockAdminUseCase)
+	h := handler.NewAdminHandler(mockUseCase)
+	w := strictServer(&handler.Server{AdminHandler: h})
+	c, rec := newAdminContext(e, http.MethodPost, "/admin/users/2/impersonate", `{"reason":"ticket-123"}`)
+	c.SetParamNames("id")
+	c.SetParamValues("2")
+
+	expiresAt := time.Now().Add(time.Hour)
+	mockUseCase.On("Impersonate", testAdminActor, 2, "ticket-123").Return(&usecase.ImpersonationResult{
+		AuthToken: "impersonation-token",
+		ExpiresAt: expiresAt,
+		User:      &entity.User{ID: 2, Email: "alice@example.com", Role: entity.UserRoleUser},
+	}, nil)
+
+	if assert.NoError(t, w.AdminImpersonateUser(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		cookies := rec.Result().Cookies()
+		if assert.Len(t, cookies, 1) {
+			assert.Equal(t, "auth_token", cookies[0].Name)
+			assert.Equal(t, "impersonation-token", cookies[0].Value)
+		}
+		assert.NotContains(t, rec.Body.String(), "impersonation-token")
+	}
+}
+
+func TestAdminImpersonateUser_NotAllowed(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockAdminUseCase)
+	h := handler.NewAdminHandler(mockUseCase)
+	w := strictServer(&handler.Server{AdminHandler: h})
+	c, rec := newAdminContext(e, http.MethodPost, "/admin/users/3/impersonate", `{"reason":"ticket-123"}`)
+	c.SetParamNames("id")
+	c.SetParamValues("3")
+
+	mockUseCase.On("Impersonate", testAdminActor, 3, "ticket-123").Return(nil, usecase.ErrImpersonationNotAllowed)
+
+	handler.HTTPErrorHandler(w.AdminImpersonateUser(c), c)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	assert.Empty(t, rec.Result().Cookies())
+}
@@ -7,6 +7,7 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/golang-jwt/jwt/v4"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -28,8 +29,8 @@ func (m *MockCategoryUseCase) CreateCategory(category *entity.Category) (*entity
 	return args.Get(0).(*entity.Category), args.Error(1)
 }
 
-func (m *MockCategoryUseCase) GetCategoryByID(categoryID int) (*entity.Category, error) {
-	args := m.Called(categoryID)
+func (m *MockCategoryUseCase) GetCategoryByID(userID int, categoryID int) (*entity.Category, error) {
+	args := m.Called(userID, categoryID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -44,6 +45,14 @@ func (m *MockCategoryUseCase) GetCategoriesByUserID(userID int) ([]entity.Catego
 	return args.Get(0).([]entity.Category), args.Error(1)
 }
 
+func (m *MockCategoryUseCase) GetCategoriesByIDs(userID int, categoryIDs []int) ([]entity.Category, error) {
+	args := m.Called(userID, categoryIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.Category), args.Error(1)
+}
+
 func (m *MockCategoryUseCase) UpdateCategory(category *entity.Category) (*entity.Category, error) {
 	args := m.Called(category)
 	if args.Get(0) == nil {
@@ -52,8 +61,8 @@ func (m *MockCategoryUseCase) UpdateCategory(category *entity.Category) (*entity
 	return args.Get(0).(*entity.Category), args.Error(1)
 }
 
-func (m *MockCategoryUseCase) DeleteCategory(categoryID int) error {
-	args := m.Called(categoryID)
+func (m *MockCategoryUseCase) DeleteCategory(userID int, categoryID int, version int) error {
+	args := m.Called(userID, categoryID, version)
 	return args.Error(0)
 }
 
@@ -61,6 +70,7 @@ func TestCreateCategory(t *testing.T) {
 	e := echo.New()
 	mockUseCase := new(MockCategoryUseCase)
 	h := handler.NewCategoryHandler(mockUseCase)
+	w := strictServer(&handler.Server{CategoryHandler: h})
 
 	requestBody := presenter.CreateCategoryJSONRequestBody{
 		UserId: 1,
@@ -74,6 +84,11 @@ func TestCreateCategory(t *testing.T) {
 
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
+	c.Set("user", &jwt.Token{
+		Claims: jwt.MapClaims{
+			"user_id": float64(1),
+		},
+	})
 
 	mockCategory := &entity.Category{
 		ID:     1,
@@ -84,7 +99,7 @@ func TestCreateCategory(t *testing.T) {
 
 	mockUseCase.On("CreateCategory", mock.AnythingOfType("*entity.Category")).Return(mockCategory, nil)
 
-	if assert.NoError(t, h.CreateCategory(c)) {
+	if assert.NoError(t, w.CreateCategory(c)) {
 		assert.Equal(t, http.StatusCreated, rec.Code)
 		var response presenter.CategoryResponse
 		json.Unmarshal(rec.Body.Bytes(), &response)
@@ -98,10 +113,16 @@ func TestGetCategoryByID(t *testing.T) {
 	e := echo.New()
 	mockUseCase := new(MockCategoryUseCase)
 	h := handler.NewCategoryHandler(mockUseCase)
+	w := strictServer(&handler.Server{CategoryHandler: h})
 
 	req := httptest.NewRequest(http.MethodGet, "/categories/1", nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
+	c.Set("user", &jwt.Token{
+		Claims: jwt.MapClaims{
+			"user_id": float64(1),
+		},
+	})
 	c.SetParamNames("id")
 	c.SetParamValues("1")
 
@@ -112,9 +133,9 @@ func TestGetCategoryByID(t *testing.T) {
 		Type:   "expense",
 	}
 
-	mockUseCase.On("GetCategoryByID", 1).Return(mockCategory, nil)
+	mockUseCase.On("GetCategoryByID", 1, 1).Return(mockCategory, nil)
 
-	if assert.NoError(t, h.GetCategoryByID(c)) {
+	if assert.NoError(t, w.GetCategoryById(c)) {
 		assert.Equal(t, http.StatusOK, rec.Code)
 		var response presenter.CategoryResponse
 		json.Unmarshal(rec.Body.Bytes(), &response)
@@ -128,10 +149,16 @@ func TestGetCategoriesByUserID(t *testing.T) {
 	e := echo.New()
 	mockUseCase := new(MockCategoryUseCase)
 	h := handler.NewCategoryHandler(mockUseCase)
+	w := strictServer(&handler.Server{CategoryHandler: h})
 
 	req := httptest.NewRequest(http.MethodGet, "/categories?user_id=1", nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
+	c.Set("user", &jwt.Token{
+		Claims: jwt.MapClaims{
+			"user_id": float64(1),
+		},
+	})
 
 	mockCategories := []entity.Category{
 		{ID: 1, UserID: 1, Name: "Groceries", Type: "expense"},
@@ -140,7 +167,7 @@ func TestGetCategoriesByUserID(t *testing.T) {
 
 	mockUseCase.On("GetCategoriesByUserID", 1).Return(mockCategories, nil)
 
-	if assert.NoError(t, h.GetCategoriesByUserID(c)) {
+	if assert.NoError(t, w.GetCategories(c)) {
 		assert.Equal(t, http.StatusOK, rec.Code)
 		var response []presenter.CategoryResponse
 		json.Unmarshal(rec.Body.Bytes(), &response)
@@ -154,6 +181,7 @@ func TestUpdateCategory(t *testing.T) {
 	e := echo.New()
 	mockUseCase := new(MockCategoryUseCase)
 	h := handler.NewCategoryHandler(mockUseCase)
+	w := strictServer(&handler.Server{CategoryHandler: h})
 
 	requestBody := presenter.CategoryUpdateRequestBody{
 		Name: "Updated Name",
@@ -161,29 +189,39 @@ func TestUpdateCategory(t *testing.T) {
 	}
 	jsonBody, _ := json.Marshal(requestBody)
 
-	req := httptest.NewRequest(http.MethodPut, "/categories/1", bytes.NewReader(jsonBody))
+	req := httptest.NewRequest(http.MethodPatch, "/categories/1", bytes.NewReader(jsonBody))
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set("If-Match", `"1"`)
 
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
+	c.Set("user", &jwt.Token{
+		Claims: jwt.MapClaims{
+			"user_id": float64(1),
+		},
+	})
 	c.SetParamNames("id")
 	c.SetParamValues("1")
 
 	mockCategory := &entity.Category{
-		ID:   1,
-		Name: "Updated Name",
-		Type: "expense",
+		ID:      1,
+		Name:    "Updated Name",
+		Type:    "expense",
+		Version: 2,
 	}
 
-	mockUseCase.On("UpdateCategory", mock.AnythingOfType("*entity.Category")).Return(mockCategory, nil)
+	mockUseCase.On("UpdateCategory", mock.MatchedBy(func(category *entity.Category) bool {
+		return category.ID == 1 && category.UserID == 1 && category.Version == 1
+	})).Return(mockCategory, nil)
 
-	if assert.NoError(t, h.UpdateCategory(c)) {
+	if assert.NoError(t, w.UpdateCategoryById(c)) {
 		assert.Equal(t, http.StatusOK, rec.Code)
 		var response presenter.CategoryResponse
 		json.Unmarshal(rec.Body.Bytes(), &response)
 		assert.Equal(t, 1, response.Id)
 		assert.Equal(t, "Updated Name", response.Name)
 		assert.Equal(t, "expense", string(response.Type))
+		assert.Equal(t, `"2"`, rec.Header().Get("ETag"))
 	}
 }
 
@@ -191,16 +229,23 @@ func TestDeleteCategory(t *testing.T) {
 	e := echo.New()
 	mockUseCase := new(MockCategoryUseCase)
 	h := handler.NewCategoryHandler(mockUseCase)
+	w := strictServer(&handler.Server{CategoryHandler: h})
 
 	req := httptest.NewRequest(http.MethodDelete, "/categories/1", nil)
+	req.Header.Set("If-Match", `"1"`)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
+	c.Set("user", &jwt.Token{
+		Claims: jwt.MapClaims{
+			"user_id": float64(1),
+		},
+	})
 	c.SetParamNames("id")
 	c.SetParamValues("1")
 
-	mockUseCase.On("DeleteCategory", 1).Return(nil)
+	mockUseCase.On("DeleteCategory", 1, 1, 1).Return(nil)
 
-	if assert.NoError(t, h.DeleteCategory(c)) {
+	if assert.NoError(t, w.DeleteCategoryById(c)) {
 		assert.Equal(t, http.StatusNoContent, rec.Code)
 	}
 }
@@ -0,0 +1,88 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"household-account-backend/adapter/controller/echo/handler"
+	"household-account-backend/adapter/controller/echo/presenter"
+	"household-account-backend/usecase"
+)
+
+func handleError(method string, err error) (*httptest.ResponseRecorder, presenter.Problem) {
+	e := echo.New()
+	req := httptest.NewRequest(method, "/transactions/1", nil)
+	rec := httptest.NewRecorder()
+	handler.HTTPErrorHandler(err, e.NewContext(req, rec))
+
+	var problem presenter.Problem
+	_ = json.Unmarshal(rec.Body.Bytes(), &problem)
+	return rec, problem
+}
+
+func TestHTTPErrorHandler_DomainErrors(t *testing.T) {
+	tests := []struct {
+		err    error
+		status int
+	}{
+		{&usecase.NotFoundError{Message: "transaction not found"}, http.StatusNotFound},
+		{&usecase.ConflictError{Message: "already exists"}, http.StatusConflict},
+		{&usecase.UnauthorizedError{Message: "invalid credentials"}, http.StatusUnauthorized},
+		{&usecase.ForbiddenError{Message: "account is disabled"}, http.StatusForbidden},
+		{usecase.ErrVersionConflict, http.StatusPreconditionFailed},
+		{echo.NewHTTPError(http.StatusBadRequest, "Invalid ID"), http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		// ラップされたエラーも種類から判定する
+		rec, problem := handleError(http.MethodGet, fmt.Errorf("wrapped: %w", tt.err))
+
+		assert.Equal(t, tt.status, rec.Code)
+		assert.Equal(t, handler.ProblemContentType, rec.Header().Get(echo.HeaderContentType))
+		assert.Equal(t, "about:blank", problem.Type)
+		assert.Equal(t, http.StatusText(tt.status), problem.Title)
+		assert.Equal(t, tt.status, problem.Status)
+		if assert.NotNil(t, problem.Detail) {
+			assert.NotEmpty(t, *problem.Detail)
+		}
+		if assert.NotNil(t, problem.Instance) {
+			assert.Equal(t, "/transactions/1", *problem.Instance)
+		}
+	}
+}
+
+func TestHTTPErrorHandler_ValidationError(t *testing.T) {
+	err := &usecase.ValidationError{
+		Message: "invalid request",
+		Fields:  []usecase.FieldError{{Field: "amount", Message: "must be greater than 0"}},
+	}
+
+	rec, problem := handleError(http.MethodPost, err)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Equal(t, "invalid request", *problem.Detail)
+	if assert.NotNil(t, problem.Errors) {
+		assert.Equal(t, []presenter.FieldError{{Field: "amount", Message: "must be greater than 0"}}, *problem.Errors)
+	}
+}
+
+func TestHTTPErrorHandler_InternalErrorHidesDetail(t *testing.T) {
+	rec, problem := handleError(http.MethodGet, errors.New("dial tcp 10.0.0.1:3306: connection refused"))
+
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.Nil(t, problem.Detail)
+	assert.NotContains(t, rec.Body.String(), "10.0.0.1")
+}
+
+func TestHTTPErrorHandler_Head(t *testing.T) {
+	rec, _ := handleError(http.MethodHead, &usecase.NotFoundError{Message: "transaction not found"})
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Empty(t, rec.Body.String())
+}
@@ -0,0 +1,131 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"household-account-backend/adapter/controller/echo/handler"
+	"household-account-backend/entity"
+	"household-account-backend/usecase"
+)
+
+type MockEventStreamUseCase struct {
+	mock.Mock
+}
+
+func (m *MockEventStreamUseCase) Subscribe(userID int, lastEventID int) (*usecase.EventSubscription, error) {
+	args := m.Called(userID, lastEventID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecase.EventSubscription), args.Error(1)
+}
+
+func newEventStreamContext(e *echo.Echo, lastEventID string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodGet, "/events/stream", nil)
+	req.Header.Set("Last-Event-ID", lastEventID)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user", &jwt.Token{
+		Claims: jwt.MapClaims{
+			"user_id": float64(1),
+		},
+	})
+	return c, rec
+}
+
+func TestStreamEvents(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockEventStreamUseCase)
+	h := handler.NewEventStreamHandler(mockUseCase, time.Minute)
+	w := strictServer(&handler.Server{EventStreamHandler: h})
+
+	req := httptest.NewRequest(http.MethodGet, "/events/stream", nil)
+	req.Header.Set("Last-Event-ID", "10")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user", &jwt.Token{
+		Claims: jwt.MapClaims{
+			"user_id": float64(1),
+		},
+	})
+
+	replay := []entity.OutboxEvent{
+		{ID: 11, UserID: 1, Type: entity.EventTransactionCreated, Payload: `{"id":1}`},
+	}
+	events := make(chan entity.OutboxEvent, 3)
+	// 再送済みのイベントと新しいイベント
+	events <- entity.OutboxEvent{ID: 11, UserID: 1, Type: entity.EventTransactionCreated, Payload: `{"id":1}`}
+	events <- entity.OutboxEvent{ID: 12, UserID: 1, Type: entity.EventCategoryUpdated, Payload: `{"id":2}`}
+	// IDが小さくても後からコミットされたイベントは送る
+	events <- entity.OutboxEvent{ID: 9, UserID: 1, Type: entity.EventTransactionDeleted, Payload: `{"id":3}`}
+	close(events)
+
+	unsubscribed := false
+	mockUseCase.On("Subscribe", 1, 10).Return(&usecase.EventSubscription{
+		Replay:      replay,
+		Events:      events,
+		Unsubscribe: func() { unsubscribed = true },
+	}, nil)
+
+	if assert.NoError(t, w.StreamEvents(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "text/event-stream", rec.Header().Get(echo.HeaderContentType))
+		body := rec.Body.String()
+		assert.Equal(t, 1, strings.Count(body, "id: 11\n"))
+		assert.Contains(t, body, "id: 12\nevent: category.updated\ndata: {\"id\":2}\n\n")
+		assert.Contains(t, body, "id: 9\nevent: transaction.deleted\n")
+		assert.True(t, unsubscribed)
+	}
+}
+
+func TestStreamEventsReset(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockEventStreamUseCase)
+	h := handler.NewEventStreamHandler(mockUseCase, time.Minute)
+	w := strictServer(&handler.Server{EventStreamHandler: h})
+	c, rec := newEventStreamContext(e, "10")
+
+	events := make(chan entity.OutboxEvent)
+	close(events)
+	mockUseCase.On("Subscribe", 1, 10).Return(&usecase.EventSubscription{
+		Reset:        true,
+		ResetEventID: 900,
+		Events:       events,
+		Unsubscribe:  func() {},
+	}, nil)
+
+	if assert.NoError(t, w.StreamEvents(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "id: 900\nevent: reset\ndata: {}\n\n", rec.Body.String())
+	}
+}
+
+func TestStreamEventsInvalidLastEventID(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockEventStreamUseCase)
+	h := handler.NewEventStreamHandler(mockUseCase, time.Minute)
+	w := strictServer(&handler.Server{EventStreamHandler: h})
+
+	req := httptest.NewRequest(http.MethodGet, "/events/stream", nil)
+	req.Header.Set("Last-Event-ID", "abc")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user", &jwt.Token{
+		Claims: jwt.MapClaims{
+			"user_id": float64(1),
+		},
+	})
+
+	handler.HTTPErrorHandler(w.StreamEvents(c), c)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockUseCase.AssertNotCalled(t, "Subscribe", mock.Anything, mock.Anything)
+}
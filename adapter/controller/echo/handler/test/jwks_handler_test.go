@@ -0,0 +1,43 @@
+package handler_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"household-account-backend/adapter/controller/echo/handler"
+	"household-account-backend/adapter/controller/echo/presenter"
+	"household-account-backend/pkg/jwtkeys"
+)
+
+func TestGetJWKS(t *testing.T) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	keyManager, err := jwtkeys.NewKeyManager([]*jwtkeys.Key{jwtkeys.NewEdDSAKey("2026-10", key)}, jwtkeys.Config{})
+	require.NoError(t, err)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	h := handler.NewJWKSHandler(keyManager)
+	w := strictServer(&handler.Server{JWKSHandler: h})
+
+	if assert.NoError(t, w.GetJWKS(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var response presenter.JWKSResponse
+		json.Unmarshal(rec.Body.Bytes(), &response)
+		require.Len(t, response.Keys, 1)
+		assert.Equal(t, "2026-10", response.Keys[0].Kid)
+		assert.Equal(t, presenter.OKP, response.Keys[0].Kty)
+		assert.Equal(t, "Ed25519", *response.Keys[0].Crv)
+		assert.Nil(t, response.Keys[0].N)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/golang-jwt/jwt/v4"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -29,8 +30,8 @@ func (m *MockMonthlySummaryUseCase) CreateMonthlySummary(summary *entity.Monthly
 	return args.Get(0).(*entity.MonthlySummary), args.Error(1)
 }
 
-func (m *MockMonthlySummaryUseCase) GetMonthlySummaryByID(summaryID int) (*entity.MonthlySummary, error) {
-	args := m.Called(summaryID)
+func (m *MockMonthlySummaryUseCase) GetMonthlySummaryByID(userID int, summaryID int) (*entity.MonthlySummary, error) {
+	args := m.Called(userID, summaryID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -53,8 +54,8 @@ func (m *MockMonthlySummaryUseCase) UpdateMonthlySummary(summary *entity.Monthly
 	return args.Get(0).(*entity.MonthlySummary), args.Error(1)
 }
 
-func (m *MockMonthlySummaryUseCase) DeleteMonthlySummary(summaryID int) error {
-	args := m.Called(summaryID)
+func (m *MockMonthlySummaryUseCase) DeleteMonthlySummary(userID int, summaryID int, version int) error {
+	args := m.Called(userID, summaryID, version)
 	return args.Error(0)
 }
 
@@ -62,6 +63,7 @@ func TestCreateMonthlySummary(t *testing.T) {
 	e := echo.New()
 	mockUseCase := new(MockMonthlySummaryUseCase)
 	h := handler.NewMonthlySummaryHandler(mockUseCase)
+	w := strictServer(&handler.Server{MonthlySummaryHandler: h})
 
 	requestBody := presenter.CreateMonthlySummaryJSONRequestBody{
 		UserId:    1,
@@ -77,6 +79,11 @@ func TestCreateMonthlySummary(t *testing.T) {
 
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
+	c.Set("user", &jwt.Token{
+		Claims: jwt.MapClaims{
+			"user_id": float64(1),
+		},
+	})
 
 	mockSummary := &entity.MonthlySummary{
 		ID:        1,
@@ -89,7 +96,7 @@ func TestCreateMonthlySummary(t *testing.T) {
 
 	mockUseCase.On("CreateMonthlySummary", mock.AnythingOfType("*entity.MonthlySummary")).Return(mockSummary, nil)
 
-	if assert.NoError(t, h.CreateMonthlySummary(c)) {
+	if assert.NoError(t, w.CreateMonthlySummary(c)) {
 		assert.Equal(t, http.StatusCreated, rec.Code)
 		var response presenter.MonthlySummaryResponse
 		json.Unmarshal(rec.Body.Bytes(), &response)
@@ -105,11 +112,17 @@ func TestGetMonthlySummaryByID(t *testing.T) {
 	e := echo.New()
 	mockUseCase := new(MockMonthlySummaryUseCase)
 	h := handler.NewMonthlySummaryHandler(mockUseCase)
+	w := strictServer(&handler.Server{MonthlySummaryHandler: h})
 
 	summaryID := 1
 	req := httptest.NewRequest(http.MethodGet, "/monthly-summaries/"+strconv.Itoa(summaryID), nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
+	c.Set("user", &jwt.Token{
+		Claims: jwt.MapClaims{
+			"user_id": float64(1),
+		},
+	})
 	c.SetParamNames("id")
 	c.SetParamValues(strconv.Itoa(summaryID))
 
@@ -122,9 +135,9 @@ func TestGetMonthlySummaryByID(t *testing.T) {
 		Balance:   500.0,
 	}
 
-	mockUseCase.On("GetMonthlySummaryByID", summaryID).Return(mockSummary, nil)
+	mockUseCase.On("GetMonthlySummaryByID", 1, summaryID).Return(mockSummary, nil)
 
-	if assert.NoError(t, h.GetMonthlySummaryByID(c)) {
+	if assert.NoError(t, w.GetMonthlySummaryById(c)) {
 		assert.Equal(t, http.StatusOK, rec.Code)
 		var response presenter.MonthlySummaryResponse
 		json.Unmarshal(rec.Body.Bytes(), &response)
@@ -137,11 +150,17 @@ func TestGetMonthlySummariesByUserID(t *testing.T) {
 	e := echo.New()
 	mockUseCase := new(MockMonthlySummaryUseCase)
 	h := handler.NewMonthlySummaryHandler(mockUseCase)
+	w := strictServer(&handler.Server{MonthlySummaryHandler: h})
 
 	userID := 1
 	req := httptest.NewRequest(http.MethodGet, "/monthly-summaries?user_id="+strconv.Itoa(userID), nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
+	c.Set("user", &jwt.Token{
+		Claims: jwt.MapClaims{
+			"user_id": float64(1),
+		},
+	})
 
 	mockSummaries := []entity.MonthlySummary{
 		{
@@ -164,7 +183,7 @@ func TestGetMonthlySummariesByUserID(t *testing.T) {
 
 	mockUseCase.On("GetMonthlySummariesByUserID", userID).Return(mockSummaries, nil)
 
-	if assert.NoError(t, h.GetMonthlySummariesByUserID(c)) {
+	if assert.NoError(t, w.GetMonthlySummaries(c)) {
 		assert.Equal(t, http.StatusOK, rec.Code)
 		var response []presenter.MonthlySummaryResponse
 		json.Unmarshal(rec.Body.Bytes(), &response)
@@ -178,6 +197,7 @@ func TestUpdateMonthlySummary(t *testing.T) {
 	e := echo.New()
 	mockUseCase := new(MockMonthlySummaryUseCase)
 	h := handler.NewMonthlySummaryHandler(mockUseCase)
+	w := strictServer(&handler.Server{MonthlySummaryHandler: h})
 
 	summaryID := 1
 	requestBody := presenter.UpdateMonthlySummaryByIdJSONRequestBody{
@@ -187,11 +207,17 @@ func TestUpdateMonthlySummary(t *testing.T) {
 	}
 	jsonBody, _ := json.Marshal(requestBody)
 
-	req := httptest.NewRequest(http.MethodPut, "/monthly-summaries/"+strconv.Itoa(summaryID), bytes.NewReader(jsonBody))
+	req := httptest.NewRequest(http.MethodPatch, "/monthly-summaries/"+strconv.Itoa(summaryID), bytes.NewReader(jsonBody))
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set("If-Match", `"1"`)
 
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
+	c.Set("user", &jwt.Token{
+		Claims: jwt.MapClaims{
+			"user_id": float64(1),
+		},
+	})
 	c.SetParamNames("id")
 	c.SetParamValues(strconv.Itoa(summaryID))
 
@@ -204,7 +230,7 @@ func TestUpdateMonthlySummary(t *testing.T) {
 
 	mockUseCase.On("UpdateMonthlySummary", mock.AnythingOfType("*entity.MonthlySummary")).Return(mockSummary, nil)
 
-	if assert.NoError(t, h.UpdateMonthlySummary(c)) {
+	if assert.NoError(t, w.UpdateMonthlySummaryById(c)) {
 		assert.Equal(t, http.StatusOK, rec.Code)
 		var response presenter.MonthlySummaryResponse
 		json.Unmarshal(rec.Body.Bytes(), &response)
@@ -219,17 +245,24 @@ func TestDeleteMonthlySummary(t *testing.T) {
 	e := echo.New()
 	mockUseCase := new(MockMonthlySummaryUseCase)
 	h := handler.NewMonthlySummaryHandler(mockUseCase)
+	w := strictServer(&handler.Server{MonthlySummaryHandler: h})
 
 	summaryID := 1
 	req := httptest.NewRequest(http.MethodDelete, "/monthly-summaries/"+strconv.Itoa(summaryID), nil)
+	req.Header.Set("If-Match", `"1"`)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
+	c.Set("user", &jwt.Token{
+		Claims: jwt.MapClaims{
+			"user_id": float64(1),
+		},
+	})
 	c.SetParamNames("id")
 	c.SetParamValues(strconv.Itoa(summaryID))
 
-	mockUseCase.On("DeleteMonthlySummary", summaryID).Return(nil)
+	mockUseCase.On("DeleteMonthlySummary", 1, summaryID, 1).Return(nil)
 
-	if assert.NoError(t, h.DeleteMonthlySummary(c)) {
+	if assert.NoError(t, w.DeleteMonthlySummaryById(c)) {
 		assert.Equal(t, http.StatusNoContent, rec.Code)
 	}
 }
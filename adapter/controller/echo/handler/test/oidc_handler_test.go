@@ -0,0 +1,209 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"household-account-backend/adapter/controller/echo/handler"
+	"household-account-backend/entity"
+	"household-account-backend/usecase"
+)
+
+type MockOIDCUseCase struct {
+	mock.Mock
+}
+
+func (m *MockOIDCUseCase) Providers() []string {
+	args := m.Called()
+	return args.Get(0).([]string)
+}
+
+func (m *MockOIDCUseCase) BeginAuthorization(ctx context.Context, provider string, linkUserID int) (*usecase.OIDCAuthorization, error) {
+	args := m.Called(provider, linkUserID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecase.OIDCAuthorization), args.Error(1)
+}
+
+func (m *MockOIDCUseCase) HandleCallback(ctx context.Context, provider string, state string, code string) (*usecase.OIDCCallbackResult, error) {
+	args := m.Called(provider, state, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecase.OIDCCallbackResult), args.Error(1)
+}
+
+func (m *MockOIDCUseCase) GetIdentities(userID int) ([]entity.UserIdentity, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.UserIdentity), args.Error(1)
+}
+
+func (m *MockOIDCUseCase) UnlinkIdentity(userID int, provider string) error {
+	args := m.Called(userID, provider)
+	return args.Error(0)
+}
+
+func newOIDCCallbackContext(e *echo.Echo, cookieState string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/auth/oidc/mock/callback?state=state&code=code", nil)
+	if cookieState != "" {
+		req.AddCookie(&http.Cookie{Name: "oidc_state", Value: cookieState})
+	}
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("provider")
+	c.SetParamValues("mock")
+	return c, rec
+}
+
+func findCookie(rec *httptest.ResponseRecorder, name string) *http.Cookie {
+	for _, cookie := range rec.Result().Cookies() {
+		if cookie.Name == name {
+			return cookie
+		}
+	}
+	return nil
+}
+
+func TestOIDCStartLogin(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockOIDCUseCase)
+	h := handler.NewOIDCHandler(mockUseCase, "http://localhost:3000")
+	w := strictServer(&handler.Server{OIDCHandler: h})
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/auth/oidc/mock/login", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("provider")
+	c.SetParamValues("mock")
+
+	mockUseCase.On("BeginAuthorization", "mock", 0).Return(&usecase.OIDCAuthorization{
+		URL:   "https://idp.example.com/authorize?state=state",
+		State: "state",
+	}, nil)
+
+	if assert.NoError(t, w.StartOIDCLogin(c)) {
+		assert.Equal(t, http.StatusFound, rec.Code)
+		assert.Equal(t, "https://idp.example.com/authorize?state=state", rec.Header().Get(echo.HeaderLocation))
+		cookie := findCookie(rec, "oidc_state")
+		if assert.NotNil(t, cookie) {
+			assert.Equal(t, "state", cookie.Value)
+			assert.True(t, cookie.HttpOnly)
+		}
+	}
+}
+
+func TestOIDCStartLogin_UnknownProvider(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockOIDCUseCase)
+	h := handler.NewOIDCHandler(mockUseCase, "http://localhost:3000")
+	w := strictServer(&handler.Server{OIDCHandler: h})
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/auth/oidc/unknown/login", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("provider")
+	c.SetParamValues("unknown")
+
+	mockUseCase.On("BeginAuthorization", "unknown", 0).Return(nil, usecase.ErrUnknownOIDCProvider)
+
+	handler.HTTPErrorHandler(w.StartOIDCLogin(c), c)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestOIDCCallback(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockOIDCUseCase)
+	h := handler.NewOIDCHandler(mockUseCase, "http://localhost:3000")
+	w := strictServer(&handler.Server{OIDCHandler: h})
+	c, rec := newOIDCCallbackContext(e, "state")
+
+	mockUseCase.On("HandleCallback", "mock", "state", "code").Return(&usecase.OIDCCallbackResult{
+		Login: &usecase.LoginResult{AuthToken: "token"},
+	}, nil)
+
+	if assert.NoError(t, w.HandleOIDCCallback(c)) {
+		assert.Equal(t, http.StatusFound, rec.Code)
+		assert.Equal(t, "http://localhost:3000/", rec.Header().Get(echo.HeaderLocation))
+		cookie := findCookie(rec, "auth_token")
+		if assert.NotNil(t, cookie) {
+			assert.Equal(t, "token", cookie.Value)
+		}
+	}
+}
+
+func TestOIDCCallback_StateMismatch(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockOIDCUseCase)
+	h := handler.NewOIDCHandler(mockUseCase, "http://localhost:3000")
+	w := strictServer(&handler.Server{OIDCHandler: h})
+	c, rec := newOIDCCallbackContext(e, "other")
+
+	if assert.NoError(t, w.HandleOIDCCallback(c)) {
+		assert.Equal(t, http.StatusFound, rec.Code)
+		assert.Equal(t, "http://localhost:3000/login?error=oidc_failed", rec.Header().Get(echo.HeaderLocation))
+		assert.Nil(t, findCookie(rec, "auth_token"))
+		mockUseCase.AssertNotCalled(t, "HandleCallback", mock.Anything, mock.Anything, mock.Anything)
+	}
+}
+
+func TestOIDCCallback_TOTPChallenge(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockOIDCUseCase)
+	h := handler.NewOIDCHandler(mockUseCase, "http://localhost:3000")
+	w := strictServer(&handler.Server{OIDCHandler: h})
+	c, rec := newOIDCCallbackContext(e, "state")
+
+	mockUseCase.On("HandleCallback", "mock", "state", "code").Return(&usecase.OIDCCallbackResult{
+		Login: &usecase.LoginResult{TOTPChallenge: "challenge"},
+	}, nil)
+
+	if assert.NoError(t, w.HandleOIDCCallback(c)) {
+		assert.Equal(t, http.StatusFound, rec.Code)
+		location, _ := url.Parse(rec.Header().Get(echo.HeaderLocation))
+		assert.Equal(t, "/login/totp", location.Path)
+		assert.Equal(t, "challenge", location.Query().Get("challenge_token"))
+		assert.Nil(t, findCookie(rec, "auth_token"))
+	}
+}
+
+func TestOIDCCallback_LinkRequired(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockOIDCUseCase)
+	h := handler.NewOIDCHandler(mockUseCase, "http://localhost:3000")
+	w := strictServer(&handler.Server{OIDCHandler: h})
+	c, rec := newOIDCCallbackContext(e, "state")
+
+	mockUseCase.On("HandleCallback", "mock", "state", "code").Return(nil, usecase.ErrOIDCLinkRequired)
+
+	if assert.NoError(t, w.HandleOIDCCallback(c)) {
+		assert.Equal(t, "http://localhost:3000/login?error=link_required", rec.Header().Get(echo.HeaderLocation))
+	}
+}
+
+func TestOIDCUnlinkIdentity_NotFound(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockOIDCUseCase)
+	h := handler.NewOIDCHandler(mockUseCase, "http://localhost:3000")
+	w := strictServer(&handler.Server{OIDCHandler: h})
+	req := httptest.NewRequest(http.MethodDelete, "/users/identities/mock", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("provider")
+	c.SetParamValues("mock")
+	c.Set("user", &jwt.Token{Claims: jwt.MapClaims{"user_id": float64(1)}})
+
+	mockUseCase.On("UnlinkIdentity", 1, "mock").Return(usecase.ErrIdentityNotFound)
+
+	handler.HTTPErrorHandler(w.UnlinkUserIdentity(c), c)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
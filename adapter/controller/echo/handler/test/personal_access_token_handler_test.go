@@ -0,0 +1,138 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"household-account-backend/adapter/controller/echo/handler"
+	"household-account-backend/adapter/controller/echo/presenter"
+	"household-account-backend/entity"
+	"household-account-backend/usecase"
+)
+
+type MockPersonalAccessTokenUseCase struct {
+	mock.Mock
+}
+
+func (m *MockPersonalAccessTokenUseCase) CreateToken(token *entity.PersonalAccessToken) (*entity.PersonalAccessToken, string, error) {
+	args := m.Called(token)
+	if args.Get(0) == nil {
+		return nil, "", args.Error(2)
+	}
+	return args.Get(0).(*entity.PersonalAccessToken), args.String(1), args.Error(2)
+}
+
+func (m *MockPersonalAccessTokenUseCase) GetTokensByUserID(userID int) ([]entity.PersonalAccessToken, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.PersonalAccessToken), args.Error(1)
+}
+
+func (m *MockPersonalAccessTokenUseCase) DeleteToken(userID int, tokenID int) error {
+	args := m.Called(userID, tokenID)
+	return args.Error(0)
+}
+
+func (m *MockPersonalAccessTokenUseCase) Authenticate(token string) (*entity.PersonalAccessToken, error) {
+	args := m.Called(token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.PersonalAccessToken), args.Error(1)
+}
+
+func newPersonalAccessTokenContext(e *echo.Echo, method string, body string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, "/users/tokens", bytes.NewReader([]byte(body)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user", &jwt.Token{Claims: jwt.MapClaims{"user_id": float64(1)}})
+	return c, rec
+}
+
+func TestCreatePersonalAccessToken(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockPersonalAccessTokenUseCase)
+	h := handler.NewPersonalAccessTokenHandler(mockUseCase)
+	w := strictServer(&handler.Server{PersonalAccessTokenHandler: h})
+	c, rec := newPersonalAccessTokenContext(e, http.MethodPost, `{"name":"import script","scopes":["read:transactions","write:transactions"]}`)
+
+	mockUseCase.On("CreateToken", mock.MatchedBy(func(token *entity.PersonalAccessToken) bool {
+		return token.UserID == 1 && token.Name == "import script" && token.Scopes == "read:transactions,write:transactions"
+	})).Return(&entity.PersonalAccessToken{
+		ID:          1,
+		UserID:      1,
+		Name:        "import script",
+		TokenPrefix: "hha_12345678",
+		Scopes:      "read:transactions,write:transactions",
+	}, "hha_1234567890", nil)
+
+	if assert.NoError(t, w.CreatePersonalAccessToken(c)) {
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		var response presenter.PersonalAccessTokenResponse
+		json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.Equal(t, "hha_1234567890", *response.Token)
+		assert.Equal(t, []presenter.TokenScope{"read:transactions", "write:transactions"}, response.Scopes)
+		assert.NotContains(t, rec.Body.String(), "token_hash")
+	}
+}
+
+func TestCreatePersonalAccessToken_InvalidScope(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockPersonalAccessTokenUseCase)
+	h := handler.NewPersonalAccessTokenHandler(mockUseCase)
+	w := strictServer(&handler.Server{PersonalAccessTokenHandler: h})
+	c, rec := newPersonalAccessTokenContext(e, http.MethodPost, `{"name":"script","scopes":["admin"]}`)
+
+	mockUseCase.On("CreateToken", mock.Anything).Return(nil, "", usecase.ErrInvalidTokenScope)
+
+	handler.HTTPErrorHandler(w.CreatePersonalAccessToken(c), c)
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
+
+func TestGetPersonalAccessTokens(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockPersonalAccessTokenUseCase)
+	h := handler.NewPersonalAccessTokenHandler(mockUseCase)
+	w := strictServer(&handler.Server{PersonalAccessTokenHandler: h})
+	c, rec := newPersonalAccessTokenContext(e, http.MethodGet, "")
+
+	mockUseCase.On("GetTokensByUserID", 1).Return([]entity.PersonalAccessToken{
+		{ID: 1, UserID: 1, Name: "script", TokenPrefix: "hha_12345678", TokenHash: "hash", Scopes: "read:reports"},
+	}, nil)
+
+	if assert.NoError(t, w.GetPersonalAccessTokens(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var response []presenter.PersonalAccessTokenResponse
+		json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.Len(t, response, 1)
+		// 一覧ではトークン本体とハッシュを返さない
+		assert.Nil(t, response[0].Token)
+		assert.NotContains(t, rec.Body.String(), "hash")
+	}
+}
+
+func TestDeletePersonalAccessToken_NotFound(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockPersonalAccessTokenUseCase)
+	h := handler.NewPersonalAccessTokenHandler(mockUseCase)
+	w := strictServer(&handler.Server{PersonalAccessTokenHandler: h})
+	c, rec := newPersonalAccessTokenContext(e, http.MethodDelete, "")
+	c.SetParamNames("id")
+	c.SetParamValues("99")
+
+	mockUseCase.On("DeleteToken", 1, 99).Return(usecase.ErrAccessTokenNotFound)
+
+	handler.HTTPErrorHandler(w.DeletePersonalAccessToken(c), c)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -0,0 +1,60 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"household-account-backend/adapter/controller/echo/handler"
+	"household-account-backend/adapter/controller/echo/presenter"
+	"household-account-backend/usecase"
+)
+
+// strictServer はルーターと同じく、OpenAPIの定義から生成したラッパーを通して操作を呼び出す
+// パラメータの変換やボディの読み込みも含めて検証するため、ハンドラーのメソッドを直接呼ばない
+func strictServer(server *handler.Server) *presenter.ServerInterfaceWrapper {
+	return &presenter.ServerInterfaceWrapper{Handler: handler.NewStrictHandler(server)}
+}
+
+func TestStrictServerPassesAuthenticatedUser(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockUserUseCase)
+	h := handler.NewUserHandler(mockUseCase)
+	w := strictServer(&handler.Server{UserHandler: h})
+
+	req := httptest.NewRequest(http.MethodGet, "/users", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user", &jwt.Token{
+		Claims: jwt.MapClaims{
+			"user_id": float64(7),
+		},
+	})
+
+	mockUseCase.On("GetCurrentUser", 7).Return(nil, &usecase.NotFoundError{Message: "user not found"})
+
+	handler.HTTPErrorHandler(w.GetCurrentUser(c), c)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestStrictServerRejectsInvalidPathParameter(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockCategoryUseCase)
+	h := handler.NewCategoryHandler(mockUseCase)
+	w := strictServer(&handler.Server{CategoryHandler: h})
+
+	req := httptest.NewRequest(http.MethodGet, "/categories/abc", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("abc")
+
+	handler.HTTPErrorHandler(w.GetCategoryById(c), c)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockUseCase.AssertNotCalled(t, "GetCategoryByID")
+}
@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/golang-jwt/jwt/v4"
 	"github.com/labstack/echo/v4"
 	"github.com/oapi-codegen/runtime/types"
 	"github.com/stretchr/testify/assert"
@@ -16,6 +17,7 @@ import (
 	"household-account-backend/adapter/controller/echo/handler"
 	"household-account-backend/adapter/controller/echo/presenter"
 	"household-account-backend/entity"
+	"household-account-backend/usecase"
 )
 
 type MockTransactionUseCase struct {
@@ -30,8 +32,8 @@ func (m *MockTransactionUseCase) CreateTransaction(transaction *entity.Transacti
 	return args.Get(0).(*entity.Transaction), args.Error(1)
 }
 
-func (m *MockTransactionUseCase) GetTransactionByID(transactionID int) (*entity.Transaction, error) {
-	args := m.Called(transactionID)
+func (m *MockTransactionUseCase) GetTransactionByID(userID int, transactionID int) (*entity.Transaction, error) {
+	args := m.Called(userID, transactionID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -46,6 +48,14 @@ func (m *MockTransactionUseCase) GetTransactionsByUserID(userID int) ([]entity.T
 	return args.Get(0).([]entity.Transaction), args.Error(1)
 }
 
+func (m *MockTransactionUseCase) FindTransactions(userID int, filter entity.TransactionFilter) ([]entity.Transaction, error) {
+	args := m.Called(userID, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.Transaction), args.Error(1)
+}
+
 func (m *MockTransactionUseCase) UpdateTransaction(transaction *entity.Transaction) (*entity.Transaction, error) {
 	args := m.Called(transaction)
 	if args.Get(0) == nil {
@@ -54,15 +64,24 @@ func (m *MockTransactionUseCase) UpdateTransaction(transaction *entity.Transacti
 	return args.Get(0).(*entity.Transaction), args.Error(1)
 }
 
-func (m *MockTransactionUseCase) DeleteTransaction(transactionID int) error {
-	args := m.Called(transactionID)
+func (m *MockTransactionUseCase) DeleteTransaction(userID int, transactionID int, version int) error {
+	args := m.Called(userID, transactionID, version)
 	return args.Error(0)
 }
 
+func (m *MockTransactionUseCase) BulkTransactions(userID int, operations []entity.TransactionOperation, atomic bool) ([]entity.TransactionOperationResult, error) {
+	args := m.Called(userID, operations, atomic)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.TransactionOperationResult), args.Error(1)
+}
+
 func TestCreateTransaction(t *testing.T) {
 	e := echo.New()
 	mockUseCase := new(MockTransactionUseCase)
 	h := handler.NewTransactionHandler(mockUseCase)
+	w := strictServer(&handler.Server{TransactionHandler: h})
 
 	requestBody := presenter.CreateTransactionJSONRequestBody{
 		UserId:     1,
@@ -78,6 +97,11 @@ func TestCreateTransaction(t *testing.T) {
 
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
+	c.Set("user", &jwt.Token{
+		Claims: jwt.MapClaims{
+			"user_id": float64(1),
+		},
+	})
 
 	mockTransaction := &entity.Transaction{
 		ID:         1,
@@ -90,7 +114,7 @@ func TestCreateTransaction(t *testing.T) {
 
 	mockUseCase.On("CreateTransaction", mock.AnythingOfType("*entity.Transaction")).Return(mockTransaction, nil)
 
-	if assert.NoError(t, h.CreateTransaction(c)) {
+	if assert.NoError(t, w.CreateTransaction(c)) {
 		assert.Equal(t, http.StatusCreated, rec.Code)
 		var response presenter.TransactionResponse
 		json.Unmarshal(rec.Body.Bytes(), &response)
@@ -104,10 +128,16 @@ func TestGetTransactionByID(t *testing.T) {
 	e := echo.New()
 	mockUseCase := new(MockTransactionUseCase)
 	h := handler.NewTransactionHandler(mockUseCase)
+	w := strictServer(&handler.Server{TransactionHandler: h})
 
 	req := httptest.NewRequest(http.MethodGet, "/transactions/1", nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
+	c.Set("user", &jwt.Token{
+		Claims: jwt.MapClaims{
+			"user_id": float64(1),
+		},
+	})
 	c.SetParamNames("id")
 	c.SetParamValues("1")
 
@@ -120,9 +150,9 @@ func TestGetTransactionByID(t *testing.T) {
 		Content:    "Groceries",
 	}
 
-	mockUseCase.On("GetTransactionByID", 1).Return(mockTransaction, nil)
+	mockUseCase.On("GetTransactionByID", 1, 1).Return(mockTransaction, nil)
 
-	if assert.NoError(t, h.GetTransactionByID(c)) {
+	if assert.NoError(t, w.GetTransactionById(c)) {
 		assert.Equal(t, http.StatusOK, rec.Code)
 		var response presenter.TransactionResponse
 		json.Unmarshal(rec.Body.Bytes(), &response)
@@ -135,10 +165,16 @@ func TestGetTransactionsByUserID(t *testing.T) {
 	e := echo.New()
 	mockUseCase := new(MockTransactionUseCase)
 	h := handler.NewTransactionHandler(mockUseCase)
+	w := strictServer(&handler.Server{TransactionHandler: h})
 
 	req := httptest.NewRequest(http.MethodGet, "/transactions?user_id=1", nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
+	c.Set("user", &jwt.Token{
+		Claims: jwt.MapClaims{
+			"user_id": float64(1),
+		},
+	})
 
 	mockTransactions := []entity.Transaction{
 		{ID: 1, UserID: 1, CategoryID: 1, Date: time.Now(), Amount: 100.50, Content: "Groceries"},
@@ -147,7 +183,7 @@ func TestGetTransactionsByUserID(t *testing.T) {
 
 	mockUseCase.On("GetTransactionsByUserID", 1).Return(mockTransactions, nil)
 
-	if assert.NoError(t, h.GetTransactionsByUserID(c)) {
+	if assert.NoError(t, w.GetTransactions(c)) {
 		assert.Equal(t, http.StatusOK, rec.Code)
 		var response []presenter.TransactionResponse
 		json.Unmarshal(rec.Body.Bytes(), &response)
@@ -161,6 +197,7 @@ func TestUpdateTransaction(t *testing.T) {
 	e := echo.New()
 	mockUseCase := new(MockTransactionUseCase)
 	h := handler.NewTransactionHandler(mockUseCase)
+	w := strictServer(&handler.Server{TransactionHandler: h})
 
 	requestBody := presenter.TransactionUpdateRequestBody{
 		UserId:     1,
@@ -171,11 +208,17 @@ func TestUpdateTransaction(t *testing.T) {
 	}
 	jsonBody, _ := json.Marshal(requestBody)
 
-	req := httptest.NewRequest(http.MethodPut, "/transactions/1", bytes.NewReader(jsonBody))
+	req := httptest.NewRequest(http.MethodPatch, "/transactions/1", bytes.NewReader(jsonBody))
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set("If-Match", `"1"`)
 
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
+	c.Set("user", &jwt.Token{
+		Claims: jwt.MapClaims{
+			"user_id": float64(1),
+		},
+	})
 	c.SetParamNames("id")
 	c.SetParamValues("1")
 
@@ -190,7 +233,7 @@ func TestUpdateTransaction(t *testing.T) {
 
 	mockUseCase.On("UpdateTransaction", mock.AnythingOfType("*entity.Transaction")).Return(mockTransaction, nil)
 
-	if assert.NoError(t, h.UpdateTransaction(c)) {
+	if assert.NoError(t, w.UpdateTransactionById(c)) {
 		assert.Equal(t, http.StatusOK, rec.Code)
 		var response presenter.TransactionResponse
 		json.Unmarshal(rec.Body.Bytes(), &response)
@@ -202,20 +245,221 @@ func TestDeleteTransaction(t *testing.T) {
 	e := echo.New()
 	mockUseCase := new(MockTransactionUseCase)
 	h := handler.NewTransactionHandler(mockUseCase)
+	w := strictServer(&handler.Server{TransactionHandler: h})
 
 	req := httptest.NewRequest(http.MethodDelete, "/transactions/1", nil)
+	req.Header.Set("If-Match", `"1"`)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
+	c.Set("user", &jwt.Token{
+		Claims: jwt.MapClaims{
+			"user_id": float64(1),
+		},
+	})
 	c.SetParamNames("id")
 	c.SetParamValues("1")
 
-	mockUseCase.On("DeleteTransaction", 1).Return(nil)
+	mockUseCase.On("DeleteTransaction", 1, 1, 1).Return(nil)
 
-	if assert.NoError(t, h.DeleteTransaction(c)) {
+	if assert.NoError(t, w.DeleteTransactionById(c)) {
 		assert.Equal(t, http.StatusNoContent, rec.Code)
 	}
 }
 
+func TestGetTransactionByIDSetsETag(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockTransactionUseCase)
+	h := handler.NewTransactionHandler(mockUseCase)
+	w := strictServer(&handler.Server{TransactionHandler: h})
+
+	req := httptest.NewRequest(http.MethodGet, "/transactions/1", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user", &jwt.Token{
+		Claims: jwt.MapClaims{
+			"user_id": float64(1),
+		},
+	})
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	mockUseCase.On("GetTransactionByID", 1, 1).Return(&entity.Transaction{ID: 1, UserID: 1, Date: time.Now(), Version: 3}, nil)
+
+	if assert.NoError(t, w.GetTransactionById(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `"3"`, rec.Header().Get("ETag"))
+		var response presenter.TransactionResponse
+		json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.Equal(t, 3, response.Version)
+	}
+}
+
+func TestUpdateTransactionWithoutIfMatch(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockTransactionUseCase)
+	h := handler.NewTransactionHandler(mockUseCase)
+	w := strictServer(&handler.Server{TransactionHandler: h})
+
+	req := httptest.NewRequest(http.MethodPatch, "/transactions/1", bytes.NewReader([]byte(`{}`)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user", &jwt.Token{
+		Claims: jwt.MapClaims{
+			"user_id": float64(1),
+		},
+	})
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	// If-Matchは定義で必須のため、生成したラッパーがハンドラーを呼ぶ前に拒否する
+	handler.HTTPErrorHandler(w.UpdateTransactionById(c), c)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockUseCase.AssertNotCalled(t, "UpdateTransaction", mock.Anything)
+}
+
+func TestUpdateTransactionVersionConflict(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockTransactionUseCase)
+	h := handler.NewTransactionHandler(mockUseCase)
+	w := strictServer(&handler.Server{TransactionHandler: h})
+
+	requestBody := presenter.TransactionUpdateRequestBody{
+		UserId:     1,
+		CategoryId: 1,
+		Date:       types.Date{Time: time.Now()},
+		Amount:     200.00,
+		Content:    pointerToString("Updated Groceries"),
+	}
+	jsonBody, _ := json.Marshal(requestBody)
+
+	req := httptest.NewRequest(http.MethodPatch, "/transactions/1", bytes.NewReader(jsonBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set("If-Match", `"1"`)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user", &jwt.Token{
+		Claims: jwt.MapClaims{
+			"user_id": float64(1),
+		},
+	})
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	mockUseCase.On("UpdateTransaction", mock.MatchedBy(func(transaction *entity.Transaction) bool {
+		return transaction.Version == 1
+	})).Return(nil, usecase.ErrVersionConflict)
+
+	handler.HTTPErrorHandler(w.UpdateTransactionById(c), c)
+	assert.Equal(t, http.StatusPreconditionFailed, rec.Code)
+}
+
+func TestUpdateTransactionWeakETag(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockTransactionUseCase)
+	h := handler.NewTransactionHandler(mockUseCase)
+	w := strictServer(&handler.Server{TransactionHandler: h})
+
+	requestBody := presenter.TransactionUpdateRequestBody{
+		UserId:     1,
+		CategoryId: 1,
+		Date:       types.Date{Time: time.Now()},
+		Amount:     200.00,
+	}
+	jsonBody, _ := json.Marshal(requestBody)
+
+	req := httptest.NewRequest(http.MethodPatch, "/transactions/1", bytes.NewReader(jsonBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set("If-Match", `W/"1"`)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user", &jwt.Token{
+		Claims: jwt.MapClaims{
+			"user_id": float64(1),
+		},
+	})
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	// 弱いETagはバージョンが同じでも一致しない
+	handler.HTTPErrorHandler(w.UpdateTransactionById(c), c)
+	assert.Equal(t, http.StatusPreconditionFailed, rec.Code)
+	mockUseCase.AssertNotCalled(t, "UpdateTransaction", mock.Anything)
+}
+
+func TestDeleteTransactionVersionConflict(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockTransactionUseCase)
+	h := handler.NewTransactionHandler(mockUseCase)
+	w := strictServer(&handler.Server{TransactionHandler: h})
+
+	req := httptest.NewRequest(http.MethodDelete, "/transactions/1", nil)
+	req.Header.Set("If-Match", `"1"`)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user", &jwt.Token{
+		Claims: jwt.MapClaims{
+			"user_id": float64(1),
+		},
+	})
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	mockUseCase.On("DeleteTransaction", 1, 1, 1).Return(usecase.ErrVersionConflict)
+
+	handler.HTTPErrorHandler(w.DeleteTransactionById(c), c)
+	assert.Equal(t, http.StatusPreconditionFailed, rec.Code)
+}
+
 func pointerToString(s string) *string {
 	return &s
 }
+
+func newBulkTransactionsContext(e *echo.Echo, body string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodPost, "/transactions/bulk", bytes.NewReader([]byte(body)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user", &jwt.Token{
+		Claims: jwt.MapClaims{
+			"user_id": float64(1),
+		},
+	})
+	return c, rec
+}
+
+func TestBulkTransactionsWithoutVersion(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockTransactionUseCase)
+	h := handler.NewTransactionHandler(mockUseCase)
+	w := strictServer(&handler.Server{TransactionHandler: h})
+	c, rec := newBulkTransactionsContext(e, `{"operations":[{"op":"delete","id":3}]}`)
+
+	handler.HTTPErrorHandler(w.BulkTransactions(c), c)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockUseCase.AssertNotCalled(t, "BulkTransactions", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestBulkTransactionsVersionConflict(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockTransactionUseCase)
+	h := handler.NewTransactionHandler(mockUseCase)
+	w := strictServer(&handler.Server{TransactionHandler: h})
+	c, rec := newBulkTransactionsContext(e, `{"mode":"best_effort","operations":[{"op":"delete","id":3,"version":1}]}`)
+
+	mockUseCase.On("BulkTransactions", 1, []entity.TransactionOperation{
+		{Op: entity.TransactionOperationDelete, Transaction: entity.Transaction{ID: 3, Version: 1}},
+	}, false).Return([]entity.TransactionOperationResult{
+		{Index: 0, Op: entity.TransactionOperationDelete, Error: usecase.ErrVersionConflict.Error(), Err: usecase.ErrVersionConflict},
+	}, nil)
+
+	if assert.NoError(t, w.BulkTransactions(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var response presenter.TransactionBulkResultList
+		json.Unmarshal(rec.Body.Bytes(), &response)
+		if assert.Len(t, response.Results, 1) && assert.NotNil(t, response.Results[0].Status) {
+			assert.False(t, response.Results[0].Success)
+			assert.Equal(t, http.StatusPreconditionFailed, *response.Results[0].Status)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"household-account-backend/adapter/controller/echo/handler"
+	"household-account-backend/adapter/controller/echo/presenter"
+	"household-account-backend/usecase"
+)
+
+type MockTwoFactorUseCase struct {
+	mock.Mock
+}
+
+func (m *MockTwoFactorUseCase) Enabled(userID int) (bool, error) {
+	args := m.Called(userID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockTwoFactorUseCase) BeginEnrollment(userID int) (*usecase.TOTPEnrollment, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecase.TOTPEnrollment), args.Error(1)
+}
+
+func (m *MockTwoFactorUseCase) ConfirmEnrollment(userID int, code string) ([]string, error) {
+	args := m.Called(userID, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockTwoFactorUseCase) Disable(userID int, code string, clientIP string) error {
+	args := m.Called(userID, code, clientIP)
+	return args.Error(0)
+}
+
+func (m *MockTwoFactorUseCase) RegenerateRecoveryCodes(userID int, code string, clientIP string) ([]string, error) {
+	args := m.Called(userID, code, clientIP)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockTwoFactorUseCase) VerifyCode(userID int, code string) error {
+	args := m.Called(userID, code)
+	return args.Error(0)
+}
+
+func newTwoFactorContext(e *echo.Echo, body string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodPost, "/users/totp", bytes.NewReader([]byte(body)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user", &jwt.Token{Claims: jwt.MapClaims{"user_id": float64(1)}})
+	return c, rec
+}
+
+func TestEnrollTOTP(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockTwoFactorUseCase)
+	h := handler.NewTwoFactorHandler(mockUseCase)
+	w := strictServer(&handler.Server{TwoFactorHandler: h})
+	c, rec := newTwoFactorContext(e, "")
+
+	mockUseCase.On("BeginEnrollment", 1).Return(&usecase.TOTPEnrollment{
+		Secret: "SECRET",
+		URI:    "otpauth://totp/Household%20Account:test@example.com?secret=SECRET",
+	}, nil)
+
+	if assert.NoError(t, w.EnrollTOTP(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var response presenter.TOTPEnrollmentResponse
+		json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.Equal(t, "SECRET", response.Secret)
+		assert.Contains(t, response.OtpauthUri, "secret=SECRET")
+	}
+}
+
+func TestEnrollTOTP_AlreadyEnabled(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockTwoFactorUseCase)
+	h := handler.NewTwoFactorHandler(mockUseCase)
+	w := strictServer(&handler.Server{TwoFactorHandler: h})
+	c, rec := newTwoFactorContext(e, "")
+
+	mockUseCase.On("BeginEnrollment", 1).Return(nil, usecase.ErrTOTPAlreadyEnabled)
+
+	handler.HTTPErrorHandler(w.EnrollTOTP(c), c)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+}
+
+func TestConfirmTOTP(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockTwoFactorUseCase)
+	h := handler.NewTwoFactorHandler(mockUseCase)
+	w := strictServer(&handler.Server{TwoFactorHandler: h})
+	c, rec := newTwoFactorContext(e, `{"code":"123456"}`)
+
+	mockUseCase.On("ConfirmEnrollment", 1, "123456").Return([]string{"abcde-12345"}, nil)
+
+	if assert.NoError(t, w.ConfirmTOTP(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var response presenter.RecoveryCodesResponse
+		json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.Equal(t, []string{"abcde-12345"}, response.RecoveryCodes)
+	}
+}
+
+func TestConfirmTOTP_InvalidCode(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockTwoFactorUseCase)
+	h := handler.NewTwoFactorHandler(mockUseCase)
+	w := strictServer(&handler.Server{TwoFactorHandler: h})
+	c, rec := newTwoFactorContext(e, `{"code":"000000"}`)
+
+	mockUseCase.On("ConfirmEnrollment", 1, "000000").Return(nil, usecase.ErrInvalidTOTPCode)
+
+	handler.HTTPErrorHandler(w.ConfirmTOTP(c), c)
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
+
+func TestDisableTOTP(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockTwoFactorUseCase)
+	h := handler.NewTwoFactorHandler(mockUseCase)
+	w := strictServer(&handler.Server{TwoFactorHandler: h})
+	c, rec := newTwoFactorContext(e, `{"code":"abcde-12345"}`)
+
+	mockUseCase.On("Disable", 1, "abcde-12345", mock.Anything).Return(nil)
+
+	if assert.NoError(t, w.DisableTOTP(c)) {
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	}
+}
+
+func TestDisableTOTP_Throttled(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockTwoFactorUseCase)
+	h := handler.NewTwoFactorHandler(mockUseCase)
+	w := strictServer(&handler.Server{TwoFactorHandler: h})
+	c, rec := newTwoFactorContext(e, `{"code":"123456"}`)
+
+	mockUseCase.On("Disable", 1, "123456", mock.Anything).Return(&usecase.LoginThrottledError{RetryAfter: 90 * time.Second, Locked: true})
+
+	if assert.NoError(t, w.DisableTOTP(c)) {
+		assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+		assert.Equal(t, "90", rec.Header().Get("Retry-After"))
+	}
+}
+
+func TestRegenerateRecoveryCodes_NotEnabled(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockTwoFactorUseCase)
+	h := handler.NewTwoFactorHandler(mockUseCase)
+	w := strictServer(&handler.Server{TwoFactorHandler: h})
+	c, rec := newTwoFactorContext(e, `{"code":"123456"}`)
+
+	mockUseCase.On("RegenerateRecoveryCodes", 1, "123456", mock.Anything).Return(nil, usecase.ErrTOTPNotEnabled)
+
+	handler.HTTPErrorHandler(w.RegenerateRecoveryCodes(c), c)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+}
@@ -17,6 +17,7 @@ import (
 	"household-account-backend/adapter/controller/echo/handler"
 	"household-account-backend/adapter/controller/echo/presenter"
 	"household-account-backend/entity"
+	"household-account-backend/usecase"
 )
 
 type MockUserUseCase struct {
@@ -31,11 +32,27 @@ func (m *MockUserUseCase) Signup(user *entity.User) (*entity.User, error) {
 	return args.Get(0).(*entity.User), args.Error(1)
 }
 
-func (m *MockUserUseCase) Login(credentials *entity.Credentials) (string, error) {
-	args := m.Called(credentials)
+func (m *MockUserUseCase) Login(credentials *entity.Credentials, clientIP string) (*usecase.LoginResult, error) {
+	args := m.Called(credentials, clientIP)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecase.LoginResult), args.Error(1)
+}
+
+func (m *MockUserUseCase) LoginWithTOTP(challenge string, code string, clientIP string) (string, error) {
+	args := m.Called(challenge, code, clientIP)
 	return args.String(0), args.Error(1)
 }
 
+func (m *MockUserUseCase) CompleteLogin(userID int) (*usecase.LoginResult, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecase.LoginResult), args.Error(1)
+}
+
 func (m *MockUserUseCase) GetCurrentUser(userID int) (*entity.User, error) {
 	args := m.Called(userID)
 	if args.Get(0) == nil {
@@ -52,8 +69,23 @@ func (m *MockUserUseCase) UpdateUser(user *entity.User) (*entity.User, error) {
 	return args.Get(0).(*entity.User), args.Error(1)
 }
 
-func (m *MockUserUseCase) DeleteUser(userID int) error {
-	args := m.Called(userID)
+func (m *MockUserUseCase) VerifyEmail(token string) error {
+	args := m.Called(token)
+	return args.Error(0)
+}
+
+func (m *MockUserUseCase) RequestPasswordReset(email string) error {
+	args := m.Called(email)
+	return args.Error(0)
+}
+
+func (m *MockUserUseCase) ResetPassword(token string, newPassword string) error {
+	args := m.Called(token, newPassword)
+	return args.Error(0)
+}
+
+func (m *MockUserUseCase) UnlockAccount(token string) error {
+	args := m.Called(token)
 	return args.Error(0)
 }
 
@@ -61,6 +93,7 @@ func TestSignup(t *testing.T) {
 	e := echo.New()
 	mockUseCase := new(MockUserUseCase)
 	h := handler.NewUserHandler(mockUseCase)
+	w := strictServer(&handler.Server{UserHandler: h})
 
 	requestBody := presenter.CreateUserJSONRequestBody{
 		Email:    "test@example.com",
@@ -84,7 +117,7 @@ func TestSignup(t *testing.T) {
 
 	mockUseCase.On("Signup", mock.AnythingOfType("*entity.User")).Return(mockUser, nil)
 
-	if assert.NoError(t, h.Signup(c)) {
+	if assert.NoError(t, w.CreateUser(c)) {
 		assert.Equal(t, http.StatusCreated, rec.Code)
 		var response presenter.UserResponse
 		json.Unmarshal(rec.Body.Bytes(), &response)
@@ -98,6 +131,7 @@ func TestLogin(t *testing.T) {
 	e := echo.New()
 	mockUseCase := new(MockUserUseCase)
 	h := handler.NewUserHandler(mockUseCase)
+	w := strictServer(&handler.Server{UserHandler: h})
 
 	requestBody := entity.Credentials{
 		Email:    "test@example.com",
@@ -112,9 +146,9 @@ func TestLogin(t *testing.T) {
 	c := e.NewContext(req, rec)
 
 	token := "dummy_jwt_token"
-	mockUseCase.On("Login", mock.AnythingOfType("*entity.Credentials")).Return(token, nil)
+	mockUseCase.On("Login", mock.AnythingOfType("*entity.Credentials"), mock.Anything).Return(&usecase.LoginResult{AuthToken: token}, nil)
 
-	if assert.NoError(t, h.Login(c)) {
+	if assert.NoError(t, w.LoginUser(c)) {
 		assert.Equal(t, http.StatusOK, rec.Code)
 		cookie := rec.Result().Cookies()
 		assert.Len(t, cookie, 1)
@@ -123,10 +157,165 @@ func TestLogin(t *testing.T) {
 	}
 }
 
+func TestLogin_InvalidCredentials(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockUserUseCase)
+	h := handler.NewUserHandler(mockUseCase)
+	w := strictServer(&handler.Server{UserHandler: h})
+
+	req := httptest.NewRequest(http.MethodPost, "/login", bytes.NewReader([]byte(`{"email":"test@example.com","password":"wrong"}`)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.RemoteAddr = "192.0.2.1:12345"
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUseCase.On("Login", mock.AnythingOfType("*entity.Credentials"), "192.0.2.1").Return(nil, usecase.ErrInvalidCredentials)
+
+	handler.HTTPErrorHandler(w.LoginUser(c), c)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Empty(t, rec.Result().Cookies())
+}
+
+func TestLogin_Throttled(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockUserUseCase)
+	h := handler.NewUserHandler(mockUseCase)
+	w := strictServer(&handler.Server{UserHandler: h})
+
+	req := httptest.NewRequest(http.MethodPost, "/login", bytes.NewReader([]byte(`{"email":"test@example.com","password":"password123"}`)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUseCase.On("Login", mock.AnythingOfType("*entity.Credentials"), mock.Anything).Return(nil, &usecase.LoginThrottledError{RetryAfter: 1500 * time.Millisecond, Locked: true})
+
+	handler.HTTPErrorHandler(w.LoginUser(c), c)
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "2", rec.Header().Get("Retry-After"))
+}
+
+func TestUnlockAccount(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockUserUseCase)
+	h := handler.NewUserHandler(mockUseCase)
+	w := strictServer(&handler.Server{UserHandler: h})
+
+	req := httptest.NewRequest(http.MethodPost, "/auth/unlock", bytes.NewReader([]byte(`{"token":"token"}`)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUseCase.On("UnlockAccount", "token").Return(nil)
+
+	if assert.NoError(t, w.UnlockAccount(c)) {
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	}
+}
+
+func TestUnlockAccount_InvalidToken(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockUserUseCase)
+	h := handler.NewUserHandler(mockUseCase)
+	w := strictServer(&handler.Server{UserHandler: h})
+
+	req := httptest.NewRequest(http.MethodPost, "/auth/unlock", bytes.NewReader([]byte(`{"token":"expired"}`)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUseCase.On("UnlockAccount", "expired").Return(usecase.ErrInvalidUserToken)
+
+	handler.HTTPErrorHandler(w.UnlockAccount(c), c)
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
+
+func TestLogin_TOTPRequired(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockUserUseCase)
+	h := handler.NewUserHandler(mockUseCase)
+	w := strictServer(&handler.Server{UserHandler: h})
+
+	req := httptest.NewRequest(http.MethodPost, "/login", bytes.NewReader([]byte(`{"email":"test@example.com","password":"password123"}`)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUseCase.On("Login", mock.AnythingOfType("*entity.Credentials"), mock.Anything).Return(&usecase.LoginResult{TOTPChallenge: "challenge"}, nil)
+
+	if assert.NoError(t, w.LoginUser(c)) {
+		assert.Equal(t, http.StatusAccepted, rec.Code)
+		assert.Empty(t, rec.Result().Cookies())
+		var response presenter.LoginChallengeResponse
+		json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.Equal(t, "challenge", response.ChallengeToken)
+	}
+}
+
+func TestLoginTOTP(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockUserUseCase)
+	h := handler.NewUserHandler(mockUseCase)
+	w := strictServer(&handler.Server{UserHandler: h})
+
+	req := httptest.NewRequest(http.MethodPost, "/login/totp", bytes.NewReader([]byte(`{"challenge_token":"challenge","code":"123456"}`)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUseCase.On("LoginWithTOTP", "challenge", "123456", mock.Anything).Return("dummy_jwt_token", nil)
+
+	if assert.NoError(t, w.LoginUserWithTOTP(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		cookie := rec.Result().Cookies()
+		assert.Len(t, cookie, 1)
+		assert.Equal(t, "auth_token", cookie[0].Name)
+		assert.Equal(t, "dummy_jwt_token", cookie[0].Value)
+	}
+}
+
+func TestLoginTOTP_InvalidCode(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockUserUseCase)
+	h := handler.NewUserHandler(mockUseCase)
+	w := strictServer(&handler.Server{UserHandler: h})
+
+	req := httptest.NewRequest(http.MethodPost, "/login/totp", bytes.NewReader([]byte(`{"challenge_token":"challenge","code":"000000"}`)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUseCase.On("LoginWithTOTP", "challenge", "000000", mock.Anything).Return("", usecase.ErrInvalidTOTPCode)
+
+	handler.HTTPErrorHandler(w.LoginUserWithTOTP(c), c)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Empty(t, rec.Result().Cookies())
+}
+
+func TestLoginTOTP_Throttled(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockUserUseCase)
+	h := handler.NewUserHandler(mockUseCase)
+	w := strictServer(&handler.Server{UserHandler: h})
+
+	req := httptest.NewRequest(http.MethodPost, "/login/totp", bytes.NewReader([]byte(`{"challenge_token":"challenge","code":"000000"}`)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUseCase.On("LoginWithTOTP", "challenge", "000000", mock.Anything).Return("", &usecase.LoginThrottledError{RetryAfter: 90 * time.Second, Locked: true})
+
+	if assert.NoError(t, w.LoginUserWithTOTP(c)) {
+		assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+		assert.Equal(t, "90", rec.Header().Get("Retry-After"))
+		assert.Empty(t, rec.Result().Cookies())
+	}
+}
+
 func TestGetCurrentUser(t *testing.T) {
 	e := echo.New()
 	mockUseCase := new(MockUserUseCase)
 	h := handler.NewUserHandler(mockUseCase)
+	w := strictServer(&handler.Server{UserHandler: h})
 
 	req := httptest.NewRequest(http.MethodGet, "/user", nil)
 	rec := httptest.NewRecorder()
@@ -146,7 +335,7 @@ func TestGetCurrentUser(t *testing.T) {
 		},
 	})
 
-	if assert.NoError(t, h.GetCurrentUser(c)) {
+	if assert.NoError(t, w.GetCurrentUser(c)) {
 		assert.Equal(t, http.StatusOK, rec.Code)
 		var response presenter.UserResponse
 		json.Unmarshal(rec.Body.Bytes(), &response)
@@ -160,12 +349,13 @@ func TestLogout(t *testing.T) {
 	e := echo.New()
 	mockUseCase := new(MockUserUseCase)
 	h := handler.NewUserHandler(mockUseCase)
+	w := strictServer(&handler.Server{UserHandler: h})
 
 	req := httptest.NewRequest(http.MethodPost, "/logout", nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
 
-	if assert.NoError(t, h.Logout(c)) {
+	if assert.NoError(t, w.LogoutUser(c)) {
 		assert.Equal(t, http.StatusOK, rec.Code)
 		cookie := rec.Result().Cookies()
 		assert.Len(t, cookie, 1)
@@ -175,25 +365,90 @@ func TestLogout(t *testing.T) {
 	}
 }
 
-func TestDeleteUser(t *testing.T) {
+func TestVerifyEmail(t *testing.T) {
 	e := echo.New()
 	mockUseCase := new(MockUserUseCase)
 	h := handler.NewUserHandler(mockUseCase)
+	w := strictServer(&handler.Server{UserHandler: h})
 
-	req := httptest.NewRequest(http.MethodDelete, "/user", nil)
+	req := httptest.NewRequest(http.MethodPost, "/verify-email", bytes.NewReader([]byte(`{"token":"token"}`)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
 
-	userID := 1
-	mockUseCase.On("DeleteUser", userID).Return(nil)
+	mockUseCase.On("VerifyEmail", "token").Return(nil)
 
-	c.Set("user", &jwt.Token{
-		Claims: jwt.MapClaims{
-			"user_id": float64(userID),
-		},
-	})
+	if assert.NoError(t, w.VerifyEmail(c)) {
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	}
+}
 
-	if assert.NoError(t, h.DeleteUser(c)) {
+func TestVerifyEmail_InvalidToken(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockUserUseCase)
+	h := handler.NewUserHandler(mockUseCase)
+	w := strictServer(&handler.Server{UserHandler: h})
+
+	req := httptest.NewRequest(http.MethodPost, "/verify-email", bytes.NewReader([]byte(`{"token":"expired"}`)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUseCase.On("VerifyEmail", "expired").Return(usecase.ErrInvalidUserToken)
+
+	handler.HTTPErrorHandler(w.VerifyEmail(c), c)
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
+
+func TestRequestPasswordReset(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockUserUseCase)
+	h := handler.NewUserHandler(mockUseCase)
+	w := strictServer(&handler.Server{UserHandler: h})
+
+	req := httptest.NewRequest(http.MethodPost, "/password-reset/request", bytes.NewReader([]byte(`{"email":"test@example.com"}`)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUseCase.On("RequestPasswordReset", "test@example.com").Return(nil)
+
+	if assert.NoError(t, w.RequestPasswordReset(c)) {
+		assert.Equal(t, http.StatusAccepted, rec.Code)
+	}
+}
+
+func TestConfirmPasswordReset(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockUserUseCase)
+	h := handler.NewUserHandler(mockUseCase)
+	w := strictServer(&handler.Server{UserHandler: h})
+
+	req := httptest.NewRequest(http.MethodPost, "/password-reset/confirm", bytes.NewReader([]byte(`{"token":"token","password":"newpassword"}`)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUseCase.On("ResetPassword", "token", "newpassword").Return(nil)
+
+	if assert.NoError(t, w.ConfirmPasswordReset(c)) {
 		assert.Equal(t, http.StatusNoContent, rec.Code)
 	}
 }
+
+func TestConfirmPasswordReset_InvalidToken(t *testing.T) {
+	e := echo.New()
+	mockUseCase := new(MockUserUseCase)
+	h := handler.NewUserHandler(mockUseCase)
+	w := strictServer(&handler.Server{UserHandler: h})
+
+	req := httptest.NewRequest(http.MethodPost, "/password-reset/confirm", bytes.NewReader([]byte(`{"token":"used","password":"newpassword"}`)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUseCase.On("ResetPassword", "used", "newpassword").Return(usecase.ErrInvalidUserToken)
+
+	handler.HTTPErrorHandler(w.ConfirmPasswordReset(c), c)
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
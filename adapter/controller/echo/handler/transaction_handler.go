@@ -1,11 +1,9 @@
 package handler
 
 import (
-	"net/http"
-	"strconv"
+	"context"
+	"errors"
 
-	"github.com/golang-jwt/jwt/v4"
-	"github.com/labstack/echo/v4"
 	"github.com/oapi-codegen/runtime/types"
 
 	"household-account-backend/adapter/controller/echo/presenter"
@@ -32,123 +30,162 @@ func transactionToResponse(transaction *entity.Transaction) *presenter.Transacti
 		Date:       types.Date{Time: transaction.Date},
 		Amount:     float32(transaction.Amount),
 		Content:    &transaction.Content,
+		Version:    transaction.Version,
 	}
 }
 
-func (h *TransactionHandler) CreateTransaction(c echo.Context) error {
-	user := c.Get("user").(*jwt.Token)
-	claims := user.Claims.(jwt.MapClaims)
-	userId := int(claims["user_id"].(float64))
-
-	var requestBody presenter.CreateTransactionJSONRequestBody
-	if err := c.Bind(&requestBody); err != nil {
-		logger.Warn(err.Error())
-		return c.JSON(http.StatusBadRequest, &presenter.ErrorResponse{Message: err.Error()})
+// transactionResponse はETagヘッダーにバージョンを設定した取引のレスポンスを返す
+func transactionResponse(transaction *entity.Transaction) presenter.TransactionResponseJSONResponse {
+	return presenter.TransactionResponseJSONResponse{
+		Body:    *transactionToResponse(transaction),
+		Headers: presenter.TransactionResponseResponseHeaders{ETag: etag(transaction.Version)},
 	}
+}
 
+func (h *TransactionHandler) CreateTransaction(ctx context.Context, request presenter.CreateTransactionRequestObject) (presenter.CreateTransactionResponseObject, error) {
 	transaction := &entity.Transaction{
-		UserID:     userId,
-		CategoryID: requestBody.CategoryId,
-		Date:       requestBody.Date.Time,
-		Amount:     float32(requestBody.Amount),
-		Content:    *requestBody.Content,
+		UserID:     currentUserID(ctx),
+		CategoryID: request.Body.CategoryId,
+		Date:       request.Body.Date.Time,
+		Amount:     float32(request.Body.Amount),
+	}
+	if request.Body.Content != nil {
+		transaction.Content = *request.Body.Content
 	}
 
 	createdTransaction, err := h.transactionUseCase.CreateTransaction(transaction)
 	if err != nil {
-		logger.Error(err.Error())
-		return c.JSON(http.StatusInternalServerError, &presenter.ErrorResponse{Message: err.Error()})
+		return nil, err
 	}
 
-	return c.JSON(http.StatusCreated, transactionToResponse(createdTransaction))
+	return presenter.CreateTransaction201JSONResponse{TransactionResponseJSONResponse: transactionResponse(createdTransaction)}, nil
 }
 
-func (h *TransactionHandler) GetTransactionsByUserID(c echo.Context) error {
-	user := c.Get("user").(*jwt.Token)
-	claims := user.Claims.(jwt.MapClaims)
-	userId := int(claims["user_id"].(float64))
-
-	transactions, err := h.transactionUseCase.GetTransactionsByUserID(userId)
+func (h *TransactionHandler) GetTransactions(ctx context.Context, request presenter.GetTransactionsRequestObject) (presenter.GetTransactionsResponseObject, error) {
+	transactions, err := h.transactionUseCase.GetTransactionsByUserID(currentUserID(ctx))
 	if err != nil {
-		logger.Error(err.Error())
-		return c.JSON(http.StatusInternalServerError, &presenter.ErrorResponse{Message: "Failed to retrieve transactions"})
+		return nil, err
 	}
 
-	var response []presenter.TransactionResponse
+	response := presenter.GetTransactions200JSONResponse{}
 	for _, transaction := range transactions {
 		response = append(response, *transactionToResponse(&transaction))
 	}
-
-	return c.JSON(http.StatusOK, response)
+	return response, nil
 }
 
-func (h *TransactionHandler) GetTransactionByID(c echo.Context) error {
-	user := c.Get("user").(*jwt.Token)
-	claims := user.Claims.(jwt.MapClaims)
-	userId := int(claims["user_id"].(float64))
-
-	transactionId, err := strconv.Atoi(c.Param("id"))
+func (h *TransactionHandler) GetTransactionById(ctx context.Context, request presenter.GetTransactionByIdRequestObject) (presenter.GetTransactionByIdResponseObject, error) {
+	transaction, err := h.transactionUseCase.GetTransactionByID(currentUserID(ctx), request.Id)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, &presenter.ErrorResponse{Message: "Invalid ID"})
+		return nil, err
 	}
 
-	transaction, err := h.transactionUseCase.GetTransactionByID(userId, transactionId)
-	if err != nil {
-		logger.Error(err.Error())
-		return c.JSON(http.StatusNotFound, &presenter.ErrorResponse{Message: "Transaction not found"})
-	}
-
-	return c.JSON(http.StatusOK, transactionToResponse(transaction))
+	return presenter.GetTransactionById200JSONResponse{TransactionResponseJSONResponse: transactionResponse(transaction)}, nil
 }
 
-func (h *TransactionHandler) UpdateTransaction(c echo.Context) error {
-	user := c.Get("user").(*jwt.Token)
-	claims := user.Claims.(jwt.MapClaims)
-	userId := int(claims["user_id"].(float64))
-
-	transactionId, err := strconv.Atoi(c.Param("id"))
+func (h *TransactionHandler) UpdateTransactionById(ctx context.Context, request presenter.UpdateTransactionByIdRequestObject) (presenter.UpdateTransactionByIdResponseObject, error) {
+	version, err := parseIfMatch(request.Params.IfMatch)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, &presenter.ErrorResponse{Message: "Invalid ID"})
-	}
-
-	var requestBody presenter.TransactionUpdateRequestBody
-	if err := c.Bind(&requestBody); err != nil {
-		logger.Warn(err.Error())
-		return c.JSON(http.StatusBadRequest, &presenter.ErrorResponse{Message: "Invalid request format"})
+		return nil, err
 	}
 
 	transaction := &entity.Transaction{
-		ID:         transactionId,
-		UserID:     userId,
-		CategoryID: requestBody.CategoryId,
-		Date:       requestBody.Date.Time,
-		Amount:     float32(requestBody.Amount),
-		Content:    *requestBody.Content,
+		ID:         request.Id,
+		UserID:     currentUserID(ctx),
+		CategoryID: request.Body.CategoryId,
+		Date:       request.Body.Date.Time,
+		Amount:     float32(request.Body.Amount),
+		Version:    version,
+	}
+	if request.Body.Content != nil {
+		transaction.Content = *request.Body.Content
 	}
 
 	updatedTransaction, err := h.transactionUseCase.UpdateTransaction(transaction)
 	if err != nil {
-		logger.Error(err.Error())
-		return c.JSON(http.StatusInternalServerError, &presenter.ErrorResponse{Message: "Failed to update transaction"})
+		return nil, err
 	}
 
-	return c.JSON(http.StatusOK, transactionToResponse(updatedTransaction))
+	return presenter.UpdateTransactionById200JSONResponse{TransactionResponseJSONResponse: transactionResponse(updatedTransaction)}, nil
 }
 
-func (h *TransactionHandler) DeleteTransaction(c echo.Context) error {
-	user := c.Get("user").(*jwt.Token)
-	claims := user.Claims.(jwt.MapClaims)
-	userId := int(claims["user_id"].(float64))
-	
-	transactionId, err := strconv.Atoi(c.Param("id"))
+func (h *TransactionHandler) DeleteTransactionById(ctx context.Context, request presenter.DeleteTransactionByIdRequestObject) (presenter.DeleteTransactionByIdResponseObject, error) {
+	version, err := parseIfMatch(request.Params.IfMatch)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, &presenter.ErrorResponse{Message: "Invalid ID"})
+		return nil, err
+	}
+
+	if err := h.transactionUseCase.DeleteTransaction(currentUserID(ctx), request.Id, version); err != nil {
+		return nil, err
+	}
+
+	return presenter.DeleteTransactionById204Response{}, nil
+}
+
+func (h *TransactionHandler) BulkTransactions(ctx context.Context, request presenter.BulkTransactionsRequestObject) (presenter.BulkTransactionsResponseObject, error) {
+	atomic := request.Body.Mode == nil || *request.Body.Mode == presenter.Atomic
+
+	operations := make([]entity.TransactionOperation, 0, len(request.Body.Operations))
+	for _, operation := range request.Body.Operations {
+		transaction := entity.Transaction{}
+		if operation.Id != nil {
+			transaction.ID = *operation.Id
+		}
+		if operation.CategoryId != nil {
+			transaction.CategoryID = *operation.CategoryId
+		}
+		if operation.Date != nil {
+			transaction.Date = operation.Date.Time
+		}
+		if operation.Amount != nil {
+			transaction.Amount = *operation.Amount
+		}
+		if operation.Content != nil {
+			transaction.Content = *operation.Content
+		}
+		if operation.Version != nil {
+			transaction.Version = *operation.Version
+		}
+		if operation.Op != presenter.Create && transaction.Version <= 0 {
+			return nil, errBulkVersionRequired
+		}
+		operations = append(operations, entity.TransactionOperation{
+			Op:          string(operation.Op),
+			Transaction: transaction,
+		})
 	}
 
-	if err := h.transactionUseCase.DeleteTransaction(userId, transactionId); err != nil {
-		logger.Error(err.Error())
-		return c.JSON(http.StatusInternalServerError, &presenter.ErrorResponse{Message: "Failed to delete transaction"})
+	results, err := h.transactionUseCase.BulkTransactions(currentUserID(ctx), operations, atomic)
+	if err != nil && !errors.Is(err, usecase.ErrBulkOperationFailed) {
+		return nil, err
 	}
 
-	return c.NoContent(http.StatusNoContent)
+	response := presenter.TransactionBulkResultList{
+		Committed: err == nil,
+		Results:   []presenter.TransactionBulkResult{},
+	}
+	for _, result := range results {
+		item := presenter.TransactionBulkResult{
+			Index:   result.Index,
+			Op:      result.Op,
+			Success: result.Success,
+		}
+		if result.Transaction != nil {
+			item.Transaction = transactionToResponse(result.Transaction)
+		}
+		if result.Error != "" {
+			item.Error = &result.Error
+		}
+		if result.Err != nil {
+			status := NewProblem(result.Err).Status
+			item.Status = &status
+		}
+		response.Results = append(response.Results, item)
+	}
+
+	if err != nil {
+		logger.Warn(err.Error())
+		return presenter.BulkTransactions422JSONResponse(response), nil
+	}
+	return presenter.BulkTransactions200JSONResponse{TransactionBulkResponseJSONResponse: presenter.TransactionBulkResponseJSONResponse(response)}, nil
 }
@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"household-account-backend/adapter/controller/echo/presenter"
+	"household-account-backend/usecase"
+)
+
+type TwoFactorHandler struct {
+	twoFactorUseCase usecase.TwoFactorUseCase
+}
+
+func NewTwoFactorHandler(twoFactorUseCase usecase.TwoFactorUseCase) *TwoFactorHandler {
+	return &TwoFactorHandler{
+		twoFactorUseCase: twoFactorUseCase,
+	}
+}
+
+func (h *TwoFactorHandler) EnrollTOTP(ctx context.Context, request presenter.EnrollTOTPRequestObject) (presenter.EnrollTOTPResponseObject, error) {
+	enrollment, err := h.twoFactorUseCase.BeginEnrollment(currentUserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.EnrollTOTP200JSONResponse{TOTPEnrollmentResponseJSONResponse: presenter.TOTPEnrollmentResponseJSONResponse{
+		Secret:     enrollment.Secret,
+		OtpauthUri: enrollment.URI,
+	}}, nil
+}
+
+func (h *TwoFactorHandler) ConfirmTOTP(ctx context.Context, request presenter.ConfirmTOTPRequestObject) (presenter.ConfirmTOTPResponseObject, error) {
+	recoveryCodes, err := h.twoFactorUseCase.ConfirmEnrollment(currentUserID(ctx), request.Body.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.ConfirmTOTP200JSONResponse{RecoveryCodesResponseJSONResponse: presenter.RecoveryCodesResponseJSONResponse{RecoveryCodes: recoveryCodes}}, nil
+}
+
+func (h *TwoFactorHandler) DisableTOTP(ctx context.Context, request presenter.DisableTOTPRequestObject) (presenter.DisableTOTPResponseObject, error) {
+	if err := h.twoFactorUseCase.Disable(currentUserID(ctx), request.Body.Code, clientIP(ctx)); err != nil {
+		var throttled *usecase.LoginThrottledError
+		if errors.As(err, &throttled) {
+			response := presenter.DisableTOTP429ApplicationProblemPlusJSONResponse{}
+			response.Body = *NewProblem(echo.NewHTTPError(http.StatusTooManyRequests, throttledMessage))
+			response.Headers.RetryAfter = int(math.Ceil(throttled.RetryAfter.Seconds()))
+			return response, nil
+		}
+		return nil, err
+	}
+
+	return presenter.DisableTOTP204Response{}, nil
+}
+
+func (h *TwoFactorHandler) RegenerateRecoveryCodes(ctx context.Context, request presenter.RegenerateRecoveryCodesRequestObject) (presenter.RegenerateRecoveryCodesResponseObject, error) {
+	recoveryCodes, err := h.twoFactorUseCase.RegenerateRecoveryCodes(currentUserID(ctx), request.Body.Code, clientIP(ctx))
+	if err != nil {
+		var throttled *usecase.LoginThrottledError
+		if errors.As(err, &throttled) {
+			response := presenter.RegenerateRecoveryCodes429ApplicationProblemPlusJSONResponse{}
+			response.Body = *NewProblem(echo.NewHTTPError(http.StatusTooManyRequests, throttledMessage))
+			response.Headers.RetryAfter = int(math.Ceil(throttled.RetryAfter.Seconds()))
+			return response, nil
+		}
+		return nil, err
+	}
+
+	return presenter.RegenerateRecoveryCodes200JSONResponse{RecoveryCodesResponseJSONResponse: presenter.RecoveryCodesResponseJSONResponse{RecoveryCodes: recoveryCodes}}, nil
+}
@@ -1,11 +1,13 @@
 package handler
 
 import (
+	"context"
+	"errors"
+	"math"
 	"net/http"
 	"os"
 	"time"
 
-	"github.com/golang-jwt/jwt/v4"
 	"github.com/labstack/echo/v4"
 	"github.com/oapi-codegen/runtime/types"
 
@@ -15,6 +17,10 @@ import (
 	"household-account-backend/usecase"
 )
 
+// throttledMessage はログイン失敗が続いた場合のメッセージ
+// アカウントの登録有無やロック状態は区別せずに返す
+const throttledMessage = "Too many failed login attempts. Please try again later"
+
 type UserHandler struct {
 	userUseCase usecase.UserUseCase
 }
@@ -25,133 +31,177 @@ func NewUserHandler(userUseCase usecase.UserUseCase) *UserHandler {
 	}
 }
 
-func userToResponse(user *entity.User) *presenter.UserResponse {
-	return &presenter.UserResponse{
-		Id:        user.ID,
-		Email:     types.Email(user.Email),
-		Name:      user.Name,
+func userToResponse(user *entity.User) presenter.UserResponseJSONResponse {
+	return presenter.UserResponseJSONResponse{
+		Id:            user.ID,
+		Email:         types.Email(user.Email),
+		Name:          user.Name,
+		EmailVerified: user.EmailVerified(),
+		Role:          presenter.UserRole(user.Role),
 	}
 }
 
-func (u *UserHandler) Signup(c echo.Context) error {
-	var requestBody presenter.CreateUserJSONRequestBody
-	if err := c.Bind(&requestBody); err != nil {
-		logger.Warn(err.Error())
-		return c.JSON(http.StatusBadRequest, &presenter.ErrorResponse{Message: err.Error()})
-	}
-
+func (u *UserHandler) CreateUser(ctx context.Context, request presenter.CreateUserRequestObject) (presenter.CreateUserResponseObject, error) {
 	user := &entity.User{
-		Email:    string(requestBody.Email),
-		Password: requestBody.Password,
-		Name:     requestBody.Name,
+		Email:    string(request.Body.Email),
+		Password: request.Body.Password,
+		Name:     request.Body.Name,
 	}
 
 	createdUser, err := u.userUseCase.Signup(user)
 	if err != nil {
-		logger.Error(err.Error())
-		return c.JSON(http.StatusInternalServerError, &presenter.ErrorResponse{Message: err.Error()})
+		return nil, err
 	}
 
-	return c.JSON(http.StatusCreated, userToResponse(createdUser))
+	return presenter.CreateUser201JSONResponse{UserResponseJSONResponse: userToResponse(createdUser)}, nil
 }
 
-func (u *UserHandler) Login(c echo.Context) error {
+func (u *UserHandler) LoginUser(ctx context.Context, request presenter.LoginUserRequestObject) (presenter.LoginUserResponseObject, error) {
 	logger.Info("Loginが呼ばれた")
-	var credentials entity.Credentials
-	if err := c.Bind(&credentials); err != nil {
-		logger.Warn(err.Error())
-		return c.JSON(http.StatusBadRequest, &presenter.ErrorResponse{Message: err.Error()})
+	credentials := &entity.Credentials{
+		Email:    string(request.Body.Email),
+		Password: request.Body.Password,
+	}
+
+	result, err := u.userUseCase.Login(credentials, clientIP(ctx))
+	if err != nil {
+		var throttled *usecase.LoginThrottledError
+		if errors.As(err, &throttled) {
+			response := presenter.LoginUser429ApplicationProblemPlusJSONResponse{}
+			response.Body = *NewProblem(echo.NewHTTPError(http.StatusTooManyRequests, throttledMessage))
+			// 待ち時間が1秒未満でも0にならないよう切り上げる
+			response.Headers.RetryAfter = int(math.Ceil(throttled.RetryAfter.Seconds()))
+			return response, nil
+		}
+		return nil, err
+	}
+	// 二要素認証が有効な場合はCookieを発行せず、TOTPコードの入力を求める
+	if result.TOTPChallenge != "" {
+		return presenter.LoginUser202JSONResponse{
+			LoginChallengeResponseJSONResponse: presenter.LoginChallengeResponseJSONResponse{ChallengeToken: result.TOTPChallenge},
+		}, nil
 	}
 
-	tokenString, err := u.userUseCase.Login(&credentials)
+	response := presenter.LoginUser200JSONResponse{}
+	response.Body.Message = "Login successful"
+	response.Headers.SetCookie = authCookie(result.AuthToken).String()
+	return response, nil
+}
+
+func (u *UserHandler) LoginUserWithTOTP(ctx context.Context, request presenter.LoginUserWithTOTPRequestObject) (presenter.LoginUserWithTOTPResponseObject, error) {
+	tokenString, err := u.userUseCase.LoginWithTOTP(request.Body.ChallengeToken, request.Body.Code, clientIP(ctx))
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, err.Error())
+		var throttled *usecase.LoginThrottledError
+		if errors.As(err, &throttled) {
+			response := presenter.LoginUserWithTOTP429ApplicationProblemPlusJSONResponse{}
+			response.Body = *NewProblem(echo.NewHTTPError(http.StatusTooManyRequests, throttledMessage))
+			response.Headers.RetryAfter = int(math.Ceil(throttled.RetryAfter.Seconds()))
+			return response, nil
+		}
+		// ログインの途中のため、チャレンジやコードの誤りは入力エラーではなく認証の失敗として返す
+		if errors.Is(err, usecase.ErrInvalidUserToken) || errors.Is(err, usecase.ErrInvalidTOTPCode) {
+			return nil, echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+		}
+		return nil, err
 	}
-	// Set JWT token as a secure cookie
+
+	return presenter.LoginUserWithTOTP200Response{
+		Headers: presenter.LoginUserWithTOTP200ResponseHeaders{SetCookie: authCookie(tokenString).String()},
+	}, nil
+}
+
+// authCookie はJWTを設定した認証用Cookieを返す
+func authCookie(tokenString string) *http.Cookie {
+	return authCookieUntil(tokenString, time.Now().Add(24*time.Hour))
+}
+
+// authCookieUntil は有効期限を指定してJWTを設定した認証用Cookieを返す
+func authCookieUntil(tokenString string, expires time.Time) *http.Cookie {
 	cookie := new(http.Cookie)
 	cookie.Name = "auth_token"
 	cookie.Value = tokenString
-	cookie.Expires = time.Now().Add(24 * time.Hour)
+	cookie.Expires = expires
 	cookie.Path = "/"
 	cookie.Domain = os.Getenv("API_DOMAIN")
 	// cookie.Secure = true
 	cookie.HttpOnly = true
 	cookie.SameSite = http.SameSiteNoneMode
-	c.SetCookie(cookie)
-	logger.Info("cookieの中身: " + cookie.Value)
+	return cookie
+}
 
-	return c.NoContent(http.StatusOK)
+func (u *UserHandler) LogoutUser(ctx context.Context, request presenter.LogoutUserRequestObject) (presenter.LogoutUserResponseObject, error) {
+	response := presenter.LogoutUser200JSONResponse{}
+	response.Body.Message = "Logout successful"
+	response.Headers.SetCookie = authCookieUntil("", time.Now().Add(-1*time.Hour)).String()
+	return response, nil
 }
 
-func (u *UserHandler) Logout(c echo.Context) error {
-	cookie := new(http.Cookie)
-	cookie.Name = "auth_token"
-	cookie.Value = ""
-	cookie.Expires = time.Now().Add(-1 * time.Hour)
-	cookie.Path = "/"
-	cookie.Domain = os.Getenv("API_DOMAIN")
-	// cookie.Secure = trueas
-	cookie.HttpOnly = true
-	cookie.SameSite = http.SameSiteNoneMode
-	c.SetCookie(cookie)
+func (u *UserHandler) GetCsrfToken(ctx context.Context, request presenter.GetCsrfTokenRequestObject) (presenter.GetCsrfTokenResponseObject, error) {
+	return presenter.GetCsrfToken200JSONResponse{CsrfToken: csrfToken(ctx)}, nil
+}
+
+func (u *UserHandler) VerifyEmail(ctx context.Context, request presenter.VerifyEmailRequestObject) (presenter.VerifyEmailResponseObject, error) {
+	if err := u.userUseCase.VerifyEmail(request.Body.Token); err != nil {
+		return nil, err
+	}
 
-	return c.NoContent(http.StatusOK)
+	return presenter.VerifyEmail204Response{}, nil
 }
 
-func (u *UserHandler) CsrfToken(c echo.Context) error {
-	secret := os.Getenv("SECRET")
-	logger.Info("secret: " + secret)
+func (u *UserHandler) UnlockAccount(ctx context.Context, request presenter.UnlockAccountRequestObject) (presenter.UnlockAccountResponseObject, error) {
+	if err := u.userUseCase.UnlockAccount(request.Body.Token); err != nil {
+		return nil, err
+	}
 
-	token := c.Get("csrf").(string)
-	return c.JSON(http.StatusOK, echo.Map{
-		"csrf_token": token,
-	})
+	return presenter.UnlockAccount204Response{}, nil
 }
 
-func (u *UserHandler) GetCurrentUser(c echo.Context) error {
-	logger.Info("GetCurrentUserが呼ばれた")
-	// JWTトークンからユーザーIDを取得
-	user := c.Get("user").(*jwt.Token)
-	claims := user.Claims.(jwt.MapClaims)
-	userId := int(claims["user_id"].(float64)) // トークンから取得した user_id
+func (u *UserHandler) RequestPasswordReset(ctx context.Context, request presenter.RequestPasswordResetRequestObject) (presenter.RequestPasswordResetResponseObject, error) {
+	if request.Body.Email == "" {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "Email is required")
+	}
 
-	// ユースケースからユーザー情報を取得
-	userEntity, err := u.userUseCase.GetCurrentUser(userId)
-	if err != nil {
-		logger.Error(err.Error())
-		return c.JSON(http.StatusInternalServerError, &presenter.ErrorResponse{Message: "Failed to retrieve user"})
+	if err := u.userUseCase.RequestPasswordReset(string(request.Body.Email)); err != nil {
+		return nil, err
 	}
 
-	// レスポンスとしてユーザー情報を返す
-	return c.JSON(http.StatusOK, userToResponse(userEntity))
+	// メールアドレスの登録有無に関わらず同じレスポンスを返す
+	return presenter.RequestPasswordReset202Response{}, nil
 }
 
-func (u *UserHandler) UpdateUser(c echo.Context) error {
-	// リクエストボディを取得
-	var requestBody presenter.UpdateCurrentUserJSONRequestBody
-	if err := c.Bind(&requestBody); err != nil {
-		logger.Warn(err.Error())
-		return c.JSON(http.StatusBadRequest, &presenter.ErrorResponse{Message: "Invalid request format"})
+func (u *UserHandler) ConfirmPasswordReset(ctx context.Context, request presenter.ConfirmPasswordResetRequestObject) (presenter.ConfirmPasswordResetResponseObject, error) {
+	if err := u.userUseCase.ResetPassword(request.Body.Token, request.Body.Password); err != nil {
+		return nil, err
 	}
 
-	// JWTトークンからユーザーIDを取得
-	user := c.Get("user").(*jwt.Token)
-	claims := user.Claims.(jwt.MapClaims)
-	userId := int(claims["user_id"].(float64))
+	return presenter.ConfirmPasswordReset204Response{}, nil
+}
 
+func (u *UserHandler) GetCurrentUser(ctx context.Context, request presenter.GetCurrentUserRequestObject) (presenter.GetCurrentUserResponseObject, error) {
+	logger.Info("GetCurrentUserが呼ばれた")
+	// ユースケースからユーザー情報を取得
+	userEntity, err := u.userUseCase.GetCurrentUser(currentUserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	// レスポンスとしてユーザー情報を返す
+	return presenter.GetCurrentUser200JSONResponse{UserResponseJSONResponse: userToResponse(userEntity)}, nil
+}
+
+func (u *UserHandler) UpdateCurrentUser(ctx context.Context, request presenter.UpdateCurrentUserRequestObject) (presenter.UpdateCurrentUserResponseObject, error) {
 	// 更新対象ユーザーのエンティティを作成
 	userEntity := &entity.User{
-		ID:    userId,
-		Email: string(requestBody.Email),
-		Name:  requestBody.Name,
+		ID:    currentUserID(ctx),
+		Email: string(request.Body.Email),
+		Name:  request.Body.Name,
 	}
 
 	// パスワードが提供されている場合はハッシュ化
-	if requestBody.Password != "" {
-		hashedPassword, err := usecase.HashPassword(requestBody.Password)
+	if request.Body.Password != "" {
+		hashedPassword, err := usecase.HashPassword(request.Body.Password)
 		if err != nil {
-			logger.Error("Password hashing failed: " + err.Error())
-			return c.JSON(http.StatusInternalServerError, &presenter.ErrorResponse{Message: "Failed to hash password"})
+			return nil, err
 		}
 		userEntity.Password = hashedPassword
 	}
@@ -159,23 +209,9 @@ func (u *UserHandler) UpdateUser(c echo.Context) error {
 	// ユーザーを更新
 	updatedUser, err := u.userUseCase.UpdateUser(userEntity)
 	if err != nil {
-		logger.Error("Failed to update user: " + err.Error())
-		return c.JSON(http.StatusInternalServerError, &presenter.ErrorResponse{Message: "Failed to update user"})
+		return nil, err
 	}
 
 	// 更新後のユーザー情報をレスポンスとして返す
-	return c.JSON(http.StatusOK, userToResponse(updatedUser))
-}
-
-func (u *UserHandler) DeleteUser(c echo.Context) error {
-	user := c.Get("user").(*jwt.Token)
-	claims := user.Claims.(jwt.MapClaims)
-	userId := int(claims["user_id"].(float64))
-
-	if err := u.userUseCase.DeleteUser(userId); err != nil {
-		logger.Error(err.Error())
-		return c.JSON(http.StatusInternalServerError, &presenter.ErrorResponse{Message: err.Error()})
-	}
-
-	return c.NoContent(http.StatusNoContent)
+	return presenter.UpdateCurrentUser200JSONResponse{UserResponseJSONResponse: userToResponse(updatedUser)}, nil
 }
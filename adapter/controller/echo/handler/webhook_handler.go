@@ -0,0 +1,151 @@
+package handler
+
+import (
+	"context"
+	"strings"
+
+	"household-account-backend/adapter/controller/echo/presenter"
+	"household-account-backend/entity"
+	"household-account-backend/usecase"
+)
+
+type WebhookHandler struct {
+	webhookUseCase usecase.WebhookUseCase
+}
+
+func NewWebhookHandler(webhookUseCase usecase.WebhookUseCase) *WebhookHandler {
+	return &WebhookHandler{
+		webhookUseCase: webhookUseCase,
+	}
+}
+
+func webhookToResponse(webhook *entity.Webhook) *presenter.WebhookResponse {
+	events := []presenter.WebhookEvent{}
+	for _, event := range webhook.EventList() {
+		events = append(events, presenter.WebhookEvent(event))
+	}
+	return &presenter.WebhookResponse{
+		Id:        webhook.ID,
+		Url:       webhook.URL,
+		Events:    events,
+		Active:    webhook.Active,
+		CreatedAt: webhook.CreatedAt,
+	}
+}
+
+func webhookDeliveryToResponse(delivery *entity.WebhookDelivery) *presenter.WebhookDeliveryResponse {
+	response := &presenter.WebhookDeliveryResponse{
+		Id:         delivery.ID,
+		WebhookId:  delivery.WebhookID,
+		EventId:    delivery.EventID,
+		EventType:  delivery.EventType,
+		Payload:    delivery.Payload,
+		StatusCode: delivery.StatusCode,
+		Success:    delivery.Success,
+		Replay:     delivery.Replay,
+		CreatedAt:  delivery.CreatedAt,
+	}
+	if delivery.Error != "" {
+		response.Error = &delivery.Error
+	}
+	return response
+}
+
+func joinWebhookEvents(events []presenter.WebhookEvent) string {
+	values := make([]string, 0, len(events))
+	for _, event := range events {
+		values = append(values, string(event))
+	}
+	return strings.Join(values, ",")
+}
+
+func (h *WebhookHandler) CreateWebhook(ctx context.Context, request presenter.CreateWebhookRequestObject) (presenter.CreateWebhookResponseObject, error) {
+	webhook := &entity.Webhook{
+		UserID: currentUserID(ctx),
+		URL:    request.Body.Url,
+		Events: joinWebhookEvents(request.Body.Events),
+		Active: true,
+	}
+	if request.Body.Active != nil {
+		webhook.Active = *request.Body.Active
+	}
+
+	createdWebhook, err := h.webhookUseCase.CreateWebhook(webhook)
+	if err != nil {
+		return nil, err
+	}
+
+	// シークレットは作成時のレスポンスでのみ返す
+	response := webhookToResponse(createdWebhook)
+	response.Secret = &createdWebhook.Secret
+	return presenter.CreateWebhook201JSONResponse{WebhookResponseJSONResponse: presenter.WebhookResponseJSONResponse(*response)}, nil
+}
+
+func (h *WebhookHandler) GetWebhooks(ctx context.Context, request presenter.GetWebhooksRequestObject) (presenter.GetWebhooksResponseObject, error) {
+	webhooks, err := h.webhookUseCase.GetWebhooksByUserID(currentUserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	response := presenter.GetWebhooks200JSONResponse{}
+	for _, webhook := range webhooks {
+		response = append(response, *webhookToResponse(&webhook))
+	}
+	return response, nil
+}
+
+func (h *WebhookHandler) GetWebhookById(ctx context.Context, request presenter.GetWebhookByIdRequestObject) (presenter.GetWebhookByIdResponseObject, error) {
+	webhook, err := h.webhookUseCase.GetWebhookByID(currentUserID(ctx), request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.GetWebhookById200JSONResponse{WebhookResponseJSONResponse: presenter.WebhookResponseJSONResponse(*webhookToResponse(webhook))}, nil
+}
+
+func (h *WebhookHandler) UpdateWebhookById(ctx context.Context, request presenter.UpdateWebhookByIdRequestObject) (presenter.UpdateWebhookByIdResponseObject, error) {
+	webhook := &entity.Webhook{
+		ID:     request.Id,
+		UserID: currentUserID(ctx),
+		URL:    request.Body.Url,
+		Events: joinWebhookEvents(request.Body.Events),
+		Active: request.Body.Active,
+	}
+
+	updatedWebhook, err := h.webhookUseCase.UpdateWebhook(webhook)
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.UpdateWebhookById200JSONResponse{WebhookResponseJSONResponse: presenter.WebhookResponseJSONResponse(*webhookToResponse(updatedWebhook))}, nil
+}
+
+func (h *WebhookHandler) DeleteWebhookById(ctx context.Context, request presenter.DeleteWebhookByIdRequestObject) (presenter.DeleteWebhookByIdResponseObject, error) {
+	if err := h.webhookUseCase.DeleteWebhook(currentUserID(ctx), request.Id); err != nil {
+		return nil, err
+	}
+
+	return presenter.DeleteWebhookById204Response{}, nil
+}
+
+func (h *WebhookHandler) GetWebhookDeliveries(ctx context.Context, request presenter.GetWebhookDeliveriesRequestObject) (presenter.GetWebhookDeliveriesResponseObject, error) {
+	deliveries, err := h.webhookUseCase.GetDeliveries(currentUserID(ctx), request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	response := presenter.GetWebhookDeliveries200JSONResponse{}
+	for _, delivery := range deliveries {
+		response = append(response, *webhookDeliveryToResponse(&delivery))
+	}
+	return response, nil
+}
+
+func (h *WebhookHandler) ReplayWebhookDelivery(ctx context.Context, request presenter.ReplayWebhookDeliveryRequestObject) (presenter.ReplayWebhookDeliveryResponseObject, error) {
+	delivery, err := h.webhookUseCase.ReplayDelivery(currentUserID(ctx), request.Id, request.DeliveryId)
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.ReplayWebhookDelivery201JSONResponse{WebhookDeliveryResponseJSONResponse: presenter.WebhookDeliveryResponseJSONResponse(*webhookDeliveryToResponse(delivery))}, nil
+}
@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"net"
+
+	"github.com/labstack/echo/v4"
+)
+
+// NewIPExtractor はc.RealIP()でクライアントのIPアドレスを取得する方法を返す
+// 信頼するプロキシから転送されたリクエストの場合のみX-Forwarded-Forを参照し、
+// 指定がない場合はクライアントが任意に設定できるヘッダーを使わず接続元のアドレスを使う
+func NewIPExtractor(trustedProxies []*net.IPNet) echo.IPExtractor {
+	if len(trustedProxies) == 0 {
+		return echo.ExtractIPDirect()
+	}
+	// echoの既定ではプライベートアドレスなども信頼するため、設定した範囲だけを信頼する
+	options := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+	for _, network := range trustedProxies {
+		options = append(options, echo.TrustIPRange(network))
+	}
+	return echo.ExtractIPFromXFFHeader(options...)
+}
@@ -0,0 +1,130 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/labstack/echo/v4"
+
+	"household-account-backend/entity"
+	"household-account-backend/pkg/logger"
+	"household-account-backend/usecase"
+)
+
+const (
+	IdempotencyKeyHeader     = "Idempotency-Key"
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+)
+
+// 再送時に復元するレスポンスヘッダー
+var idempotentResponseHeaders = []string{echo.HeaderContentType, echo.HeaderLocation, "ETag"}
+
+// idempotencyResponseRecorder はクライアントへ返すレスポンスボディを保存用に複製する
+type idempotencyResponseRecorder struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *idempotencyResponseRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
+
+// IdempotencyMiddleware はIdempotency-Keyヘッダー付きのPOSTリクエストを1度だけ処理する
+// 同じキーでの再送には保存したレスポンスを返し、異なるボディでのキーの再利用は422で拒否する
+// JWTMiddlewareの後に登録し、キーはユーザーごとに管理する
+func IdempotencyMiddleware(idempotencyUseCase usecase.IdempotencyUseCase) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			key := req.Header.Get(IdempotencyKeyHeader)
+			if req.Method != http.MethodPost || key == "" {
+				return next(c)
+			}
+
+			body, err := io.ReadAll(req.Body)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body").SetInternal(err)
+			}
+			req.Body = io.NopCloser(bytes.NewReader(body))
+
+			requestHash := usecase.HashIdempotentRequest(req.Method, req.URL.Path, body)
+			record, replay, err := idempotencyUseCase.Begin(idempotencyUserID(c), key, requestHash)
+			if err != nil {
+				return err
+			}
+			if replay {
+				return replayIdempotentResponse(c, record)
+			}
+
+			recorder := &idempotencyResponseRecorder{ResponseWriter: c.Response().Writer}
+			c.Response().Writer = recorder
+			// エラーもレスポンスに変換してから記録し、4xxのエラーは再送時に同じ内容を返す
+			if err := next(c); err != nil {
+				c.Error(err)
+			}
+			c.Response().Writer = recorder.ResponseWriter
+
+			// サーバーエラーは保存せず、同じキーでの再試行を許可する
+			status := c.Response().Status
+			if !c.Response().Committed || status >= http.StatusInternalServerError {
+				if releaseErr := idempotencyUseCase.Release(record); releaseErr != nil {
+					logger.Error("failed to release idempotency key", "error", releaseErr.Error())
+				}
+				return nil
+			}
+
+			headers := map[string]string{}
+			for _, name := range idempotentResponseHeaders {
+				if value := c.Response().Header().Get(name); value != "" {
+					headers[name] = value
+				}
+			}
+			if err := idempotencyUseCase.Complete(record, status, headers, recorder.body.Bytes()); err != nil {
+				logger.Error("failed to save idempotent response", "error", err.Error())
+			}
+			return nil
+		}
+	}
+}
+
+func replayIdempotentResponse(c echo.Context, record *entity.IdempotencyRecord) error {
+	headers := map[string]string{}
+	if record.ResponseHeaders != "" {
+		if err := json.Unmarshal([]byte(record.ResponseHeaders), &headers); err != nil {
+			logger.Warn("invalid stored idempotent response headers", "error", err.Error())
+		}
+	}
+	for name, value := range headers {
+		c.Response().Header().Set(name, value)
+	}
+	c.Response().Header().Set(IdempotentReplayedHeader, "true")
+
+	if record.ResponseBody == "" {
+		return c.NoContent(record.StatusCode)
+	}
+	contentType := headers[echo.HeaderContentType]
+	if contentType == "" {
+		contentType = echo.MIMEApplicationJSONCharsetUTF8
+	}
+	return c.Blob(record.StatusCode, contentType, []byte(record.ResponseBody))
+}
+
+func idempotencyUserID(c echo.Context) int {
+	token, ok := c.Get("user").(*jwt.Token)
+	if !ok {
+		return 0
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return 0
+	}
+	userID, ok := claims["user_id"].(float64)
+	if !ok {
+		return 0
+	}
+	return int(userID)
+}
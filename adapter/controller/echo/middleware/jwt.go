@@ -1,48 +1,152 @@
 package middleware
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
-	"os"
+	"strings"
+	"time"
 	"github.com/golang-jwt/jwt/v4"
 	"github.com/labstack/echo/v4"
+	"household-account-backend/pkg/jwtkeys"
 	"household-account-backend/pkg/logger"
+	"household-account-backend/usecase"
 )
 
-func JWTMiddleware() echo.MiddlewareFunc {
+// PersonalAccessTokenContextKey はトークンで認証した場合にパーソナルアクセストークンを保存するキー
+const PersonalAccessTokenContextKey = "personal_access_token"
+
+// CurrentUserContextKey は認証したユーザーの *entity.User を保存するキー
+const CurrentUserContextKey = "current_user"
+
+// TokenScopes はパーソナルアクセストークンでアクセスする場合に必要なスコープ
+// Readは参照系(GET/HEAD)、Writeはそれ以外のメソッドで必要になり、空の場合はトークンでのアクセスを許可しない
+type TokenScopes struct {
+	Read  string
+	Write string
+}
+
+// JWTMiddleware はCookieのJWT、またはAuthorization: Bearerのパーソナルアクセストークンでユーザーを認証する
+// どちらの場合も後続の処理では c.Get("user") の *jwt.Token からuser_idを取得できる
+// CookieのJWTはkidヘッダーで選んだ鍵で署名を検証し、iss・audも確認する
+// 無効にされたユーザーと、強制ログアウトより前に発行したCookieのJWTは拒否する
+func JWTMiddleware(keyManager *jwtkeys.KeyManager, personalAccessTokenUseCase usecase.PersonalAccessTokenUseCase, sessionUseCase usecase.SessionUseCase, scopes TokenScopes) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
+			// Authorizationヘッダーがある場合はCookieを使わずトークンのみで認証する
+			if token, ok := bearerToken(c); ok {
+				return authenticatePersonalAccessToken(c, next, personalAccessTokenUseCase, sessionUseCase, token, scopes)
+			}
+
 			// Cookieから"auth_token"を取得
 			// クライアントから送信されたリクエスト内のCookieを調べ、"auth_token"を取得する。
 			cookie, err := c.Cookie("auth_token")
 			if err != nil {
-				return c.JSON(http.StatusUnauthorized, echo.Map{"message": "Missing auth_token cookie"})
+				return echo.NewHTTPError(http.StatusUnauthorized, "Missing auth_token cookie")
 			}
 
 			// JWTトークンを解析して署名を検証
 			// トークンの署名が正しいかどうかを確認し、有効性を検証する。
-			token, err := jwt.Parse(cookie.Value, func(token *jwt.Token) (interface{}, error) {
-				if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-					return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-				}
-				return []byte(os.Getenv("SECRET")), nil
-			})
+			token, err := keyManager.Parse(cookie.Value)
 
 			if err != nil || !token.Valid {
-				return c.JSON(http.StatusUnauthorized, echo.Map{"message": "Invalid token"})
+				return echo.NewHTTPError(http.StatusUnauthorized, "Invalid token")
 			}
 
 			// トークンのClaimsを型変換し、正しい形式（jwt.MapClaims）であることを確認
-			if claims, ok := token.Claims.(jwt.MapClaims); ok {
-				logger.Info("Parsed JWT Token Claims: " + fmt.Sprintf("%v", claims))
-				// 後続の処理で利用できるようにトークン全体をコンテキストに保存
-				c.Set("user", token)
-			} else {
+			claims, ok := token.Claims.(jwt.MapClaims)
+			if !ok {
 				logger.Error("Invalid token claims")
-				return c.JSON(http.StatusUnauthorized, "Invalid Claims")
+				return echo.NewHTTPError(http.StatusUnauthorized, "Invalid Claims")
+			}
+			userID, ok := claims["user_id"].(float64)
+			if !ok {
+				return echo.NewHTTPError(http.StatusUnauthorized, "Invalid token")
+			}
+			// iatがない場合は強制ログアウトの前に発行したものとして扱う
+			var issuedAt time.Time
+			if iat, ok := claims["iat"].(float64); ok {
+				issuedAt = time.Unix(int64(iat), 0)
+			}
+			if err := authorizeUser(c, sessionUseCase, int(userID), &issuedAt); err != nil {
+				return err
+			}
+
+			// なりすましのセッションでは参照のみ許可する
+			if _, impersonated := claims[usecase.ImpersonatorClaim]; impersonated && !readOnlyMethod(c.Request().Method) {
+				return echo.NewHTTPError(http.StatusForbidden, "Impersonated sessions are read-only")
 			}
 
+			// 後続の処理で利用できるようにトークン全体をコンテキストに保存
+			c.Set("user", token)
 			return next(c)
 		}
 	}
-}
\ No newline at end of file
+}
+
+// HasBearerToken はAuthorizationヘッダーでトークンが送られているかを返す
+// ブラウザが自動で付与するCookieに依存しないため、CSRF対策の対象外にできる
+func HasBearerToken(c echo.Context) bool {
+	_, ok := bearerToken(c)
+	return ok
+}
+
+func bearerToken(c echo.Context) (string, bool) {
+	scheme, token, ok := strings.Cut(c.Request().Header.Get(echo.HeaderAuthorization), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	return strings.TrimSpace(token), true
+}
+
+// authorizeUser はユーザーが利用できる状態かを確かめ、コンテキストに保存する
+// 利用できない場合はエラーを返す
+func authorizeUser(c echo.Context, sessionUseCase usecase.SessionUseCase, userID int, issuedAt *time.Time) error {
+	user, err := sessionUseCase.Authorize(userID, issuedAt)
+	switch {
+	case errors.Is(err, usecase.ErrAccountDisabled):
+		return echo.NewHTTPError(http.StatusForbidden, "Account is disabled")
+	case errors.Is(err, usecase.ErrSessionRevoked):
+		return echo.NewHTTPError(http.StatusUnauthorized, "Session has been revoked")
+	case err != nil:
+		return fmt.Errorf("session authorization failed for user %d: %w", userID, err)
+	}
+	c.Set(CurrentUserContextKey, user)
+	return nil
+}
+
+func readOnlyMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead
+}
+
+func authenticatePersonalAccessToken(c echo.Context, next echo.HandlerFunc, personalAccessTokenUseCase usecase.PersonalAccessTokenUseCase, sessionUseCase usecase.SessionUseCase, token string, scopes TokenScopes) error {
+	accessToken, err := personalAccessTokenUseCase.Authenticate(token)
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidAccessToken) {
+			c.Response().Header().Set(echo.HeaderWWWAuthenticate, `Bearer error="invalid_token"`)
+			return echo.NewHTTPError(http.StatusUnauthorized, "Invalid token")
+		}
+		return fmt.Errorf("personal access token lookup failed: %w", err)
+	}
+
+	required := scopes.Write
+	if readOnlyMethod(c.Request().Method) {
+		required = scopes.Read
+	}
+	if required == "" {
+		return echo.NewHTTPError(http.StatusForbidden, "Personal access tokens cannot access this endpoint")
+	}
+	if !accessToken.HasScope(required) {
+		c.Response().Header().Set(echo.HeaderWWWAuthenticate, fmt.Sprintf(`Bearer error="insufficient_scope", scope="%s"`, required))
+		return echo.NewHTTPError(http.StatusForbidden, "Token does not have the required scope: "+required)
+	}
+
+	if err := authorizeUser(c, sessionUseCase, accessToken.UserID, nil); err != nil {
+		return err
+	}
+
+	// ハンドラーはCookie認証と同じくJWTのクレームからユーザーIDを取得する
+	c.Set("user", &jwt.Token{Claims: jwt.MapClaims{"user_id": float64(accessToken.UserID)}, Valid: true})
+	c.Set(PersonalAccessTokenContextKey, accessToken)
+	return next(c)
+}
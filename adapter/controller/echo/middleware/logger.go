@@ -24,7 +24,10 @@ func CustomRequestLogger() echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
 			start := time.Now()
-			err := next(c)
+			// 記録するステータスがエラーのレスポンスと一致するよう、先にエラーを書き込む
+			if err := next(c); err != nil {
+				c.Error(err)
+			}
 			stop := time.Now()
 
 			status := c.Response().Status
@@ -50,7 +53,7 @@ func CustomRequestLogger() echo.MiddlewareFunc {
 				c.Request().URL.Path,
 			)
 
-			return err
+			return nil
 		}
 	}
 }
@@ -64,7 +67,7 @@ func CustomRecovery() echo.MiddlewareFunc {
 					logger.ZapLogger.Error("Panic recovered",
 						zap.Any("error", r),
 					)
-					c.Error(err)
+					c.Error(fmt.Errorf("panic recovered: %v", r))
 				}
 			}()
 			return next(c)
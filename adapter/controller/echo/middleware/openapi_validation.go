@@ -0,0 +1,139 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers/legacy"
+	"github.com/labstack/echo/v4"
+
+	"household-account-backend/usecase"
+)
+
+// APIBasePath はOpenAPIの定義のパスの前に付くAPIのパス
+const APIBasePath = "/api/v1"
+
+// requestBodyField はボディ全体に対するエラーの項目名
+const requestBodyField = "body"
+
+// OpenAPIValidationMiddleware はリクエストのパラメータとボディをOpenAPIの定義で検証する
+// 定義に合わないリクエストはハンドラーを呼ばずに、項目ごとのエラーを含む422を返す
+// 定義にないパスやメソッドはそのまま通す。認証はJWTMiddlewareで行うため検証しない
+func OpenAPIValidationMiddleware(swagger *openapi3.T) (echo.MiddlewareFunc, error) {
+	validate, err := NewRequestValidator(swagger)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if err := validate(c.Request()); err != nil {
+				return err
+			}
+			return next(c)
+		}
+	}, nil
+}
+
+// RequestValidator はリクエストを定義で検証し、定義に合わない場合は*usecase.ValidationErrorを返す
+type RequestValidator func(req *http.Request) error
+
+// NewRequestValidator はOpenAPIの定義からリクエストの検証を作成する
+// フレームワークに依存しないため、ginのサーバーでも同じ検証に使う
+func NewRequestValidator(swagger *openapi3.T) (RequestValidator, error) {
+	// 定義のサーバーはホスト名を含むため、パスのみで照合する
+	spec := *swagger
+	spec.Servers = openapi3.Servers{{URL: APIBasePath}}
+	router, err := legacy.NewRouter(&spec)
+	if err != nil {
+		return nil, err
+	}
+	options := &openapi3filter.Options{
+		AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+		MultiError:         true,
+	}
+
+	return func(req *http.Request) error {
+		route, pathParams, err := router.FindRoute(req)
+		if err != nil {
+			return nil
+		}
+
+		// ボディは検証後に読み直せるよう、openapi3filterがリクエストに戻す
+		input := &openapi3filter.RequestValidationInput{
+			Request:    req,
+			PathParams: pathParams,
+			Route:      route,
+			Options:    options,
+		}
+		if err := openapi3filter.ValidateRequest(req.Context(), input); err != nil {
+			return &usecase.ValidationError{
+				Message: "request does not match the API definition",
+				Fields:  firstFieldErrors(requestFieldErrors(err)),
+			}
+		}
+		return nil
+	}, nil
+}
+
+// requestFieldErrors は検証エラーを項目ごとのエラーに変換する
+// ボディの項目はJSONのパスを"."でつないだ名前、パラメータはパラメータ名になる
+// MultiErrorのAsは最初に一致したエラーしか返さないため、errors.Asを使わずに展開する
+func requestFieldErrors(err error) []usecase.FieldError {
+	switch err := err.(type) {
+	case openapi3.MultiError:
+		var fields []usecase.FieldError
+		for _, e := range err {
+			fields = append(fields, requestFieldErrors(e)...)
+		}
+		return fields
+	case *openapi3filter.RequestError:
+		field := requestBodyField
+		if err.Parameter != nil {
+			field = err.Parameter.Name
+		}
+		if err.Err == nil {
+			return []usecase.FieldError{{Field: field, Message: err.Reason}}
+		}
+		return schemaFieldErrors(field, err.Err, err.Reason)
+	}
+	return []usecase.FieldError{{Field: requestBodyField, Message: err.Error()}}
+}
+
+// firstFieldErrors は項目ごとに最初のエラーのみを残す
+// exclusiveMinimumのように1つの制約から複数のエラーが報告される場合がある
+func firstFieldErrors(fields []usecase.FieldError) []usecase.FieldError {
+	seen := map[string]bool{}
+	first := make([]usecase.FieldError, 0, len(fields))
+	for _, field := range fields {
+		if seen[field.Field] {
+			continue
+		}
+		seen[field.Field] = true
+		first = append(first, field)
+	}
+	return first
+}
+
+func schemaFieldErrors(field string, err error, reason string) []usecase.FieldError {
+	switch err := err.(type) {
+	case openapi3.MultiError:
+		var fields []usecase.FieldError
+		for _, e := range err {
+			fields = append(fields, schemaFieldErrors(field, e, reason)...)
+		}
+		return fields
+	case *openapi3.SchemaError:
+		if pointer := err.JSONPointer(); len(pointer) > 0 && field == requestBodyField {
+			field = strings.Join(pointer, ".")
+		}
+		return []usecase.FieldError{{Field: field, Message: err.Reason}}
+	}
+	// JSONとして読めないボディなどは理由のみを返し、内部のエラーの詳細は含めない
+	if reason == "" {
+		reason = err.Error()
+	}
+	return []usecase.FieldError{{Field: field, Message: reason}}
+}
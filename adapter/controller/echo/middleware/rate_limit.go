@@ -0,0 +1,80 @@
+package middleware
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/labstack/echo/v4"
+
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/pkg/logger"
+)
+
+// レート制限の状態を返すヘッダー(draft-ietf-httpapi-ratelimit-headers)
+const (
+	RateLimitLimitHeader     = "RateLimit-Limit"
+	RateLimitRemainingHeader = "RateLimit-Remaining"
+	RateLimitResetHeader     = "RateLimit-Reset"
+	RateLimitPolicyHeader    = "RateLimit-Policy"
+)
+
+// RateLimitConfig はルートグループごとのレート制限の設定
+type RateLimitConfig struct {
+	// Name はバケットのキーに含める名前。グループごとに別々に制限する
+	Name   string
+	Limit  int
+	Period time.Duration
+}
+
+// RateLimitMiddleware はトークンバケットでリクエスト数を制限する
+// JWTMiddlewareの後に登録した場合はユーザーごと、それ以外はIPアドレスごとに制限する
+// Limitが0以下の場合は制限しない
+func RateLimitMiddleware(rateLimitStore gateway.RateLimitStore, config RateLimitConfig) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if config.Limit <= 0 || config.Period <= 0 {
+				return next(c)
+			}
+
+			result, err := rateLimitStore.Take(rateLimitKey(c, config.Name), config.Limit, config.Period, time.Now())
+			if err != nil {
+				// バックエンドの障害でAPI全体を止めないよう制限せずに通す
+				logger.Error("rate limit lookup failed", "group", config.Name, "error", err.Error())
+				return next(c)
+			}
+
+			header := c.Response().Header()
+			header.Set(RateLimitLimitHeader, strconv.Itoa(config.Limit))
+			header.Set(RateLimitRemainingHeader, strconv.Itoa(result.Remaining))
+			header.Set(RateLimitResetHeader, strconv.Itoa(ceilSeconds(result.ResetAfter)))
+			header.Set(RateLimitPolicyHeader, fmt.Sprintf("%d;w=%d", config.Limit, ceilSeconds(config.Period)))
+			if !result.Allowed {
+				header.Set("Retry-After", strconv.Itoa(ceilSeconds(result.RetryAfter)))
+				return echo.NewHTTPError(http.StatusTooManyRequests, "Too many requests")
+			}
+
+			return next(c)
+		}
+	}
+}
+
+func rateLimitKey(c echo.Context, name string) string {
+	if token, ok := c.Get("user").(*jwt.Token); ok {
+		if claims, ok := token.Claims.(jwt.MapClaims); ok {
+			if userID, ok := claims["user_id"].(float64); ok {
+				return fmt.Sprintf("%s:user:%d", name, int(userID))
+			}
+		}
+	}
+	// RealIPはルーターのIPExtractorにより、信頼するプロキシを経由した場合のみヘッダーの値を使う
+	return name + ":ip:" + c.RealIP()
+}
+
+// ceilSeconds は1秒未満でも0にならないよう秒数を切り上げる
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
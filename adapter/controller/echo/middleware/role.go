@@ -0,0 +1,23 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"household-account-backend/entity"
+)
+
+// RequireRole はJWTMiddlewareで認証したユーザーが指定のロールを持つ場合のみ許可する
+// JWTMiddlewareより後に設定する
+func RequireRole(role string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			user, ok := c.Get(CurrentUserContextKey).(*entity.User)
+			if !ok || user.Role != role {
+				return echo.NewHTTPError(http.StatusForbidden, "Insufficient role")
+			}
+			return next(c)
+		}
+	}
+}
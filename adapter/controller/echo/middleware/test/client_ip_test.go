@@ -0,0 +1,68 @@
+package middleware_test
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+
+	"household-account-backend/adapter/controller/echo/middleware"
+)
+
+type IPExtractorSuite struct {
+	suite.Suite
+}
+
+func TestIPExtractorSuite(t *testing.T) {
+	suite.Run(t, new(IPExtractorSuite))
+}
+
+func (suite *IPExtractorSuite) realIP(trustedProxies []*net.IPNet, remoteAddr string, headers map[string]string) string {
+	router := echo.New()
+	router.IPExtractor = middleware.NewIPExtractor(trustedProxies)
+	var realIP string
+	router.GET("/", func(c echo.Context) error {
+		realIP = c.RealIP()
+		return c.NoContent(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = remoteAddr
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	router.ServeHTTP(httptest.NewRecorder(), req)
+	return realIP
+}
+
+func (suite *IPExtractorSuite) TestIgnoresHeadersWithoutTrustedProxies() {
+	ip := suite.realIP(nil, "192.0.2.1:12345", map[string]string{
+		echo.HeaderXForwardedFor: "198.51.100.1",
+		echo.HeaderXRealIP:       "198.51.100.2",
+	})
+	suite.Assert().Equal("192.0.2.1", ip)
+
+	// プライベートアドレスからの接続でもヘッダーは信頼しない
+	ip = suite.realIP(nil, "10.0.0.1:12345", map[string]string{echo.HeaderXForwardedFor: "198.51.100.1"})
+	suite.Assert().Equal("10.0.0.1", ip)
+}
+
+func (suite *IPExtractorSuite) TestUsesForwardedForFromTrustedProxy() {
+	_, proxies, err := net.ParseCIDR("10.0.0.0/24")
+	suite.Require().NoError(err)
+	trusted := []*net.IPNet{proxies}
+
+	ip := suite.realIP(trusted, "10.0.0.5:12345", map[string]string{echo.HeaderXForwardedFor: "198.51.100.1"})
+	suite.Assert().Equal("198.51.100.1", ip)
+
+	// クライアントが付けたヘッダーの値はプロキシが追加したアドレスより前にあり、使われない
+	ip = suite.realIP(trusted, "10.0.0.5:12345", map[string]string{echo.HeaderXForwardedFor: "203.0.113.9, 198.51.100.1"})
+	suite.Assert().Equal("198.51.100.1", ip)
+
+	// 信頼していないプロキシから転送されたヘッダーは使わない
+	ip = suite.realIP(trusted, "10.0.1.5:12345", map[string]string{echo.HeaderXForwardedFor: "198.51.100.1"})
+	suite.Assert().Equal("10.0.1.5", ip)
+}
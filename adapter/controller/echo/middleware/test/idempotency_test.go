@@ -0,0 +1,119 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+
+	"household-account-backend/adapter/controller/echo/handler"
+	"household-account-backend/adapter/controller/echo/middleware"
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/pkg/tester"
+	"household-account-backend/usecase"
+)
+
+type IdempotencyMiddlewareSuite struct {
+	tester.DBSQLiteSuite
+	router *echo.Echo
+	calls  int
+}
+
+func TestIdempotencyMiddlewareSuite(t *testing.T) {
+	suite.Run(t, new(IdempotencyMiddlewareSuite))
+}
+
+func (suite *IdempotencyMiddlewareSuite) SetupSuite() {
+	suite.DBSQLiteSuite.SetupSuite()
+
+	idempotencyUseCase := usecase.NewIdempotencyUseCase(gateway.NewIdempotencyRepository(suite.DB), time.Hour)
+	suite.router = echo.New()
+	suite.router.HTTPErrorHandler = handler.HTTPErrorHandler
+	group := suite.router.Group("", func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			c.Set("user", &jwt.Token{Claims: jwt.MapClaims{"user_id": float64(1)}})
+			return next(c)
+		}
+	}, middleware.IdempotencyMiddleware(idempotencyUseCase))
+	group.POST("/transactions", func(c echo.Context) error {
+		suite.calls++
+		c.Response().Header().Set("ETag", `"1"`)
+		return c.JSON(http.StatusCreated, map[string]int{"id": suite.calls})
+	})
+	group.POST("/conflict", func(c echo.Context) error {
+		suite.calls++
+		return &usecase.ConflictError{Message: "already exists"}
+	})
+	group.POST("/failure", func(c echo.Context) error {
+		suite.calls++
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "failure"})
+	})
+}
+
+func (suite *IdempotencyMiddlewareSuite) SetupTest() {
+	suite.calls = 0
+}
+
+func (suite *IdempotencyMiddlewareSuite) post(path string, key string, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	if key != "" {
+		req.Header.Set(middleware.IdempotencyKeyHeader, key)
+	}
+	rec := httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, req)
+	return rec
+}
+
+func (suite *IdempotencyMiddlewareSuite) TestReplayStoredResponse() {
+	first := suite.post("/transactions", "replay-key", `{"amount":100}`)
+	suite.Assert().Equal(http.StatusCreated, first.Code)
+	suite.Assert().Empty(first.Header().Get(middleware.IdempotentReplayedHeader))
+
+	second := suite.post("/transactions", "replay-key", `{"amount":100}`)
+	suite.Assert().Equal(http.StatusCreated, second.Code)
+	suite.Assert().Equal("true", second.Header().Get(middleware.IdempotentReplayedHeader))
+	suite.Assert().Equal(`"1"`, second.Header().Get("ETag"))
+	suite.Assert().JSONEq(first.Body.String(), second.Body.String())
+	suite.Assert().Equal(1, suite.calls)
+}
+
+func (suite *IdempotencyMiddlewareSuite) TestRejectReusedKeyWithDifferentBody() {
+	suite.post("/transactions", "reused-key", `{"amount":100}`)
+
+	rec := suite.post("/transactions", "reused-key", `{"amount":200}`)
+	suite.Assert().Equal(http.StatusUnprocessableEntity, rec.Code)
+	suite.Assert().Equal(1, suite.calls)
+}
+
+func (suite *IdempotencyMiddlewareSuite) TestServerErrorIsNotStored() {
+	suite.post("/failure", "failure-key", `{}`)
+	rec := suite.post("/failure", "failure-key", `{}`)
+	suite.Assert().Equal(http.StatusInternalServerError, rec.Code)
+	suite.Assert().Empty(rec.Header().Get(middleware.IdempotentReplayedHeader))
+	suite.Assert().Equal(2, suite.calls)
+}
+
+func (suite *IdempotencyMiddlewareSuite) TestWithoutKey() {
+	suite.post("/transactions", "", `{"amount":100}`)
+	suite.post("/transactions", "", `{"amount":100}`)
+	suite.Assert().Equal(2, suite.calls)
+}
+
+func (suite *IdempotencyMiddlewareSuite) TestClientErrorIsStored() {
+	first := suite.post("/conflict", "conflict-key", `{}`)
+	suite.Assert().Equal(http.StatusConflict, first.Code)
+	suite.Assert().Equal(handler.ProblemContentType, first.Header().Get(echo.HeaderContentType))
+
+	second := suite.post("/conflict", "conflict-key", `{}`)
+	suite.Assert().Equal(http.StatusConflict, second.Code)
+	suite.Assert().Equal("true", second.Header().Get(middleware.IdempotentReplayedHeader))
+	suite.Assert().Equal(handler.ProblemContentType, second.Header().Get(echo.HeaderContentType))
+	suite.Assert().JSONEq(first.Body.String(), second.Body.String())
+	suite.Assert().Equal(1, suite.calls)
+}
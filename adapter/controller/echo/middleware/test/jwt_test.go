@@ -0,0 +1,235 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+
+	"household-account-backend/adapter/controller/echo/middleware"
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/entity"
+	"household-account-backend/pkg/jwtkeys"
+	"household-account-backend/pkg/tester"
+	"household-account-backend/usecase"
+)
+
+type JWTMiddlewareSuite struct {
+	tester.DBSQLiteSuite
+	router     *echo.Echo
+	repository gateway.PersonalAccessTokenRepository
+	useCase    usecase.PersonalAccessTokenUseCase
+	keyManager *jwtkeys.KeyManager
+	token      string
+}
+
+func TestJWTMiddlewareSuite(t *testing.T) {
+	suite.Run(t, new(JWTMiddlewareSuite))
+}
+
+func (suite *JWTMiddlewareSuite) SetupSuite() {
+	suite.DBSQLiteSuite.SetupSuite()
+
+	keyManager, err := jwtkeys.NewKeyManager([]*jwtkeys.Key{jwtkeys.NewHMACKey("test", []byte("secret"))}, jwtkeys.Config{
+		Issuer:   "household-account-backend",
+		Audience: "household-account",
+	})
+	suite.Require().NoError(err)
+	suite.keyManager = keyManager
+
+	disabledAt := time.Now()
+	for _, user := range []*entity.User{
+		{ID: 1, Email: "user1@example.com", Name: "user1"},
+		{ID: 2, Email: "user2@example.com", Name: "user2"},
+		{ID: 3, Email: "admin@example.com", Name: "admin", Role: entity.UserRoleAdmin},
+		{ID: 4, Email: "disabled@example.com", Name: "disabled", DisabledAt: &disabledAt},
+	} {
+		suite.Require().NoError(suite.DB.Create(user).Error)
+	}
+
+	suite.repository = gateway.NewPersonalAccessTokenRepository(suite.DB)
+	suite.useCase = usecase.NewPersonalAccessTokenUseCase(suite.repository)
+	sessionUseCase := usecase.NewSessionUseCase(gateway.NewUserRepository(suite.DB))
+	_, token, err := suite.useCase.CreateToken(&entity.PersonalAccessToken{
+		UserID: 1,
+		Name:   "script",
+		Scopes: entity.ScopeReadTransactions,
+	})
+	suite.Require().NoError(err)
+	suite.token = token
+
+	handler := func(c echo.Context) error {
+		claims := c.Get("user").(*jwt.Token).Claims.(jwt.MapClaims)
+		return c.JSON(http.StatusOK, claims)
+	}
+	suite.router = echo.New()
+	transactions := suite.router.Group("/transactions", middleware.JWTMiddleware(suite.keyManager, suite.useCase, sessionUseCase, middleware.TokenScopes{
+		Read:  entity.ScopeReadTransactions,
+		Write: entity.ScopeWriteTransactions,
+	}))
+	transactions.GET("", handler)
+	transactions.POST("", handler)
+	users := suite.router.Group("/users", middleware.JWTMiddleware(suite.keyManager, suite.useCase, sessionUseCase, middleware.TokenScopes{}))
+	users.GET("", handler)
+	admin := suite.router.Group("/admin", middleware.JWTMiddleware(suite.keyManager, suite.useCase, sessionUseCase, middleware.TokenScopes{}), middleware.RequireRole(entity.UserRoleAdmin))
+	admin.GET("", handler)
+}
+
+func (suite *JWTMiddlewareSuite) request(method string, path string, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	if token != "" {
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, req)
+	return rec
+}
+
+func (suite *JWTMiddlewareSuite) requestWithCookie(token string) *httptest.ResponseRecorder {
+	return suite.requestPathWithCookie(http.MethodGet, "/users", token)
+}
+
+func (suite *JWTMiddlewareSuite) requestPathWithCookie(method string, path string, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	req.AddCookie(&http.Cookie{Name: "auth_token", Value: token})
+	rec := httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, req)
+	return rec
+}
+
+func (suite *JWTMiddlewareSuite) TestCookieToken() {
+	token, err := suite.keyManager.Sign(jwt.MapClaims{"user_id": 2, "exp": time.Now().Add(time.Hour).Unix()})
+	suite.Require().NoError(err)
+
+	rec := suite.requestWithCookie(token)
+	suite.Assert().Equal(http.StatusOK, rec.Code)
+	suite.Assert().Contains(rec.Body.String(), `"user_id":2`)
+}
+
+func (suite *JWTMiddlewareSuite) TestCookieTokenWithoutIssuer() {
+	// 鍵管理を導入する前の形式のトークン
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"user_id": 2, "exp": time.Now().Add(time.Hour).Unix()}).SignedString([]byte("secret"))
+	suite.Require().NoError(err)
+
+	rec := suite.requestWithCookie(token)
+	suite.Assert().Equal(http.StatusUnauthorized, rec.Code)
+}
+
+func (suite *JWTMiddlewareSuite) TestBearerTokenWithScope() {
+	rec := suite.request(http.MethodGet, "/transactions", suite.token)
+	suite.Assert().Equal(http.StatusOK, rec.Code)
+	suite.Assert().JSONEq(`{"user_id":1}`, rec.Body.String())
+
+	tokens, err := suite.repository.GetTokensByUserID(1)
+	suite.Require().NoError(err)
+	suite.Assert().NotNil(tokens[0].LastUsedAt)
+}
+
+func (suite *JWTMiddlewareSuite) TestBearerTokenWithoutScope() {
+	rec := suite.request(http.MethodPost, "/transactions", suite.token)
+	suite.Assert().Equal(http.StatusForbidden, rec.Code)
+	suite.Assert().Contains(rec.Header().Get(echo.HeaderWWWAuthenticate), `scope="write:transactions"`)
+}
+
+func (suite *JWTMiddlewareSuite) TestBearerTokenNotAllowed() {
+	rec := suite.request(http.MethodGet, "/users", suite.token)
+	suite.Assert().Equal(http.StatusForbidden, rec.Code)
+}
+
+func (suite *JWTMiddlewareSuite) TestInvalidBearerToken() {
+	rec := suite.request(http.MethodGet, "/transactions", usecase.PersonalAccessTokenPrefix+"unknown")
+	suite.Assert().Equal(http.StatusUnauthorized, rec.Code)
+	suite.Assert().Contains(rec.Header().Get(echo.HeaderWWWAuthenticate), "invalid_token")
+}
+
+func (suite *JWTMiddlewareSuite) TestExpiredBearerToken() {
+	expiresAt := time.Now().Add(time.Hour)
+	_, token, err := suite.useCase.CreateToken(&entity.PersonalAccessToken{
+		UserID:    1,
+		Name:      "expired",
+		Scopes:    entity.ScopeReadTransactions,
+		ExpiresAt: &expiresAt,
+	})
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.DB.Model(&entity.PersonalAccessToken{}).
+		Where("token_hash = ?", usecase.HashUserToken(token)).
+		Update("expires_at", time.Now().Add(-time.Minute)).Error)
+
+	rec := suite.request(http.MethodGet, "/transactions", token)
+	suite.Assert().Equal(http.StatusUnauthorized, rec.Code)
+}
+
+func (suite *JWTMiddlewareSuite) TestBearerTokenIgnoresCookie() {
+	cookieToken, err := suite.keyManager.Sign(jwt.MapClaims{"user_id": 2, "exp": time.Now().Add(time.Hour).Unix()})
+	suite.Require().NoError(err)
+
+	req := httptest.NewRequest(http.MethodGet, "/transactions", nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer invalid")
+	req.AddCookie(&http.Cookie{Name: "auth_token", Value: cookieToken})
+	rec := httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, req)
+	suite.Assert().Equal(http.StatusUnauthorized, rec.Code)
+}
+
+func (suite *JWTMiddlewareSuite) TestHasBearerToken() {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/transactions", nil)
+	suite.Assert().False(middleware.HasBearerToken(e.NewContext(req, httptest.NewRecorder())))
+	req.Header.Set(echo.HeaderAuthorization, "bearer "+suite.token)
+	suite.Assert().True(middleware.HasBearerToken(e.NewContext(req, httptest.NewRecorder())))
+}
+
+func (suite *JWTMiddlewareSuite) cookieToken(claims jwt.MapClaims) string {
+	claims["exp"] = time.Now().Add(time.Hour).Unix()
+	token, err := suite.keyManager.Sign(claims)
+	suite.Require().NoError(err)
+	return token
+}
+
+func (suite *JWTMiddlewareSuite) TestDisabledUser() {
+	rec := suite.requestWithCookie(suite.cookieToken(jwt.MapClaims{"user_id": 4}))
+	suite.Assert().Equal(http.StatusForbidden, rec.Code)
+
+	_, token, err := suite.useCase.CreateToken(&entity.PersonalAccessToken{UserID: 4, Name: "script", Scopes: entity.ScopeReadTransactions})
+	suite.Require().NoError(err)
+	rec = suite.request(http.MethodGet, "/transactions", token)
+	suite.Assert().Equal(http.StatusForbidden, rec.Code)
+}
+
+func (suite *JWTMiddlewareSuite) TestRevokedSession() {
+	user := &entity.User{Email: "revoked@example.com", Name: "revoked"}
+	suite.Require().NoError(suite.DB.Create(user).Error)
+	token := suite.cookieToken(jwt.MapClaims{"user_id": user.ID})
+	suite.Assert().Equal(http.StatusOK, suite.requestWithCookie(token).Code)
+
+	suite.Require().NoError(suite.DB.Model(user).Update("sessions_revoked_at", time.Now()).Error)
+	rec := suite.requestWithCookie(token)
+	suite.Assert().Equal(http.StatusUnauthorized, rec.Code)
+	suite.Assert().Contains(rec.Body.String(), "revoked")
+}
+
+func (suite *JWTMiddlewareSuite) TestDeletedUser() {
+	rec := suite.requestWithCookie(suite.cookieToken(jwt.MapClaims{"user_id": 999}))
+	suite.Assert().Equal(http.StatusUnauthorized, rec.Code)
+}
+
+func (suite *JWTMiddlewareSuite) TestImpersonatedSessionIsReadOnly() {
+	token := suite.cookieToken(jwt.MapClaims{"user_id": 1, usecase.ImpersonatorClaim: 3})
+
+	rec := suite.requestPathWithCookie(http.MethodGet, "/transactions", token)
+	suite.Assert().Equal(http.StatusOK, rec.Code)
+	rec = suite.requestPathWithCookie(http.MethodPost, "/transactions", token)
+	suite.Assert().Equal(http.StatusForbidden, rec.Code)
+}
+
+func (suite *JWTMiddlewareSuite) TestRequireRole() {
+	rec := suite.requestPathWithCookie(http.MethodGet, "/admin", suite.cookieToken(jwt.MapClaims{"user_id": 1}))
+	suite.Assert().Equal(http.StatusForbidden, rec.Code)
+
+	rec = suite.requestPathWithCookie(http.MethodGet, "/admin", suite.cookieToken(jwt.MapClaims{"user_id": 3}))
+	suite.Assert().Equal(http.StatusOK, rec.Code)
+}
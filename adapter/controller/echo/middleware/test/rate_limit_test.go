@@ -0,0 +1,130 @@
+package middleware_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+
+	"household-account-backend/adapter/controller/echo/middleware"
+	"household-account-backend/adapter/gateway"
+)
+
+type failingRateLimitStore struct{}
+
+func (failingRateLimitStore) Take(key string, limit int, period time.Duration, now time.Time) (*gateway.RateLimitResult, error) {
+	return nil, errors.New("backend unavailable")
+}
+
+type RateLimitMiddlewareSuite struct {
+	suite.Suite
+	router *echo.Echo
+}
+
+func TestRateLimitMiddlewareSuite(t *testing.T) {
+	suite.Run(t, new(RateLimitMiddlewareSuite))
+}
+
+func (suite *RateLimitMiddlewareSuite) SetupTest() {
+	store := gateway.NewInMemoryRateLimitStore()
+	suite.router = echo.New()
+	// 本番と同じくプロキシを設定しない場合の取得方法を使う
+	suite.router.IPExtractor = middleware.NewIPExtractor(nil)
+	ok := func(c echo.Context) error { return c.NoContent(http.StatusOK) }
+
+	// X-User-Id ヘッダーがある場合はJWTMiddlewareの代わりにユーザーを設定する
+	setUser := func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if c.Request().Header.Get("X-User-Id") == "1" {
+				c.Set("user", &jwt.Token{Claims: jwt.MapClaims{"user_id": float64(1)}})
+			}
+			return next(c)
+		}
+	}
+	suite.router.GET("/auth", ok, setUser, middleware.RateLimitMiddleware(store, middleware.RateLimitConfig{Name: "auth", Limit: 2, Period: time.Minute}))
+	suite.router.GET("/transactions", ok, setUser, middleware.RateLimitMiddleware(store, middleware.RateLimitConfig{Name: "transactions", Limit: 1, Period: time.Minute}))
+	suite.router.GET("/unlimited", ok, middleware.RateLimitMiddleware(store, middleware.RateLimitConfig{Name: "unlimited"}))
+	suite.router.GET("/failing", ok, middleware.RateLimitMiddleware(failingRateLimitStore{}, middleware.RateLimitConfig{Name: "failing", Limit: 1, Period: time.Minute}))
+}
+
+func (suite *RateLimitMiddlewareSuite) get(path string, ip string, userID string) *httptest.ResponseRecorder {
+	return suite.getWithHeaders(path, ip, userID, nil)
+}
+
+func (suite *RateLimitMiddlewareSuite) getWithHeaders(path string, ip string, userID string, headers map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	req.RemoteAddr = ip + ":12345"
+	if userID != "" {
+		req.Header.Set("X-User-Id", userID)
+	}
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	rec := httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, req)
+	return rec
+}
+
+func (suite *RateLimitMiddlewareSuite) TestHeadersAndTooManyRequests() {
+	first := suite.get("/auth", "192.0.2.1", "")
+	suite.Assert().Equal(http.StatusOK, first.Code)
+	suite.Assert().Equal("2", first.Header().Get(middleware.RateLimitLimitHeader))
+	suite.Assert().Equal("1", first.Header().Get(middleware.RateLimitRemainingHeader))
+	suite.Assert().Equal("30", first.Header().Get(middleware.RateLimitResetHeader))
+	suite.Assert().Equal("2;w=60", first.Header().Get(middleware.RateLimitPolicyHeader))
+
+	second := suite.get("/auth", "192.0.2.1", "")
+	suite.Assert().Equal(http.StatusOK, second.Code)
+	suite.Assert().Equal("0", second.Header().Get(middleware.RateLimitRemainingHeader))
+
+	third := suite.get("/auth", "192.0.2.1", "")
+	suite.Assert().Equal(http.StatusTooManyRequests, third.Code)
+	suite.Assert().Equal("30", third.Header().Get("Retry-After"))
+
+	// 別のIPアドレスは別のバケットで数える
+	suite.Assert().Equal(http.StatusOK, suite.get("/auth", "198.51.100.1", "").Code)
+}
+
+func (suite *RateLimitMiddlewareSuite) TestSpoofedForwardedForSharesBucket() {
+	suite.Assert().Equal(http.StatusOK, suite.get("/auth", "192.0.2.1", "").Code)
+	suite.Assert().Equal(http.StatusOK, suite.get("/auth", "192.0.2.1", "").Code)
+
+	// クライアントが付けたヘッダーを変えても接続元が同じなら同じバケットで数える
+	spoofed := suite.getWithHeaders("/auth", "192.0.2.1", "", map[string]string{
+		echo.HeaderXForwardedFor: "203.0.113.1",
+		echo.HeaderXRealIP:       "203.0.113.2",
+	})
+	suite.Assert().Equal(http.StatusTooManyRequests, spoofed.Code)
+}
+
+func (suite *RateLimitMiddlewareSuite) TestKeyedByUser() {
+	suite.Assert().Equal(http.StatusOK, suite.get("/transactions", "192.0.2.1", "1").Code)
+	// ログイン中のユーザーはIPアドレスが変わっても同じバケットで数える
+	suite.Assert().Equal(http.StatusTooManyRequests, suite.get("/transactions", "198.51.100.1", "1").Code)
+	// 未ログインのリクエストはIPアドレスで数える
+	suite.Assert().Equal(http.StatusOK, suite.get("/transactions", "192.0.2.1", "").Code)
+}
+
+func (suite *RateLimitMiddlewareSuite) TestGroupsAreIndependent() {
+	suite.Assert().Equal(http.StatusOK, suite.get("/transactions", "192.0.2.1", "").Code)
+	suite.Assert().Equal(http.StatusTooManyRequests, suite.get("/transactions", "192.0.2.1", "").Code)
+	suite.Assert().Equal(http.StatusOK, suite.get("/auth", "192.0.2.1", "").Code)
+}
+
+func (suite *RateLimitMiddlewareSuite) TestDisabledWhenLimitIsZero() {
+	for i := 0; i < 10; i++ {
+		rec := suite.get("/unlimited", "192.0.2.1", "")
+		suite.Assert().Equal(http.StatusOK, rec.Code)
+		suite.Assert().Empty(rec.Header().Get(middleware.RateLimitLimitHeader))
+	}
+}
+
+func (suite *RateLimitMiddlewareSuite) TestFailOpenOnStoreError() {
+	suite.Assert().Equal(http.StatusOK, suite.get("/failing", "192.0.2.1", "").Code)
+	suite.Assert().Equal(http.StatusOK, suite.get("/failing", "192.0.2.1", "").Code)
+}
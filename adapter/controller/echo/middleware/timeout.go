@@ -25,9 +25,7 @@ func TimeoutMiddleware(duration time.Duration) echo.MiddlewareFunc {
 			case err := <-errChan:
 				return err
 			case <-ctx.Done():
-				return c.JSON(http.StatusRequestTimeout, map[string]string{
-					"message": "timeout",
-				})
+				return echo.NewHTTPError(http.StatusRequestTimeout, "timeout")
 			}
 		}
 	}
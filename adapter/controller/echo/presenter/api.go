@@ -15,15 +15,36 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
 	"github.com/oapi-codegen/runtime"
+	strictecho "github.com/oapi-codegen/runtime/strictmiddleware/echo"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 const (
-	CsrfAuthScopes = "CsrfAuth.Scopes"
+	BearerAuthScopes = "BearerAuth.Scopes"
+	CsrfAuthScopes   = "CsrfAuth.Scopes"
+)
+
+// Defines values for AccountDeletionRequestStatus.
+const (
+	AccountDeletionRequestStatusCancelled AccountDeletionRequestStatus = "cancelled"
+	AccountDeletionRequestStatusCompleted AccountDeletionRequestStatus = "completed"
+	AccountDeletionRequestStatusFailed    AccountDeletionRequestStatus = "failed"
+	AccountDeletionRequestStatusPending   AccountDeletionRequestStatus = "pending"
+	AccountDeletionRequestStatusScheduled AccountDeletionRequestStatus = "scheduled"
+)
+
+// Defines values for AdminAuditLogRequestAction.
+const (
+	UserDisable     AdminAuditLogRequestAction = "user.disable"
+	UserEnable      AdminAuditLogRequestAction = "user.enable"
+	UserForceLogout AdminAuditLogRequestAction = "user.force_logout"
+	UserImpersonate AdminAuditLogRequestAction = "user.impersonate"
+	UserUpdateRole  AdminAuditLogRequestAction = "user.update_role"
 )
 
 // Defines values for CategoryCreateRequestType.
@@ -44,6 +65,131 @@ const (
 	Income  CategoryUpdateRequestType = "income"
 )
 
+// Defines values for DataExportRequestStatus.
+const (
+	DataExportRequestStatusCompleted  DataExportRequestStatus = "completed"
+	DataExportRequestStatusExpired    DataExportRequestStatus = "expired"
+	DataExportRequestStatusFailed     DataExportRequestStatus = "failed"
+	DataExportRequestStatusPending    DataExportRequestStatus = "pending"
+	DataExportRequestStatusProcessing DataExportRequestStatus = "processing"
+)
+
+// Defines values for JSONWebKeyAlg.
+const (
+	EdDSA JSONWebKeyAlg = "EdDSA"
+	RS256 JSONWebKeyAlg = "RS256"
+)
+
+// Defines values for JSONWebKeyKty.
+const (
+	OKP JSONWebKeyKty = "OKP"
+	RSA JSONWebKeyKty = "RSA"
+)
+
+// Defines values for TokenScope.
+const (
+	ReadCategories    TokenScope = "read:categories"
+	ReadEvents        TokenScope = "read:events"
+	ReadReports       TokenScope = "read:reports"
+	ReadTransactions  TokenScope = "read:transactions"
+	ReadWebhooks      TokenScope = "read:webhooks"
+	WriteCategories   TokenScope = "write:categories"
+	WriteReports      TokenScope = "write:reports"
+	WriteTransactions TokenScope = "write:transactions"
+	WriteWebhooks     TokenScope = "write:webhooks"
+)
+
+// Defines values for TransactionBulkOperationOp.
+const (
+	Create TransactionBulkOperationOp = "create"
+	Delete TransactionBulkOperationOp = "delete"
+	Update TransactionBulkOperationOp = "update"
+)
+
+// Defines values for TransactionBulkRequestMode.
+const (
+	Atomic     TransactionBulkRequestMode = "atomic"
+	BestEffort TransactionBulkRequestMode = "best_effort"
+)
+
+// Defines values for UserRole.
+const (
+	Admin UserRole = "admin"
+	User  UserRole = "user"
+)
+
+// Defines values for WebhookEvent.
+const (
+	Asterisk              WebhookEvent = "*"
+	CategoryCreated       WebhookEvent = "category.created"
+	CategoryDeleted       WebhookEvent = "category.deleted"
+	CategoryUpdated       WebhookEvent = "category.updated"
+	MonthlySummaryCreated WebhookEvent = "monthly_summary.created"
+	MonthlySummaryDeleted WebhookEvent = "monthly_summary.deleted"
+	MonthlySummaryUpdated WebhookEvent = "monthly_summary.updated"
+	TransactionCreated    WebhookEvent = "transaction.created"
+	TransactionDeleted    WebhookEvent = "transaction.deleted"
+	TransactionUpdated    WebhookEvent = "transaction.updated"
+)
+
+// Defines values for AdminSearchUsersParamsStatus.
+const (
+	Active   AdminSearchUsersParamsStatus = "active"
+	Disabled AdminSearchUsersParamsStatus = "disabled"
+)
+
+// AccountDeletionRequest defines model for AccountDeletionRequest.
+type AccountDeletionRequest struct {
+	ConfirmedAt *time.Time `json:"confirmed_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	Id          int        `json:"id"`
+
+	// ScheduledAt End of the grace period. All data is deleted after this time.
+	ScheduledAt *time.Time                   `json:"scheduled_at,omitempty"`
+	Status      AccountDeletionRequestStatus `json:"status"`
+}
+
+// AccountDeletionRequestStatus defines model for AccountDeletionRequest.Status.
+type AccountDeletionRequestStatus string
+
+// AdminAuditLogRequest defines model for AdminAuditLogRequest.
+type AdminAuditLogRequest struct {
+	Action       AdminAuditLogRequestAction `json:"action"`
+	AdminId      int                        `json:"admin_id"`
+	CreatedAt    time.Time                  `json:"created_at"`
+	Detail       string                     `json:"detail"`
+	Id           int                        `json:"id"`
+	IpAddress    string                     `json:"ip_address"`
+	TargetUserId int                        `json:"target_user_id"`
+}
+
+// AdminAuditLogRequestAction defines model for AdminAuditLogRequest.Action.
+type AdminAuditLogRequestAction string
+
+// AdminUserRequest defines model for AdminUserRequest.
+type AdminUserRequest struct {
+	CreatedAt     time.Time           `json:"created_at"`
+	Disabled      bool                `json:"disabled"`
+	DisabledAt    *time.Time          `json:"disabled_at,omitempty"`
+	Email         openapi_types.Email `json:"email"`
+	EmailVerified bool                `json:"email_verified"`
+	Id            int                 `json:"id"`
+	Name          string              `json:"name"`
+	Role          UserRole            `json:"role"`
+}
+
+// AdminUserRoleUpdateRequest defines model for AdminUserRoleUpdateRequest.
+type AdminUserRoleUpdateRequest struct {
+	Role UserRole `json:"role"`
+}
+
+// AdminUsersRequest defines model for AdminUsersRequest.
+type AdminUsersRequest struct {
+	// Total Number of matching users before limit and offset are applied
+	Total int64              `json:"total"`
+	Users []AdminUserRequest `json:"users"`
+}
+
 // CategoryCreateRequest defines model for CategoryCreateRequest.
 type CategoryCreateRequest struct {
 	Name   string                    `json:"name"`
@@ -56,9 +202,10 @@ type CategoryCreateRequestType string
 
 // CategoryRequest defines model for CategoryRequest.
 type CategoryRequest struct {
-	Id   int                 `json:"id"`
-	Name string              `json:"name"`
-	Type CategoryRequestType `json:"type"`
+	Id      int                 `json:"id"`
+	Name    string              `json:"name"`
+	Type    CategoryRequestType `json:"type"`
+	Version int                 `json:"version"`
 }
 
 // CategoryRequestType defines model for CategoryRequest.Type.
@@ -73,6 +220,82 @@ type CategoryUpdateRequest struct {
 // CategoryUpdateRequestType defines model for CategoryUpdateRequest.Type.
 type CategoryUpdateRequestType string
 
+// DataExportRequest defines model for DataExportRequest.
+type DataExportRequest struct {
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	Error       *string    `json:"error,omitempty"`
+
+	// ExpiresAt The archive can be downloaded until this time
+	ExpiresAt *time.Time              `json:"expires_at,omitempty"`
+	FileSize  int64                   `json:"file_size"`
+	Id        int                     `json:"id"`
+	Status    DataExportRequestStatus `json:"status"`
+}
+
+// DataExportRequestStatus defines model for DataExportRequest.Status.
+type DataExportRequestStatus string
+
+// EmailVerificationRequest defines model for EmailVerificationRequest.
+type EmailVerificationRequest struct {
+	Token string `json:"token"`
+}
+
+// FieldError defines model for FieldError.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ImpersonationCreateRequest defines model for ImpersonationCreateRequest.
+type ImpersonationCreateRequest struct {
+	// Reason Why the user is impersonated, e.g. a support ticket number
+	Reason string `json:"reason"`
+}
+
+// ImpersonationRequest defines model for ImpersonationRequest.
+type ImpersonationRequest struct {
+	ExpiresAt time.Time        `json:"expires_at"`
+	User      AdminUserRequest `json:"user"`
+}
+
+// JSONWebKey Public key in RFC 7517 format. RSA keys have n and e, Ed25519 keys have crv and x.
+type JSONWebKey struct {
+	Alg JSONWebKeyAlg `json:"alg"`
+	Crv *string       `json:"crv,omitempty"`
+	E   *string       `json:"e,omitempty"`
+	Kid string        `json:"kid"`
+	Kty JSONWebKeyKty `json:"kty"`
+	N   *string       `json:"n,omitempty"`
+	Use string        `json:"use"`
+	X   *string       `json:"x,omitempty"`
+}
+
+// JSONWebKeyAlg defines model for JSONWebKey.Alg.
+type JSONWebKeyAlg string
+
+// JSONWebKeyKty defines model for JSONWebKey.Kty.
+type JSONWebKeyKty string
+
+// JWKSRequest defines model for JWKSRequest.
+type JWKSRequest struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// LoginChallengeRequest defines model for LoginChallengeRequest.
+type LoginChallengeRequest struct {
+	// ChallengeToken Pass to /auth/login/totp with a TOTP or recovery code
+	ChallengeToken string `json:"challenge_token"`
+}
+
+// LoginTOTPRequest defines model for LoginTOTPRequest.
+type LoginTOTPRequest struct {
+	ChallengeToken string `json:"challenge_token"`
+
+	// Code 6-digit TOTP code or a recovery code
+	Code string `json:"code"`
+}
+
 // MonthlySummaryCreateRequest defines model for MonthlySummaryCreateRequest.
 type MonthlySummaryCreateRequest struct {
 	Balance   float32 `json:"balance"`
@@ -88,6 +311,7 @@ type MonthlySummaryRequest struct {
 	Expense   float32 `json:"expense"`
 	Id        int     `json:"id"`
 	Income    float32 `json:"income"`
+	Version   int     `json:"version"`
 	YearMonth string  `json:"year_month"`
 }
 
@@ -99,8 +323,158 @@ type MonthlySummaryUpdateRequest struct {
 	YearMonth string  `json:"year_month"`
 }
 
+// OIDCAuthorizationRequest defines model for OIDCAuthorizationRequest.
+type OIDCAuthorizationRequest struct {
+	AuthorizationUrl string `json:"authorization_url"`
+}
+
+// OIDCProvidersRequest defines model for OIDCProvidersRequest.
+type OIDCProvidersRequest struct {
+	Providers []string `json:"providers"`
+}
+
+// PasswordResetConfirmRequest defines model for PasswordResetConfirmRequest.
+type PasswordResetConfirmRequest struct {
+	Password string `json:"password"`
+	Token    string `json:"token"`
+}
+
+// PasswordResetRequest defines model for PasswordResetRequest.
+type PasswordResetRequest struct {
+	Email openapi_types.Email `json:"email"`
+}
+
+// PersonalAccessTokenCreateRequest defines model for PersonalAccessTokenCreateRequest.
+type PersonalAccessTokenCreateRequest struct {
+	// ExpiresAt Omit for a token that does not expire
+	ExpiresAt *time.Time   `json:"expires_at,omitempty"`
+	Name      string       `json:"name"`
+	Scopes    []TokenScope `json:"scopes"`
+}
+
+// PersonalAccessTokenRequest defines model for PersonalAccessTokenRequest.
+type PersonalAccessTokenRequest struct {
+	CreatedAt  time.Time    `json:"created_at"`
+	ExpiresAt  *time.Time   `json:"expires_at,omitempty"`
+	Id         int          `json:"id"`
+	LastUsedAt *time.Time   `json:"last_used_at,omitempty"`
+	Name       string       `json:"name"`
+	Scopes     []TokenScope `json:"scopes"`
+
+	// Token The token. Only returned when the token is created.
+	Token *string `json:"token,omitempty"`
+
+	// TokenPrefix Leading characters of the token to tell tokens apart
+	TokenPrefix string `json:"token_prefix"`
+}
+
+// Problem RFC 7807 problem details
+type Problem struct {
+	// Detail Explanation specific to this occurrence of the problem
+	Detail *string `json:"detail,omitempty"`
+
+	// Errors Details of each invalid field. Only returned for validation errors
+	Errors *[]FieldError `json:"errors,omitempty"`
+
+	// Instance Request path where the problem occurred
+	Instance *string `json:"instance,omitempty"`
+
+	// Status HTTP status code
+	Status int `json:"status"`
+
+	// Title Short summary of the problem type
+	Title string `json:"title"`
+
+	// Type URI reference that identifies the problem type
+	Type string `json:"type"`
+}
+
+// RecoveryCodesRequest defines model for RecoveryCodesRequest.
+type RecoveryCodesRequest struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// SystemStatsRequest defines model for SystemStatsRequest.
+type SystemStatsRequest struct {
+	AdminCount        int64 `json:"admin_count"`
+	DisabledUserCount int64 `json:"disabled_user_count"`
+
+	// NewUserCount Users registered since `since`
+	NewUserCount            int64   `json:"new_user_count"`
+	RecentTransactionAmount float64 `json:"recent_transaction_amount"`
+
+	// RecentTransactionCount Transactions dated on or after `since`
+	RecentTransactionCount int64     `json:"recent_transaction_count"`
+	Since                  time.Time `json:"since"`
+	TransactionAmount      float64   `json:"transaction_amount"`
+	TransactionCount       int64     `json:"transaction_count"`
+	UserCount              int64     `json:"user_count"`
+}
+
+// TOTPCodeRequest defines model for TOTPCodeRequest.
+type TOTPCodeRequest struct {
+	// Code 6-digit TOTP code or a recovery code
+	Code string `json:"code"`
+}
+
+// TOTPEnrollmentRequest defines model for TOTPEnrollmentRequest.
+type TOTPEnrollmentRequest struct {
+	OtpauthUri string `json:"otpauth_uri"`
+	Secret     string `json:"secret"`
+}
+
+// TokenScope defines model for TokenScope.
+type TokenScope string
+
+// TransactionBulkOperation defines model for TransactionBulkOperation.
+type TransactionBulkOperation struct {
+	// Amount Must not be 0. The sign follows the server's sign policy; positive amounts only by default, or negative amounts for expense categories when the policy is signed.
+	Amount     *float32            `json:"amount,omitempty"`
+	CategoryId *int                `json:"category_id,omitempty"`
+	Content    *string             `json:"content,omitempty"`
+	Date       *openapi_types.Date `json:"date,omitempty"`
+
+	// Id Target transaction ID. Required for update and delete.
+	Id *int                       `json:"id,omitempty"`
+	Op TransactionBulkOperationOp `json:"op"`
+
+	// Version Expected version of the transaction. Required for update and delete; a request without it returns 400. When the version does not match, the operation fails with status 412.
+	Version *int `json:"version,omitempty"`
+}
+
+// TransactionBulkOperationOp defines model for TransactionBulkOperation.Op.
+type TransactionBulkOperationOp string
+
+// TransactionBulkRequest defines model for TransactionBulkRequest.
+type TransactionBulkRequest struct {
+	Mode       *TransactionBulkRequestMode `json:"mode,omitempty"`
+	Operations []TransactionBulkOperation  `json:"operations"`
+}
+
+// TransactionBulkRequestMode defines model for TransactionBulkRequest.Mode.
+type TransactionBulkRequestMode string
+
+// TransactionBulkResult defines model for TransactionBulkResult.
+type TransactionBulkResult struct {
+	Error *string `json:"error,omitempty"`
+	Index int     `json:"index"`
+	Op    string  `json:"op"`
+
+	// Status HTTP status the operation would have returned on its own when it failed, e.g. 412 for a version conflict
+	Status      *int                `json:"status,omitempty"`
+	Success     bool                `json:"success"`
+	Transaction *TransactionRequest `json:"transaction,omitempty"`
+}
+
+// TransactionBulkResultList defines model for TransactionBulkResultList.
+type TransactionBulkResultList struct {
+	Committed bool                    `json:"committed"`
+	Results   []TransactionBulkResult `json:"results"`
+}
+
 // TransactionCreateRequest defines model for TransactionCreateRequest.
 type TransactionCreateRequest struct {
+	// Amount Must not be 0. The sign follows the server's sign policy; positive amounts only by default, or negative amounts for expense categories when the policy is signed.
 	Amount     float32            `json:"amount"`
 	CategoryId int                `json:"category_id"`
 	Content    *string            `json:"content,omitempty"`
@@ -116,10 +490,12 @@ type TransactionRequest struct {
 	Date       openapi_types.Date `json:"date"`
 	Id         int                `json:"id"`
 	UserId     int                `json:"user_id"`
+	Version    int                `json:"version"`
 }
 
 // TransactionUpdateRequest defines model for TransactionUpdateRequest.
 type TransactionUpdateRequest struct {
+	// Amount Must not be 0. The sign follows the server's sign policy; positive amounts only by default, or negative amounts for expense categories when the policy is signed.
 	Amount     float32            `json:"amount"`
 	CategoryId int                `json:"category_id"`
 	Content    *string            `json:"content,omitempty"`
@@ -134,13 +510,25 @@ type UserCreateRequest struct {
 	Password string              `json:"password"`
 }
 
+// UserIdentityRequest defines model for UserIdentityRequest.
+type UserIdentityRequest struct {
+	CreatedAt time.Time `json:"created_at"`
+	Email     string    `json:"email"`
+	Provider  string    `json:"provider"`
+}
+
 // UserRequest defines model for UserRequest.
 type UserRequest struct {
-	Email openapi_types.Email `json:"email"`
-	Id    int                 `json:"id"`
-	Name  string              `json:"name"`
+	Email         openapi_types.Email `json:"email"`
+	EmailVerified bool                `json:"email_verified"`
+	Id            int                 `json:"id"`
+	Name          string              `json:"name"`
+	Role          UserRole            `json:"role"`
 }
 
+// UserRole defines model for UserRole.
+type UserRole string
+
 // UserUpdateRequest defines model for UserUpdateRequest.
 type UserUpdateRequest struct {
 	Email    openapi_types.Email `json:"email"`
@@ -148,35 +536,166 @@ type UserUpdateRequest struct {
 	Password string              `json:"password"`
 }
 
+// WebhookCreateRequest defines model for WebhookCreateRequest.
+type WebhookCreateRequest struct {
+	Active *bool          `json:"active,omitempty"`
+	Events []WebhookEvent `json:"events"`
+	Url    string         `json:"url"`
+}
+
+// WebhookDeliveryRequest defines model for WebhookDeliveryRequest.
+type WebhookDeliveryRequest struct {
+	CreatedAt  time.Time `json:"created_at"`
+	Error      *string   `json:"error,omitempty"`
+	EventId    int       `json:"event_id"`
+	EventType  string    `json:"event_type"`
+	Id         int       `json:"id"`
+	Payload    string    `json:"payload"`
+	Replay     bool      `json:"replay"`
+	StatusCode int       `json:"status_code"`
+	Success    bool      `json:"success"`
+	WebhookId  int       `json:"webhook_id"`
+}
+
+// WebhookEvent defines model for WebhookEvent.
+type WebhookEvent string
+
+// WebhookRequest defines model for WebhookRequest.
+type WebhookRequest struct {
+	Active    bool           `json:"active"`
+	CreatedAt time.Time      `json:"created_at"`
+	Events    []WebhookEvent `json:"events"`
+	Id        int            `json:"id"`
+
+	// Secret HMAC signing secret. Only returned when the webhook is created.
+	Secret *string `json:"secret,omitempty"`
+	Url    string  `json:"url"`
+}
+
+// WebhookUpdateRequest defines model for WebhookUpdateRequest.
+type WebhookUpdateRequest struct {
+	Active bool           `json:"active"`
+	Events []WebhookEvent `json:"events"`
+	Url    string         `json:"url"`
+}
+
+// IdempotencyKey defines model for IdempotencyKey.
+type IdempotencyKey = string
+
+// IfMatch defines model for IfMatch.
+type IfMatch = string
+
+// AccountDeletionResponse defines model for AccountDeletionResponse.
+type AccountDeletionResponse = AccountDeletionRequest
+
+// AdminUserResponse defines model for AdminUserResponse.
+type AdminUserResponse = AdminUserRequest
+
+// AdminUsersResponse defines model for AdminUsersResponse.
+type AdminUsersResponse = AdminUsersRequest
+
 // CategoryResponse defines model for CategoryResponse.
 type CategoryResponse = CategoryRequest
 
-// ErrorResponse defines model for ErrorResponse.
-type ErrorResponse struct {
-	Message string `json:"message"`
-}
+// DataExportResponse defines model for DataExportResponse.
+type DataExportResponse = DataExportRequest
+
+// ErrorResponse RFC 7807 problem details
+type ErrorResponse = Problem
+
+// ImpersonationResponse defines model for ImpersonationResponse.
+type ImpersonationResponse = ImpersonationRequest
+
+// JWKSResponse defines model for JWKSResponse.
+type JWKSResponse = JWKSRequest
+
+// LoginChallengeResponse defines model for LoginChallengeResponse.
+type LoginChallengeResponse = LoginChallengeRequest
 
 // MonthlySummaryResponse defines model for MonthlySummaryResponse.
 type MonthlySummaryResponse = MonthlySummaryRequest
 
+// OIDCAuthorizationResponse defines model for OIDCAuthorizationResponse.
+type OIDCAuthorizationResponse = OIDCAuthorizationRequest
+
+// OIDCProvidersResponse defines model for OIDCProvidersResponse.
+type OIDCProvidersResponse = OIDCProvidersRequest
+
+// PersonalAccessTokenResponse defines model for PersonalAccessTokenResponse.
+type PersonalAccessTokenResponse = PersonalAccessTokenRequest
+
+// RecoveryCodesResponse defines model for RecoveryCodesResponse.
+type RecoveryCodesResponse = RecoveryCodesRequest
+
+// SystemStatsResponse defines model for SystemStatsResponse.
+type SystemStatsResponse = SystemStatsRequest
+
+// TOTPEnrollmentResponse defines model for TOTPEnrollmentResponse.
+type TOTPEnrollmentResponse = TOTPEnrollmentRequest
+
+// TooManyRequestsResponse RFC 7807 problem details
+type TooManyRequestsResponse = Problem
+
+// TransactionBulkResponse defines model for TransactionBulkResponse.
+type TransactionBulkResponse = TransactionBulkResultList
+
 // TransactionResponse defines model for TransactionResponse.
 type TransactionResponse = TransactionRequest
 
 // UserResponse defines model for UserResponse.
 type UserResponse = UserRequest
 
+// WebhookDeliveryResponse defines model for WebhookDeliveryResponse.
+type WebhookDeliveryResponse = WebhookDeliveryRequest
+
+// WebhookResponse defines model for WebhookResponse.
+type WebhookResponse = WebhookRequest
+
+// AccountDeletionConfirmRequestBody defines model for AccountDeletionConfirmRequestBody.
+type AccountDeletionConfirmRequestBody = EmailVerificationRequest
+
+// AccountUnlockRequestBody defines model for AccountUnlockRequestBody.
+type AccountUnlockRequestBody = EmailVerificationRequest
+
+// AdminUserRoleUpdateRequestBody defines model for AdminUserRoleUpdateRequestBody.
+type AdminUserRoleUpdateRequestBody = AdminUserRoleUpdateRequest
+
 // CategoryCreateRequestBody defines model for CategoryCreateRequestBody.
 type CategoryCreateRequestBody = CategoryCreateRequest
 
 // CategoryUpdateRequestBody defines model for CategoryUpdateRequestBody.
 type CategoryUpdateRequestBody = CategoryUpdateRequest
 
+// EmailVerificationRequestBody defines model for EmailVerificationRequestBody.
+type EmailVerificationRequestBody = EmailVerificationRequest
+
+// ImpersonationRequestBody defines model for ImpersonationRequestBody.
+type ImpersonationRequestBody = ImpersonationCreateRequest
+
+// LoginTOTPRequestBody defines model for LoginTOTPRequestBody.
+type LoginTOTPRequestBody = LoginTOTPRequest
+
 // MonthlySummaryCreateRequestBody defines model for MonthlySummaryCreateRequestBody.
 type MonthlySummaryCreateRequestBody = MonthlySummaryCreateRequest
 
 // MonthlySummaryUpdateRequestBody defines model for MonthlySummaryUpdateRequestBody.
 type MonthlySummaryUpdateRequestBody = MonthlySummaryUpdateRequest
 
+// PasswordResetConfirmRequestBody defines model for PasswordResetConfirmRequestBody.
+type PasswordResetConfirmRequestBody = PasswordResetConfirmRequest
+
+// PasswordResetRequestBody defines model for PasswordResetRequestBody.
+type PasswordResetRequestBody = PasswordResetRequest
+
+// PersonalAccessTokenCreateRequestBody defines model for PersonalAccessTokenCreateRequestBody.
+type PersonalAccessTokenCreateRequestBody = PersonalAccessTokenCreateRequest
+
+// TOTPCodeRequestBody defines model for TOTPCodeRequestBody.
+type TOTPCodeRequestBody = TOTPCodeRequest
+
+// TransactionBulkRequestBody defines model for TransactionBulkRequestBody.
+type TransactionBulkRequestBody = TransactionBulkRequest
+
 // TransactionCreateRequestBody defines model for TransactionCreateRequestBody.
 type TransactionCreateRequestBody = TransactionCreateRequest
 
@@ -189,18 +708,153 @@ type UserCreateRequestBody = UserCreateRequest
 // UserUpdateRequestBody defines model for UserUpdateRequestBody.
 type UserUpdateRequestBody = UserUpdateRequest
 
+// WebhookCreateRequestBody defines model for WebhookCreateRequestBody.
+type WebhookCreateRequestBody = WebhookCreateRequest
+
+// WebhookUpdateRequestBody defines model for WebhookUpdateRequestBody.
+type WebhookUpdateRequestBody = WebhookUpdateRequest
+
+// AdminGetAuditLogsParams defines parameters for AdminGetAuditLogs.
+type AdminGetAuditLogsParams struct {
+	// UserId Only return actions on this user
+	UserId *int `form:"user_id,omitempty" json:"user_id,omitempty"`
+	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// AdminSearchUsersParams defines parameters for AdminSearchUsers.
+type AdminSearchUsersParams struct {
+	// Q Part of the email address or name
+	Q      *string                       `form:"q,omitempty" json:"q,omitempty"`
+	Role   *UserRole                     `form:"role,omitempty" json:"role,omitempty"`
+	Status *AdminSearchUsersParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+	Limit  *int                          `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int                          `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// AdminSearchUsersParamsStatus defines parameters for AdminSearchUsers.
+type AdminSearchUsersParamsStatus string
+
 // LoginUserJSONBody defines parameters for LoginUser.
 type LoginUserJSONBody struct {
 	Email    openapi_types.Email `json:"email"`
 	Password string              `json:"password"`
 }
 
+// HandleOIDCCallbackParams defines parameters for HandleOIDCCallback.
+type HandleOIDCCallbackParams struct {
+	State string  `form:"state" json:"state"`
+	Code  *string `form:"code,omitempty" json:"code,omitempty"`
+
+	// Error Error returned by the provider instead of code
+	Error *string `form:"error,omitempty" json:"error,omitempty"`
+
+	// OidcState State set when the authorization request was started
+	OidcState *string `form:"oidc_state,omitempty" json:"oidc_state,omitempty"`
+}
+
+// CreateCategoryParams defines parameters for CreateCategory.
+type CreateCategoryParams struct {
+	// IdempotencyKey Unique key to safely retry the request. A retry with the same key returns the stored response.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// DeleteCategoryByIdParams defines parameters for DeleteCategoryById.
+type DeleteCategoryByIdParams struct {
+	// IfMatch ETag of the resource returned by GET. "*" skips the version check. Weak ETags (W/) never match and return 412.
+	IfMatch IfMatch `json:"If-Match"`
+}
+
+// UpdateCategoryByIdParams defines parameters for UpdateCategoryById.
+type UpdateCategoryByIdParams struct {
+	// IfMatch ETag of the resource returned by GET. "*" skips the version check. Weak ETags (W/) never match and return 412.
+	IfMatch IfMatch `json:"If-Match"`
+}
+
+// StreamEventsParams defines parameters for StreamEvents.
+type StreamEventsParams struct {
+	// LastEventID ID of the last received event. Events after it are sent again on reconnect.
+	// Event IDs are assigned before commit, so events created up to a minute before it are sent again as well.
+	LastEventID *int `json:"Last-Event-ID,omitempty"`
+}
+
+// CreateMonthlySummaryParams defines parameters for CreateMonthlySummary.
+type CreateMonthlySummaryParams struct {
+	// IdempotencyKey Unique key to safely retry the request. A retry with the same key returns the stored response.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// DeleteMonthlySummaryByIdParams defines parameters for DeleteMonthlySummaryById.
+type DeleteMonthlySummaryByIdParams struct {
+	// IfMatch ETag of the resource returned by GET. "*" skips the version check. Weak ETags (W/) never match and return 412.
+	IfMatch IfMatch `json:"If-Match"`
+}
+
+// UpdateMonthlySummaryByIdParams defines parameters for UpdateMonthlySummaryById.
+type UpdateMonthlySummaryByIdParams struct {
+	// IfMatch ETag of the resource returned by GET. "*" skips the version check. Weak ETags (W/) never match and return 412.
+	IfMatch IfMatch `json:"If-Match"`
+}
+
+// CreateTransactionParams defines parameters for CreateTransaction.
+type CreateTransactionParams struct {
+	// IdempotencyKey Unique key to safely retry the request. A retry with the same key returns the stored response.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// BulkTransactionsParams defines parameters for BulkTransactions.
+type BulkTransactionsParams struct {
+	// IdempotencyKey Unique key to safely retry the request. A retry with the same key returns the stored response.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// DeleteTransactionByIdParams defines parameters for DeleteTransactionById.
+type DeleteTransactionByIdParams struct {
+	// IfMatch ETag of the resource returned by GET. "*" skips the version check. Weak ETags (W/) never match and return 412.
+	IfMatch IfMatch `json:"If-Match"`
+}
+
+// UpdateTransactionByIdParams defines parameters for UpdateTransactionById.
+type UpdateTransactionByIdParams struct {
+	// IfMatch ETag of the resource returned by GET. "*" skips the version check. Weak ETags (W/) never match and return 412.
+	IfMatch IfMatch `json:"If-Match"`
+}
+
+// CreateWebhookParams defines parameters for CreateWebhook.
+type CreateWebhookParams struct {
+	// IdempotencyKey Unique key to safely retry the request. A retry with the same key returns the stored response.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// AdminImpersonateUserJSONRequestBody defines body for AdminImpersonateUser for application/json ContentType.
+type AdminImpersonateUserJSONRequestBody = ImpersonationCreateRequest
+
+// AdminUpdateUserRoleJSONRequestBody defines body for AdminUpdateUserRole for application/json ContentType.
+type AdminUpdateUserRoleJSONRequestBody = AdminUserRoleUpdateRequest
+
+// ConfirmAccountDeletionJSONRequestBody defines body for ConfirmAccountDeletion for application/json ContentType.
+type ConfirmAccountDeletionJSONRequestBody = EmailVerificationRequest
+
 // LoginUserJSONRequestBody defines body for LoginUser for application/json ContentType.
 type LoginUserJSONRequestBody LoginUserJSONBody
 
+// LoginUserWithTOTPJSONRequestBody defines body for LoginUserWithTOTP for application/json ContentType.
+type LoginUserWithTOTPJSONRequestBody = LoginTOTPRequest
+
+// ConfirmPasswordResetJSONRequestBody defines body for ConfirmPasswordReset for application/json ContentType.
+type ConfirmPasswordResetJSONRequestBody = PasswordResetConfirmRequest
+
+// RequestPasswordResetJSONRequestBody defines body for RequestPasswordReset for application/json ContentType.
+type RequestPasswordResetJSONRequestBody = PasswordResetRequest
+
 // CreateUserJSONRequestBody defines body for CreateUser for application/json ContentType.
 type CreateUserJSONRequestBody = UserCreateRequest
 
+// UnlockAccountJSONRequestBody defines body for UnlockAccount for application/json ContentType.
+type UnlockAccountJSONRequestBody = EmailVerificationRequest
+
+// VerifyEmailJSONRequestBody defines body for VerifyEmail for application/json ContentType.
+type VerifyEmailJSONRequestBody = EmailVerificationRequest
+
 // CreateCategoryJSONRequestBody defines body for CreateCategory for application/json ContentType.
 type CreateCategoryJSONRequestBody = CategoryCreateRequest
 
@@ -216,12 +870,33 @@ type UpdateMonthlySummaryByIdJSONRequestBody = MonthlySummaryUpdateRequest
 // CreateTransactionJSONRequestBody defines body for CreateTransaction for application/json ContentType.
 type CreateTransactionJSONRequestBody = TransactionCreateRequest
 
+// BulkTransactionsJSONRequestBody defines body for BulkTransactions for application/json ContentType.
+type BulkTransactionsJSONRequestBody = TransactionBulkRequest
+
 // UpdateTransactionByIdJSONRequestBody defines body for UpdateTransactionById for application/json ContentType.
 type UpdateTransactionByIdJSONRequestBody = TransactionUpdateRequest
 
 // UpdateCurrentUserJSONRequestBody defines body for UpdateCurrentUser for application/json ContentType.
 type UpdateCurrentUserJSONRequestBody = UserUpdateRequest
 
+// CreatePersonalAccessTokenJSONRequestBody defines body for CreatePersonalAccessToken for application/json ContentType.
+type CreatePersonalAccessTokenJSONRequestBody = PersonalAccessTokenCreateRequest
+
+// ConfirmTOTPJSONRequestBody defines body for ConfirmTOTP for application/json ContentType.
+type ConfirmTOTPJSONRequestBody = TOTPCodeRequest
+
+// DisableTOTPJSONRequestBody defines body for DisableTOTP for application/json ContentType.
+type DisableTOTPJSONRequestBody = TOTPCodeRequest
+
+// RegenerateRecoveryCodesJSONRequestBody defines body for RegenerateRecoveryCodes for application/json ContentType.
+type RegenerateRecoveryCodesJSONRequestBody = TOTPCodeRequest
+
+// CreateWebhookJSONRequestBody defines body for CreateWebhook for application/json ContentType.
+type CreateWebhookJSONRequestBody = WebhookCreateRequest
+
+// UpdateWebhookByIdJSONRequestBody defines body for UpdateWebhookById for application/json ContentType.
+type UpdateWebhookByIdJSONRequestBody = WebhookUpdateRequest
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -295,6 +970,45 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// GetJWKS request
+	GetJWKS(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminGetAuditLogs request
+	AdminGetAuditLogs(ctx context.Context, params *AdminGetAuditLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminGetSystemStats request
+	AdminGetSystemStats(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminSearchUsers request
+	AdminSearchUsers(ctx context.Context, params *AdminSearchUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminGetUser request
+	AdminGetUser(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminDisableUser request
+	AdminDisableUser(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminEnableUser request
+	AdminEnableUser(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminImpersonateUserWithBody request with any body
+	AdminImpersonateUserWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdminImpersonateUser(ctx context.Context, id int, body AdminImpersonateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminForceLogout request
+	AdminForceLogout(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminUpdateUserRoleWithBody request with any body
+	AdminUpdateUserRoleWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdminUpdateUserRole(ctx context.Context, id int, body AdminUpdateUserRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ConfirmAccountDeletionWithBody request with any body
+	ConfirmAccountDeletionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ConfirmAccountDeletion(ctx context.Context, body ConfirmAccountDeletionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetCsrfToken request
 	GetCsrfToken(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -303,70 +1017,112 @@ type ClientInterface interface {
 
 	LoginUser(ctx context.Context, body LoginUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// LoginUserWithTOTPWithBody request with any body
+	LoginUserWithTOTPWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LoginUserWithTOTP(ctx context.Context, body LoginUserWithTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// LogoutUser request
 	LogoutUser(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetOIDCProviders request
+	GetOIDCProviders(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// HandleOIDCCallback request
+	HandleOIDCCallback(ctx context.Context, provider string, params *HandleOIDCCallbackParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StartOIDCLogin request
+	StartOIDCLogin(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ConfirmPasswordResetWithBody request with any body
+	ConfirmPasswordResetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ConfirmPasswordReset(ctx context.Context, body ConfirmPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RequestPasswordResetWithBody request with any body
+	RequestPasswordResetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RequestPasswordReset(ctx context.Context, body RequestPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// CreateUserWithBody request with any body
 	CreateUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	CreateUser(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// UnlockAccountWithBody request with any body
+	UnlockAccountWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UnlockAccount(ctx context.Context, body UnlockAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// VerifyEmailWithBody request with any body
+	VerifyEmailWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	VerifyEmail(ctx context.Context, body VerifyEmailJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetCategories request
 	GetCategories(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CreateCategoryWithBody request with any body
-	CreateCategoryWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	CreateCategoryWithBody(ctx context.Context, params *CreateCategoryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	CreateCategory(ctx context.Context, body CreateCategoryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	CreateCategory(ctx context.Context, params *CreateCategoryParams, body CreateCategoryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DeleteCategoryById request
-	DeleteCategoryById(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+	DeleteCategoryById(ctx context.Context, id int, params *DeleteCategoryByIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetCategoryById request
 	GetCategoryById(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// UpdateCategoryByIdWithBody request with any body
-	UpdateCategoryByIdWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	UpdateCategoryByIdWithBody(ctx context.Context, id int, params *UpdateCategoryByIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	UpdateCategoryById(ctx context.Context, id int, body UpdateCategoryByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	UpdateCategoryById(ctx context.Context, id int, params *UpdateCategoryByIdParams, body UpdateCategoryByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StreamEvents request
+	StreamEvents(ctx context.Context, params *StreamEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetMonthlySummaries request
 	GetMonthlySummaries(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CreateMonthlySummaryWithBody request with any body
-	CreateMonthlySummaryWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	CreateMonthlySummaryWithBody(ctx context.Context, params *CreateMonthlySummaryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	CreateMonthlySummary(ctx context.Context, body CreateMonthlySummaryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	CreateMonthlySummary(ctx context.Context, params *CreateMonthlySummaryParams, body CreateMonthlySummaryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DeleteMonthlySummaryById request
-	DeleteMonthlySummaryById(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+	DeleteMonthlySummaryById(ctx context.Context, id int, params *DeleteMonthlySummaryByIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetMonthlySummaryById request
 	GetMonthlySummaryById(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// UpdateMonthlySummaryByIdWithBody request with any body
-	UpdateMonthlySummaryByIdWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	UpdateMonthlySummaryByIdWithBody(ctx context.Context, id int, params *UpdateMonthlySummaryByIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	UpdateMonthlySummaryById(ctx context.Context, id int, body UpdateMonthlySummaryByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	UpdateMonthlySummaryById(ctx context.Context, id int, params *UpdateMonthlySummaryByIdParams, body UpdateMonthlySummaryByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetTransactions request
 	GetTransactions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CreateTransactionWithBody request with any body
-	CreateTransactionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	CreateTransactionWithBody(ctx context.Context, params *CreateTransactionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateTransaction(ctx context.Context, params *CreateTransactionParams, body CreateTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	CreateTransaction(ctx context.Context, body CreateTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// BulkTransactionsWithBody request with any body
+	BulkTransactionsWithBody(ctx context.Context, params *BulkTransactionsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	BulkTransactions(ctx context.Context, params *BulkTransactionsParams, body BulkTransactionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DeleteTransactionById request
-	DeleteTransactionById(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+	DeleteTransactionById(ctx context.Context, id int, params *DeleteTransactionByIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetTransactionById request
 	GetTransactionById(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// UpdateTransactionByIdWithBody request with any body
-	UpdateTransactionByIdWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	UpdateTransactionByIdWithBody(ctx context.Context, id int, params *UpdateTransactionByIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	UpdateTransactionById(ctx context.Context, id int, body UpdateTransactionByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	UpdateTransactionById(ctx context.Context, id int, params *UpdateTransactionByIdParams, body UpdateTransactionByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DeleteCurrentUser request
 	DeleteCurrentUser(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -378,34 +1134,91 @@ type ClientInterface interface {
 	UpdateCurrentUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	UpdateCurrentUser(ctx context.Context, body UpdateCurrentUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
-}
 
-func (c *Client) GetCsrfToken(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetCsrfTokenRequest(c.Server)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
+	// CancelAccountDeletion request
+	CancelAccountDeletion(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-func (c *Client) LoginUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewLoginUserRequestWithBody(c.Server, contentType, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+	// GetAccountDeletion request
+	GetAccountDeletion(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RequestDataExport request
+	RequestDataExport(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDataExports request
+	GetDataExports(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDataExport request
+	GetDataExport(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DownloadDataExport request
+	DownloadDataExport(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUserIdentities request
+	GetUserIdentities(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UnlinkUserIdentity request
+	UnlinkUserIdentity(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LinkUserIdentity request
+	LinkUserIdentity(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPersonalAccessTokens request
+	GetPersonalAccessTokens(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreatePersonalAccessTokenWithBody request with any body
+	CreatePersonalAccessTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreatePersonalAccessToken(ctx context.Context, body CreatePersonalAccessTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeletePersonalAccessToken request
+	DeletePersonalAccessToken(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ConfirmTOTPWithBody request with any body
+	ConfirmTOTPWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ConfirmTOTP(ctx context.Context, body ConfirmTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DisableTOTPWithBody request with any body
+	DisableTOTPWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DisableTOTP(ctx context.Context, body DisableTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EnrollTOTP request
+	EnrollTOTP(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RegenerateRecoveryCodesWithBody request with any body
+	RegenerateRecoveryCodesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RegenerateRecoveryCodes(ctx context.Context, body RegenerateRecoveryCodesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWebhooks request
+	GetWebhooks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateWebhookWithBody request with any body
+	CreateWebhookWithBody(ctx context.Context, params *CreateWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateWebhook(ctx context.Context, params *CreateWebhookParams, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteWebhookById request
+	DeleteWebhookById(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWebhookById request
+	GetWebhookById(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateWebhookByIdWithBody request with any body
+	UpdateWebhookByIdWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateWebhookById(ctx context.Context, id int, body UpdateWebhookByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWebhookDeliveries request
+	GetWebhookDeliveries(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReplayWebhookDelivery request
+	ReplayWebhookDelivery(ctx context.Context, id int, deliveryId int, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) LoginUser(ctx context.Context, body LoginUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewLoginUserRequest(c.Server, body)
+func (c *Client) GetJWKS(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetJWKSRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -416,8 +1229,8 @@ func (c *Client) LoginUser(ctx context.Context, body LoginUserJSONRequestBody, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) LogoutUser(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewLogoutUserRequest(c.Server)
+func (c *Client) AdminGetAuditLogs(ctx context.Context, params *AdminGetAuditLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminGetAuditLogsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -428,8 +1241,8 @@ func (c *Client) LogoutUser(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateUserRequestWithBody(c.Server, contentType, body)
+func (c *Client) AdminGetSystemStats(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminGetSystemStatsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -440,8 +1253,8 @@ func (c *Client) CreateUserWithBody(ctx context.Context, contentType string, bod
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateUser(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateUserRequest(c.Server, body)
+func (c *Client) AdminSearchUsers(ctx context.Context, params *AdminSearchUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminSearchUsersRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -452,8 +1265,8 @@ func (c *Client) CreateUser(ctx context.Context, body CreateUserJSONRequestBody,
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetCategories(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetCategoriesRequest(c.Server)
+func (c *Client) AdminGetUser(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminGetUserRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -464,8 +1277,8 @@ func (c *Client) GetCategories(ctx context.Context, reqEditors ...RequestEditorF
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateCategoryWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateCategoryRequestWithBody(c.Server, contentType, body)
+func (c *Client) AdminDisableUser(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminDisableUserRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -476,8 +1289,8 @@ func (c *Client) CreateCategoryWithBody(ctx context.Context, contentType string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateCategory(ctx context.Context, body CreateCategoryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateCategoryRequest(c.Server, body)
+func (c *Client) AdminEnableUser(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminEnableUserRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -488,8 +1301,8 @@ func (c *Client) CreateCategory(ctx context.Context, body CreateCategoryJSONRequ
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteCategoryById(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteCategoryByIdRequest(c.Server, id)
+func (c *Client) AdminImpersonateUserWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminImpersonateUserRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -500,8 +1313,8 @@ func (c *Client) DeleteCategoryById(ctx context.Context, id int, reqEditors ...R
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetCategoryById(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetCategoryByIdRequest(c.Server, id)
+func (c *Client) AdminImpersonateUser(ctx context.Context, id int, body AdminImpersonateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminImpersonateUserRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -512,8 +1325,8 @@ func (c *Client) GetCategoryById(ctx context.Context, id int, reqEditors ...Requ
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateCategoryByIdWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateCategoryByIdRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) AdminForceLogout(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminForceLogoutRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -524,8 +1337,8 @@ func (c *Client) UpdateCategoryByIdWithBody(ctx context.Context, id int, content
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateCategoryById(ctx context.Context, id int, body UpdateCategoryByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateCategoryByIdRequest(c.Server, id, body)
+func (c *Client) AdminUpdateUserRoleWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminUpdateUserRoleRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -536,8 +1349,8 @@ func (c *Client) UpdateCategoryById(ctx context.Context, id int, body UpdateCate
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetMonthlySummaries(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetMonthlySummariesRequest(c.Server)
+func (c *Client) AdminUpdateUserRole(ctx context.Context, id int, body AdminUpdateUserRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminUpdateUserRoleRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -548,8 +1361,8 @@ func (c *Client) GetMonthlySummaries(ctx context.Context, reqEditors ...RequestE
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateMonthlySummaryWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateMonthlySummaryRequestWithBody(c.Server, contentType, body)
+func (c *Client) ConfirmAccountDeletionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmAccountDeletionRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -560,8 +1373,8 @@ func (c *Client) CreateMonthlySummaryWithBody(ctx context.Context, contentType s
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateMonthlySummary(ctx context.Context, body CreateMonthlySummaryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateMonthlySummaryRequest(c.Server, body)
+func (c *Client) ConfirmAccountDeletion(ctx context.Context, body ConfirmAccountDeletionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmAccountDeletionRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -572,8 +1385,8 @@ func (c *Client) CreateMonthlySummary(ctx context.Context, body CreateMonthlySum
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteMonthlySummaryById(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteMonthlySummaryByIdRequest(c.Server, id)
+func (c *Client) GetCsrfToken(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCsrfTokenRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -584,8 +1397,8 @@ func (c *Client) DeleteMonthlySummaryById(ctx context.Context, id int, reqEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetMonthlySummaryById(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetMonthlySummaryByIdRequest(c.Server, id)
+func (c *Client) LoginUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoginUserRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -596,8 +1409,8 @@ func (c *Client) GetMonthlySummaryById(ctx context.Context, id int, reqEditors .
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateMonthlySummaryByIdWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateMonthlySummaryByIdRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) LoginUser(ctx context.Context, body LoginUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoginUserRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -608,8 +1421,8 @@ func (c *Client) UpdateMonthlySummaryByIdWithBody(ctx context.Context, id int, c
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateMonthlySummaryById(ctx context.Context, id int, body UpdateMonthlySummaryByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateMonthlySummaryByIdRequest(c.Server, id, body)
+func (c *Client) LoginUserWithTOTPWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoginUserWithTOTPRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -620,8 +1433,8 @@ func (c *Client) UpdateMonthlySummaryById(ctx context.Context, id int, body Upda
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetTransactions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetTransactionsRequest(c.Server)
+func (c *Client) LoginUserWithTOTP(ctx context.Context, body LoginUserWithTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoginUserWithTOTPRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -632,8 +1445,8 @@ func (c *Client) GetTransactions(ctx context.Context, reqEditors ...RequestEdito
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateTransactionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateTransactionRequestWithBody(c.Server, contentType, body)
+func (c *Client) LogoutUser(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLogoutUserRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -644,8 +1457,8 @@ func (c *Client) CreateTransactionWithBody(ctx context.Context, contentType stri
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateTransaction(ctx context.Context, body CreateTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateTransactionRequest(c.Server, body)
+func (c *Client) GetOIDCProviders(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOIDCProvidersRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -656,8 +1469,8 @@ func (c *Client) CreateTransaction(ctx context.Context, body CreateTransactionJS
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteTransactionById(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteTransactionByIdRequest(c.Server, id)
+func (c *Client) HandleOIDCCallback(ctx context.Context, provider string, params *HandleOIDCCallbackParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHandleOIDCCallbackRequest(c.Server, provider, params)
 	if err != nil {
 		return nil, err
 	}
@@ -668,8 +1481,8 @@ func (c *Client) DeleteTransactionById(ctx context.Context, id int, reqEditors .
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetTransactionById(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetTransactionByIdRequest(c.Server, id)
+func (c *Client) StartOIDCLogin(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStartOIDCLoginRequest(c.Server, provider)
 	if err != nil {
 		return nil, err
 	}
@@ -680,8 +1493,8 @@ func (c *Client) GetTransactionById(ctx context.Context, id int, reqEditors ...R
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateTransactionByIdWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateTransactionByIdRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) ConfirmPasswordResetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmPasswordResetRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -692,8 +1505,8 @@ func (c *Client) UpdateTransactionByIdWithBody(ctx context.Context, id int, cont
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateTransactionById(ctx context.Context, id int, body UpdateTransactionByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateTransactionByIdRequest(c.Server, id, body)
+func (c *Client) ConfirmPasswordReset(ctx context.Context, body ConfirmPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmPasswordResetRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -704,8 +1517,8 @@ func (c *Client) UpdateTransactionById(ctx context.Context, id int, body UpdateT
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteCurrentUser(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteCurrentUserRequest(c.Server)
+func (c *Client) RequestPasswordResetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRequestPasswordResetRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -716,8 +1529,8 @@ func (c *Client) DeleteCurrentUser(ctx context.Context, reqEditors ...RequestEdi
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetCurrentUser(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetCurrentUserRequest(c.Server)
+func (c *Client) RequestPasswordReset(ctx context.Context, body RequestPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRequestPasswordResetRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -728,8 +1541,8 @@ func (c *Client) GetCurrentUser(ctx context.Context, reqEditors ...RequestEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateCurrentUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateCurrentUserRequestWithBody(c.Server, contentType, body)
+func (c *Client) CreateUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUserRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -740,8 +1553,8 @@ func (c *Client) UpdateCurrentUserWithBody(ctx context.Context, contentType stri
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateCurrentUser(ctx context.Context, body UpdateCurrentUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateCurrentUserRequest(c.Server, body)
+func (c *Client) CreateUser(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUserRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -752,667 +1565,748 @@ func (c *Client) UpdateCurrentUser(ctx context.Context, body UpdateCurrentUserJS
 	return c.Client.Do(req)
 }
 
-// NewGetCsrfTokenRequest generates requests for GetCsrfToken
-func NewGetCsrfTokenRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) UnlockAccountWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnlockAccountRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/auth/csrf")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UnlockAccount(ctx context.Context, body UnlockAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnlockAccountRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewLoginUserRequest calls the generic LoginUser builder with application/json body
-func NewLoginUserRequest(server string, body LoginUserJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) VerifyEmailWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyEmailRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewLoginUserRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewLoginUserRequestWithBody generates requests for LoginUser with any type of body
-func NewLoginUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) VerifyEmail(ctx context.Context, body VerifyEmailJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyEmailRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/auth/login")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetCategories(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCategoriesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewLogoutUserRequest generates requests for LogoutUser
-func NewLogoutUserRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateCategoryWithBody(ctx context.Context, params *CreateCategoryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateCategoryRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/auth/logout")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateCategory(ctx context.Context, params *CreateCategoryParams, body CreateCategoryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateCategoryRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCreateUserRequest calls the generic CreateUser builder with application/json body
-func NewCreateUserRequest(server string, body CreateUserJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) DeleteCategoryById(ctx context.Context, id int, params *DeleteCategoryByIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteCategoryByIdRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateUserRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateUserRequestWithBody generates requests for CreateUser with any type of body
-func NewCreateUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetCategoryById(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCategoryByIdRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/auth/signup")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateCategoryByIdWithBody(ctx context.Context, id int, params *UpdateCategoryByIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCategoryByIdRequestWithBody(c.Server, id, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetCategoriesRequest generates requests for GetCategories
-func NewGetCategoriesRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateCategoryById(ctx context.Context, id int, params *UpdateCategoryByIdParams, body UpdateCategoryByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCategoryByIdRequest(c.Server, id, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/categories")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) StreamEvents(ctx context.Context, params *StreamEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStreamEventsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCreateCategoryRequest calls the generic CreateCategory builder with application/json body
-func NewCreateCategoryRequest(server string, body CreateCategoryJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetMonthlySummaries(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMonthlySummariesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateCategoryRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateCategoryRequestWithBody generates requests for CreateCategory with any type of body
-func NewCreateCategoryRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateMonthlySummaryWithBody(ctx context.Context, params *CreateMonthlySummaryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateMonthlySummaryRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/categories")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateMonthlySummary(ctx context.Context, params *CreateMonthlySummaryParams, body CreateMonthlySummaryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateMonthlySummaryRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteCategoryByIdRequest generates requests for DeleteCategoryById
-func NewDeleteCategoryByIdRequest(server string, id int) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) DeleteMonthlySummaryById(ctx context.Context, id int, params *DeleteMonthlySummaryByIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteMonthlySummaryByIdRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetMonthlySummaryById(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMonthlySummaryByIdRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/categories/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateMonthlySummaryByIdWithBody(ctx context.Context, id int, params *UpdateMonthlySummaryByIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMonthlySummaryByIdRequestWithBody(c.Server, id, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetCategoryByIdRequest generates requests for GetCategoryById
-func NewGetCategoryByIdRequest(server string, id int) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) UpdateMonthlySummaryById(ctx context.Context, id int, params *UpdateMonthlySummaryByIdParams, body UpdateMonthlySummaryByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMonthlySummaryByIdRequest(c.Server, id, params, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetTransactions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTransactionsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/categories/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateTransactionWithBody(ctx context.Context, params *CreateTransactionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTransactionRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUpdateCategoryByIdRequest calls the generic UpdateCategoryById builder with application/json body
-func NewUpdateCategoryByIdRequest(server string, id int, body UpdateCategoryByIdJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) CreateTransaction(ctx context.Context, params *CreateTransactionParams, body CreateTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTransactionRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateCategoryByIdRequestWithBody(server, id, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateCategoryByIdRequestWithBody generates requests for UpdateCategoryById with any type of body
-func NewUpdateCategoryByIdRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) BulkTransactionsWithBody(ctx context.Context, params *BulkTransactionsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBulkTransactionsRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) BulkTransactions(ctx context.Context, params *BulkTransactionsParams, body BulkTransactionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBulkTransactionsRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/categories/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteTransactionById(ctx context.Context, id int, params *DeleteTransactionByIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTransactionByIdRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetMonthlySummariesRequest generates requests for GetMonthlySummaries
-func NewGetMonthlySummariesRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetTransactionById(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTransactionByIdRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/monthly-summaries")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateTransactionByIdWithBody(ctx context.Context, id int, params *UpdateTransactionByIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTransactionByIdRequestWithBody(c.Server, id, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCreateMonthlySummaryRequest calls the generic CreateMonthlySummary builder with application/json body
-func NewCreateMonthlySummaryRequest(server string, body CreateMonthlySummaryJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) UpdateTransactionById(ctx context.Context, id int, params *UpdateTransactionByIdParams, body UpdateTransactionByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTransactionByIdRequest(c.Server, id, params, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateMonthlySummaryRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateMonthlySummaryRequestWithBody generates requests for CreateMonthlySummary with any type of body
-func NewCreateMonthlySummaryRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteCurrentUser(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteCurrentUserRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/monthly-summaries")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetCurrentUser(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCurrentUserRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteMonthlySummaryByIdRequest generates requests for DeleteMonthlySummaryById
-func NewDeleteMonthlySummaryByIdRequest(server string, id int) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) UpdateCurrentUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCurrentUserRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateCurrentUser(ctx context.Context, body UpdateCurrentUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCurrentUserRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/monthly-summaries/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CancelAccountDeletion(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelAccountDeletionRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetMonthlySummaryByIdRequest generates requests for GetMonthlySummaryById
-func NewGetMonthlySummaryByIdRequest(server string, id int) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) GetAccountDeletion(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAccountDeletionRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) RequestDataExport(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRequestDataExportRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/monthly-summaries/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetDataExports(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDataExportsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUpdateMonthlySummaryByIdRequest calls the generic UpdateMonthlySummaryById builder with application/json body
-func NewUpdateMonthlySummaryByIdRequest(server string, id int, body UpdateMonthlySummaryByIdJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetDataExport(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDataExportRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateMonthlySummaryByIdRequestWithBody(server, id, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateMonthlySummaryByIdRequestWithBody generates requests for UpdateMonthlySummaryById with any type of body
-func NewUpdateMonthlySummaryByIdRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) DownloadDataExport(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDownloadDataExportRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetUserIdentities(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserIdentitiesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/monthly-summaries/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UnlinkUserIdentity(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnlinkUserIdentityRequest(c.Server, provider)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+func (c *Client) LinkUserIdentity(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLinkUserIdentityRequest(c.Server, provider)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetTransactionsRequest generates requests for GetTransactions
-func NewGetTransactionsRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetPersonalAccessTokens(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPersonalAccessTokensRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/transactions")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreatePersonalAccessTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePersonalAccessTokenRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) CreatePersonalAccessToken(ctx context.Context, body CreatePersonalAccessTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePersonalAccessTokenRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateTransactionRequest calls the generic CreateTransaction builder with application/json body
-func NewCreateTransactionRequest(server string, body CreateTransactionJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) DeletePersonalAccessToken(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletePersonalAccessTokenRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateTransactionRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateTransactionRequestWithBody generates requests for CreateTransaction with any type of body
-func NewCreateTransactionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) ConfirmTOTPWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmTOTPRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/transactions")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ConfirmTOTP(ctx context.Context, body ConfirmTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmTOTPRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) DisableTOTPWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDisableTOTPRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewDeleteTransactionByIdRequest generates requests for DeleteTransactionById
-func NewDeleteTransactionByIdRequest(server string, id int) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) DisableTOTP(ctx context.Context, body DisableTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDisableTOTPRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) EnrollTOTP(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEnrollTOTPRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/transactions/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) RegenerateRecoveryCodesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegenerateRecoveryCodesRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+func (c *Client) RegenerateRecoveryCodes(ctx context.Context, body RegenerateRecoveryCodesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegenerateRecoveryCodesRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetTransactionByIdRequest generates requests for GetTransactionById
-func NewGetTransactionByIdRequest(server string, id int) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) GetWebhooks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWebhooksRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateWebhookWithBody(ctx context.Context, params *CreateWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWebhookRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/transactions/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateWebhook(ctx context.Context, params *CreateWebhookParams, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWebhookRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) DeleteWebhookById(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteWebhookByIdRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) GetWebhookById(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWebhookByIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateTransactionByIdRequest calls the generic UpdateTransactionById builder with application/json body
-func NewUpdateTransactionByIdRequest(server string, id int, body UpdateTransactionByIdJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) UpdateWebhookByIdWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateWebhookByIdRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateTransactionByIdRequestWithBody(server, id, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateTransactionByIdRequestWithBody generates requests for UpdateTransactionById with any type of body
-func NewUpdateTransactionByIdRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+func (c *Client) UpdateWebhookById(ctx context.Context, id int, body UpdateWebhookByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateWebhookByIdRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam0 string
+func (c *Client) GetWebhookDeliveries(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWebhookDeliveriesRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) ReplayWebhookDelivery(ctx context.Context, id int, deliveryId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReplayWebhookDeliveryRequest(c.Server, id, deliveryId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetJWKSRequest generates requests for GetJWKS
+func NewGetJWKSRequest(server string) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/transactions/%s", pathParam0)
+	operationPath := fmt.Sprintf("/.well-known/jwks.json")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1422,18 +2316,16 @@ func NewUpdateTransactionByIdRequestWithBody(server string, id int, contentType
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewDeleteCurrentUserRequest generates requests for DeleteCurrentUser
-func NewDeleteCurrentUserRequest(server string) (*http.Request, error) {
+// NewAdminGetAuditLogsRequest generates requests for AdminGetAuditLogs
+func NewAdminGetAuditLogsRequest(server string, params *AdminGetAuditLogsParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1441,7 +2333,7 @@ func NewDeleteCurrentUserRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/users")
+	operationPath := fmt.Sprintf("/admin/audit-logs")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1451,7 +2343,45 @@ func NewDeleteCurrentUserRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.UserId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, *params.UserId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1459,8 +2389,8 @@ func NewDeleteCurrentUserRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetCurrentUserRequest generates requests for GetCurrentUser
-func NewGetCurrentUserRequest(server string) (*http.Request, error) {
+// NewAdminGetSystemStatsRequest generates requests for AdminGetSystemStats
+func NewAdminGetSystemStatsRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1468,7 +2398,7 @@ func NewGetCurrentUserRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/users")
+	operationPath := fmt.Sprintf("/admin/stats")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1486,19 +2416,8 @@ func NewGetCurrentUserRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewUpdateCurrentUserRequest calls the generic UpdateCurrentUser builder with application/json body
-func NewUpdateCurrentUserRequest(server string, body UpdateCurrentUserJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateCurrentUserRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewUpdateCurrentUserRequestWithBody generates requests for UpdateCurrentUser with any type of body
-func NewUpdateCurrentUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewAdminSearchUsersRequest generates requests for AdminSearchUsers
+func NewAdminSearchUsersRequest(server string, params *AdminSearchUsersParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1506,7 +2425,7 @@ func NewUpdateCurrentUserRequestWithBody(server string, contentType string, body
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/users")
+	operationPath := fmt.Sprintf("/admin/users")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1516,2167 +2435,13901 @@ func NewUpdateCurrentUserRequestWithBody(server string, contentType string, body
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	req.Header.Add("Content-Type", contentType)
+		if params.Q != nil {
 
-	return req, nil
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, *params.Q); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
 		}
-	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+		if params.Role != nil {
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return &ClientWithResponses{client}, nil
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "role", runtime.ParamLocationQuery, *params.Role); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
 		}
-		c.Server = newBaseURL.String()
-		return nil
-	}
-}
-
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetCsrfTokenWithResponse request
-	GetCsrfTokenWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCsrfTokenResponse, error)
 
-	// LoginUserWithBodyWithResponse request with any body
-	LoginUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginUserResponse, error)
+		if params.Status != nil {
 
-	LoginUserWithResponse(ctx context.Context, body LoginUserJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginUserResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// LogoutUserWithResponse request
-	LogoutUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LogoutUserResponse, error)
+		}
 
-	// CreateUserWithBodyWithResponse request with any body
-	CreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserResponse, error)
+		if params.Limit != nil {
 
-	CreateUserWithResponse(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// GetCategoriesWithResponse request
-	GetCategoriesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCategoriesResponse, error)
+		}
 
-	// CreateCategoryWithBodyWithResponse request with any body
-	CreateCategoryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCategoryResponse, error)
+		if params.Offset != nil {
 
-	CreateCategoryWithResponse(ctx context.Context, body CreateCategoryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCategoryResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// DeleteCategoryByIdWithResponse request
-	DeleteCategoryByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteCategoryByIdResponse, error)
+		}
 
-	// GetCategoryByIdWithResponse request
-	GetCategoryByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetCategoryByIdResponse, error)
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	// UpdateCategoryByIdWithBodyWithResponse request with any body
-	UpdateCategoryByIdWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCategoryByIdResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	UpdateCategoryByIdWithResponse(ctx context.Context, id int, body UpdateCategoryByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCategoryByIdResponse, error)
+	return req, nil
+}
 
-	// GetMonthlySummariesWithResponse request
-	GetMonthlySummariesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMonthlySummariesResponse, error)
+// NewAdminGetUserRequest generates requests for AdminGetUser
+func NewAdminGetUserRequest(server string, id int) (*http.Request, error) {
+	var err error
 
-	// CreateMonthlySummaryWithBodyWithResponse request with any body
-	CreateMonthlySummaryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMonthlySummaryResponse, error)
+	var pathParam0 string
 
-	CreateMonthlySummaryWithResponse(ctx context.Context, body CreateMonthlySummaryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMonthlySummaryResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteMonthlySummaryByIdWithResponse request
-	DeleteMonthlySummaryByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteMonthlySummaryByIdResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetMonthlySummaryByIdWithResponse request
-	GetMonthlySummaryByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetMonthlySummaryByIdResponse, error)
+	operationPath := fmt.Sprintf("/admin/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// UpdateMonthlySummaryByIdWithBodyWithResponse request with any body
-	UpdateMonthlySummaryByIdWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMonthlySummaryByIdResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	UpdateMonthlySummaryByIdWithResponse(ctx context.Context, id int, body UpdateMonthlySummaryByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMonthlySummaryByIdResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetTransactionsWithResponse request
-	GetTransactionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTransactionsResponse, error)
+	return req, nil
+}
 
-	// CreateTransactionWithBodyWithResponse request with any body
-	CreateTransactionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTransactionResponse, error)
+// NewAdminDisableUserRequest generates requests for AdminDisableUser
+func NewAdminDisableUserRequest(server string, id int) (*http.Request, error) {
+	var err error
 
-	CreateTransactionWithResponse(ctx context.Context, body CreateTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTransactionResponse, error)
+	var pathParam0 string
 
-	// DeleteTransactionByIdWithResponse request
-	DeleteTransactionByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteTransactionByIdResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetTransactionByIdWithResponse request
-	GetTransactionByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetTransactionByIdResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateTransactionByIdWithBodyWithResponse request with any body
-	UpdateTransactionByIdWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTransactionByIdResponse, error)
+	operationPath := fmt.Sprintf("/admin/users/%s/disable", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	UpdateTransactionByIdWithResponse(ctx context.Context, id int, body UpdateTransactionByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTransactionByIdResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteCurrentUserWithResponse request
-	DeleteCurrentUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteCurrentUserResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetCurrentUserWithResponse request
-	GetCurrentUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCurrentUserResponse, error)
+	return req, nil
+}
 
-	// UpdateCurrentUserWithBodyWithResponse request with any body
-	UpdateCurrentUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCurrentUserResponse, error)
+// NewAdminEnableUserRequest generates requests for AdminEnableUser
+func NewAdminEnableUserRequest(server string, id int) (*http.Request, error) {
+	var err error
 
-	UpdateCurrentUserWithResponse(ctx context.Context, body UpdateCurrentUserJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCurrentUserResponse, error)
-}
+	var pathParam0 string
 
-type GetCsrfTokenResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		CsrfToken *string `json:"csrf_token,omitempty"`
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r GetCsrfTokenResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetCsrfTokenResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/admin/users/%s/enable", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type LoginUserResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		CsrfToken *string `json:"csrf_token,omitempty"`
-		Message   string  `json:"message"`
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	JSON401 *ErrorResponse
-}
 
-// Status returns HTTPResponse.Status
-func (r LoginUserResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r LoginUserResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return req, nil
 }
 
-type LogoutUserResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Message string `json:"message"`
+// NewAdminImpersonateUserRequest calls the generic AdminImpersonateUser builder with application/json body
+func NewAdminImpersonateUserRequest(server string, id int, body AdminImpersonateUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAdminImpersonateUserRequestWithBody(server, id, "application/json", bodyReader)
 }
 
-// Status returns HTTPResponse.Status
-func (r LogoutUserResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewAdminImpersonateUserRequestWithBody generates requests for AdminImpersonateUser with any type of body
+func NewAdminImpersonateUserRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r LogoutUserResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateUserResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *UserResponse
-	JSON400      *ErrorResponse
-}
+	operationPath := fmt.Sprintf("/admin/users/%s/impersonate", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r CreateUserResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateUserResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetCategoriesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *CategoryResponse
-	JSON400      *ErrorResponse
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r GetCategoriesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetCategoriesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewAdminForceLogoutRequest generates requests for AdminForceLogout
+func NewAdminForceLogoutRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateCategoryResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *CategoryResponse
-	JSON400      *ErrorResponse
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r CreateCategoryResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/admin/users/%s/logout", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateCategoryResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteCategoryByIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteCategoryByIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewAdminUpdateUserRoleRequest calls the generic AdminUpdateUserRole builder with application/json body
+func NewAdminUpdateUserRoleRequest(server string, id int, body AdminUpdateUserRoleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewAdminUpdateUserRoleRequestWithBody(server, id, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteCategoryByIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewAdminUpdateUserRoleRequestWithBody generates requests for AdminUpdateUserRole with any type of body
+func NewAdminUpdateUserRoleRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetCategoryByIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *CategoryResponse
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetCategoryByIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/admin/users/%s/role", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetCategoryByIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UpdateCategoryByIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *CategoryResponse
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateCategoryByIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewConfirmAccountDeletionRequest calls the generic ConfirmAccountDeletion builder with application/json body
+func NewConfirmAccountDeletionRequest(server string, body ConfirmAccountDeletionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewConfirmAccountDeletionRequestWithBody(server, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateCategoryByIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewConfirmAccountDeletionRequestWithBody generates requests for ConfirmAccountDeletion with any type of body
+func NewConfirmAccountDeletionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetMonthlySummariesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MonthlySummaryResponse
-	JSON400      *ErrorResponse
-}
+	operationPath := fmt.Sprintf("/auth/confirm-deletion")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetMonthlySummariesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetMonthlySummariesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateMonthlySummaryResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *MonthlySummaryResponse
-	JSON400      *ErrorResponse
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateMonthlySummaryResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewGetCsrfTokenRequest generates requests for GetCsrfToken
+func NewGetCsrfTokenRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	operationPath := fmt.Sprintf("/auth/csrf")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateMonthlySummaryResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewLoginUserRequest calls the generic LoginUser builder with application/json body
+func NewLoginUserRequest(server string, body LoginUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewLoginUserRequestWithBody(server, "application/json", bodyReader)
 }
 
-type DeleteMonthlySummaryByIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
+// NewLoginUserRequestWithBody generates requests for LoginUser with any type of body
+func NewLoginUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/login")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteMonthlySummaryByIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewLoginUserWithTOTPRequest calls the generic LoginUserWithTOTP builder with application/json body
+func NewLoginUserWithTOTPRequest(server string, body LoginUserWithTOTPJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewLoginUserWithTOTPRequestWithBody(server, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteMonthlySummaryByIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+// NewLoginUserWithTOTPRequestWithBody generates requests for LoginUserWithTOTP with any type of body
+func NewLoginUserWithTOTPRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/login/totp")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewLogoutUserRequest generates requests for LogoutUser
+func NewLogoutUserRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/logout")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOIDCProvidersRequest generates requests for GetOIDCProviders
+func NewGetOIDCProvidersRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/oidc/providers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewHandleOIDCCallbackRequest generates requests for HandleOIDCCallback
+func NewHandleOIDCCallbackRequest(server string, provider string, params *HandleOIDCCallbackParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "provider", runtime.ParamLocationPath, provider)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/oidc/%s/callback", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "state", runtime.ParamLocationQuery, params.State); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Code != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "code", runtime.ParamLocationQuery, *params.Code); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Error != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "error", runtime.ParamLocationQuery, *params.Error); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.OidcState != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "oidc_state", runtime.ParamLocationCookie, *params.OidcState)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "oidc_state",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewStartOIDCLoginRequest generates requests for StartOIDCLogin
+func NewStartOIDCLoginRequest(server string, provider string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "provider", runtime.ParamLocationPath, provider)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/oidc/%s/login", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewConfirmPasswordResetRequest calls the generic ConfirmPasswordReset builder with application/json body
+func NewConfirmPasswordResetRequest(server string, body ConfirmPasswordResetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewConfirmPasswordResetRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewConfirmPasswordResetRequestWithBody generates requests for ConfirmPasswordReset with any type of body
+func NewConfirmPasswordResetRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/password-reset/confirm")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRequestPasswordResetRequest calls the generic RequestPasswordReset builder with application/json body
+func NewRequestPasswordResetRequest(server string, body RequestPasswordResetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRequestPasswordResetRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRequestPasswordResetRequestWithBody generates requests for RequestPasswordReset with any type of body
+func NewRequestPasswordResetRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/password-reset/request")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateUserRequest calls the generic CreateUser builder with application/json body
+func NewCreateUserRequest(server string, body CreateUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateUserRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateUserRequestWithBody generates requests for CreateUser with any type of body
+func NewCreateUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/signup")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUnlockAccountRequest calls the generic UnlockAccount builder with application/json body
+func NewUnlockAccountRequest(server string, body UnlockAccountJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUnlockAccountRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUnlockAccountRequestWithBody generates requests for UnlockAccount with any type of body
+func NewUnlockAccountRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/unlock")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewVerifyEmailRequest calls the generic VerifyEmail builder with application/json body
+func NewVerifyEmailRequest(server string, body VerifyEmailJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewVerifyEmailRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewVerifyEmailRequestWithBody generates requests for VerifyEmail with any type of body
+func NewVerifyEmailRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/verify-email")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetCategoriesRequest generates requests for GetCategories
+func NewGetCategoriesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/categories")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateCategoryRequest calls the generic CreateCategory builder with application/json body
+func NewCreateCategoryRequest(server string, params *CreateCategoryParams, body CreateCategoryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateCategoryRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewCreateCategoryRequestWithBody generates requests for CreateCategory with any type of body
+func NewCreateCategoryRequestWithBody(server string, params *CreateCategoryParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/categories")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteCategoryByIdRequest generates requests for DeleteCategoryById
+func NewDeleteCategoryByIdRequest(server string, id int, params *DeleteCategoryByIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/categories/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, params.IfMatch)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("If-Match", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetCategoryByIdRequest generates requests for GetCategoryById
+func NewGetCategoryByIdRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/categories/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateCategoryByIdRequest calls the generic UpdateCategoryById builder with application/json body
+func NewUpdateCategoryByIdRequest(server string, id int, params *UpdateCategoryByIdParams, body UpdateCategoryByIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateCategoryByIdRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewUpdateCategoryByIdRequestWithBody generates requests for UpdateCategoryById with any type of body
+func NewUpdateCategoryByIdRequestWithBody(server string, id int, params *UpdateCategoryByIdParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/categories/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, params.IfMatch)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("If-Match", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewStreamEventsRequest generates requests for StreamEvents
+func NewStreamEventsRequest(server string, params *StreamEventsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/events/stream")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.LastEventID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Last-Event-ID", runtime.ParamLocationHeader, *params.LastEventID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Last-Event-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetMonthlySummariesRequest generates requests for GetMonthlySummaries
+func NewGetMonthlySummariesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/monthly-summaries")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateMonthlySummaryRequest calls the generic CreateMonthlySummary builder with application/json body
+func NewCreateMonthlySummaryRequest(server string, params *CreateMonthlySummaryParams, body CreateMonthlySummaryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateMonthlySummaryRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewCreateMonthlySummaryRequestWithBody generates requests for CreateMonthlySummary with any type of body
+func NewCreateMonthlySummaryRequestWithBody(server string, params *CreateMonthlySummaryParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/monthly-summaries")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteMonthlySummaryByIdRequest generates requests for DeleteMonthlySummaryById
+func NewDeleteMonthlySummaryByIdRequest(server string, id int, params *DeleteMonthlySummaryByIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/monthly-summaries/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, params.IfMatch)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("If-Match", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetMonthlySummaryByIdRequest generates requests for GetMonthlySummaryById
+func NewGetMonthlySummaryByIdRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/monthly-summaries/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateMonthlySummaryByIdRequest calls the generic UpdateMonthlySummaryById builder with application/json body
+func NewUpdateMonthlySummaryByIdRequest(server string, id int, params *UpdateMonthlySummaryByIdParams, body UpdateMonthlySummaryByIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateMonthlySummaryByIdRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewUpdateMonthlySummaryByIdRequestWithBody generates requests for UpdateMonthlySummaryById with any type of body
+func NewUpdateMonthlySummaryByIdRequestWithBody(server string, id int, params *UpdateMonthlySummaryByIdParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/monthly-summaries/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, params.IfMatch)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("If-Match", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetTransactionsRequest generates requests for GetTransactions
+func NewGetTransactionsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transactions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateTransactionRequest calls the generic CreateTransaction builder with application/json body
+func NewCreateTransactionRequest(server string, params *CreateTransactionParams, body CreateTransactionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTransactionRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewCreateTransactionRequestWithBody generates requests for CreateTransaction with any type of body
+func NewCreateTransactionRequestWithBody(server string, params *CreateTransactionParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transactions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewBulkTransactionsRequest calls the generic BulkTransactions builder with application/json body
+func NewBulkTransactionsRequest(server string, params *BulkTransactionsParams, body BulkTransactionsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewBulkTransactionsRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewBulkTransactionsRequestWithBody generates requests for BulkTransactions with any type of body
+func NewBulkTransactionsRequestWithBody(server string, params *BulkTransactionsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transactions/bulk")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteTransactionByIdRequest generates requests for DeleteTransactionById
+func NewDeleteTransactionByIdRequest(server string, id int, params *DeleteTransactionByIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transactions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, params.IfMatch)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("If-Match", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetTransactionByIdRequest generates requests for GetTransactionById
+func NewGetTransactionByIdRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transactions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateTransactionByIdRequest calls the generic UpdateTransactionById builder with application/json body
+func NewUpdateTransactionByIdRequest(server string, id int, params *UpdateTransactionByIdParams, body UpdateTransactionByIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateTransactionByIdRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewUpdateTransactionByIdRequestWithBody generates requests for UpdateTransactionById with any type of body
+func NewUpdateTransactionByIdRequestWithBody(server string, id int, params *UpdateTransactionByIdParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transactions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, params.IfMatch)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("If-Match", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteCurrentUserRequest generates requests for DeleteCurrentUser
+func NewDeleteCurrentUserRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetCurrentUserRequest generates requests for GetCurrentUser
+func NewGetCurrentUserRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateCurrentUserRequest calls the generic UpdateCurrentUser builder with application/json body
+func NewUpdateCurrentUserRequest(server string, body UpdateCurrentUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateCurrentUserRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUpdateCurrentUserRequestWithBody generates requests for UpdateCurrentUser with any type of body
+func NewUpdateCurrentUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCancelAccountDeletionRequest generates requests for CancelAccountDeletion
+func NewCancelAccountDeletionRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/deletion")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAccountDeletionRequest generates requests for GetAccountDeletion
+func NewGetAccountDeletionRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/deletion")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRequestDataExportRequest generates requests for RequestDataExport
+func NewRequestDataExportRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDataExportsRequest generates requests for GetDataExports
+func NewGetDataExportsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/exports")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDataExportRequest generates requests for GetDataExport
+func NewGetDataExportRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/exports/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDownloadDataExportRequest generates requests for DownloadDataExport
+func NewDownloadDataExportRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/exports/%s/download", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUserIdentitiesRequest generates requests for GetUserIdentities
+func NewGetUserIdentitiesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/identities")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUnlinkUserIdentityRequest generates requests for UnlinkUserIdentity
+func NewUnlinkUserIdentityRequest(server string, provider string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "provider", runtime.ParamLocationPath, provider)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/identities/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLinkUserIdentityRequest generates requests for LinkUserIdentity
+func NewLinkUserIdentityRequest(server string, provider string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "provider", runtime.ParamLocationPath, provider)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/identities/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPersonalAccessTokensRequest generates requests for GetPersonalAccessTokens
+func NewGetPersonalAccessTokensRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/tokens")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreatePersonalAccessTokenRequest calls the generic CreatePersonalAccessToken builder with application/json body
+func NewCreatePersonalAccessTokenRequest(server string, body CreatePersonalAccessTokenJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreatePersonalAccessTokenRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreatePersonalAccessTokenRequestWithBody generates requests for CreatePersonalAccessToken with any type of body
+func NewCreatePersonalAccessTokenRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/tokens")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeletePersonalAccessTokenRequest generates requests for DeletePersonalAccessToken
+func NewDeletePersonalAccessTokenRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/tokens/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewConfirmTOTPRequest calls the generic ConfirmTOTP builder with application/json body
+func NewConfirmTOTPRequest(server string, body ConfirmTOTPJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewConfirmTOTPRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewConfirmTOTPRequestWithBody generates requests for ConfirmTOTP with any type of body
+func NewConfirmTOTPRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/totp/confirm")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDisableTOTPRequest calls the generic DisableTOTP builder with application/json body
+func NewDisableTOTPRequest(server string, body DisableTOTPJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDisableTOTPRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewDisableTOTPRequestWithBody generates requests for DisableTOTP with any type of body
+func NewDisableTOTPRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/totp/disable")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewEnrollTOTPRequest generates requests for EnrollTOTP
+func NewEnrollTOTPRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/totp/enroll")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRegenerateRecoveryCodesRequest calls the generic RegenerateRecoveryCodes builder with application/json body
+func NewRegenerateRecoveryCodesRequest(server string, body RegenerateRecoveryCodesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRegenerateRecoveryCodesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRegenerateRecoveryCodesRequestWithBody generates requests for RegenerateRecoveryCodes with any type of body
+func NewRegenerateRecoveryCodesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/totp/recovery-codes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetWebhooksRequest generates requests for GetWebhooks
+func NewGetWebhooksRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateWebhookRequest calls the generic CreateWebhook builder with application/json body
+func NewCreateWebhookRequest(server string, params *CreateWebhookParams, body CreateWebhookJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateWebhookRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewCreateWebhookRequestWithBody generates requests for CreateWebhook with any type of body
+func NewCreateWebhookRequestWithBody(server string, params *CreateWebhookParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteWebhookByIdRequest generates requests for DeleteWebhookById
+func NewDeleteWebhookByIdRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetWebhookByIdRequest generates requests for GetWebhookById
+func NewGetWebhookByIdRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateWebhookByIdRequest calls the generic UpdateWebhookById builder with application/json body
+func NewUpdateWebhookByIdRequest(server string, id int, body UpdateWebhookByIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateWebhookByIdRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateWebhookByIdRequestWithBody generates requests for UpdateWebhookById with any type of body
+func NewUpdateWebhookByIdRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetWebhookDeliveriesRequest generates requests for GetWebhookDeliveries
+func NewGetWebhookDeliveriesRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/%s/deliveries", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewReplayWebhookDeliveryRequest generates requests for ReplayWebhookDelivery
+func NewReplayWebhookDeliveryRequest(server string, id int, deliveryId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "deliveryId", runtime.ParamLocationPath, deliveryId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/%s/deliveries/%s/replay", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetJWKSWithResponse request
+	GetJWKSWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetJWKSResponse, error)
+
+	// AdminGetAuditLogsWithResponse request
+	AdminGetAuditLogsWithResponse(ctx context.Context, params *AdminGetAuditLogsParams, reqEditors ...RequestEditorFn) (*AdminGetAuditLogsResponse, error)
+
+	// AdminGetSystemStatsWithResponse request
+	AdminGetSystemStatsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminGetSystemStatsResponse, error)
+
+	// AdminSearchUsersWithResponse request
+	AdminSearchUsersWithResponse(ctx context.Context, params *AdminSearchUsersParams, reqEditors ...RequestEditorFn) (*AdminSearchUsersResponse, error)
+
+	// AdminGetUserWithResponse request
+	AdminGetUserWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*AdminGetUserResponse, error)
+
+	// AdminDisableUserWithResponse request
+	AdminDisableUserWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*AdminDisableUserResponse, error)
+
+	// AdminEnableUserWithResponse request
+	AdminEnableUserWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*AdminEnableUserResponse, error)
+
+	// AdminImpersonateUserWithBodyWithResponse request with any body
+	AdminImpersonateUserWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminImpersonateUserResponse, error)
+
+	AdminImpersonateUserWithResponse(ctx context.Context, id int, body AdminImpersonateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminImpersonateUserResponse, error)
+
+	// AdminForceLogoutWithResponse request
+	AdminForceLogoutWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*AdminForceLogoutResponse, error)
+
+	// AdminUpdateUserRoleWithBodyWithResponse request with any body
+	AdminUpdateUserRoleWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminUpdateUserRoleResponse, error)
+
+	AdminUpdateUserRoleWithResponse(ctx context.Context, id int, body AdminUpdateUserRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminUpdateUserRoleResponse, error)
+
+	// ConfirmAccountDeletionWithBodyWithResponse request with any body
+	ConfirmAccountDeletionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfirmAccountDeletionResponse, error)
+
+	ConfirmAccountDeletionWithResponse(ctx context.Context, body ConfirmAccountDeletionJSONRequestBody, reqEditors ...RequestEditorFn) (*ConfirmAccountDeletionResponse, error)
+
+	// GetCsrfTokenWithResponse request
+	GetCsrfTokenWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCsrfTokenResponse, error)
+
+	// LoginUserWithBodyWithResponse request with any body
+	LoginUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginUserResponse, error)
+
+	LoginUserWithResponse(ctx context.Context, body LoginUserJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginUserResponse, error)
+
+	// LoginUserWithTOTPWithBodyWithResponse request with any body
+	LoginUserWithTOTPWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginUserWithTOTPResponse, error)
+
+	LoginUserWithTOTPWithResponse(ctx context.Context, body LoginUserWithTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginUserWithTOTPResponse, error)
+
+	// LogoutUserWithResponse request
+	LogoutUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LogoutUserResponse, error)
+
+	// GetOIDCProvidersWithResponse request
+	GetOIDCProvidersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOIDCProvidersResponse, error)
+
+	// HandleOIDCCallbackWithResponse request
+	HandleOIDCCallbackWithResponse(ctx context.Context, provider string, params *HandleOIDCCallbackParams, reqEditors ...RequestEditorFn) (*HandleOIDCCallbackResponse, error)
+
+	// StartOIDCLoginWithResponse request
+	StartOIDCLoginWithResponse(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*StartOIDCLoginResponse, error)
+
+	// ConfirmPasswordResetWithBodyWithResponse request with any body
+	ConfirmPasswordResetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfirmPasswordResetResponse, error)
+
+	ConfirmPasswordResetWithResponse(ctx context.Context, body ConfirmPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*ConfirmPasswordResetResponse, error)
+
+	// RequestPasswordResetWithBodyWithResponse request with any body
+	RequestPasswordResetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RequestPasswordResetResponse, error)
+
+	RequestPasswordResetWithResponse(ctx context.Context, body RequestPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*RequestPasswordResetResponse, error)
+
+	// CreateUserWithBodyWithResponse request with any body
+	CreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserResponse, error)
+
+	CreateUserWithResponse(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserResponse, error)
+
+	// UnlockAccountWithBodyWithResponse request with any body
+	UnlockAccountWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UnlockAccountResponse, error)
+
+	UnlockAccountWithResponse(ctx context.Context, body UnlockAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*UnlockAccountResponse, error)
+
+	// VerifyEmailWithBodyWithResponse request with any body
+	VerifyEmailWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VerifyEmailResponse, error)
+
+	VerifyEmailWithResponse(ctx context.Context, body VerifyEmailJSONRequestBody, reqEditors ...RequestEditorFn) (*VerifyEmailResponse, error)
+
+	// GetCategoriesWithResponse request
+	GetCategoriesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCategoriesResponse, error)
+
+	// CreateCategoryWithBodyWithResponse request with any body
+	CreateCategoryWithBodyWithResponse(ctx context.Context, params *CreateCategoryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCategoryResponse, error)
+
+	CreateCategoryWithResponse(ctx context.Context, params *CreateCategoryParams, body CreateCategoryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCategoryResponse, error)
+
+	// DeleteCategoryByIdWithResponse request
+	DeleteCategoryByIdWithResponse(ctx context.Context, id int, params *DeleteCategoryByIdParams, reqEditors ...RequestEditorFn) (*DeleteCategoryByIdResponse, error)
+
+	// GetCategoryByIdWithResponse request
+	GetCategoryByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetCategoryByIdResponse, error)
+
+	// UpdateCategoryByIdWithBodyWithResponse request with any body
+	UpdateCategoryByIdWithBodyWithResponse(ctx context.Context, id int, params *UpdateCategoryByIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCategoryByIdResponse, error)
+
+	UpdateCategoryByIdWithResponse(ctx context.Context, id int, params *UpdateCategoryByIdParams, body UpdateCategoryByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCategoryByIdResponse, error)
+
+	// StreamEventsWithResponse request
+	StreamEventsWithResponse(ctx context.Context, params *StreamEventsParams, reqEditors ...RequestEditorFn) (*StreamEventsResponse, error)
+
+	// GetMonthlySummariesWithResponse request
+	GetMonthlySummariesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMonthlySummariesResponse, error)
+
+	// CreateMonthlySummaryWithBodyWithResponse request with any body
+	CreateMonthlySummaryWithBodyWithResponse(ctx context.Context, params *CreateMonthlySummaryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMonthlySummaryResponse, error)
+
+	CreateMonthlySummaryWithResponse(ctx context.Context, params *CreateMonthlySummaryParams, body CreateMonthlySummaryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMonthlySummaryResponse, error)
+
+	// DeleteMonthlySummaryByIdWithResponse request
+	DeleteMonthlySummaryByIdWithResponse(ctx context.Context, id int, params *DeleteMonthlySummaryByIdParams, reqEditors ...RequestEditorFn) (*DeleteMonthlySummaryByIdResponse, error)
+
+	// GetMonthlySummaryByIdWithResponse request
+	GetMonthlySummaryByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetMonthlySummaryByIdResponse, error)
+
+	// UpdateMonthlySummaryByIdWithBodyWithResponse request with any body
+	UpdateMonthlySummaryByIdWithBodyWithResponse(ctx context.Context, id int, params *UpdateMonthlySummaryByIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMonthlySummaryByIdResponse, error)
+
+	UpdateMonthlySummaryByIdWithResponse(ctx context.Context, id int, params *UpdateMonthlySummaryByIdParams, body UpdateMonthlySummaryByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMonthlySummaryByIdResponse, error)
+
+	// GetTransactionsWithResponse request
+	GetTransactionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTransactionsResponse, error)
+
+	// CreateTransactionWithBodyWithResponse request with any body
+	CreateTransactionWithBodyWithResponse(ctx context.Context, params *CreateTransactionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTransactionResponse, error)
+
+	CreateTransactionWithResponse(ctx context.Context, params *CreateTransactionParams, body CreateTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTransactionResponse, error)
+
+	// BulkTransactionsWithBodyWithResponse request with any body
+	BulkTransactionsWithBodyWithResponse(ctx context.Context, params *BulkTransactionsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BulkTransactionsResponse, error)
+
+	BulkTransactionsWithResponse(ctx context.Context, params *BulkTransactionsParams, body BulkTransactionsJSONRequestBody, reqEditors ...RequestEditorFn) (*BulkTransactionsResponse, error)
+
+	// DeleteTransactionByIdWithResponse request
+	DeleteTransactionByIdWithResponse(ctx context.Context, id int, params *DeleteTransactionByIdParams, reqEditors ...RequestEditorFn) (*DeleteTransactionByIdResponse, error)
+
+	// GetTransactionByIdWithResponse request
+	GetTransactionByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetTransactionByIdResponse, error)
+
+	// UpdateTransactionByIdWithBodyWithResponse request with any body
+	UpdateTransactionByIdWithBodyWithResponse(ctx context.Context, id int, params *UpdateTransactionByIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTransactionByIdResponse, error)
+
+	UpdateTransactionByIdWithResponse(ctx context.Context, id int, params *UpdateTransactionByIdParams, body UpdateTransactionByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTransactionByIdResponse, error)
+
+	// DeleteCurrentUserWithResponse request
+	DeleteCurrentUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteCurrentUserResponse, error)
+
+	// GetCurrentUserWithResponse request
+	GetCurrentUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCurrentUserResponse, error)
+
+	// UpdateCurrentUserWithBodyWithResponse request with any body
+	UpdateCurrentUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCurrentUserResponse, error)
+
+	UpdateCurrentUserWithResponse(ctx context.Context, body UpdateCurrentUserJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCurrentUserResponse, error)
+
+	// CancelAccountDeletionWithResponse request
+	CancelAccountDeletionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CancelAccountDeletionResponse, error)
+
+	// GetAccountDeletionWithResponse request
+	GetAccountDeletionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAccountDeletionResponse, error)
+
+	// RequestDataExportWithResponse request
+	RequestDataExportWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RequestDataExportResponse, error)
+
+	// GetDataExportsWithResponse request
+	GetDataExportsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDataExportsResponse, error)
+
+	// GetDataExportWithResponse request
+	GetDataExportWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetDataExportResponse, error)
+
+	// DownloadDataExportWithResponse request
+	DownloadDataExportWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DownloadDataExportResponse, error)
+
+	// GetUserIdentitiesWithResponse request
+	GetUserIdentitiesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserIdentitiesResponse, error)
+
+	// UnlinkUserIdentityWithResponse request
+	UnlinkUserIdentityWithResponse(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*UnlinkUserIdentityResponse, error)
+
+	// LinkUserIdentityWithResponse request
+	LinkUserIdentityWithResponse(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*LinkUserIdentityResponse, error)
+
+	// GetPersonalAccessTokensWithResponse request
+	GetPersonalAccessTokensWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetPersonalAccessTokensResponse, error)
+
+	// CreatePersonalAccessTokenWithBodyWithResponse request with any body
+	CreatePersonalAccessTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePersonalAccessTokenResponse, error)
+
+	CreatePersonalAccessTokenWithResponse(ctx context.Context, body CreatePersonalAccessTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePersonalAccessTokenResponse, error)
+
+	// DeletePersonalAccessTokenWithResponse request
+	DeletePersonalAccessTokenWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeletePersonalAccessTokenResponse, error)
+
+	// ConfirmTOTPWithBodyWithResponse request with any body
+	ConfirmTOTPWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfirmTOTPResponse, error)
+
+	ConfirmTOTPWithResponse(ctx context.Context, body ConfirmTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*ConfirmTOTPResponse, error)
+
+	// DisableTOTPWithBodyWithResponse request with any body
+	DisableTOTPWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DisableTOTPResponse, error)
+
+	DisableTOTPWithResponse(ctx context.Context, body DisableTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*DisableTOTPResponse, error)
+
+	// EnrollTOTPWithResponse request
+	EnrollTOTPWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*EnrollTOTPResponse, error)
+
+	// RegenerateRecoveryCodesWithBodyWithResponse request with any body
+	RegenerateRecoveryCodesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegenerateRecoveryCodesResponse, error)
+
+	RegenerateRecoveryCodesWithResponse(ctx context.Context, body RegenerateRecoveryCodesJSONRequestBody, reqEditors ...RequestEditorFn) (*RegenerateRecoveryCodesResponse, error)
+
+	// GetWebhooksWithResponse request
+	GetWebhooksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWebhooksResponse, error)
+
+	// CreateWebhookWithBodyWithResponse request with any body
+	CreateWebhookWithBodyWithResponse(ctx context.Context, params *CreateWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error)
+
+	CreateWebhookWithResponse(ctx context.Context, params *CreateWebhookParams, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error)
+
+	// DeleteWebhookByIdWithResponse request
+	DeleteWebhookByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteWebhookByIdResponse, error)
+
+	// GetWebhookByIdWithResponse request
+	GetWebhookByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetWebhookByIdResponse, error)
+
+	// UpdateWebhookByIdWithBodyWithResponse request with any body
+	UpdateWebhookByIdWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWebhookByIdResponse, error)
+
+	UpdateWebhookByIdWithResponse(ctx context.Context, id int, body UpdateWebhookByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWebhookByIdResponse, error)
+
+	// GetWebhookDeliveriesWithResponse request
+	GetWebhookDeliveriesWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetWebhookDeliveriesResponse, error)
+
+	// ReplayWebhookDeliveryWithResponse request
+	ReplayWebhookDeliveryWithResponse(ctx context.Context, id int, deliveryId int, reqEditors ...RequestEditorFn) (*ReplayWebhookDeliveryResponse, error)
+}
+
+type GetJWKSResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *JWKSResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetJWKSResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetJWKSResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminGetAuditLogsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]AdminAuditLogRequest
+	ApplicationproblemJSON403 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminGetAuditLogsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminGetAuditLogsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminGetSystemStatsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *SystemStatsResponse
+	ApplicationproblemJSON403 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminGetSystemStatsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminGetSystemStatsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminSearchUsersResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *AdminUsersResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON403 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminSearchUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminSearchUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminGetUserResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *AdminUserResponse
+	ApplicationproblemJSON403 *ErrorResponse
+	ApplicationproblemJSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminGetUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminGetUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminDisableUserResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *AdminUserResponse
+	ApplicationproblemJSON403 *ErrorResponse
+	ApplicationproblemJSON404 *ErrorResponse
+	ApplicationproblemJSON409 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminDisableUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminDisableUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminEnableUserResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *AdminUserResponse
+	ApplicationproblemJSON403 *ErrorResponse
+	ApplicationproblemJSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminEnableUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminEnableUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminImpersonateUserResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ImpersonationResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON403 *ErrorResponse
+	ApplicationproblemJSON404 *ErrorResponse
+	ApplicationproblemJSON409 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminImpersonateUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminImpersonateUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminForceLogoutResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON403 *ErrorResponse
+	ApplicationproblemJSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminForceLogoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminForceLogoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminUpdateUserRoleResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *AdminUserResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON403 *ErrorResponse
+	ApplicationproblemJSON404 *ErrorResponse
+	ApplicationproblemJSON409 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminUpdateUserRoleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminUpdateUserRoleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ConfirmAccountDeletionResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *AccountDeletionResponse
+	ApplicationproblemJSON400 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ConfirmAccountDeletionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConfirmAccountDeletionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCsrfTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		CsrfToken string `json:"csrf_token"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCsrfTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCsrfTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LoginUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message string `json:"message"`
+	}
+	JSON202                   *LoginChallengeResponse
+	ApplicationproblemJSON401 *ErrorResponse
+	ApplicationproblemJSON403 *ErrorResponse
+	ApplicationproblemJSON429 *TooManyRequestsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r LoginUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LoginUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LoginUserWithTOTPResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON401 *ErrorResponse
+	ApplicationproblemJSON429 *TooManyRequestsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r LoginUserWithTOTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LoginUserWithTOTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LogoutUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message string `json:"message"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r LogoutUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LogoutUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOIDCProvidersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OIDCProvidersResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOIDCProvidersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOIDCProvidersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HandleOIDCCallbackResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r HandleOIDCCallbackResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HandleOIDCCallbackResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StartOIDCLoginResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r StartOIDCLoginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StartOIDCLoginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ConfirmPasswordResetResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ConfirmPasswordResetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConfirmPasswordResetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RequestPasswordResetResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RequestPasswordResetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RequestPasswordResetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateUserResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *UserResponse
+	ApplicationproblemJSON400 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UnlockAccountResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UnlockAccountResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnlockAccountResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VerifyEmailResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r VerifyEmailResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VerifyEmailResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCategoriesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]CategoryRequest
+	ApplicationproblemJSON400 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCategoriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCategoriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateCategoryResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *CategoryResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON409 *ErrorResponse
+	ApplicationproblemJSON422 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateCategoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateCategoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteCategoryByIdResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON404 *ErrorResponse
+	ApplicationproblemJSON412 *ErrorResponse
+	ApplicationproblemJSON428 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteCategoryByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteCategoryByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCategoryByIdResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *CategoryResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCategoryByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCategoryByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateCategoryByIdResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *CategoryResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON404 *ErrorResponse
+	ApplicationproblemJSON412 *ErrorResponse
+	ApplicationproblemJSON422 *ErrorResponse
+	ApplicationproblemJSON428 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateCategoryByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateCategoryByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StreamEventsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r StreamEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StreamEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMonthlySummariesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]MonthlySummaryRequest
+	ApplicationproblemJSON400 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMonthlySummariesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMonthlySummariesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateMonthlySummaryResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *MonthlySummaryResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON409 *ErrorResponse
+	ApplicationproblemJSON422 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateMonthlySummaryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateMonthlySummaryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteMonthlySummaryByIdResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON404 *ErrorResponse
+	ApplicationproblemJSON412 *ErrorResponse
+	ApplicationproblemJSON428 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteMonthlySummaryByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteMonthlySummaryByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMonthlySummaryByIdResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *MonthlySummaryResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMonthlySummaryByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMonthlySummaryByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateMonthlySummaryByIdResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *MonthlySummaryResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON404 *ErrorResponse
+	ApplicationproblemJSON412 *ErrorResponse
+	ApplicationproblemJSON422 *ErrorResponse
+	ApplicationproblemJSON428 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateMonthlySummaryByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateMonthlySummaryByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTransactionsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]TransactionRequest
+	ApplicationproblemJSON400 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTransactionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTransactionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTransactionResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *TransactionResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON409 *ErrorResponse
+	ApplicationproblemJSON422 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTransactionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTransactionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type BulkTransactionsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *TransactionBulkResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON409 *ErrorResponse
+	JSON422                   *TransactionBulkResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r BulkTransactionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BulkTransactionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTransactionByIdResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON404 *ErrorResponse
+	ApplicationproblemJSON412 *ErrorResponse
+	ApplicationproblemJSON428 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTransactionByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTransactionByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTransactionByIdResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *TransactionResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTransactionByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTransactionByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateTransactionByIdResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *TransactionResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON404 *ErrorResponse
+	ApplicationproblemJSON412 *ErrorResponse
+	ApplicationproblemJSON422 *ErrorResponse
+	ApplicationproblemJSON428 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateTransactionByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateTransactionByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteCurrentUserResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON202                   *AccountDeletionResponse
+	ApplicationproblemJSON409 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteCurrentUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteCurrentUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCurrentUserResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *UserResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCurrentUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCurrentUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateCurrentUserResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *UserResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateCurrentUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateCurrentUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CancelAccountDeletionResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CancelAccountDeletionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CancelAccountDeletionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAccountDeletionResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *AccountDeletionResponse
+	ApplicationproblemJSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAccountDeletionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAccountDeletionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RequestDataExportResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON202                   *DataExportResponse
+	ApplicationproblemJSON409 *ErrorResponse
+	ApplicationproblemJSON429 *TooManyRequestsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RequestDataExportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RequestDataExportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDataExportsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]DataExportRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDataExportsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDataExportsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDataExportResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *DataExportResponse
+	ApplicationproblemJSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDataExportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDataExportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DownloadDataExportResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON404 *ErrorResponse
+	ApplicationproblemJSON409 *ErrorResponse
+	ApplicationproblemJSON410 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DownloadDataExportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DownloadDataExportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserIdentitiesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]UserIdentityRequest
+	ApplicationproblemJSON401 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserIdentitiesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserIdentitiesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UnlinkUserIdentityResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UnlinkUserIdentityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnlinkUserIdentityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LinkUserIdentityResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *OIDCAuthorizationResponse
+	ApplicationproblemJSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r LinkUserIdentityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LinkUserIdentityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPersonalAccessTokensResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]PersonalAccessTokenRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPersonalAccessTokensResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPersonalAccessTokensResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreatePersonalAccessTokenResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *PersonalAccessTokenResponse
+	ApplicationproblemJSON400 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreatePersonalAccessTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreatePersonalAccessTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeletePersonalAccessTokenResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeletePersonalAccessTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeletePersonalAccessTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ConfirmTOTPResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *RecoveryCodesResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON409 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ConfirmTOTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConfirmTOTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DisableTOTPResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON409 *ErrorResponse
+	ApplicationproblemJSON429 *TooManyRequestsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DisableTOTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DisableTOTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EnrollTOTPResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *TOTPEnrollmentResponse
+	ApplicationproblemJSON409 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r EnrollTOTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EnrollTOTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RegenerateRecoveryCodesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *RecoveryCodesResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON409 *ErrorResponse
+	ApplicationproblemJSON429 *TooManyRequestsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RegenerateRecoveryCodesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RegenerateRecoveryCodesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWebhooksResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]WebhookRequest
+	ApplicationproblemJSON400 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWebhooksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWebhooksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateWebhookResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *WebhookResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON409 *ErrorResponse
+	ApplicationproblemJSON422 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateWebhookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateWebhookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteWebhookByIdResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteWebhookByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteWebhookByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWebhookByIdResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *WebhookResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWebhookByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWebhookByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateWebhookByIdResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *WebhookResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateWebhookByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateWebhookByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWebhookDeliveriesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]WebhookDeliveryRequest
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWebhookDeliveriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWebhookDeliveriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReplayWebhookDeliveryResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *WebhookDeliveryResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ReplayWebhookDeliveryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReplayWebhookDeliveryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetJWKSWithResponse request returning *GetJWKSResponse
+func (c *ClientWithResponses) GetJWKSWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetJWKSResponse, error) {
+	rsp, err := c.GetJWKS(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetJWKSResponse(rsp)
+}
+
+// AdminGetAuditLogsWithResponse request returning *AdminGetAuditLogsResponse
+func (c *ClientWithResponses) AdminGetAuditLogsWithResponse(ctx context.Context, params *AdminGetAuditLogsParams, reqEditors ...RequestEditorFn) (*AdminGetAuditLogsResponse, error) {
+	rsp, err := c.AdminGetAuditLogs(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminGetAuditLogsResponse(rsp)
+}
+
+// AdminGetSystemStatsWithResponse request returning *AdminGetSystemStatsResponse
+func (c *ClientWithResponses) AdminGetSystemStatsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminGetSystemStatsResponse, error) {
+	rsp, err := c.AdminGetSystemStats(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminGetSystemStatsResponse(rsp)
+}
+
+// AdminSearchUsersWithResponse request returning *AdminSearchUsersResponse
+func (c *ClientWithResponses) AdminSearchUsersWithResponse(ctx context.Context, params *AdminSearchUsersParams, reqEditors ...RequestEditorFn) (*AdminSearchUsersResponse, error) {
+	rsp, err := c.AdminSearchUsers(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminSearchUsersResponse(rsp)
+}
+
+// AdminGetUserWithResponse request returning *AdminGetUserResponse
+func (c *ClientWithResponses) AdminGetUserWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*AdminGetUserResponse, error) {
+	rsp, err := c.AdminGetUser(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminGetUserResponse(rsp)
+}
+
+// AdminDisableUserWithResponse request returning *AdminDisableUserResponse
+func (c *ClientWithResponses) AdminDisableUserWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*AdminDisableUserResponse, error) {
+	rsp, err := c.AdminDisableUser(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminDisableUserResponse(rsp)
+}
+
+// AdminEnableUserWithResponse request returning *AdminEnableUserResponse
+func (c *ClientWithResponses) AdminEnableUserWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*AdminEnableUserResponse, error) {
+	rsp, err := c.AdminEnableUser(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminEnableUserResponse(rsp)
+}
+
+// AdminImpersonateUserWithBodyWithResponse request with arbitrary body returning *AdminImpersonateUserResponse
+func (c *ClientWithResponses) AdminImpersonateUserWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminImpersonateUserResponse, error) {
+	rsp, err := c.AdminImpersonateUserWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminImpersonateUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) AdminImpersonateUserWithResponse(ctx context.Context, id int, body AdminImpersonateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminImpersonateUserResponse, error) {
+	rsp, err := c.AdminImpersonateUser(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminImpersonateUserResponse(rsp)
+}
+
+// AdminForceLogoutWithResponse request returning *AdminForceLogoutResponse
+func (c *ClientWithResponses) AdminForceLogoutWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*AdminForceLogoutResponse, error) {
+	rsp, err := c.AdminForceLogout(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminForceLogoutResponse(rsp)
+}
+
+// AdminUpdateUserRoleWithBodyWithResponse request with arbitrary body returning *AdminUpdateUserRoleResponse
+func (c *ClientWithResponses) AdminUpdateUserRoleWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminUpdateUserRoleResponse, error) {
+	rsp, err := c.AdminUpdateUserRoleWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminUpdateUserRoleResponse(rsp)
+}
+
+func (c *ClientWithResponses) AdminUpdateUserRoleWithResponse(ctx context.Context, id int, body AdminUpdateUserRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminUpdateUserRoleResponse, error) {
+	rsp, err := c.AdminUpdateUserRole(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminUpdateUserRoleResponse(rsp)
+}
+
+// ConfirmAccountDeletionWithBodyWithResponse request with arbitrary body returning *ConfirmAccountDeletionResponse
+func (c *ClientWithResponses) ConfirmAccountDeletionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfirmAccountDeletionResponse, error) {
+	rsp, err := c.ConfirmAccountDeletionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfirmAccountDeletionResponse(rsp)
+}
+
+func (c *ClientWithResponses) ConfirmAccountDeletionWithResponse(ctx context.Context, body ConfirmAccountDeletionJSONRequestBody, reqEditors ...RequestEditorFn) (*ConfirmAccountDeletionResponse, error) {
+	rsp, err := c.ConfirmAccountDeletion(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfirmAccountDeletionResponse(rsp)
+}
+
+// GetCsrfTokenWithResponse request returning *GetCsrfTokenResponse
+func (c *ClientWithResponses) GetCsrfTokenWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCsrfTokenResponse, error) {
+	rsp, err := c.GetCsrfToken(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCsrfTokenResponse(rsp)
+}
+
+// LoginUserWithBodyWithResponse request with arbitrary body returning *LoginUserResponse
+func (c *ClientWithResponses) LoginUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginUserResponse, error) {
+	rsp, err := c.LoginUserWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) LoginUserWithResponse(ctx context.Context, body LoginUserJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginUserResponse, error) {
+	rsp, err := c.LoginUser(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginUserResponse(rsp)
+}
+
+// LoginUserWithTOTPWithBodyWithResponse request with arbitrary body returning *LoginUserWithTOTPResponse
+func (c *ClientWithResponses) LoginUserWithTOTPWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginUserWithTOTPResponse, error) {
+	rsp, err := c.LoginUserWithTOTPWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginUserWithTOTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) LoginUserWithTOTPWithResponse(ctx context.Context, body LoginUserWithTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginUserWithTOTPResponse, error) {
+	rsp, err := c.LoginUserWithTOTP(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginUserWithTOTPResponse(rsp)
+}
+
+// LogoutUserWithResponse request returning *LogoutUserResponse
+func (c *ClientWithResponses) LogoutUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LogoutUserResponse, error) {
+	rsp, err := c.LogoutUser(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLogoutUserResponse(rsp)
+}
+
+// GetOIDCProvidersWithResponse request returning *GetOIDCProvidersResponse
+func (c *ClientWithResponses) GetOIDCProvidersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOIDCProvidersResponse, error) {
+	rsp, err := c.GetOIDCProviders(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOIDCProvidersResponse(rsp)
+}
+
+// HandleOIDCCallbackWithResponse request returning *HandleOIDCCallbackResponse
+func (c *ClientWithResponses) HandleOIDCCallbackWithResponse(ctx context.Context, provider string, params *HandleOIDCCallbackParams, reqEditors ...RequestEditorFn) (*HandleOIDCCallbackResponse, error) {
+	rsp, err := c.HandleOIDCCallback(ctx, provider, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHandleOIDCCallbackResponse(rsp)
+}
+
+// StartOIDCLoginWithResponse request returning *StartOIDCLoginResponse
+func (c *ClientWithResponses) StartOIDCLoginWithResponse(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*StartOIDCLoginResponse, error) {
+	rsp, err := c.StartOIDCLogin(ctx, provider, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStartOIDCLoginResponse(rsp)
+}
+
+// ConfirmPasswordResetWithBodyWithResponse request with arbitrary body returning *ConfirmPasswordResetResponse
+func (c *ClientWithResponses) ConfirmPasswordResetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfirmPasswordResetResponse, error) {
+	rsp, err := c.ConfirmPasswordResetWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfirmPasswordResetResponse(rsp)
+}
+
+func (c *ClientWithResponses) ConfirmPasswordResetWithResponse(ctx context.Context, body ConfirmPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*ConfirmPasswordResetResponse, error) {
+	rsp, err := c.ConfirmPasswordReset(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfirmPasswordResetResponse(rsp)
+}
+
+// RequestPasswordResetWithBodyWithResponse request with arbitrary body returning *RequestPasswordResetResponse
+func (c *ClientWithResponses) RequestPasswordResetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RequestPasswordResetResponse, error) {
+	rsp, err := c.RequestPasswordResetWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRequestPasswordResetResponse(rsp)
+}
+
+func (c *ClientWithResponses) RequestPasswordResetWithResponse(ctx context.Context, body RequestPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*RequestPasswordResetResponse, error) {
+	rsp, err := c.RequestPasswordReset(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRequestPasswordResetResponse(rsp)
+}
+
+// CreateUserWithBodyWithResponse request with arbitrary body returning *CreateUserResponse
+func (c *ClientWithResponses) CreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserResponse, error) {
+	rsp, err := c.CreateUserWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateUserWithResponse(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserResponse, error) {
+	rsp, err := c.CreateUser(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUserResponse(rsp)
+}
+
+// UnlockAccountWithBodyWithResponse request with arbitrary body returning *UnlockAccountResponse
+func (c *ClientWithResponses) UnlockAccountWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UnlockAccountResponse, error) {
+	rsp, err := c.UnlockAccountWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnlockAccountResponse(rsp)
+}
+
+func (c *ClientWithResponses) UnlockAccountWithResponse(ctx context.Context, body UnlockAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*UnlockAccountResponse, error) {
+	rsp, err := c.UnlockAccount(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnlockAccountResponse(rsp)
+}
+
+// VerifyEmailWithBodyWithResponse request with arbitrary body returning *VerifyEmailResponse
+func (c *ClientWithResponses) VerifyEmailWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VerifyEmailResponse, error) {
+	rsp, err := c.VerifyEmailWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyEmailResponse(rsp)
+}
+
+func (c *ClientWithResponses) VerifyEmailWithResponse(ctx context.Context, body VerifyEmailJSONRequestBody, reqEditors ...RequestEditorFn) (*VerifyEmailResponse, error) {
+	rsp, err := c.VerifyEmail(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyEmailResponse(rsp)
+}
+
+// GetCategoriesWithResponse request returning *GetCategoriesResponse
+func (c *ClientWithResponses) GetCategoriesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCategoriesResponse, error) {
+	rsp, err := c.GetCategories(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCategoriesResponse(rsp)
+}
+
+// CreateCategoryWithBodyWithResponse request with arbitrary body returning *CreateCategoryResponse
+func (c *ClientWithResponses) CreateCategoryWithBodyWithResponse(ctx context.Context, params *CreateCategoryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCategoryResponse, error) {
+	rsp, err := c.CreateCategoryWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCategoryResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateCategoryWithResponse(ctx context.Context, params *CreateCategoryParams, body CreateCategoryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCategoryResponse, error) {
+	rsp, err := c.CreateCategory(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCategoryResponse(rsp)
+}
+
+// DeleteCategoryByIdWithResponse request returning *DeleteCategoryByIdResponse
+func (c *ClientWithResponses) DeleteCategoryByIdWithResponse(ctx context.Context, id int, params *DeleteCategoryByIdParams, reqEditors ...RequestEditorFn) (*DeleteCategoryByIdResponse, error) {
+	rsp, err := c.DeleteCategoryById(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteCategoryByIdResponse(rsp)
+}
+
+// GetCategoryByIdWithResponse request returning *GetCategoryByIdResponse
+func (c *ClientWithResponses) GetCategoryByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetCategoryByIdResponse, error) {
+	rsp, err := c.GetCategoryById(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCategoryByIdResponse(rsp)
+}
+
+// UpdateCategoryByIdWithBodyWithResponse request with arbitrary body returning *UpdateCategoryByIdResponse
+func (c *ClientWithResponses) UpdateCategoryByIdWithBodyWithResponse(ctx context.Context, id int, params *UpdateCategoryByIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCategoryByIdResponse, error) {
+	rsp, err := c.UpdateCategoryByIdWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCategoryByIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateCategoryByIdWithResponse(ctx context.Context, id int, params *UpdateCategoryByIdParams, body UpdateCategoryByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCategoryByIdResponse, error) {
+	rsp, err := c.UpdateCategoryById(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCategoryByIdResponse(rsp)
+}
+
+// StreamEventsWithResponse request returning *StreamEventsResponse
+func (c *ClientWithResponses) StreamEventsWithResponse(ctx context.Context, params *StreamEventsParams, reqEditors ...RequestEditorFn) (*StreamEventsResponse, error) {
+	rsp, err := c.StreamEvents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStreamEventsResponse(rsp)
+}
+
+// GetMonthlySummariesWithResponse request returning *GetMonthlySummariesResponse
+func (c *ClientWithResponses) GetMonthlySummariesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMonthlySummariesResponse, error) {
+	rsp, err := c.GetMonthlySummaries(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMonthlySummariesResponse(rsp)
+}
+
+// CreateMonthlySummaryWithBodyWithResponse request with arbitrary body returning *CreateMonthlySummaryResponse
+func (c *ClientWithResponses) CreateMonthlySummaryWithBodyWithResponse(ctx context.Context, params *CreateMonthlySummaryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMonthlySummaryResponse, error) {
+	rsp, err := c.CreateMonthlySummaryWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateMonthlySummaryResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateMonthlySummaryWithResponse(ctx context.Context, params *CreateMonthlySummaryParams, body CreateMonthlySummaryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMonthlySummaryResponse, error) {
+	rsp, err := c.CreateMonthlySummary(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateMonthlySummaryResponse(rsp)
+}
+
+// DeleteMonthlySummaryByIdWithResponse request returning *DeleteMonthlySummaryByIdResponse
+func (c *ClientWithResponses) DeleteMonthlySummaryByIdWithResponse(ctx context.Context, id int, params *DeleteMonthlySummaryByIdParams, reqEditors ...RequestEditorFn) (*DeleteMonthlySummaryByIdResponse, error) {
+	rsp, err := c.DeleteMonthlySummaryById(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteMonthlySummaryByIdResponse(rsp)
+}
+
+// GetMonthlySummaryByIdWithResponse request returning *GetMonthlySummaryByIdResponse
+func (c *ClientWithResponses) GetMonthlySummaryByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetMonthlySummaryByIdResponse, error) {
+	rsp, err := c.GetMonthlySummaryById(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMonthlySummaryByIdResponse(rsp)
+}
+
+// UpdateMonthlySummaryByIdWithBodyWithResponse request with arbitrary body returning *UpdateMonthlySummaryByIdResponse
+func (c *ClientWithResponses) UpdateMonthlySummaryByIdWithBodyWithResponse(ctx context.Context, id int, params *UpdateMonthlySummaryByIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMonthlySummaryByIdResponse, error) {
+	rsp, err := c.UpdateMonthlySummaryByIdWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateMonthlySummaryByIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateMonthlySummaryByIdWithResponse(ctx context.Context, id int, params *UpdateMonthlySummaryByIdParams, body UpdateMonthlySummaryByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMonthlySummaryByIdResponse, error) {
+	rsp, err := c.UpdateMonthlySummaryById(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateMonthlySummaryByIdResponse(rsp)
+}
+
+// GetTransactionsWithResponse request returning *GetTransactionsResponse
+func (c *ClientWithResponses) GetTransactionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTransactionsResponse, error) {
+	rsp, err := c.GetTransactions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTransactionsResponse(rsp)
+}
+
+// CreateTransactionWithBodyWithResponse request with arbitrary body returning *CreateTransactionResponse
+func (c *ClientWithResponses) CreateTransactionWithBodyWithResponse(ctx context.Context, params *CreateTransactionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTransactionResponse, error) {
+	rsp, err := c.CreateTransactionWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTransactionResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateTransactionWithResponse(ctx context.Context, params *CreateTransactionParams, body CreateTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTransactionResponse, error) {
+	rsp, err := c.CreateTransaction(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTransactionResponse(rsp)
+}
+
+// BulkTransactionsWithBodyWithResponse request with arbitrary body returning *BulkTransactionsResponse
+func (c *ClientWithResponses) BulkTransactionsWithBodyWithResponse(ctx context.Context, params *BulkTransactionsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BulkTransactionsResponse, error) {
+	rsp, err := c.BulkTransactionsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBulkTransactionsResponse(rsp)
+}
+
+func (c *ClientWithResponses) BulkTransactionsWithResponse(ctx context.Context, params *BulkTransactionsParams, body BulkTransactionsJSONRequestBody, reqEditors ...RequestEditorFn) (*BulkTransactionsResponse, error) {
+	rsp, err := c.BulkTransactions(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBulkTransactionsResponse(rsp)
+}
+
+// DeleteTransactionByIdWithResponse request returning *DeleteTransactionByIdResponse
+func (c *ClientWithResponses) DeleteTransactionByIdWithResponse(ctx context.Context, id int, params *DeleteTransactionByIdParams, reqEditors ...RequestEditorFn) (*DeleteTransactionByIdResponse, error) {
+	rsp, err := c.DeleteTransactionById(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTransactionByIdResponse(rsp)
+}
+
+// GetTransactionByIdWithResponse request returning *GetTransactionByIdResponse
+func (c *ClientWithResponses) GetTransactionByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetTransactionByIdResponse, error) {
+	rsp, err := c.GetTransactionById(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTransactionByIdResponse(rsp)
+}
+
+// UpdateTransactionByIdWithBodyWithResponse request with arbitrary body returning *UpdateTransactionByIdResponse
+func (c *ClientWithResponses) UpdateTransactionByIdWithBodyWithResponse(ctx context.Context, id int, params *UpdateTransactionByIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTransactionByIdResponse, error) {
+	rsp, err := c.UpdateTransactionByIdWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTransactionByIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateTransactionByIdWithResponse(ctx context.Context, id int, params *UpdateTransactionByIdParams, body UpdateTransactionByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTransactionByIdResponse, error) {
+	rsp, err := c.UpdateTransactionById(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTransactionByIdResponse(rsp)
+}
+
+// DeleteCurrentUserWithResponse request returning *DeleteCurrentUserResponse
+func (c *ClientWithResponses) DeleteCurrentUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteCurrentUserResponse, error) {
+	rsp, err := c.DeleteCurrentUser(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteCurrentUserResponse(rsp)
+}
+
+// GetCurrentUserWithResponse request returning *GetCurrentUserResponse
+func (c *ClientWithResponses) GetCurrentUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCurrentUserResponse, error) {
+	rsp, err := c.GetCurrentUser(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCurrentUserResponse(rsp)
+}
+
+// UpdateCurrentUserWithBodyWithResponse request with arbitrary body returning *UpdateCurrentUserResponse
+func (c *ClientWithResponses) UpdateCurrentUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCurrentUserResponse, error) {
+	rsp, err := c.UpdateCurrentUserWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCurrentUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateCurrentUserWithResponse(ctx context.Context, body UpdateCurrentUserJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCurrentUserResponse, error) {
+	rsp, err := c.UpdateCurrentUser(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCurrentUserResponse(rsp)
+}
+
+// CancelAccountDeletionWithResponse request returning *CancelAccountDeletionResponse
+func (c *ClientWithResponses) CancelAccountDeletionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CancelAccountDeletionResponse, error) {
+	rsp, err := c.CancelAccountDeletion(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelAccountDeletionResponse(rsp)
+}
+
+// GetAccountDeletionWithResponse request returning *GetAccountDeletionResponse
+func (c *ClientWithResponses) GetAccountDeletionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAccountDeletionResponse, error) {
+	rsp, err := c.GetAccountDeletion(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAccountDeletionResponse(rsp)
+}
+
+// RequestDataExportWithResponse request returning *RequestDataExportResponse
+func (c *ClientWithResponses) RequestDataExportWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RequestDataExportResponse, error) {
+	rsp, err := c.RequestDataExport(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRequestDataExportResponse(rsp)
+}
+
+// GetDataExportsWithResponse request returning *GetDataExportsResponse
+func (c *ClientWithResponses) GetDataExportsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDataExportsResponse, error) {
+	rsp, err := c.GetDataExports(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDataExportsResponse(rsp)
+}
+
+// GetDataExportWithResponse request returning *GetDataExportResponse
+func (c *ClientWithResponses) GetDataExportWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetDataExportResponse, error) {
+	rsp, err := c.GetDataExport(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDataExportResponse(rsp)
+}
+
+// DownloadDataExportWithResponse request returning *DownloadDataExportResponse
+func (c *ClientWithResponses) DownloadDataExportWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DownloadDataExportResponse, error) {
+	rsp, err := c.DownloadDataExport(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDownloadDataExportResponse(rsp)
+}
+
+// GetUserIdentitiesWithResponse request returning *GetUserIdentitiesResponse
+func (c *ClientWithResponses) GetUserIdentitiesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserIdentitiesResponse, error) {
+	rsp, err := c.GetUserIdentities(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserIdentitiesResponse(rsp)
+}
+
+// UnlinkUserIdentityWithResponse request returning *UnlinkUserIdentityResponse
+func (c *ClientWithResponses) UnlinkUserIdentityWithResponse(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*UnlinkUserIdentityResponse, error) {
+	rsp, err := c.UnlinkUserIdentity(ctx, provider, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnlinkUserIdentityResponse(rsp)
+}
+
+// LinkUserIdentityWithResponse request returning *LinkUserIdentityResponse
+func (c *ClientWithResponses) LinkUserIdentityWithResponse(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*LinkUserIdentityResponse, error) {
+	rsp, err := c.LinkUserIdentity(ctx, provider, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLinkUserIdentityResponse(rsp)
+}
+
+// GetPersonalAccessTokensWithResponse request returning *GetPersonalAccessTokensResponse
+func (c *ClientWithResponses) GetPersonalAccessTokensWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetPersonalAccessTokensResponse, error) {
+	rsp, err := c.GetPersonalAccessTokens(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPersonalAccessTokensResponse(rsp)
+}
+
+// CreatePersonalAccessTokenWithBodyWithResponse request with arbitrary body returning *CreatePersonalAccessTokenResponse
+func (c *ClientWithResponses) CreatePersonalAccessTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePersonalAccessTokenResponse, error) {
+	rsp, err := c.CreatePersonalAccessTokenWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePersonalAccessTokenResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreatePersonalAccessTokenWithResponse(ctx context.Context, body CreatePersonalAccessTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePersonalAccessTokenResponse, error) {
+	rsp, err := c.CreatePersonalAccessToken(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePersonalAccessTokenResponse(rsp)
+}
+
+// DeletePersonalAccessTokenWithResponse request returning *DeletePersonalAccessTokenResponse
+func (c *ClientWithResponses) DeletePersonalAccessTokenWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeletePersonalAccessTokenResponse, error) {
+	rsp, err := c.DeletePersonalAccessToken(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeletePersonalAccessTokenResponse(rsp)
+}
+
+// ConfirmTOTPWithBodyWithResponse request with arbitrary body returning *ConfirmTOTPResponse
+func (c *ClientWithResponses) ConfirmTOTPWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfirmTOTPResponse, error) {
+	rsp, err := c.ConfirmTOTPWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfirmTOTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) ConfirmTOTPWithResponse(ctx context.Context, body ConfirmTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*ConfirmTOTPResponse, error) {
+	rsp, err := c.ConfirmTOTP(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfirmTOTPResponse(rsp)
+}
+
+// DisableTOTPWithBodyWithResponse request with arbitrary body returning *DisableTOTPResponse
+func (c *ClientWithResponses) DisableTOTPWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DisableTOTPResponse, error) {
+	rsp, err := c.DisableTOTPWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDisableTOTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) DisableTOTPWithResponse(ctx context.Context, body DisableTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*DisableTOTPResponse, error) {
+	rsp, err := c.DisableTOTP(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDisableTOTPResponse(rsp)
+}
+
+// EnrollTOTPWithResponse request returning *EnrollTOTPResponse
+func (c *ClientWithResponses) EnrollTOTPWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*EnrollTOTPResponse, error) {
+	rsp, err := c.EnrollTOTP(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEnrollTOTPResponse(rsp)
+}
+
+// RegenerateRecoveryCodesWithBodyWithResponse request with arbitrary body returning *RegenerateRecoveryCodesResponse
+func (c *ClientWithResponses) RegenerateRecoveryCodesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegenerateRecoveryCodesResponse, error) {
+	rsp, err := c.RegenerateRecoveryCodesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegenerateRecoveryCodesResponse(rsp)
+}
+
+func (c *ClientWithResponses) RegenerateRecoveryCodesWithResponse(ctx context.Context, body RegenerateRecoveryCodesJSONRequestBody, reqEditors ...RequestEditorFn) (*RegenerateRecoveryCodesResponse, error) {
+	rsp, err := c.RegenerateRecoveryCodes(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegenerateRecoveryCodesResponse(rsp)
+}
+
+// GetWebhooksWithResponse request returning *GetWebhooksResponse
+func (c *ClientWithResponses) GetWebhooksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWebhooksResponse, error) {
+	rsp, err := c.GetWebhooks(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWebhooksResponse(rsp)
+}
+
+// CreateWebhookWithBodyWithResponse request with arbitrary body returning *CreateWebhookResponse
+func (c *ClientWithResponses) CreateWebhookWithBodyWithResponse(ctx context.Context, params *CreateWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error) {
+	rsp, err := c.CreateWebhookWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateWebhookResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateWebhookWithResponse(ctx context.Context, params *CreateWebhookParams, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error) {
+	rsp, err := c.CreateWebhook(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateWebhookResponse(rsp)
+}
+
+// DeleteWebhookByIdWithResponse request returning *DeleteWebhookByIdResponse
+func (c *ClientWithResponses) DeleteWebhookByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteWebhookByIdResponse, error) {
+	rsp, err := c.DeleteWebhookById(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteWebhookByIdResponse(rsp)
+}
+
+// GetWebhookByIdWithResponse request returning *GetWebhookByIdResponse
+func (c *ClientWithResponses) GetWebhookByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetWebhookByIdResponse, error) {
+	rsp, err := c.GetWebhookById(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWebhookByIdResponse(rsp)
+}
+
+// UpdateWebhookByIdWithBodyWithResponse request with arbitrary body returning *UpdateWebhookByIdResponse
+func (c *ClientWithResponses) UpdateWebhookByIdWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWebhookByIdResponse, error) {
+	rsp, err := c.UpdateWebhookByIdWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateWebhookByIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateWebhookByIdWithResponse(ctx context.Context, id int, body UpdateWebhookByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWebhookByIdResponse, error) {
+	rsp, err := c.UpdateWebhookById(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateWebhookByIdResponse(rsp)
+}
+
+// GetWebhookDeliveriesWithResponse request returning *GetWebhookDeliveriesResponse
+func (c *ClientWithResponses) GetWebhookDeliveriesWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetWebhookDeliveriesResponse, error) {
+	rsp, err := c.GetWebhookDeliveries(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWebhookDeliveriesResponse(rsp)
+}
+
+// ReplayWebhookDeliveryWithResponse request returning *ReplayWebhookDeliveryResponse
+func (c *ClientWithResponses) ReplayWebhookDeliveryWithResponse(ctx context.Context, id int, deliveryId int, reqEditors ...RequestEditorFn) (*ReplayWebhookDeliveryResponse, error) {
+	rsp, err := c.ReplayWebhookDelivery(ctx, id, deliveryId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReplayWebhookDeliveryResponse(rsp)
+}
+
+// ParseGetJWKSResponse parses an HTTP response from a GetJWKSWithResponse call
+func ParseGetJWKSResponse(rsp *http.Response) (*GetJWKSResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetJWKSResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest JWKSResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminGetAuditLogsResponse parses an HTTP response from a AdminGetAuditLogsWithResponse call
+func ParseAdminGetAuditLogsResponse(rsp *http.Response) (*AdminGetAuditLogsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminGetAuditLogsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []AdminAuditLogRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminGetSystemStatsResponse parses an HTTP response from a AdminGetSystemStatsWithResponse call
+func ParseAdminGetSystemStatsResponse(rsp *http.Response) (*AdminGetSystemStatsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminGetSystemStatsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SystemStatsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminSearchUsersResponse parses an HTTP response from a AdminSearchUsersWithResponse call
+func ParseAdminSearchUsersResponse(rsp *http.Response) (*AdminSearchUsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminSearchUsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AdminUsersResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminGetUserResponse parses an HTTP response from a AdminGetUserWithResponse call
+func ParseAdminGetUserResponse(rsp *http.Response) (*AdminGetUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminGetUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AdminUserResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminDisableUserResponse parses an HTTP response from a AdminDisableUserWithResponse call
+func ParseAdminDisableUserResponse(rsp *http.Response) (*AdminDisableUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminDisableUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AdminUserResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminEnableUserResponse parses an HTTP response from a AdminEnableUserWithResponse call
+func ParseAdminEnableUserResponse(rsp *http.Response) (*AdminEnableUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminEnableUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AdminUserResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminImpersonateUserResponse parses an HTTP response from a AdminImpersonateUserWithResponse call
+func ParseAdminImpersonateUserResponse(rsp *http.Response) (*AdminImpersonateUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminImpersonateUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ImpersonationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminForceLogoutResponse parses an HTTP response from a AdminForceLogoutWithResponse call
+func ParseAdminForceLogoutResponse(rsp *http.Response) (*AdminForceLogoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminForceLogoutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminUpdateUserRoleResponse parses an HTTP response from a AdminUpdateUserRoleWithResponse call
+func ParseAdminUpdateUserRoleResponse(rsp *http.Response) (*AdminUpdateUserRoleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminUpdateUserRoleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AdminUserResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseConfirmAccountDeletionResponse parses an HTTP response from a ConfirmAccountDeletionWithResponse call
+func ParseConfirmAccountDeletionResponse(rsp *http.Response) (*ConfirmAccountDeletionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ConfirmAccountDeletionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AccountDeletionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCsrfTokenResponse parses an HTTP response from a GetCsrfTokenWithResponse call
+func ParseGetCsrfTokenResponse(rsp *http.Response) (*GetCsrfTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCsrfTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			CsrfToken string `json:"csrf_token"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLoginUserResponse parses an HTTP response from a LoginUserWithResponse call
+func ParseLoginUserResponse(rsp *http.Response) (*LoginUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LoginUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message string `json:"message"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest LoginChallengeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequestsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLoginUserWithTOTPResponse parses an HTTP response from a LoginUserWithTOTPWithResponse call
+func ParseLoginUserWithTOTPResponse(rsp *http.Response) (*LoginUserWithTOTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LoginUserWithTOTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequestsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLogoutUserResponse parses an HTTP response from a LogoutUserWithResponse call
+func ParseLogoutUserResponse(rsp *http.Response) (*LogoutUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LogoutUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message string `json:"message"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOIDCProvidersResponse parses an HTTP response from a GetOIDCProvidersWithResponse call
+func ParseGetOIDCProvidersResponse(rsp *http.Response) (*GetOIDCProvidersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOIDCProvidersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OIDCProvidersResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHandleOIDCCallbackResponse parses an HTTP response from a HandleOIDCCallbackWithResponse call
+func ParseHandleOIDCCallbackResponse(rsp *http.Response) (*HandleOIDCCallbackResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HandleOIDCCallbackResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseStartOIDCLoginResponse parses an HTTP response from a StartOIDCLoginWithResponse call
+func ParseStartOIDCLoginResponse(rsp *http.Response) (*StartOIDCLoginResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StartOIDCLoginResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseConfirmPasswordResetResponse parses an HTTP response from a ConfirmPasswordResetWithResponse call
+func ParseConfirmPasswordResetResponse(rsp *http.Response) (*ConfirmPasswordResetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ConfirmPasswordResetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRequestPasswordResetResponse parses an HTTP response from a RequestPasswordResetWithResponse call
+func ParseRequestPasswordResetResponse(rsp *http.Response) (*RequestPasswordResetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RequestPasswordResetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateUserResponse parses an HTTP response from a CreateUserWithResponse call
+func ParseCreateUserResponse(rsp *http.Response) (*CreateUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest UserResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUnlockAccountResponse parses an HTTP response from a UnlockAccountWithResponse call
+func ParseUnlockAccountResponse(rsp *http.Response) (*UnlockAccountResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UnlockAccountResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseVerifyEmailResponse parses an HTTP response from a VerifyEmailWithResponse call
+func ParseVerifyEmailResponse(rsp *http.Response) (*VerifyEmailResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &VerifyEmailResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCategoriesResponse parses an HTTP response from a GetCategoriesWithResponse call
+func ParseGetCategoriesResponse(rsp *http.Response) (*GetCategoriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCategoriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []CategoryRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateCategoryResponse parses an HTTP response from a CreateCategoryWithResponse call
+func ParseCreateCategoryResponse(rsp *http.Response) (*CreateCategoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateCategoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CategoryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteCategoryByIdResponse parses an HTTP response from a DeleteCategoryByIdWithResponse call
+func ParseDeleteCategoryByIdResponse(rsp *http.Response) (*DeleteCategoryByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteCategoryByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 428:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON428 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCategoryByIdResponse parses an HTTP response from a GetCategoryByIdWithResponse call
+func ParseGetCategoryByIdResponse(rsp *http.Response) (*GetCategoryByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCategoryByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CategoryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateCategoryByIdResponse parses an HTTP response from a UpdateCategoryByIdWithResponse call
+func ParseUpdateCategoryByIdResponse(rsp *http.Response) (*UpdateCategoryByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateCategoryByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CategoryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 428:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON428 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStreamEventsResponse parses an HTTP response from a StreamEventsWithResponse call
+func ParseStreamEventsResponse(rsp *http.Response) (*StreamEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StreamEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMonthlySummariesResponse parses an HTTP response from a GetMonthlySummariesWithResponse call
+func ParseGetMonthlySummariesResponse(rsp *http.Response) (*GetMonthlySummariesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMonthlySummariesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []MonthlySummaryRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateMonthlySummaryResponse parses an HTTP response from a CreateMonthlySummaryWithResponse call
+func ParseCreateMonthlySummaryResponse(rsp *http.Response) (*CreateMonthlySummaryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateMonthlySummaryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest MonthlySummaryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteMonthlySummaryByIdResponse parses an HTTP response from a DeleteMonthlySummaryByIdWithResponse call
+func ParseDeleteMonthlySummaryByIdResponse(rsp *http.Response) (*DeleteMonthlySummaryByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteMonthlySummaryByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 428:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON428 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMonthlySummaryByIdResponse parses an HTTP response from a GetMonthlySummaryByIdWithResponse call
+func ParseGetMonthlySummaryByIdResponse(rsp *http.Response) (*GetMonthlySummaryByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMonthlySummaryByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MonthlySummaryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateMonthlySummaryByIdResponse parses an HTTP response from a UpdateMonthlySummaryByIdWithResponse call
+func ParseUpdateMonthlySummaryByIdResponse(rsp *http.Response) (*UpdateMonthlySummaryByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateMonthlySummaryByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MonthlySummaryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 428:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON428 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTransactionsResponse parses an HTTP response from a GetTransactionsWithResponse call
+func ParseGetTransactionsResponse(rsp *http.Response) (*GetTransactionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTransactionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TransactionRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateTransactionResponse parses an HTTP response from a CreateTransactionWithResponse call
+func ParseCreateTransactionResponse(rsp *http.Response) (*CreateTransactionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateTransactionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TransactionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseBulkTransactionsResponse parses an HTTP response from a BulkTransactionsWithResponse call
+func ParseBulkTransactionsResponse(rsp *http.Response) (*BulkTransactionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &BulkTransactionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TransactionBulkResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest TransactionBulkResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteTransactionByIdResponse parses an HTTP response from a DeleteTransactionByIdWithResponse call
+func ParseDeleteTransactionByIdResponse(rsp *http.Response) (*DeleteTransactionByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteTransactionByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 428:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON428 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTransactionByIdResponse parses an HTTP response from a GetTransactionByIdWithResponse call
+func ParseGetTransactionByIdResponse(rsp *http.Response) (*GetTransactionByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTransactionByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TransactionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateTransactionByIdResponse parses an HTTP response from a UpdateTransactionByIdWithResponse call
+func ParseUpdateTransactionByIdResponse(rsp *http.Response) (*UpdateTransactionByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateTransactionByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TransactionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 428:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON428 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteCurrentUserResponse parses an HTTP response from a DeleteCurrentUserWithResponse call
+func ParseDeleteCurrentUserResponse(rsp *http.Response) (*DeleteCurrentUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteCurrentUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest AccountDeletionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCurrentUserResponse parses an HTTP response from a GetCurrentUserWithResponse call
+func ParseGetCurrentUserResponse(rsp *http.Response) (*GetCurrentUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCurrentUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateCurrentUserResponse parses an HTTP response from a UpdateCurrentUserWithResponse call
+func ParseUpdateCurrentUserResponse(rsp *http.Response) (*UpdateCurrentUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateCurrentUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCancelAccountDeletionResponse parses an HTTP response from a CancelAccountDeletionWithResponse call
+func ParseCancelAccountDeletionResponse(rsp *http.Response) (*CancelAccountDeletionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CancelAccountDeletionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAccountDeletionResponse parses an HTTP response from a GetAccountDeletionWithResponse call
+func ParseGetAccountDeletionResponse(rsp *http.Response) (*GetAccountDeletionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAccountDeletionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AccountDeletionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRequestDataExportResponse parses an HTTP response from a RequestDataExportWithResponse call
+func ParseRequestDataExportResponse(rsp *http.Response) (*RequestDataExportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RequestDataExportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest DataExportResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequestsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDataExportsResponse parses an HTTP response from a GetDataExportsWithResponse call
+func ParseGetDataExportsResponse(rsp *http.Response) (*GetDataExportsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDataExportsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []DataExportRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDataExportResponse parses an HTTP response from a GetDataExportWithResponse call
+func ParseGetDataExportResponse(rsp *http.Response) (*GetDataExportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDataExportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DataExportResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDownloadDataExportResponse parses an HTTP response from a DownloadDataExportWithResponse call
+func ParseDownloadDataExportResponse(rsp *http.Response) (*DownloadDataExportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DownloadDataExportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 410:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON410 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUserIdentitiesResponse parses an HTTP response from a GetUserIdentitiesWithResponse call
+func ParseGetUserIdentitiesResponse(rsp *http.Response) (*GetUserIdentitiesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUserIdentitiesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []UserIdentityRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUnlinkUserIdentityResponse parses an HTTP response from a UnlinkUserIdentityWithResponse call
+func ParseUnlinkUserIdentityResponse(rsp *http.Response) (*UnlinkUserIdentityResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UnlinkUserIdentityResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLinkUserIdentityResponse parses an HTTP response from a LinkUserIdentityWithResponse call
+func ParseLinkUserIdentityResponse(rsp *http.Response) (*LinkUserIdentityResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LinkUserIdentityResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OIDCAuthorizationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPersonalAccessTokensResponse parses an HTTP response from a GetPersonalAccessTokensWithResponse call
+func ParseGetPersonalAccessTokensResponse(rsp *http.Response) (*GetPersonalAccessTokensResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPersonalAccessTokensResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []PersonalAccessTokenRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreatePersonalAccessTokenResponse parses an HTTP response from a CreatePersonalAccessTokenWithResponse call
+func ParseCreatePersonalAccessTokenResponse(rsp *http.Response) (*CreatePersonalAccessTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreatePersonalAccessTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest PersonalAccessTokenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeletePersonalAccessTokenResponse parses an HTTP response from a DeletePersonalAccessTokenWithResponse call
+func ParseDeletePersonalAccessTokenResponse(rsp *http.Response) (*DeletePersonalAccessTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeletePersonalAccessTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseConfirmTOTPResponse parses an HTTP response from a ConfirmTOTPWithResponse call
+func ParseConfirmTOTPResponse(rsp *http.Response) (*ConfirmTOTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ConfirmTOTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RecoveryCodesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDisableTOTPResponse parses an HTTP response from a DisableTOTPWithResponse call
+func ParseDisableTOTPResponse(rsp *http.Response) (*DisableTOTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DisableTOTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequestsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseEnrollTOTPResponse parses an HTTP response from a EnrollTOTPWithResponse call
+func ParseEnrollTOTPResponse(rsp *http.Response) (*EnrollTOTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &EnrollTOTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TOTPEnrollmentResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRegenerateRecoveryCodesResponse parses an HTTP response from a RegenerateRecoveryCodesWithResponse call
+func ParseRegenerateRecoveryCodesResponse(rsp *http.Response) (*RegenerateRecoveryCodesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RegenerateRecoveryCodesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RecoveryCodesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequestsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetWebhooksResponse parses an HTTP response from a GetWebhooksWithResponse call
+func ParseGetWebhooksResponse(rsp *http.Response) (*GetWebhooksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWebhooksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []WebhookRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateWebhookResponse parses an HTTP response from a CreateWebhookWithResponse call
+func ParseCreateWebhookResponse(rsp *http.Response) (*CreateWebhookResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateWebhookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest WebhookResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteWebhookByIdResponse parses an HTTP response from a DeleteWebhookByIdWithResponse call
+func ParseDeleteWebhookByIdResponse(rsp *http.Response) (*DeleteWebhookByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteWebhookByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetWebhookByIdResponse parses an HTTP response from a GetWebhookByIdWithResponse call
+func ParseGetWebhookByIdResponse(rsp *http.Response) (*GetWebhookByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWebhookByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateWebhookByIdResponse parses an HTTP response from a UpdateWebhookByIdWithResponse call
+func ParseUpdateWebhookByIdResponse(rsp *http.Response) (*UpdateWebhookByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateWebhookByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetWebhookDeliveriesResponse parses an HTTP response from a GetWebhookDeliveriesWithResponse call
+func ParseGetWebhookDeliveriesResponse(rsp *http.Response) (*GetWebhookDeliveriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWebhookDeliveriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []WebhookDeliveryRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReplayWebhookDeliveryResponse parses an HTTP response from a ReplayWebhookDeliveryWithResponse call
+func ParseReplayWebhookDeliveryResponse(rsp *http.Response) (*ReplayWebhookDeliveryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReplayWebhookDeliveryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest WebhookDeliveryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Get the public keys to verify authentication tokens (JWKS)
+	// (GET /.well-known/jwks.json)
+	GetJWKS(ctx echo.Context) error
+	// Get the audit log of administrator actions
+	// (GET /admin/audit-logs)
+	AdminGetAuditLogs(ctx echo.Context, params AdminGetAuditLogsParams) error
+	// Get system statistics
+	// (GET /admin/stats)
+	AdminGetSystemStats(ctx echo.Context) error
+	// Search users
+	// (GET /admin/users)
+	AdminSearchUsers(ctx echo.Context, params AdminSearchUsersParams) error
+	// Get a user
+	// (GET /admin/users/{id})
+	AdminGetUser(ctx echo.Context, id int) error
+	// Disable a user and end all of their sessions
+	// (POST /admin/users/{id}/disable)
+	AdminDisableUser(ctx echo.Context, id int) error
+	// Enable a disabled user
+	// (POST /admin/users/{id}/enable)
+	AdminEnableUser(ctx echo.Context, id int) error
+	// Log in as a user for support
+	// (POST /admin/users/{id}/impersonate)
+	AdminImpersonateUser(ctx echo.Context, id int) error
+	// End all sessions of a user
+	// (POST /admin/users/{id}/logout)
+	AdminForceLogout(ctx echo.Context, id int) error
+	// Change the role of a user
+	// (PUT /admin/users/{id}/role)
+	AdminUpdateUserRole(ctx echo.Context, id int) error
+	// Confirm an account deletion request
+	// (POST /auth/confirm-deletion)
+	ConfirmAccountDeletion(ctx echo.Context) error
+	// Get a CSRF token
+	// (GET /auth/csrf)
+	GetCsrfToken(ctx echo.Context) error
+	// Log in a user
+	// (POST /auth/login)
+	LoginUser(ctx echo.Context) error
+	// Complete a two-step login with a TOTP or recovery code
+	// (POST /auth/login/totp)
+	LoginUserWithTOTP(ctx echo.Context) error
+	// Log out a user
+	// (POST /auth/logout)
+	LogoutUser(ctx echo.Context) error
+	// Get the configured OpenID Connect providers
+	// (GET /auth/oidc/providers)
+	GetOIDCProviders(ctx echo.Context) error
+	// Callback from the OpenID Connect provider
+	// (GET /auth/oidc/{provider}/callback)
+	HandleOIDCCallback(ctx echo.Context, provider string, params HandleOIDCCallbackParams) error
+	// Start an OpenID Connect login (authorization code flow with PKCE)
+	// (GET /auth/oidc/{provider}/login)
+	StartOIDCLogin(ctx echo.Context, provider string) error
+	// Reset the password with the token sent by email
+	// (POST /auth/password-reset/confirm)
+	ConfirmPasswordReset(ctx echo.Context) error
+	// Send a password reset email
+	// (POST /auth/password-reset/request)
+	RequestPasswordReset(ctx echo.Context) error
+	// Create a new user
+	// (POST /auth/signup)
+	CreateUser(ctx echo.Context) error
+	// Unlock an account locked after repeated login failures
+	// (POST /auth/unlock)
+	UnlockAccount(ctx echo.Context) error
+	// Verify the email address with the token sent by email
+	// (POST /auth/verify-email)
+	VerifyEmail(ctx echo.Context) error
+	// Get all categories
+	// (GET /categories)
+	GetCategories(ctx echo.Context) error
+	// Create a new category
+	// (POST /categories)
+	CreateCategory(ctx echo.Context, params CreateCategoryParams) error
+	// Delete a category
+	// (DELETE /categories/{id})
+	DeleteCategoryById(ctx echo.Context, id int, params DeleteCategoryByIdParams) error
+	// Get a category by ID
+	// (GET /categories/{id})
+	GetCategoryById(ctx echo.Context, id int) error
+	// Update a category
+	// (PATCH /categories/{id})
+	UpdateCategoryById(ctx echo.Context, id int, params UpdateCategoryByIdParams) error
+	// Stream change events of the current user as Server-Sent Events
+	// (GET /events/stream)
+	StreamEvents(ctx echo.Context, params StreamEventsParams) error
+	// Get all monthly summaries for the current user
+	// (GET /monthly-summaries)
+	GetMonthlySummaries(ctx echo.Context) error
+	// Create a new monthly summary
+	// (POST /monthly-summaries)
+	CreateMonthlySummary(ctx echo.Context, params CreateMonthlySummaryParams) error
+	// Delete a monthly summary by ID
+	// (DELETE /monthly-summaries/{id})
+	DeleteMonthlySummaryById(ctx echo.Context, id int, params DeleteMonthlySummaryByIdParams) error
+	// Get a monthly summary by ID
+	// (GET /monthly-summaries/{id})
+	GetMonthlySummaryById(ctx echo.Context, id int) error
+	// Update a monthly summary by ID
+	// (PATCH /monthly-summaries/{id})
+	UpdateMonthlySummaryById(ctx echo.Context, id int, params UpdateMonthlySummaryByIdParams) error
+	// Get all transactions for the current user
+	// (GET /transactions)
+	GetTransactions(ctx echo.Context) error
+	// Create a new transaction
+	// (POST /transactions)
+	CreateTransaction(ctx echo.Context, params CreateTransactionParams) error
+	// Create, update and delete transactions in a single database transaction
+	// (POST /transactions/bulk)
+	BulkTransactions(ctx echo.Context, params BulkTransactionsParams) error
+	// Delete a transaction
+	// (DELETE /transactions/{id})
+	DeleteTransactionById(ctx echo.Context, id int, params DeleteTransactionByIdParams) error
+	// Get a transaction by ID
+	// (GET /transactions/{id})
+	GetTransactionById(ctx echo.Context, id int) error
+	// Update a transaction
+	// (PATCH /transactions/{id})
+	UpdateTransactionById(ctx echo.Context, id int, params UpdateTransactionByIdParams) error
+	// Request deletion of the current user
+	// (DELETE /users)
+	DeleteCurrentUser(ctx echo.Context) error
+	// Get the current user's information
+	// (GET /users)
+	GetCurrentUser(ctx echo.Context) error
+	// Update the current user
+	// (PATCH /users)
+	UpdateCurrentUser(ctx echo.Context) error
+	// Cancel the account deletion during the grace period
+	// (DELETE /users/deletion)
+	CancelAccountDeletion(ctx echo.Context) error
+	// Get the latest account deletion request of the current user
+	// (GET /users/deletion)
+	GetAccountDeletion(ctx echo.Context) error
+	// Request an archive of all data of the current user
+	// (POST /users/export)
+	RequestDataExport(ctx echo.Context) error
+	// Get the data exports of the current user
+	// (GET /users/exports)
+	GetDataExports(ctx echo.Context) error
+	// Get a data export
+	// (GET /users/exports/{id})
+	GetDataExport(ctx echo.Context, id int) error
+	// Download the archive of a completed data export
+	// (GET /users/exports/{id}/download)
+	DownloadDataExport(ctx echo.Context, id int) error
+	// Get external identity providers linked to the current user
+	// (GET /users/identities)
+	GetUserIdentities(ctx echo.Context) error
+	// Unlink an OpenID Connect provider from the current user
+	// (DELETE /users/identities/{provider})
+	UnlinkUserIdentity(ctx echo.Context, provider string) error
+	// Start linking an OpenID Connect provider to the current user
+	// (POST /users/identities/{provider})
+	LinkUserIdentity(ctx echo.Context, provider string) error
+	// Get the personal access tokens of the current user
+	// (GET /users/tokens)
+	GetPersonalAccessTokens(ctx echo.Context) error
+	// Create a personal access token
+	// (POST /users/tokens)
+	CreatePersonalAccessToken(ctx echo.Context) error
+	// Revoke a personal access token
+	// (DELETE /users/tokens/{id})
+	DeletePersonalAccessToken(ctx echo.Context, id int) error
+	// Confirm TOTP enrollment with a code from the authenticator app
+	// (POST /users/totp/confirm)
+	ConfirmTOTP(ctx echo.Context) error
+	// Disable two-factor authentication
+	// (POST /users/totp/disable)
+	DisableTOTP(ctx echo.Context) error
+	// Start TOTP enrollment and get the secret for an authenticator app
+	// (POST /users/totp/enroll)
+	EnrollTOTP(ctx echo.Context) error
+	// Regenerate recovery codes. Previously issued codes can no longer be used
+	// (POST /users/totp/recovery-codes)
+	RegenerateRecoveryCodes(ctx echo.Context) error
+	// Get all webhooks for the current user
+	// (GET /webhooks)
+	GetWebhooks(ctx echo.Context) error
+	// Register a new webhook
+	// (POST /webhooks)
+	CreateWebhook(ctx echo.Context, params CreateWebhookParams) error
+	// Delete a webhook
+	// (DELETE /webhooks/{id})
+	DeleteWebhookById(ctx echo.Context, id int) error
+	// Get a webhook by ID
+	// (GET /webhooks/{id})
+	GetWebhookById(ctx echo.Context, id int) error
+	// Update a webhook
+	// (PATCH /webhooks/{id})
+	UpdateWebhookById(ctx echo.Context, id int) error
+	// Get the delivery history of a webhook
+	// (GET /webhooks/{id}/deliveries)
+	GetWebhookDeliveries(ctx echo.Context, id int) error
+	// Send a past delivery payload again
+	// (POST /webhooks/{id}/deliveries/{deliveryId}/replay)
+	ReplayWebhookDelivery(ctx echo.Context, id int, deliveryId int) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// GetJWKS converts echo context to params.
+func (w *ServerInterfaceWrapper) GetJWKS(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetJWKS(ctx)
+	return err
+}
+
+// AdminGetAuditLogs converts echo context to params.
+func (w *ServerInterfaceWrapper) AdminGetAuditLogs(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AdminGetAuditLogsParams
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", ctx.QueryParams(), &params.UserId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AdminGetAuditLogs(ctx, params)
+	return err
+}
+
+// AdminGetSystemStats converts echo context to params.
+func (w *ServerInterfaceWrapper) AdminGetSystemStats(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AdminGetSystemStats(ctx)
+	return err
+}
+
+// AdminSearchUsers converts echo context to params.
+func (w *ServerInterfaceWrapper) AdminSearchUsers(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AdminSearchUsersParams
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "q", ctx.QueryParams(), &params.Q)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
+	}
+
+	// ------------- Optional query parameter "role" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "role", ctx.QueryParams(), &params.Role)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter role: %s", err))
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AdminSearchUsers(ctx, params)
+	return err
+}
+
+// AdminGetUser converts echo context to params.
+func (w *ServerInterfaceWrapper) AdminGetUser(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AdminGetUser(ctx, id)
+	return err
+}
+
+// AdminDisableUser converts echo context to params.
+func (w *ServerInterfaceWrapper) AdminDisableUser(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AdminDisableUser(ctx, id)
+	return err
+}
+
+// AdminEnableUser converts echo context to params.
+func (w *ServerInterfaceWrapper) AdminEnableUser(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AdminEnableUser(ctx, id)
+	return err
+}
+
+// AdminImpersonateUser converts echo context to params.
+func (w *ServerInterfaceWrapper) AdminImpersonateUser(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AdminImpersonateUser(ctx, id)
+	return err
+}
+
+// AdminForceLogout converts echo context to params.
+func (w *ServerInterfaceWrapper) AdminForceLogout(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AdminForceLogout(ctx, id)
+	return err
+}
+
+// AdminUpdateUserRole converts echo context to params.
+func (w *ServerInterfaceWrapper) AdminUpdateUserRole(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AdminUpdateUserRole(ctx, id)
+	return err
+}
+
+// ConfirmAccountDeletion converts echo context to params.
+func (w *ServerInterfaceWrapper) ConfirmAccountDeletion(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ConfirmAccountDeletion(ctx)
+	return err
+}
+
+// GetCsrfToken converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCsrfToken(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCsrfToken(ctx)
+	return err
+}
+
+// LoginUser converts echo context to params.
+func (w *ServerInterfaceWrapper) LoginUser(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.LoginUser(ctx)
+	return err
+}
+
+// LoginUserWithTOTP converts echo context to params.
+func (w *ServerInterfaceWrapper) LoginUserWithTOTP(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.LoginUserWithTOTP(ctx)
+	return err
+}
+
+// LogoutUser converts echo context to params.
+func (w *ServerInterfaceWrapper) LogoutUser(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.LogoutUser(ctx)
+	return err
+}
+
+// GetOIDCProviders converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOIDCProviders(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetOIDCProviders(ctx)
+	return err
+}
+
+// HandleOIDCCallback converts echo context to params.
+func (w *ServerInterfaceWrapper) HandleOIDCCallback(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "provider" -------------
+	var provider string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", ctx.Param("provider"), &provider, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter provider: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params HandleOIDCCallbackParams
+	// ------------- Required query parameter "state" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "state", ctx.QueryParams(), &params.State)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter state: %s", err))
+	}
+
+	// ------------- Optional query parameter "code" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "code", ctx.QueryParams(), &params.Code)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter code: %s", err))
+	}
+
+	// ------------- Optional query parameter "error" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "error", ctx.QueryParams(), &params.Error)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter error: %s", err))
+	}
+
+	if cookie, err := ctx.Cookie("oidc_state"); err == nil {
+
+		var value string
+		err = runtime.BindStyledParameterWithOptions("simple", "oidc_state", cookie.Value, &value, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationCookie, Explode: true, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter oidc_state: %s", err))
+		}
+		params.OidcState = &value
+
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.HandleOIDCCallback(ctx, provider, params)
+	return err
+}
+
+// StartOIDCLogin converts echo context to params.
+func (w *ServerInterfaceWrapper) StartOIDCLogin(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "provider" -------------
+	var provider string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", ctx.Param("provider"), &provider, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter provider: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StartOIDCLogin(ctx, provider)
+	return err
+}
+
+// ConfirmPasswordReset converts echo context to params.
+func (w *ServerInterfaceWrapper) ConfirmPasswordReset(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ConfirmPasswordReset(ctx)
+	return err
+}
+
+// RequestPasswordReset converts echo context to params.
+func (w *ServerInterfaceWrapper) RequestPasswordReset(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RequestPasswordReset(ctx)
+	return err
+}
+
+// CreateUser converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateUser(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateUser(ctx)
+	return err
+}
+
+// UnlockAccount converts echo context to params.
+func (w *ServerInterfaceWrapper) UnlockAccount(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UnlockAccount(ctx)
+	return err
+}
+
+// VerifyEmail converts echo context to params.
+func (w *ServerInterfaceWrapper) VerifyEmail(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.VerifyEmail(ctx)
+	return err
+}
+
+// GetCategories converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCategories(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCategories(ctx)
+	return err
+}
+
+// CreateCategory converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateCategory(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateCategoryParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateCategory(ctx, params)
+	return err
+}
+
+// DeleteCategoryById converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteCategoryById(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteCategoryByIdParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = IfMatch
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter If-Match is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteCategoryById(ctx, id, params)
+	return err
+}
+
+// GetCategoryById converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCategoryById(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCategoryById(ctx, id)
+	return err
+}
+
+// UpdateCategoryById converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateCategoryById(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateCategoryByIdParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = IfMatch
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter If-Match is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateCategoryById(ctx, id, params)
+	return err
+}
+
+// StreamEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) StreamEvents(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamEventsParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID int
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Last-Event-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Last-Event-ID: %s", err))
+		}
+
+		params.LastEventID = &LastEventID
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StreamEvents(ctx, params)
+	return err
+}
+
+// GetMonthlySummaries converts echo context to params.
+func (w *ServerInterfaceWrapper) GetMonthlySummaries(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetMonthlySummaries(ctx)
+	return err
+}
+
+// CreateMonthlySummary converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateMonthlySummary(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateMonthlySummaryParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateMonthlySummary(ctx, params)
+	return err
+}
+
+// DeleteMonthlySummaryById converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteMonthlySummaryById(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteMonthlySummaryByIdParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = IfMatch
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter If-Match is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteMonthlySummaryById(ctx, id, params)
+	return err
+}
+
+// GetMonthlySummaryById converts echo context to params.
+func (w *ServerInterfaceWrapper) GetMonthlySummaryById(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetMonthlySummaryById(ctx, id)
+	return err
+}
+
+// UpdateMonthlySummaryById converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateMonthlySummaryById(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateMonthlySummaryByIdParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = IfMatch
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter If-Match is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateMonthlySummaryById(ctx, id, params)
+	return err
+}
+
+// GetTransactions converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTransactions(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTransactions(ctx)
+	return err
+}
+
+// CreateTransaction converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateTransaction(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateTransactionParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateTransaction(ctx, params)
+	return err
+}
+
+// BulkTransactions converts echo context to params.
+func (w *ServerInterfaceWrapper) BulkTransactions(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params BulkTransactionsParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.BulkTransactions(ctx, params)
+	return err
+}
+
+// DeleteTransactionById converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTransactionById(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTransactionByIdParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = IfMatch
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter If-Match is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTransactionById(ctx, id, params)
+	return err
+}
+
+// GetTransactionById converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTransactionById(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTransactionById(ctx, id)
+	return err
+}
+
+// UpdateTransactionById converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateTransactionById(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateTransactionByIdParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = IfMatch
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter If-Match is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateTransactionById(ctx, id, params)
+	return err
+}
+
+// DeleteCurrentUser converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteCurrentUser(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteCurrentUser(ctx)
+	return err
+}
+
+// GetCurrentUser converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCurrentUser(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCurrentUser(ctx)
+	return err
+}
+
+// UpdateCurrentUser converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateCurrentUser(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateCurrentUser(ctx)
+	return err
+}
+
+// CancelAccountDeletion converts echo context to params.
+func (w *ServerInterfaceWrapper) CancelAccountDeletion(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CancelAccountDeletion(ctx)
+	return err
+}
+
+// GetAccountDeletion converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAccountDeletion(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAccountDeletion(ctx)
+	return err
+}
+
+// RequestDataExport converts echo context to params.
+func (w *ServerInterfaceWrapper) RequestDataExport(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RequestDataExport(ctx)
+	return err
+}
+
+// GetDataExports converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDataExports(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDataExports(ctx)
+	return err
+}
+
+// GetDataExport converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDataExport(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDataExport(ctx, id)
+	return err
+}
+
+// DownloadDataExport converts echo context to params.
+func (w *ServerInterfaceWrapper) DownloadDataExport(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DownloadDataExport(ctx, id)
+	return err
+}
+
+// GetUserIdentities converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUserIdentities(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUserIdentities(ctx)
+	return err
+}
+
+// UnlinkUserIdentity converts echo context to params.
+func (w *ServerInterfaceWrapper) UnlinkUserIdentity(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "provider" -------------
+	var provider string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", ctx.Param("provider"), &provider, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter provider: %s", err))
+	}
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UnlinkUserIdentity(ctx, provider)
+	return err
+}
+
+// LinkUserIdentity converts echo context to params.
+func (w *ServerInterfaceWrapper) LinkUserIdentity(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "provider" -------------
+	var provider string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", ctx.Param("provider"), &provider, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter provider: %s", err))
+	}
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.LinkUserIdentity(ctx, provider)
+	return err
+}
+
+// GetPersonalAccessTokens converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPersonalAccessTokens(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPersonalAccessTokens(ctx)
+	return err
+}
+
+// CreatePersonalAccessToken converts echo context to params.
+func (w *ServerInterfaceWrapper) CreatePersonalAccessToken(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreatePersonalAccessToken(ctx)
+	return err
+}
+
+// DeletePersonalAccessToken converts echo context to params.
+func (w *ServerInterfaceWrapper) DeletePersonalAccessToken(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeletePersonalAccessToken(ctx, id)
+	return err
+}
+
+// ConfirmTOTP converts echo context to params.
+func (w *ServerInterfaceWrapper) ConfirmTOTP(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ConfirmTOTP(ctx)
+	return err
+}
+
+// DisableTOTP converts echo context to params.
+func (w *ServerInterfaceWrapper) DisableTOTP(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DisableTOTP(ctx)
+	return err
+}
+
+// EnrollTOTP converts echo context to params.
+func (w *ServerInterfaceWrapper) EnrollTOTP(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.EnrollTOTP(ctx)
+	return err
+}
+
+// RegenerateRecoveryCodes converts echo context to params.
+func (w *ServerInterfaceWrapper) RegenerateRecoveryCodes(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RegenerateRecoveryCodes(ctx)
+	return err
+}
+
+// GetWebhooks converts echo context to params.
+func (w *ServerInterfaceWrapper) GetWebhooks(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetWebhooks(ctx)
+	return err
+}
+
+// CreateWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateWebhook(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateWebhookParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateWebhook(ctx, params)
+	return err
+}
+
+// DeleteWebhookById converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteWebhookById(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteWebhookById(ctx, id)
+	return err
+}
+
+// GetWebhookById converts echo context to params.
+func (w *ServerInterfaceWrapper) GetWebhookById(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetWebhookById(ctx, id)
+	return err
+}
+
+// UpdateWebhookById converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateWebhookById(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateWebhookById(ctx, id)
+	return err
+}
+
+// GetWebhookDeliveries converts echo context to params.
+func (w *ServerInterfaceWrapper) GetWebhookDeliveries(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetWebhookDeliveries(ctx, id)
+	return err
+}
+
+// ReplayWebhookDelivery converts echo context to params.
+func (w *ServerInterfaceWrapper) ReplayWebhookDelivery(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "deliveryId" -------------
+	var deliveryId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "deliveryId", ctx.Param("deliveryId"), &deliveryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter deliveryId: %s", err))
+	}
+
+	ctx.Set(CsrfAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ReplayWebhookDelivery(ctx, id, deliveryId)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.GET(baseURL+"/.well-known/jwks.json", wrapper.GetJWKS)
+	router.GET(baseURL+"/admin/audit-logs", wrapper.AdminGetAuditLogs)
+	router.GET(baseURL+"/admin/stats", wrapper.AdminGetSystemStats)
+	router.GET(baseURL+"/admin/users", wrapper.AdminSearchUsers)
+	router.GET(baseURL+"/admin/users/:id", wrapper.AdminGetUser)
+	router.POST(baseURL+"/admin/users/:id/disable", wrapper.AdminDisableUser)
+	router.POST(baseURL+"/admin/users/:id/enable", wrapper.AdminEnableUser)
+	router.POST(baseURL+"/admin/users/:id/impersonate", wrapper.AdminImpersonateUser)
+	router.POST(baseURL+"/admin/users/:id/logout", wrapper.AdminForceLogout)
+	router.PUT(baseURL+"/admin/users/:id/role", wrapper.AdminUpdateUserRole)
+	router.POST(baseURL+"/auth/confirm-deletion", wrapper.ConfirmAccountDeletion)
+	router.GET(baseURL+"/auth/csrf", wrapper.GetCsrfToken)
+	router.POST(baseURL+"/auth/login", wrapper.LoginUser)
+	router.POST(baseURL+"/auth/login/totp", wrapper.LoginUserWithTOTP)
+	router.POST(baseURL+"/auth/logout", wrapper.LogoutUser)
+	router.GET(baseURL+"/auth/oidc/providers", wrapper.GetOIDCProviders)
+	router.GET(baseURL+"/auth/oidc/:provider/callback", wrapper.HandleOIDCCallback)
+	router.GET(baseURL+"/auth/oidc/:provider/login", wrapper.StartOIDCLogin)
+	router.POST(baseURL+"/auth/password-reset/confirm", wrapper.ConfirmPasswordReset)
+	router.POST(baseURL+"/auth/password-reset/request", wrapper.RequestPasswordReset)
+	router.POST(baseURL+"/auth/signup", wrapper.CreateUser)
+	router.POST(baseURL+"/auth/unlock", wrapper.UnlockAccount)
+	router.POST(baseURL+"/auth/verify-email", wrapper.VerifyEmail)
+	router.GET(baseURL+"/categories", wrapper.GetCategories)
+	router.POST(baseURL+"/categories", wrapper.CreateCategory)
+	router.DELETE(baseURL+"/categories/:id", wrapper.DeleteCategoryById)
+	router.GET(baseURL+"/categories/:id", wrapper.GetCategoryById)
+	router.PATCH(baseURL+"/categories/:id", wrapper.UpdateCategoryById)
+	router.GET(baseURL+"/events/stream", wrapper.StreamEvents)
+	router.GET(baseURL+"/monthly-summaries", wrapper.GetMonthlySummaries)
+	router.POST(baseURL+"/monthly-summaries", wrapper.CreateMonthlySummary)
+	router.DELETE(baseURL+"/monthly-summaries/:id", wrapper.DeleteMonthlySummaryById)
+	router.GET(baseURL+"/monthly-summaries/:id", wrapper.GetMonthlySummaryById)
+	router.PATCH(baseURL+"/monthly-summaries/:id", wrapper.UpdateMonthlySummaryById)
+	router.GET(baseURL+"/transactions", wrapper.GetTransactions)
+	router.POST(baseURL+"/transactions", wrapper.CreateTransaction)
+	router.POST(baseURL+"/transactions/bulk", wrapper.BulkTransactions)
+	router.DELETE(baseURL+"/transactions/:id", wrapper.DeleteTransactionById)
+	router.GET(baseURL+"/transactions/:id", wrapper.GetTransactionById)
+	router.PATCH(baseURL+"/transactions/:id", wrapper.UpdateTransactionById)
+	router.DELETE(baseURL+"/users", wrapper.DeleteCurrentUser)
+	router.GET(baseURL+"/users", wrapper.GetCurrentUser)
+	router.PATCH(baseURL+"/users", wrapper.UpdateCurrentUser)
+	router.DELETE(baseURL+"/users/deletion", wrapper.CancelAccountDeletion)
+	router.GET(baseURL+"/users/deletion", wrapper.GetAccountDeletion)
+	router.POST(baseURL+"/users/export", wrapper.RequestDataExport)
+	router.GET(baseURL+"/users/exports", wrapper.GetDataExports)
+	router.GET(baseURL+"/users/exports/:id", wrapper.GetDataExport)
+	router.GET(baseURL+"/users/exports/:id/download", wrapper.DownloadDataExport)
+	router.GET(baseURL+"/users/identities", wrapper.GetUserIdentities)
+	router.DELETE(baseURL+"/users/identities/:provider", wrapper.UnlinkUserIdentity)
+	router.POST(baseURL+"/users/identities/:provider", wrapper.LinkUserIdentity)
+	router.GET(baseURL+"/users/tokens", wrapper.GetPersonalAccessTokens)
+	router.POST(baseURL+"/users/tokens", wrapper.CreatePersonalAccessToken)
+	router.DELETE(baseURL+"/users/tokens/:id", wrapper.DeletePersonalAccessToken)
+	router.POST(baseURL+"/users/totp/confirm", wrapper.ConfirmTOTP)
+	router.POST(baseURL+"/users/totp/disable", wrapper.DisableTOTP)
+	router.POST(baseURL+"/users/totp/enroll", wrapper.EnrollTOTP)
+	router.POST(baseURL+"/users/totp/recovery-codes", wrapper.RegenerateRecoveryCodes)
+	router.GET(baseURL+"/webhooks", wrapper.GetWebhooks)
+	router.POST(baseURL+"/webhooks", wrapper.CreateWebhook)
+	router.DELETE(baseURL+"/webhooks/:id", wrapper.DeleteWebhookById)
+	router.GET(baseURL+"/webhooks/:id", wrapper.GetWebhookById)
+	router.PATCH(baseURL+"/webhooks/:id", wrapper.UpdateWebhookById)
+	router.GET(baseURL+"/webhooks/:id/deliveries", wrapper.GetWebhookDeliveries)
+	router.POST(baseURL+"/webhooks/:id/deliveries/:deliveryId/replay", wrapper.ReplayWebhookDelivery)
+
+}
+
+type AccountDeletionResponseJSONResponse AccountDeletionRequest
+
+type AdminUserResponseJSONResponse AdminUserRequest
+
+type AdminUsersResponseJSONResponse AdminUsersRequest
+
+type CategoryResponseResponseHeaders struct {
+	ETag string
+}
+type CategoryResponseJSONResponse struct {
+	Body CategoryRequest
+
+	Headers CategoryResponseResponseHeaders
+}
+
+type DataExportResponseJSONResponse DataExportRequest
+
+type ErrorResponseApplicationProblemPlusJSONResponse Problem
+
+type ImpersonationResponseResponseHeaders struct {
+	SetCookie string
+}
+type ImpersonationResponseJSONResponse struct {
+	Body ImpersonationRequest
+
+	Headers ImpersonationResponseResponseHeaders
+}
+
+type JWKSResponseResponseHeaders struct {
+	CacheControl string
+}
+type JWKSResponseJSONResponse struct {
+	Body JWKSRequest
+
+	Headers JWKSResponseResponseHeaders
+}
+
+type LoginChallengeResponseJSONResponse LoginChallengeRequest
+
+type MonthlySummaryResponseResponseHeaders struct {
+	ETag string
+}
+type MonthlySummaryResponseJSONResponse struct {
+	Body MonthlySummaryRequest
+
+	Headers MonthlySummaryResponseResponseHeaders
+}
+
+type OIDCAuthorizationResponseResponseHeaders struct {
+	SetCookie string
+}
+type OIDCAuthorizationResponseJSONResponse struct {
+	Body OIDCAuthorizationRequest
+
+	Headers OIDCAuthorizationResponseResponseHeaders
+}
+
+type OIDCProvidersResponseJSONResponse OIDCProvidersRequest
+
+type PersonalAccessTokenResponseJSONResponse PersonalAccessTokenRequest
+
+type RecoveryCodesResponseJSONResponse RecoveryCodesRequest
+
+type SystemStatsResponseJSONResponse SystemStatsRequest
+
+type TOTPEnrollmentResponseJSONResponse TOTPEnrollmentRequest
+
+type TooManyRequestsResponseResponseHeaders struct {
+	RetryAfter int
+}
+type TooManyRequestsResponseApplicationProblemPlusJSONResponse struct {
+	Body Problem
+
+	Headers TooManyRequestsResponseResponseHeaders
+}
+
+type TransactionBulkResponseJSONResponse TransactionBulkResultList
+
+type TransactionResponseResponseHeaders struct {
+	ETag string
+}
+type TransactionResponseJSONResponse struct {
+	Body TransactionRequest
+
+	Headers TransactionResponseResponseHeaders
+}
+
+type UserResponseJSONResponse UserRequest
+
+type WebhookDeliveryResponseJSONResponse WebhookDeliveryRequest
+
+type WebhookResponseJSONResponse WebhookRequest
+
+type GetJWKSRequestObject struct {
+}
+
+type GetJWKSResponseObject interface {
+	VisitGetJWKSResponse(w http.ResponseWriter) error
+}
+
+type GetJWKS200JSONResponse struct{ JWKSResponseJSONResponse }
+
+func (response GetJWKS200JSONResponse) VisitGetJWKSResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type AdminGetAuditLogsRequestObject struct {
+	Params AdminGetAuditLogsParams
+}
+
+type AdminGetAuditLogsResponseObject interface {
+	VisitAdminGetAuditLogsResponse(w http.ResponseWriter) error
+}
+
+type AdminGetAuditLogs200JSONResponse []AdminAuditLogRequest
+
+func (response AdminGetAuditLogs200JSONResponse) VisitAdminGetAuditLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminGetAuditLogs403ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response AdminGetAuditLogs403ApplicationProblemPlusJSONResponse) VisitAdminGetAuditLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminGetSystemStatsRequestObject struct {
+}
+
+type AdminGetSystemStatsResponseObject interface {
+	VisitAdminGetSystemStatsResponse(w http.ResponseWriter) error
+}
+
+type AdminGetSystemStats200JSONResponse struct {
+	SystemStatsResponseJSONResponse
+}
+
+func (response AdminGetSystemStats200JSONResponse) VisitAdminGetSystemStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminGetSystemStats403ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response AdminGetSystemStats403ApplicationProblemPlusJSONResponse) VisitAdminGetSystemStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminSearchUsersRequestObject struct {
+	Params AdminSearchUsersParams
+}
+
+type AdminSearchUsersResponseObject interface {
+	VisitAdminSearchUsersResponse(w http.ResponseWriter) error
+}
+
+type AdminSearchUsers200JSONResponse struct{ AdminUsersResponseJSONResponse }
+
+func (response AdminSearchUsers200JSONResponse) VisitAdminSearchUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminSearchUsers400ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response AdminSearchUsers400ApplicationProblemPlusJSONResponse) VisitAdminSearchUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminSearchUsers403ApplicationProblemPlusJSONResponse Problem
+
+func (response AdminSearchUsers403ApplicationProblemPlusJSONResponse) VisitAdminSearchUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminGetUserRequestObject struct {
+	Id int `json:"id"`
+}
+
+type AdminGetUserResponseObject interface {
+	VisitAdminGetUserResponse(w http.ResponseWriter) error
+}
+
+type AdminGetUser200JSONResponse struct{ AdminUserResponseJSONResponse }
+
+func (response AdminGetUser200JSONResponse) VisitAdminGetUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminGetUser403ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response AdminGetUser403ApplicationProblemPlusJSONResponse) VisitAdminGetUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminGetUser404ApplicationProblemPlusJSONResponse Problem
+
+func (response AdminGetUser404ApplicationProblemPlusJSONResponse) VisitAdminGetUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminDisableUserRequestObject struct {
+	Id int `json:"id"`
+}
+
+type AdminDisableUserResponseObject interface {
+	VisitAdminDisableUserResponse(w http.ResponseWriter) error
+}
+
+type AdminDisableUser200JSONResponse struct{ AdminUserResponseJSONResponse }
+
+func (response AdminDisableUser200JSONResponse) VisitAdminDisableUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminDisableUser403ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response AdminDisableUser403ApplicationProblemPlusJSONResponse) VisitAdminDisableUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminDisableUser404ApplicationProblemPlusJSONResponse Problem
+
+func (response AdminDisableUser404ApplicationProblemPlusJSONResponse) VisitAdminDisableUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminDisableUser409ApplicationProblemPlusJSONResponse Problem
+
+func (response AdminDisableUser409ApplicationProblemPlusJSONResponse) VisitAdminDisableUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminEnableUserRequestObject struct {
+	Id int `json:"id"`
+}
+
+type AdminEnableUserResponseObject interface {
+	VisitAdminEnableUserResponse(w http.ResponseWriter) error
+}
+
+type AdminEnableUser200JSONResponse struct{ AdminUserResponseJSONResponse }
+
+func (response AdminEnableUser200JSONResponse) VisitAdminEnableUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminEnableUser403ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response AdminEnableUser403ApplicationProblemPlusJSONResponse) VisitAdminEnableUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminEnableUser404ApplicationProblemPlusJSONResponse Problem
+
+func (response AdminEnableUser404ApplicationProblemPlusJSONResponse) VisitAdminEnableUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminImpersonateUserRequestObject struct {
+	Id   int `json:"id"`
+	Body *AdminImpersonateUserJSONRequestBody
+}
+
+type AdminImpersonateUserResponseObject interface {
+	VisitAdminImpersonateUserResponse(w http.ResponseWriter) error
+}
+
+type AdminImpersonateUser200JSONResponse struct {
+	ImpersonationResponseJSONResponse
+}
+
+func (response AdminImpersonateUser200JSONResponse) VisitAdminImpersonateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type AdminImpersonateUser400ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response AdminImpersonateUser400ApplicationProblemPlusJSONResponse) VisitAdminImpersonateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminImpersonateUser403ApplicationProblemPlusJSONResponse Problem
+
+func (response AdminImpersonateUser403ApplicationProblemPlusJSONResponse) VisitAdminImpersonateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminImpersonateUser404ApplicationProblemPlusJSONResponse Problem
+
+func (response AdminImpersonateUser404ApplicationProblemPlusJSONResponse) VisitAdminImpersonateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminImpersonateUser409ApplicationProblemPlusJSONResponse Problem
+
+func (response AdminImpersonateUser409ApplicationProblemPlusJSONResponse) VisitAdminImpersonateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminForceLogoutRequestObject struct {
+	Id int `json:"id"`
+}
+
+type AdminForceLogoutResponseObject interface {
+	VisitAdminForceLogoutResponse(w http.ResponseWriter) error
+}
+
+type AdminForceLogout204Response struct {
+}
+
+func (response AdminForceLogout204Response) VisitAdminForceLogoutResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type AdminForceLogout403ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response AdminForceLogout403ApplicationProblemPlusJSONResponse) VisitAdminForceLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminForceLogout404ApplicationProblemPlusJSONResponse Problem
+
+func (response AdminForceLogout404ApplicationProblemPlusJSONResponse) VisitAdminForceLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminUpdateUserRoleRequestObject struct {
+	Id   int `json:"id"`
+	Body *AdminUpdateUserRoleJSONRequestBody
+}
+
+type AdminUpdateUserRoleResponseObject interface {
+	VisitAdminUpdateUserRoleResponse(w http.ResponseWriter) error
+}
+
+type AdminUpdateUserRole200JSONResponse struct{ AdminUserResponseJSONResponse }
+
+func (response AdminUpdateUserRole200JSONResponse) VisitAdminUpdateUserRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminUpdateUserRole400ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response AdminUpdateUserRole400ApplicationProblemPlusJSONResponse) VisitAdminUpdateUserRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminUpdateUserRole403ApplicationProblemPlusJSONResponse Problem
+
+func (response AdminUpdateUserRole403ApplicationProblemPlusJSONResponse) VisitAdminUpdateUserRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminUpdateUserRole404ApplicationProblemPlusJSONResponse Problem
+
+func (response AdminUpdateUserRole404ApplicationProblemPlusJSONResponse) VisitAdminUpdateUserRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminUpdateUserRole409ApplicationProblemPlusJSONResponse Problem
+
+func (response AdminUpdateUserRole409ApplicationProblemPlusJSONResponse) VisitAdminUpdateUserRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmAccountDeletionRequestObject struct {
+	Body *ConfirmAccountDeletionJSONRequestBody
+}
+
+type ConfirmAccountDeletionResponseObject interface {
+	VisitConfirmAccountDeletionResponse(w http.ResponseWriter) error
+}
+
+type ConfirmAccountDeletion200JSONResponse struct {
+	AccountDeletionResponseJSONResponse
+}
+
+func (response ConfirmAccountDeletion200JSONResponse) VisitConfirmAccountDeletionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmAccountDeletion400ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response ConfirmAccountDeletion400ApplicationProblemPlusJSONResponse) VisitConfirmAccountDeletionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCsrfTokenRequestObject struct {
+}
+
+type GetCsrfTokenResponseObject interface {
+	VisitGetCsrfTokenResponse(w http.ResponseWriter) error
+}
+
+type GetCsrfToken200JSONResponse struct {
+	CsrfToken string `json:"csrf_token"`
+}
+
+func (response GetCsrfToken200JSONResponse) VisitGetCsrfTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LoginUserRequestObject struct {
+	Body *LoginUserJSONRequestBody
+}
+
+type LoginUserResponseObject interface {
+	VisitLoginUserResponse(w http.ResponseWriter) error
+}
+
+type LoginUser200ResponseHeaders struct {
+	SetCookie string
+}
+
+type LoginUser200JSONResponse struct {
+	Body struct {
+		Message string `json:"message"`
+	}
+	Headers LoginUser200ResponseHeaders
+}
+
+func (response LoginUser200JSONResponse) VisitLoginUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type LoginUser202JSONResponse struct {
+	LoginChallengeResponseJSONResponse
+}
+
+func (response LoginUser202JSONResponse) VisitLoginUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LoginUser401ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response LoginUser401ApplicationProblemPlusJSONResponse) VisitLoginUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LoginUser403ApplicationProblemPlusJSONResponse Problem
+
+func (response LoginUser403ApplicationProblemPlusJSONResponse) VisitLoginUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LoginUser429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsResponseApplicationProblemPlusJSONResponse
+}
+
+func (response LoginUser429ApplicationProblemPlusJSONResponse) VisitLoginUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type LoginUserWithTOTPRequestObject struct {
+	Body *LoginUserWithTOTPJSONRequestBody
+}
+
+type LoginUserWithTOTPResponseObject interface {
+	VisitLoginUserWithTOTPResponse(w http.ResponseWriter) error
+}
+
+type LoginUserWithTOTP200ResponseHeaders struct {
+	SetCookie string
+}
+
+type LoginUserWithTOTP200Response struct {
+	Headers LoginUserWithTOTP200ResponseHeaders
+}
+
+func (response LoginUserWithTOTP200Response) VisitLoginUserWithTOTPResponse(w http.ResponseWriter) error {
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(200)
+	return nil
+}
+
+type LoginUserWithTOTP400ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response LoginUserWithTOTP400ApplicationProblemPlusJSONResponse) VisitLoginUserWithTOTPResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LoginUserWithTOTP401ApplicationProblemPlusJSONResponse Problem
+
+func (response LoginUserWithTOTP401ApplicationProblemPlusJSONResponse) VisitLoginUserWithTOTPResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LoginUserWithTOTP429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsResponseApplicationProblemPlusJSONResponse
+}
+
+func (response LoginUserWithTOTP429ApplicationProblemPlusJSONResponse) VisitLoginUserWithTOTPResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type LogoutUserRequestObject struct {
+}
+
+type LogoutUserResponseObject interface {
+	VisitLogoutUserResponse(w http.ResponseWriter) error
+}
+
+type LogoutUser200ResponseHeaders struct {
+	SetCookie string
+}
+
+type LogoutUser200JSONResponse struct {
+	Body struct {
+		Message string `json:"message"`
+	}
+	Headers LogoutUser200ResponseHeaders
+}
+
+func (response LogoutUser200JSONResponse) VisitLogoutUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetOIDCProvidersRequestObject struct {
+}
+
+type GetOIDCProvidersResponseObject interface {
+	VisitGetOIDCProvidersResponse(w http.ResponseWriter) error
+}
+
+type GetOIDCProviders200JSONResponse struct {
+	OIDCProvidersResponseJSONResponse
+}
+
+func (response GetOIDCProviders200JSONResponse) VisitGetOIDCProvidersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HandleOIDCCallbackRequestObject struct {
+	Provider string `json:"provider"`
+	Params   HandleOIDCCallbackParams
+}
+
+type HandleOIDCCallbackResponseObject interface {
+	VisitHandleOIDCCallbackResponse(w http.ResponseWriter) error
+}
+
+type HandleOIDCCallback302ResponseHeaders struct {
+	Location  string
+	SetCookie string
+}
+
+type HandleOIDCCallback302Response struct {
+	Headers HandleOIDCCallback302ResponseHeaders
+}
+
+func (response HandleOIDCCallback302Response) VisitHandleOIDCCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(302)
+	return nil
+}
+
+type StartOIDCLoginRequestObject struct {
+	Provider string `json:"provider"`
+}
+
+type StartOIDCLoginResponseObject interface {
+	VisitStartOIDCLoginResponse(w http.ResponseWriter) error
+}
+
+type StartOIDCLogin302ResponseHeaders struct {
+	Location  string
+	SetCookie string
+}
+
+type StartOIDCLogin302Response struct {
+	Headers StartOIDCLogin302ResponseHeaders
+}
+
+func (response StartOIDCLogin302Response) VisitStartOIDCLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(302)
+	return nil
+}
+
+type StartOIDCLogin404ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response StartOIDCLogin404ApplicationProblemPlusJSONResponse) VisitStartOIDCLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmPasswordResetRequestObject struct {
+	Body *ConfirmPasswordResetJSONRequestBody
+}
+
+type ConfirmPasswordResetResponseObject interface {
+	VisitConfirmPasswordResetResponse(w http.ResponseWriter) error
+}
+
+type ConfirmPasswordReset204Response struct {
+}
+
+func (response ConfirmPasswordReset204Response) VisitConfirmPasswordResetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type ConfirmPasswordReset400ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response ConfirmPasswordReset400ApplicationProblemPlusJSONResponse) VisitConfirmPasswordResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestPasswordResetRequestObject struct {
+	Body *RequestPasswordResetJSONRequestBody
+}
+
+type RequestPasswordResetResponseObject interface {
+	VisitRequestPasswordResetResponse(w http.ResponseWriter) error
+}
+
+type RequestPasswordReset202Response struct {
+}
+
+func (response RequestPasswordReset202Response) VisitRequestPasswordResetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(202)
+	return nil
+}
+
+type RequestPasswordReset400ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response RequestPasswordReset400ApplicationProblemPlusJSONResponse) VisitRequestPasswordResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateUserRequestObject struct {
+	Body *CreateUserJSONRequestBody
+}
+
+type CreateUserResponseObject interface {
+	VisitCreateUserResponse(w http.ResponseWriter) error
+}
+
+type CreateUser201JSONResponse struct{ UserResponseJSONResponse }
+
+func (response CreateUser201JSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateUser400ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response CreateUser400ApplicationProblemPlusJSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnlockAccountRequestObject struct {
+	Body *UnlockAccountJSONRequestBody
+}
+
+type UnlockAccountResponseObject interface {
+	VisitUnlockAccountResponse(w http.ResponseWriter) error
+}
+
+type UnlockAccount204Response struct {
+}
+
+func (response UnlockAccount204Response) VisitUnlockAccountResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type UnlockAccount400ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response UnlockAccount400ApplicationProblemPlusJSONResponse) VisitUnlockAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyEmailRequestObject struct {
+	Body *VerifyEmailJSONRequestBody
+}
+
+type VerifyEmailResponseObject interface {
+	VisitVerifyEmailResponse(w http.ResponseWriter) error
+}
+
+type VerifyEmail204Response struct {
+}
+
+func (response VerifyEmail204Response) VisitVerifyEmailResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type VerifyEmail400ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response VerifyEmail400ApplicationProblemPlusJSONResponse) VisitVerifyEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCategoriesRequestObject struct {
+}
+
+type GetCategoriesResponseObject interface {
+	VisitGetCategoriesResponse(w http.ResponseWriter) error
+}
+
+type GetCategories200JSONResponse []CategoryRequest
+
+func (response GetCategories200JSONResponse) VisitGetCategoriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCategories400ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response GetCategories400ApplicationProblemPlusJSONResponse) VisitGetCategoriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCategoryRequestObject struct {
+	Params CreateCategoryParams
+	Body   *CreateCategoryJSONRequestBody
+}
+
+type CreateCategoryResponseObject interface {
+	VisitCreateCategoryResponse(w http.ResponseWriter) error
+}
+
+type CreateCategory201JSONResponse struct{ CategoryResponseJSONResponse }
+
+func (response CreateCategory201JSONResponse) VisitCreateCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateCategory400ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response CreateCategory400ApplicationProblemPlusJSONResponse) VisitCreateCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCategory409ApplicationProblemPlusJSONResponse Problem
+
+func (response CreateCategory409ApplicationProblemPlusJSONResponse) VisitCreateCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCategory422ApplicationProblemPlusJSONResponse Problem
+
+func (response CreateCategory422ApplicationProblemPlusJSONResponse) VisitCreateCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCategoryByIdRequestObject struct {
+	Id     int `json:"id"`
+	Params DeleteCategoryByIdParams
+}
+
+type DeleteCategoryByIdResponseObject interface {
+	VisitDeleteCategoryByIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteCategoryById204Response struct {
+}
+
+func (response DeleteCategoryById204Response) VisitDeleteCategoryByIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteCategoryById400ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteCategoryById400ApplicationProblemPlusJSONResponse) VisitDeleteCategoryByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCategoryById404ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteCategoryById404ApplicationProblemPlusJSONResponse) VisitDeleteCategoryByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCategoryById412ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteCategoryById412ApplicationProblemPlusJSONResponse) VisitDeleteCategoryByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCategoryById428ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteCategoryById428ApplicationProblemPlusJSONResponse) VisitDeleteCategoryByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(428)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCategoryByIdRequestObject struct {
+	Id int `json:"id"`
+}
+
+type GetCategoryByIdResponseObject interface {
+	VisitGetCategoryByIdResponse(w http.ResponseWriter) error
+}
+
+type GetCategoryById200JSONResponse struct{ CategoryResponseJSONResponse }
+
+func (response GetCategoryById200JSONResponse) VisitGetCategoryByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetCategoryById400ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response GetCategoryById400ApplicationProblemPlusJSONResponse) VisitGetCategoryByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCategoryById404ApplicationProblemPlusJSONResponse Problem
+
+func (response GetCategoryById404ApplicationProblemPlusJSONResponse) VisitGetCategoryByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCategoryByIdRequestObject struct {
+	Id     int `json:"id"`
+	Params UpdateCategoryByIdParams
+	Body   *UpdateCategoryByIdJSONRequestBody
+}
+
+type UpdateCategoryByIdResponseObject interface {
+	VisitUpdateCategoryByIdResponse(w http.ResponseWriter) error
+}
+
+type UpdateCategoryById200JSONResponse struct{ CategoryResponseJSONResponse }
+
+func (response UpdateCategoryById200JSONResponse) VisitUpdateCategoryByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateCategoryById400ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateCategoryById400ApplicationProblemPlusJSONResponse) VisitUpdateCategoryByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCategoryById404ApplicationProblemPlusJSONResponse Problem
+
+func (response UpdateCategoryById404ApplicationProblemPlusJSONResponse) VisitUpdateCategoryByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCategoryById412ApplicationProblemPlusJSONResponse Problem
+
+func (response UpdateCategoryById412ApplicationProblemPlusJSONResponse) VisitUpdateCategoryByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCategoryById422ApplicationProblemPlusJSONResponse Problem
+
+func (response UpdateCategoryById422ApplicationProblemPlusJSONResponse) VisitUpdateCategoryByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCategoryById428ApplicationProblemPlusJSONResponse Problem
+
+func (response UpdateCategoryById428ApplicationProblemPlusJSONResponse) VisitUpdateCategoryByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(428)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamEventsRequestObject struct {
+	Params StreamEventsParams
+}
+
+type StreamEventsResponseObject interface {
+	VisitStreamEventsResponse(w http.ResponseWriter) error
+}
+
+type StreamEvents200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response StreamEvents200TexteventStreamResponse) VisitStreamEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type StreamEvents400ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response StreamEvents400ApplicationProblemPlusJSONResponse) VisitStreamEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMonthlySummariesRequestObject struct {
+}
+
+type GetMonthlySummariesResponseObject interface {
+	VisitGetMonthlySummariesResponse(w http.ResponseWriter) error
+}
+
+type GetMonthlySummaries200JSONResponse []MonthlySummaryRequest
+
+func (response GetMonthlySummaries200JSONResponse) VisitGetMonthlySummariesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMonthlySummaries400ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response GetMonthlySummaries400ApplicationProblemPlusJSONResponse) VisitGetMonthlySummariesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateMonthlySummaryRequestObject struct {
+	Params CreateMonthlySummaryParams
+	Body   *CreateMonthlySummaryJSONRequestBody
+}
+
+type CreateMonthlySummaryResponseObject interface {
+	VisitCreateMonthlySummaryResponse(w http.ResponseWriter) error
+}
+
+type CreateMonthlySummary201JSONResponse struct {
+	MonthlySummaryResponseJSONResponse
+}
+
+func (response CreateMonthlySummary201JSONResponse) VisitCreateMonthlySummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateMonthlySummary400ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response CreateMonthlySummary400ApplicationProblemPlusJSONResponse) VisitCreateMonthlySummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateMonthlySummary409ApplicationProblemPlusJSONResponse Problem
+
+func (response CreateMonthlySummary409ApplicationProblemPlusJSONResponse) VisitCreateMonthlySummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateMonthlySummary422ApplicationProblemPlusJSONResponse Problem
+
+func (response CreateMonthlySummary422ApplicationProblemPlusJSONResponse) VisitCreateMonthlySummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteMonthlySummaryByIdRequestObject struct {
+	Id     int `json:"id"`
+	Params DeleteMonthlySummaryByIdParams
+}
+
+type DeleteMonthlySummaryByIdResponseObject interface {
+	VisitDeleteMonthlySummaryByIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteMonthlySummaryById204Response struct {
+}
+
+func (response DeleteMonthlySummaryById204Response) VisitDeleteMonthlySummaryByIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteMonthlySummaryById400ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteMonthlySummaryById400ApplicationProblemPlusJSONResponse) VisitDeleteMonthlySummaryByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteMonthlySummaryById404ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteMonthlySummaryById404ApplicationProblemPlusJSONResponse) VisitDeleteMonthlySummaryByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteMonthlySummaryById412ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteMonthlySummaryById412ApplicationProblemPlusJSONResponse) VisitDeleteMonthlySummaryByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteMonthlySummaryById428ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteMonthlySummaryById428ApplicationProblemPlusJSONResponse) VisitDeleteMonthlySummaryByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(428)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMonthlySummaryByIdRequestObject struct {
+	Id int `json:"id"`
+}
+
+type GetMonthlySummaryByIdResponseObject interface {
+	VisitGetMonthlySummaryByIdResponse(w http.ResponseWriter) error
+}
+
+type GetMonthlySummaryById200JSONResponse struct {
+	MonthlySummaryResponseJSONResponse
+}
+
+func (response GetMonthlySummaryById200JSONResponse) VisitGetMonthlySummaryByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetMonthlySummaryById400ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response GetMonthlySummaryById400ApplicationProblemPlusJSONResponse) VisitGetMonthlySummaryByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMonthlySummaryById404ApplicationProblemPlusJSONResponse Problem
+
+func (response GetMonthlySummaryById404ApplicationProblemPlusJSONResponse) VisitGetMonthlySummaryByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateMonthlySummaryByIdRequestObject struct {
+	Id     int `json:"id"`
+	Params UpdateMonthlySummaryByIdParams
+	Body   *UpdateMonthlySummaryByIdJSONRequestBody
+}
+
+type UpdateMonthlySummaryByIdResponseObject interface {
+	VisitUpdateMonthlySummaryByIdResponse(w http.ResponseWriter) error
+}
+
+type UpdateMonthlySummaryById200JSONResponse struct {
+	MonthlySummaryResponseJSONResponse
+}
+
+func (response UpdateMonthlySummaryById200JSONResponse) VisitUpdateMonthlySummaryByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateMonthlySummaryById400ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateMonthlySummaryById400ApplicationProblemPlusJSONResponse) VisitUpdateMonthlySummaryByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateMonthlySummaryById404ApplicationProblemPlusJSONResponse Problem
+
+func (response UpdateMonthlySummaryById404ApplicationProblemPlusJSONResponse) VisitUpdateMonthlySummaryByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateMonthlySummaryById412ApplicationProblemPlusJSONResponse Problem
+
+func (response UpdateMonthlySummaryById412ApplicationProblemPlusJSONResponse) VisitUpdateMonthlySummaryByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateMonthlySummaryById422ApplicationProblemPlusJSONResponse Problem
+
+func (response UpdateMonthlySummaryById422ApplicationProblemPlusJSONResponse) VisitUpdateMonthlySummaryByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateMonthlySummaryById428ApplicationProblemPlusJSONResponse Problem
+
+func (response UpdateMonthlySummaryById428ApplicationProblemPlusJSONResponse) VisitUpdateMonthlySummaryByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(428)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransactionsRequestObject struct {
+}
+
+type GetTransactionsResponseObject interface {
+	VisitGetTransactionsResponse(w http.ResponseWriter) error
+}
+
+type GetTransactions200JSONResponse []TransactionRequest
+
+func (response GetTransactions200JSONResponse) VisitGetTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransactions400ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response GetTransactions400ApplicationProblemPlusJSONResponse) VisitGetTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTransactionRequestObject struct {
+	Params CreateTransactionParams
+	Body   *CreateTransactionJSONRequestBody
+}
+
+type CreateTransactionResponseObject interface {
+	VisitCreateTransactionResponse(w http.ResponseWriter) error
+}
+
+type CreateTransaction201JSONResponse struct {
+	TransactionResponseJSONResponse
+}
+
+func (response CreateTransaction201JSONResponse) VisitCreateTransactionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateTransaction400ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response CreateTransaction400ApplicationProblemPlusJSONResponse) VisitCreateTransactionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTransaction409ApplicationProblemPlusJSONResponse Problem
+
+func (response CreateTransaction409ApplicationProblemPlusJSONResponse) VisitCreateTransactionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTransaction422ApplicationProblemPlusJSONResponse Problem
+
+func (response CreateTransaction422ApplicationProblemPlusJSONResponse) VisitCreateTransactionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BulkTransactionsRequestObject struct {
+	Params BulkTransactionsParams
+	Body   *BulkTransactionsJSONRequestBody
+}
+
+type BulkTransactionsResponseObject interface {
+	VisitBulkTransactionsResponse(w http.ResponseWriter) error
+}
+
+type BulkTransactions200JSONResponse struct {
+	TransactionBulkResponseJSONResponse
+}
+
+func (response BulkTransactions200JSONResponse) VisitBulkTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BulkTransactions400ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response BulkTransactions400ApplicationProblemPlusJSONResponse) VisitBulkTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BulkTransactions409ApplicationProblemPlusJSONResponse Problem
+
+func (response BulkTransactions409ApplicationProblemPlusJSONResponse) VisitBulkTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BulkTransactions422JSONResponse TransactionBulkResultList
+
+func (response BulkTransactions422JSONResponse) VisitBulkTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTransactionByIdRequestObject struct {
+	Id     int `json:"id"`
+	Params DeleteTransactionByIdParams
+}
+
+type DeleteTransactionByIdResponseObject interface {
+	VisitDeleteTransactionByIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteTransactionById204Response struct {
+}
+
+func (response DeleteTransactionById204Response) VisitDeleteTransactionByIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteTransactionById400ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteTransactionById400ApplicationProblemPlusJSONResponse) VisitDeleteTransactionByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTransactionById404ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteTransactionById404ApplicationProblemPlusJSONResponse) VisitDeleteTransactionByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTransactionById412ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteTransactionById412ApplicationProblemPlusJSONResponse) VisitDeleteTransactionByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTransactionById428ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteTransactionById428ApplicationProblemPlusJSONResponse) VisitDeleteTransactionByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(428)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransactionByIdRequestObject struct {
+	Id int `json:"id"`
+}
+
+type GetTransactionByIdResponseObject interface {
+	VisitGetTransactionByIdResponse(w http.ResponseWriter) error
+}
+
+type GetTransactionById200JSONResponse struct {
+	TransactionResponseJSONResponse
+}
+
+func (response GetTransactionById200JSONResponse) VisitGetTransactionByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTransactionById400ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response GetTransactionById400ApplicationProblemPlusJSONResponse) VisitGetTransactionByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransactionById404ApplicationProblemPlusJSONResponse Problem
+
+func (response GetTransactionById404ApplicationProblemPlusJSONResponse) VisitGetTransactionByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTransactionByIdRequestObject struct {
+	Id     int `json:"id"`
+	Params UpdateTransactionByIdParams
+	Body   *UpdateTransactionByIdJSONRequestBody
+}
+
+type UpdateTransactionByIdResponseObject interface {
+	VisitUpdateTransactionByIdResponse(w http.ResponseWriter) error
+}
+
+type UpdateTransactionById200JSONResponse struct {
+	TransactionResponseJSONResponse
+}
+
+func (response UpdateTransactionById200JSONResponse) VisitUpdateTransactionByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateTransactionById400ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateTransactionById400ApplicationProblemPlusJSONResponse) VisitUpdateTransactionByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTransactionById404ApplicationProblemPlusJSONResponse Problem
+
+func (response UpdateTransactionById404ApplicationProblemPlusJSONResponse) VisitUpdateTransactionByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTransactionById412ApplicationProblemPlusJSONResponse Problem
+
+func (response UpdateTransactionById412ApplicationProblemPlusJSONResponse) VisitUpdateTransactionByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTransactionById422ApplicationProblemPlusJSONResponse Problem
+
+func (response UpdateTransactionById422ApplicationProblemPlusJSONResponse) VisitUpdateTransactionByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTransactionById428ApplicationProblemPlusJSONResponse Problem
+
+func (response UpdateTransactionById428ApplicationProblemPlusJSONResponse) VisitUpdateTransactionByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(428)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCurrentUserRequestObject struct {
+}
+
+type DeleteCurrentUserResponseObject interface {
+	VisitDeleteCurrentUserResponse(w http.ResponseWriter) error
+}
+
+type DeleteCurrentUser202JSONResponse struct {
+	AccountDeletionResponseJSONResponse
+}
+
+func (response DeleteCurrentUser202JSONResponse) VisitDeleteCurrentUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCurrentUser409ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteCurrentUser409ApplicationProblemPlusJSONResponse) VisitDeleteCurrentUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCurrentUserRequestObject struct {
+}
+
+type GetCurrentUserResponseObject interface {
+	VisitGetCurrentUserResponse(w http.ResponseWriter) error
+}
+
+type GetCurrentUser200JSONResponse struct{ UserResponseJSONResponse }
+
+func (response GetCurrentUser200JSONResponse) VisitGetCurrentUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCurrentUser400ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response GetCurrentUser400ApplicationProblemPlusJSONResponse) VisitGetCurrentUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCurrentUser404ApplicationProblemPlusJSONResponse Problem
+
+func (response GetCurrentUser404ApplicationProblemPlusJSONResponse) VisitGetCurrentUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCurrentUserRequestObject struct {
+	Body *UpdateCurrentUserJSONRequestBody
+}
+
+type UpdateCurrentUserResponseObject interface {
+	VisitUpdateCurrentUserResponse(w http.ResponseWriter) error
+}
+
+type UpdateCurrentUser200JSONResponse struct{ UserResponseJSONResponse }
+
+func (response UpdateCurrentUser200JSONResponse) VisitUpdateCurrentUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCurrentUser400ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateCurrentUser400ApplicationProblemPlusJSONResponse) VisitUpdateCurrentUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCurrentUser404ApplicationProblemPlusJSONResponse Problem
+
+func (response UpdateCurrentUser404ApplicationProblemPlusJSONResponse) VisitUpdateCurrentUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelAccountDeletionRequestObject struct {
+}
+
+type CancelAccountDeletionResponseObject interface {
+	VisitCancelAccountDeletionResponse(w http.ResponseWriter) error
+}
+
+type CancelAccountDeletion204Response struct {
+}
+
+func (response CancelAccountDeletion204Response) VisitCancelAccountDeletionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type CancelAccountDeletion404ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response CancelAccountDeletion404ApplicationProblemPlusJSONResponse) VisitCancelAccountDeletionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAccountDeletionRequestObject struct {
+}
+
+type GetAccountDeletionResponseObject interface {
+	VisitGetAccountDeletionResponse(w http.ResponseWriter) error
+}
+
+type GetAccountDeletion200JSONResponse struct {
+	AccountDeletionResponseJSONResponse
+}
+
+func (response GetAccountDeletion200JSONResponse) VisitGetAccountDeletionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAccountDeletion404ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response GetAccountDeletion404ApplicationProblemPlusJSONResponse) VisitGetAccountDeletionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestDataExportRequestObject struct {
+}
+
+type RequestDataExportResponseObject interface {
+	VisitRequestDataExportResponse(w http.ResponseWriter) error
+}
+
+type RequestDataExport202JSONResponse struct{ DataExportResponseJSONResponse }
+
+func (response RequestDataExport202JSONResponse) VisitRequestDataExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestDataExport409ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response RequestDataExport409ApplicationProblemPlusJSONResponse) VisitRequestDataExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestDataExport429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsResponseApplicationProblemPlusJSONResponse
+}
+
+func (response RequestDataExport429ApplicationProblemPlusJSONResponse) VisitRequestDataExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetDataExportsRequestObject struct {
+}
+
+type GetDataExportsResponseObject interface {
+	VisitGetDataExportsResponse(w http.ResponseWriter) error
+}
+
+type GetDataExports200JSONResponse []DataExportRequest
+
+func (response GetDataExports200JSONResponse) VisitGetDataExportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDataExportRequestObject struct {
+	Id int `json:"id"`
+}
+
+type GetDataExportResponseObject interface {
+	VisitGetDataExportResponse(w http.ResponseWriter) error
+}
+
+type GetDataExport200JSONResponse struct{ DataExportResponseJSONResponse }
+
+func (response GetDataExport200JSONResponse) VisitGetDataExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDataExport404ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response GetDataExport404ApplicationProblemPlusJSONResponse) VisitGetDataExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DownloadDataExportRequestObject struct {
+	Id int `json:"id"`
+}
+
+type DownloadDataExportResponseObject interface {
+	VisitDownloadDataExportResponse(w http.ResponseWriter) error
+}
+
+type DownloadDataExport200ResponseHeaders struct {
+	CacheControl       string
+	ContentDisposition string
+}
+
+type DownloadDataExport200ApplicationzipResponse struct {
+	Body          io.Reader
+	Headers       DownloadDataExport200ResponseHeaders
+	ContentLength int64
+}
+
+func (response DownloadDataExport200ApplicationzipResponse) VisitDownloadDataExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/zip")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type DownloadDataExport404ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response DownloadDataExport404ApplicationProblemPlusJSONResponse) VisitDownloadDataExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DownloadDataExport409ApplicationProblemPlusJSONResponse Problem
+
+func (response DownloadDataExport409ApplicationProblemPlusJSONResponse) VisitDownloadDataExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DownloadDataExport410ApplicationProblemPlusJSONResponse Problem
+
+func (response DownloadDataExport410ApplicationProblemPlusJSONResponse) VisitDownloadDataExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(410)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserIdentitiesRequestObject struct {
+}
+
+type GetUserIdentitiesResponseObject interface {
+	VisitGetUserIdentitiesResponse(w http.ResponseWriter) error
+}
+
+type GetUserIdentities200JSONResponse []UserIdentityRequest
+
+func (response GetUserIdentities200JSONResponse) VisitGetUserIdentitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserIdentities401ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response GetUserIdentities401ApplicationProblemPlusJSONResponse) VisitGetUserIdentitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnlinkUserIdentityRequestObject struct {
+	Provider string `json:"provider"`
+}
+
+type UnlinkUserIdentityResponseObject interface {
+	VisitUnlinkUserIdentityResponse(w http.ResponseWriter) error
+}
+
+type UnlinkUserIdentity204Response struct {
+}
+
+func (response UnlinkUserIdentity204Response) VisitUnlinkUserIdentityResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type UnlinkUserIdentity404ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response UnlinkUserIdentity404ApplicationProblemPlusJSONResponse) VisitUnlinkUserIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LinkUserIdentityRequestObject struct {
+	Provider string `json:"provider"`
+}
+
+type LinkUserIdentityResponseObject interface {
+	VisitLinkUserIdentityResponse(w http.ResponseWriter) error
+}
+
+type LinkUserIdentity200JSONResponse struct {
+	OIDCAuthorizationResponseJSONResponse
+}
+
+func (response LinkUserIdentity200JSONResponse) VisitLinkUserIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type LinkUserIdentity404ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response LinkUserIdentity404ApplicationProblemPlusJSONResponse) VisitLinkUserIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPersonalAccessTokensRequestObject struct {
+}
+
+type GetPersonalAccessTokensResponseObject interface {
+	VisitGetPersonalAccessTokensResponse(w http.ResponseWriter) error
+}
+
+type GetPersonalAccessTokens200JSONResponse []PersonalAccessTokenRequest
+
+func (response GetPersonalAccessTokens200JSONResponse) VisitGetPersonalAccessTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePersonalAccessTokenRequestObject struct {
+	Body *CreatePersonalAccessTokenJSONRequestBody
+}
+
+type CreatePersonalAccessTokenResponseObject interface {
+	VisitCreatePersonalAccessTokenResponse(w http.ResponseWriter) error
+}
+
+type CreatePersonalAccessToken201JSONResponse struct {
+	PersonalAccessTokenResponseJSONResponse
+}
+
+func (response CreatePersonalAccessToken201JSONResponse) VisitCreatePersonalAccessTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePersonalAccessToken400ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response CreatePersonalAccessToken400ApplicationProblemPlusJSONResponse) VisitCreatePersonalAccessTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePersonalAccessTokenRequestObject struct {
+	Id int `json:"id"`
+}
+
+type DeletePersonalAccessTokenResponseObject interface {
+	VisitDeletePersonalAccessTokenResponse(w http.ResponseWriter) error
+}
+
+type DeletePersonalAccessToken204Response struct {
+}
+
+func (response DeletePersonalAccessToken204Response) VisitDeletePersonalAccessTokenResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeletePersonalAccessToken404ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response DeletePersonalAccessToken404ApplicationProblemPlusJSONResponse) VisitDeletePersonalAccessTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmTOTPRequestObject struct {
+	Body *ConfirmTOTPJSONRequestBody
+}
+
+type ConfirmTOTPResponseObject interface {
+	VisitConfirmTOTPResponse(w http.ResponseWriter) error
+}
+
+type ConfirmTOTP200JSONResponse struct {
+	RecoveryCodesResponseJSONResponse
+}
+
+func (response ConfirmTOTP200JSONResponse) VisitConfirmTOTPResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmTOTP400ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response ConfirmTOTP400ApplicationProblemPlusJSONResponse) VisitConfirmTOTPResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmTOTP409ApplicationProblemPlusJSONResponse Problem
+
+func (response ConfirmTOTP409ApplicationProblemPlusJSONResponse) VisitConfirmTOTPResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DisableTOTPRequestObject struct {
+	Body *DisableTOTPJSONRequestBody
+}
+
+type DisableTOTPResponseObject interface {
+	VisitDisableTOTPResponse(w http.ResponseWriter) error
+}
+
+type DisableTOTP204Response struct {
+}
+
+func (response DisableTOTP204Response) VisitDisableTOTPResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DisableTOTP400ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response DisableTOTP400ApplicationProblemPlusJSONResponse) VisitDisableTOTPResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DisableTOTP409ApplicationProblemPlusJSONResponse Problem
+
+func (response DisableTOTP409ApplicationProblemPlusJSONResponse) VisitDisableTOTPResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DisableTOTP429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsResponseApplicationProblemPlusJSONResponse
+}
+
+func (response DisableTOTP429ApplicationProblemPlusJSONResponse) VisitDisableTOTPResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type EnrollTOTPRequestObject struct {
+}
+
+type EnrollTOTPResponseObject interface {
+	VisitEnrollTOTPResponse(w http.ResponseWriter) error
+}
+
+type EnrollTOTP200JSONResponse struct {
+	TOTPEnrollmentResponseJSONResponse
+}
+
+func (response EnrollTOTP200JSONResponse) VisitEnrollTOTPResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EnrollTOTP409ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response EnrollTOTP409ApplicationProblemPlusJSONResponse) VisitEnrollTOTPResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegenerateRecoveryCodesRequestObject struct {
+	Body *RegenerateRecoveryCodesJSONRequestBody
+}
+
+type RegenerateRecoveryCodesResponseObject interface {
+	VisitRegenerateRecoveryCodesResponse(w http.ResponseWriter) error
+}
+
+type RegenerateRecoveryCodes200JSONResponse struct {
+	RecoveryCodesResponseJSONResponse
+}
+
+func (response RegenerateRecoveryCodes200JSONResponse) VisitRegenerateRecoveryCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegenerateRecoveryCodes400ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response RegenerateRecoveryCodes400ApplicationProblemPlusJSONResponse) VisitRegenerateRecoveryCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegenerateRecoveryCodes409ApplicationProblemPlusJSONResponse Problem
+
+func (response RegenerateRecoveryCodes409ApplicationProblemPlusJSONResponse) VisitRegenerateRecoveryCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegenerateRecoveryCodes429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsResponseApplicationProblemPlusJSONResponse
+}
+
+func (response RegenerateRecoveryCodes429ApplicationProblemPlusJSONResponse) VisitRegenerateRecoveryCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetWebhooksRequestObject struct {
+}
+
+type GetWebhooksResponseObject interface {
+	VisitGetWebhooksResponse(w http.ResponseWriter) error
+}
+
+type GetWebhooks200JSONResponse []WebhookRequest
+
+func (response GetWebhooks200JSONResponse) VisitGetWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooks400ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response GetWebhooks400ApplicationProblemPlusJSONResponse) VisitGetWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhookRequestObject struct {
+	Params CreateWebhookParams
+	Body   *CreateWebhookJSONRequestBody
+}
+
+type CreateWebhookResponseObject interface {
+	VisitCreateWebhookResponse(w http.ResponseWriter) error
+}
+
+type CreateWebhook201JSONResponse struct{ WebhookResponseJSONResponse }
+
+func (response CreateWebhook201JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook400ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response CreateWebhook400ApplicationProblemPlusJSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook409ApplicationProblemPlusJSONResponse Problem
+
+func (response CreateWebhook409ApplicationProblemPlusJSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook422ApplicationProblemPlusJSONResponse Problem
+
+func (response CreateWebhook422ApplicationProblemPlusJSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhookByIdRequestObject struct {
+	Id int `json:"id"`
+}
+
+type DeleteWebhookByIdResponseObject interface {
+	VisitDeleteWebhookByIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteWebhookById204Response struct {
+}
+
+func (response DeleteWebhookById204Response) VisitDeleteWebhookByIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteWebhookById400ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteWebhookById400ApplicationProblemPlusJSONResponse) VisitDeleteWebhookByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetMonthlySummaryByIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MonthlySummaryResponse
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
+type DeleteWebhookById404ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteWebhookById404ApplicationProblemPlusJSONResponse) VisitDeleteWebhookByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetMonthlySummaryByIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type GetWebhookByIdRequestObject struct {
+	Id int `json:"id"`
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetMonthlySummaryByIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+type GetWebhookByIdResponseObject interface {
+	VisitGetWebhookByIdResponse(w http.ResponseWriter) error
 }
 
-type UpdateMonthlySummaryByIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MonthlySummaryResponse
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
+type GetWebhookById200JSONResponse struct{ WebhookResponseJSONResponse }
+
+func (response GetWebhookById200JSONResponse) VisitGetWebhookByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateMonthlySummaryByIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type GetWebhookById400ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateMonthlySummaryByIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+func (response GetWebhookById400ApplicationProblemPlusJSONResponse) VisitGetWebhookByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetTransactionsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TransactionResponse
-	JSON400      *ErrorResponse
+type GetWebhookById404ApplicationProblemPlusJSONResponse Problem
+
+func (response GetWebhookById404ApplicationProblemPlusJSONResponse) VisitGetWebhookByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetTransactionsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type UpdateWebhookByIdRequestObject struct {
+	Id   int `json:"id"`
+	Body *UpdateWebhookByIdJSONRequestBody
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetTransactionsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+type UpdateWebhookByIdResponseObject interface {
+	VisitUpdateWebhookByIdResponse(w http.ResponseWriter) error
 }
 
-type CreateTransactionResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *TransactionResponse
-	JSON400      *ErrorResponse
+type UpdateWebhookById200JSONResponse struct{ WebhookResponseJSONResponse }
+
+func (response UpdateWebhookById200JSONResponse) VisitUpdateWebhookByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateTransactionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type UpdateWebhookById400ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateTransactionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+func (response UpdateWebhookById400ApplicationProblemPlusJSONResponse) VisitUpdateWebhookByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteTransactionByIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
+type UpdateWebhookById404ApplicationProblemPlusJSONResponse Problem
+
+func (response UpdateWebhookById404ApplicationProblemPlusJSONResponse) VisitUpdateWebhookByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteTransactionByIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type GetWebhookDeliveriesRequestObject struct {
+	Id int `json:"id"`
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteTransactionByIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+type GetWebhookDeliveriesResponseObject interface {
+	VisitGetWebhookDeliveriesResponse(w http.ResponseWriter) error
 }
 
-type GetTransactionByIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TransactionResponse
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
+type GetWebhookDeliveries200JSONResponse []WebhookDeliveryRequest
+
+func (response GetWebhookDeliveries200JSONResponse) VisitGetWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetTransactionByIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type GetWebhookDeliveries400ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetTransactionByIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+func (response GetWebhookDeliveries400ApplicationProblemPlusJSONResponse) VisitGetWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateTransactionByIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TransactionResponse
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
+type GetWebhookDeliveries404ApplicationProblemPlusJSONResponse Problem
+
+func (response GetWebhookDeliveries404ApplicationProblemPlusJSONResponse) VisitGetWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateTransactionByIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type ReplayWebhookDeliveryRequestObject struct {
+	Id         int `json:"id"`
+	DeliveryId int `json:"deliveryId"`
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateTransactionByIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+type ReplayWebhookDeliveryResponseObject interface {
+	VisitReplayWebhookDeliveryResponse(w http.ResponseWriter) error
 }
 
-type DeleteCurrentUserResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
+type ReplayWebhookDelivery201JSONResponse struct {
+	WebhookDeliveryResponseJSONResponse
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteCurrentUserResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+func (response ReplayWebhookDelivery201JSONResponse) VisitReplayWebhookDeliveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteCurrentUserResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+type ReplayWebhookDelivery400ApplicationProblemPlusJSONResponse struct {
+	ErrorResponseApplicationProblemPlusJSONResponse
 }
 
-type GetCurrentUserResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UserResponse
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
+func (response ReplayWebhookDelivery400ApplicationProblemPlusJSONResponse) VisitReplayWebhookDeliveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetCurrentUserResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type ReplayWebhookDelivery404ApplicationProblemPlusJSONResponse Problem
+
+func (response ReplayWebhookDelivery404ApplicationProblemPlusJSONResponse) VisitReplayWebhookDeliveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetCurrentUserResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Get the public keys to verify authentication tokens (JWKS)
+	// (GET /.well-known/jwks.json)
+	GetJWKS(ctx context.Context, request GetJWKSRequestObject) (GetJWKSResponseObject, error)
+	// Get the audit log of administrator actions
+	// (GET /admin/audit-logs)
+	AdminGetAuditLogs(ctx context.Context, request AdminGetAuditLogsRequestObject) (AdminGetAuditLogsResponseObject, error)
+	// Get system statistics
+	// (GET /admin/stats)
+	AdminGetSystemStats(ctx context.Context, request AdminGetSystemStatsRequestObject) (AdminGetSystemStatsResponseObject, error)
+	// Search users
+	// (GET /admin/users)
+	AdminSearchUsers(ctx context.Context, request AdminSearchUsersRequestObject) (AdminSearchUsersResponseObject, error)
+	// Get a user
+	// (GET /admin/users/{id})
+	AdminGetUser(ctx context.Context, request AdminGetUserRequestObject) (AdminGetUserResponseObject, error)
+	// Disable a user and end all of their sessions
+	// (POST /admin/users/{id}/disable)
+	AdminDisableUser(ctx context.Context, request AdminDisableUserRequestObject) (AdminDisableUserResponseObject, error)
+	// Enable a disabled user
+	// (POST /admin/users/{id}/enable)
+	AdminEnableUser(ctx context.Context, request AdminEnableUserRequestObject) (AdminEnableUserResponseObject, error)
+	// Log in as a user for support
+	// (POST /admin/users/{id}/impersonate)
+	AdminImpersonateUser(ctx context.Context, request AdminImpersonateUserRequestObject) (AdminImpersonateUserResponseObject, error)
+	// End all sessions of a user
+	// (POST /admin/users/{id}/logout)
+	AdminForceLogout(ctx context.Context, request AdminForceLogoutRequestObject) (AdminForceLogoutResponseObject, error)
+	// Change the role of a user
+	// (PUT /admin/users/{id}/role)
+	AdminUpdateUserRole(ctx context.Context, request AdminUpdateUserRoleRequestObject) (AdminUpdateUserRoleResponseObject, error)
+	// Confirm an account deletion request
+	// (POST /auth/confirm-deletion)
+	ConfirmAccountDeletion(ctx context.Context, request ConfirmAccountDeletionRequestObject) (ConfirmAccountDeletionResponseObject, error)
+	// Get a CSRF token
+	// (GET /auth/csrf)
+	GetCsrfToken(ctx context.Context, request GetCsrfTokenRequestObject) (GetCsrfTokenResponseObject, error)
+	// Log in a user
+	// (POST /auth/login)
+	LoginUser(ctx context.Context, request LoginUserRequestObject) (LoginUserResponseObject, error)
+	// Complete a two-step login with a TOTP or recovery code
+	// (POST /auth/login/totp)
+	LoginUserWithTOTP(ctx context.Context, request LoginUserWithTOTPRequestObject) (LoginUserWithTOTPResponseObject, error)
+	// Log out a user
+	// (POST /auth/logout)
+	LogoutUser(ctx context.Context, request LogoutUserRequestObject) (LogoutUserResponseObject, error)
+	// Get the configured OpenID Connect providers
+	// (GET /auth/oidc/providers)
+	GetOIDCProviders(ctx context.Context, request GetOIDCProvidersRequestObject) (GetOIDCProvidersResponseObject, error)
+	// Callback from the OpenID Connect provider
+	// (GET /auth/oidc/{provider}/callback)
+	HandleOIDCCallback(ctx context.Context, request HandleOIDCCallbackRequestObject) (HandleOIDCCallbackResponseObject, error)
+	// Start an OpenID Connect login (authorization code flow with PKCE)
+	// (GET /auth/oidc/{provider}/login)
+	StartOIDCLogin(ctx context.Context, request StartOIDCLoginRequestObject) (StartOIDCLoginResponseObject, error)
+	// Reset the password with the token sent by email
+	// (POST /auth/password-reset/confirm)
+	ConfirmPasswordReset(ctx context.Context, request ConfirmPasswordResetRequestObject) (ConfirmPasswordResetResponseObject, error)
+	// Send a password reset email
+	// (POST /auth/password-reset/request)
+	RequestPasswordReset(ctx context.Context, request RequestPasswordResetRequestObject) (RequestPasswordResetResponseObject, error)
+	// Create a new user
+	// (POST /auth/signup)
+	CreateUser(ctx context.Context, request CreateUserRequestObject) (CreateUserResponseObject, error)
+	// Unlock an account locked after repeated login failures
+	// (POST /auth/unlock)
+	UnlockAccount(ctx context.Context, request UnlockAccountRequestObject) (UnlockAccountResponseObject, error)
+	// Verify the email address with the token sent by email
+	// (POST /auth/verify-email)
+	VerifyEmail(ctx context.Context, request VerifyEmailRequestObject) (VerifyEmailResponseObject, error)
+	// Get all categories
+	// (GET /categories)
+	GetCategories(ctx context.Context, request GetCategoriesRequestObject) (GetCategoriesResponseObject, error)
+	// Create a new category
+	// (POST /categories)
+	CreateCategory(ctx context.Context, request CreateCategoryRequestObject) (CreateCategoryResponseObject, error)
+	// Delete a category
+	// (DELETE /categories/{id})
+	DeleteCategoryById(ctx context.Context, request DeleteCategoryByIdRequestObject) (DeleteCategoryByIdResponseObject, error)
+	// Get a category by ID
+	// (GET /categories/{id})
+	GetCategoryById(ctx context.Context, request GetCategoryByIdRequestObject) (GetCategoryByIdResponseObject, error)
+	// Update a category
+	// (PATCH /categories/{id})
+	UpdateCategoryById(ctx context.Context, request UpdateCategoryByIdRequestObject) (UpdateCategoryByIdResponseObject, error)
+	// Stream change events of the current user as Server-Sent Events
+	// (GET /events/stream)
+	StreamEvents(ctx context.Context, request StreamEventsRequestObject) (StreamEventsResponseObject, error)
+	// Get all monthly summaries for the current user
+	// (GET /monthly-summaries)
+	GetMonthlySummaries(ctx context.Context, request GetMonthlySummariesRequestObject) (GetMonthlySummariesResponseObject, error)
+	// Create a new monthly summary
+	// (POST /monthly-summaries)
+	CreateMonthlySummary(ctx context.Context, request CreateMonthlySummaryRequestObject) (CreateMonthlySummaryResponseObject, error)
+	// Delete a monthly summary by ID
+	// (DELETE /monthly-summaries/{id})
+	DeleteMonthlySummaryById(ctx context.Context, request DeleteMonthlySummaryByIdRequestObject) (DeleteMonthlySummaryByIdResponseObject, error)
+	// Get a monthly summary by ID
+	// (GET /monthly-summaries/{id})
+	GetMonthlySummaryById(ctx context.Context, request GetMonthlySummaryByIdRequestObject) (GetMonthlySummaryByIdResponseObject, error)
+	// Update a monthly summary by ID
+	// (PATCH /monthly-summaries/{id})
+	UpdateMonthlySummaryById(ctx context.Context, request UpdateMonthlySummaryByIdRequestObject) (UpdateMonthlySummaryByIdResponseObject, error)
+	// Get all transactions for the current user
+	// (GET /transactions)
+	GetTransactions(ctx context.Context, request GetTransactionsRequestObject) (GetTransactionsResponseObject, error)
+	// Create a new transaction
+	// (POST /transactions)
+	CreateTransaction(ctx context.Context, request CreateTransactionRequestObject) (CreateTransactionResponseObject, error)
+	// Create, update and delete transactions in a single database transaction
+	// (POST /transactions/bulk)
+	BulkTransactions(ctx context.Context, request BulkTransactionsRequestObject) (BulkTransactionsResponseObject, error)
+	// Delete a transaction
+	// (DELETE /transactions/{id})
+	DeleteTransactionById(ctx context.Context, request DeleteTransactionByIdRequestObject) (DeleteTransactionByIdResponseObject, error)
+	// Get a transaction by ID
+	// (GET /transactions/{id})
+	GetTransactionById(ctx context.Context, request GetTransactionByIdRequestObject) (GetTransactionByIdResponseObject, error)
+	// Update a transaction
+	// (PATCH /transactions/{id})
+	UpdateTransactionById(ctx context.Context, request UpdateTransactionByIdRequestObject) (UpdateTransactionByIdResponseObject, error)
+	// Request deletion of the current user
+	// (DELETE /users)
+	DeleteCurrentUser(ctx context.Context, request DeleteCurrentUserRequestObject) (DeleteCurrentUserResponseObject, error)
+	// Get the current user's information
+	// (GET /users)
+	GetCurrentUser(ctx context.Context, request GetCurrentUserRequestObject) (GetCurrentUserResponseObject, error)
+	// Update the current user
+	// (PATCH /users)
+	UpdateCurrentUser(ctx context.Context, request UpdateCurrentUserRequestObject) (UpdateCurrentUserResponseObject, error)
+	// Cancel the account deletion during the grace period
+	// (DELETE /users/deletion)
+	CancelAccountDeletion(ctx context.Context, request CancelAccountDeletionRequestObject) (CancelAccountDeletionResponseObject, error)
+	// Get the latest account deletion request of the current user
+	// (GET /users/deletion)
+	GetAccountDeletion(ctx context.Context, request GetAccountDeletionRequestObject) (GetAccountDeletionResponseObject, error)
+	// Request an archive of all data of the current user
+	// (POST /users/export)
+	RequestDataExport(ctx context.Context, request RequestDataExportRequestObject) (RequestDataExportResponseObject, error)
+	// Get the data exports of the current user
+	// (GET /users/exports)
+	GetDataExports(ctx context.Context, request GetDataExportsRequestObject) (GetDataExportsResponseObject, error)
+	// Get a data export
+	// (GET /users/exports/{id})
+	GetDataExport(ctx context.Context, request GetDataExportRequestObject) (GetDataExportResponseObject, error)
+	// Download the archive of a completed data export
+	// (GET /users/exports/{id}/download)
+	DownloadDataExport(ctx context.Context, request DownloadDataExportRequestObject) (DownloadDataExportResponseObject, error)
+	// Get external identity providers linked to the current user
+	// (GET /users/identities)
+	GetUserIdentities(ctx context.Context, request GetUserIdentitiesRequestObject) (GetUserIdentitiesResponseObject, error)
+	// Unlink an OpenID Connect provider from the current user
+	// (DELETE /users/identities/{provider})
+	UnlinkUserIdentity(ctx context.Context, request UnlinkUserIdentityRequestObject) (UnlinkUserIdentityResponseObject, error)
+	// Start linking an OpenID Connect provider to the current user
+	// (POST /users/identities/{provider})
+	LinkUserIdentity(ctx context.Context, request LinkUserIdentityRequestObject) (LinkUserIdentityResponseObject, error)
+	// Get the personal access tokens of the current user
+	// (GET /users/tokens)
+	GetPersonalAccessTokens(ctx context.Context, request GetPersonalAccessTokensRequestObject) (GetPersonalAccessTokensResponseObject, error)
+	// Create a personal access token
+	// (POST /users/tokens)
+	CreatePersonalAccessToken(ctx context.Context, request CreatePersonalAccessTokenRequestObject) (CreatePersonalAccessTokenResponseObject, error)
+	// Revoke a personal access token
+	// (DELETE /users/tokens/{id})
+	DeletePersonalAccessToken(ctx context.Context, request DeletePersonalAccessTokenRequestObject) (DeletePersonalAccessTokenResponseObject, error)
+	// Confirm TOTP enrollment with a code from the authenticator app
+	// (POST /users/totp/confirm)
+	ConfirmTOTP(ctx context.Context, request ConfirmTOTPRequestObject) (ConfirmTOTPResponseObject, error)
+	// Disable two-factor authentication
+	// (POST /users/totp/disable)
+	DisableTOTP(ctx context.Context, request DisableTOTPRequestObject) (DisableTOTPResponseObject, error)
+	// Start TOTP enrollment and get the secret for an authenticator app
+	// (POST /users/totp/enroll)
+	EnrollTOTP(ctx context.Context, request EnrollTOTPRequestObject) (EnrollTOTPResponseObject, error)
+	// Regenerate recovery codes. Previously issued codes can no longer be used
+	// (POST /users/totp/recovery-codes)
+	RegenerateRecoveryCodes(ctx context.Context, request RegenerateRecoveryCodesRequestObject) (RegenerateRecoveryCodesResponseObject, error)
+	// Get all webhooks for the current user
+	// (GET /webhooks)
+	GetWebhooks(ctx context.Context, request GetWebhooksRequestObject) (GetWebhooksResponseObject, error)
+	// Register a new webhook
+	// (POST /webhooks)
+	CreateWebhook(ctx context.Context, request CreateWebhookRequestObject) (CreateWebhookResponseObject, error)
+	// Delete a webhook
+	// (DELETE /webhooks/{id})
+	DeleteWebhookById(ctx context.Context, request DeleteWebhookByIdRequestObject) (DeleteWebhookByIdResponseObject, error)
+	// Get a webhook by ID
+	// (GET /webhooks/{id})
+	GetWebhookById(ctx context.Context, request GetWebhookByIdRequestObject) (GetWebhookByIdResponseObject, error)
+	// Update a webhook
+	// (PATCH /webhooks/{id})
+	UpdateWebhookById(ctx context.Context, request UpdateWebhookByIdRequestObject) (UpdateWebhookByIdResponseObject, error)
+	// Get the delivery history of a webhook
+	// (GET /webhooks/{id}/deliveries)
+	GetWebhookDeliveries(ctx context.Context, request GetWebhookDeliveriesRequestObject) (GetWebhookDeliveriesResponseObject, error)
+	// Send a past delivery payload again
+	// (POST /webhooks/{id}/deliveries/{deliveryId}/replay)
+	ReplayWebhookDelivery(ctx context.Context, request ReplayWebhookDeliveryRequestObject) (ReplayWebhookDeliveryResponseObject, error)
 }
 
-type UpdateCurrentUserResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UserResponse
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
+type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
+type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateCurrentUserResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateCurrentUserResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetJWKS operation middleware
+func (sh *strictHandler) GetJWKS(ctx echo.Context) error {
+	var request GetJWKSRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetJWKS(ctx.Request().Context(), request.(GetJWKSRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetJWKS")
 	}
-	return 0
-}
 
-// GetCsrfTokenWithResponse request returning *GetCsrfTokenResponse
-func (c *ClientWithResponses) GetCsrfTokenWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCsrfTokenResponse, error) {
-	rsp, err := c.GetCsrfToken(ctx, reqEditors...)
+	response, err := handler(ctx, request)
+
 	if err != nil {
-		return nil, err
+		return err
+	} else if validResponse, ok := response.(GetJWKSResponseObject); ok {
+		return validResponse.VisitGetJWKSResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
-	return ParseGetCsrfTokenResponse(rsp)
+	return nil
 }
 
-// LoginUserWithBodyWithResponse request with arbitrary body returning *LoginUserResponse
-func (c *ClientWithResponses) LoginUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginUserResponse, error) {
-	rsp, err := c.LoginUserWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// AdminGetAuditLogs operation middleware
+func (sh *strictHandler) AdminGetAuditLogs(ctx echo.Context, params AdminGetAuditLogsParams) error {
+	var request AdminGetAuditLogsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminGetAuditLogs(ctx.Request().Context(), request.(AdminGetAuditLogsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminGetAuditLogs")
 	}
-	return ParseLoginUserResponse(rsp)
-}
 
-func (c *ClientWithResponses) LoginUserWithResponse(ctx context.Context, body LoginUserJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginUserResponse, error) {
-	rsp, err := c.LoginUser(ctx, body, reqEditors...)
+	response, err := handler(ctx, request)
+
 	if err != nil {
-		return nil, err
+		return err
+	} else if validResponse, ok := response.(AdminGetAuditLogsResponseObject); ok {
+		return validResponse.VisitAdminGetAuditLogsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
-	return ParseLoginUserResponse(rsp)
+	return nil
 }
 
-// LogoutUserWithResponse request returning *LogoutUserResponse
-func (c *ClientWithResponses) LogoutUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LogoutUserResponse, error) {
-	rsp, err := c.LogoutUser(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+// AdminGetSystemStats operation middleware
+func (sh *strictHandler) AdminGetSystemStats(ctx echo.Context) error {
+	var request AdminGetSystemStatsRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminGetSystemStats(ctx.Request().Context(), request.(AdminGetSystemStatsRequestObject))
 	}
-	return ParseLogoutUserResponse(rsp)
-}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminGetSystemStats")
+	}
+
+	response, err := handler(ctx, request)
 
-// CreateUserWithBodyWithResponse request with arbitrary body returning *CreateUserResponse
-func (c *ClientWithResponses) CreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserResponse, error) {
-	rsp, err := c.CreateUserWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
-		return nil, err
+		return err
+	} else if validResponse, ok := response.(AdminGetSystemStatsResponseObject); ok {
+		return validResponse.VisitAdminGetSystemStatsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
-	return ParseCreateUserResponse(rsp)
+	return nil
 }
 
-func (c *ClientWithResponses) CreateUserWithResponse(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserResponse, error) {
-	rsp, err := c.CreateUser(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// AdminSearchUsers operation middleware
+func (sh *strictHandler) AdminSearchUsers(ctx echo.Context, params AdminSearchUsersParams) error {
+	var request AdminSearchUsersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminSearchUsers(ctx.Request().Context(), request.(AdminSearchUsersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminSearchUsers")
 	}
-	return ParseCreateUserResponse(rsp)
-}
 
-// GetCategoriesWithResponse request returning *GetCategoriesResponse
-func (c *ClientWithResponses) GetCategoriesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCategoriesResponse, error) {
-	rsp, err := c.GetCategories(ctx, reqEditors...)
+	response, err := handler(ctx, request)
+
 	if err != nil {
-		return nil, err
+		return err
+	} else if validResponse, ok := response.(AdminSearchUsersResponseObject); ok {
+		return validResponse.VisitAdminSearchUsersResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
-	return ParseGetCategoriesResponse(rsp)
+	return nil
 }
 
-// CreateCategoryWithBodyWithResponse request with arbitrary body returning *CreateCategoryResponse
-func (c *ClientWithResponses) CreateCategoryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCategoryResponse, error) {
-	rsp, err := c.CreateCategoryWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// AdminGetUser operation middleware
+func (sh *strictHandler) AdminGetUser(ctx echo.Context, id int) error {
+	var request AdminGetUserRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminGetUser(ctx.Request().Context(), request.(AdminGetUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminGetUser")
 	}
-	return ParseCreateCategoryResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateCategoryWithResponse(ctx context.Context, body CreateCategoryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCategoryResponse, error) {
-	rsp, err := c.CreateCategory(ctx, body, reqEditors...)
+	response, err := handler(ctx, request)
+
 	if err != nil {
-		return nil, err
+		return err
+	} else if validResponse, ok := response.(AdminGetUserResponseObject); ok {
+		return validResponse.VisitAdminGetUserResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
-	return ParseCreateCategoryResponse(rsp)
+	return nil
 }
 
-// DeleteCategoryByIdWithResponse request returning *DeleteCategoryByIdResponse
-func (c *ClientWithResponses) DeleteCategoryByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteCategoryByIdResponse, error) {
-	rsp, err := c.DeleteCategoryById(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+// AdminDisableUser operation middleware
+func (sh *strictHandler) AdminDisableUser(ctx echo.Context, id int) error {
+	var request AdminDisableUserRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminDisableUser(ctx.Request().Context(), request.(AdminDisableUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminDisableUser")
 	}
-	return ParseDeleteCategoryByIdResponse(rsp)
-}
 
-// GetCategoryByIdWithResponse request returning *GetCategoryByIdResponse
-func (c *ClientWithResponses) GetCategoryByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetCategoryByIdResponse, error) {
-	rsp, err := c.GetCategoryById(ctx, id, reqEditors...)
+	response, err := handler(ctx, request)
+
 	if err != nil {
-		return nil, err
+		return err
+	} else if validResponse, ok := response.(AdminDisableUserResponseObject); ok {
+		return validResponse.VisitAdminDisableUserResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
-	return ParseGetCategoryByIdResponse(rsp)
+	return nil
 }
 
-// UpdateCategoryByIdWithBodyWithResponse request with arbitrary body returning *UpdateCategoryByIdResponse
-func (c *ClientWithResponses) UpdateCategoryByIdWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCategoryByIdResponse, error) {
-	rsp, err := c.UpdateCategoryByIdWithBody(ctx, id, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// AdminEnableUser operation middleware
+func (sh *strictHandler) AdminEnableUser(ctx echo.Context, id int) error {
+	var request AdminEnableUserRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminEnableUser(ctx.Request().Context(), request.(AdminEnableUserRequestObject))
 	}
-	return ParseUpdateCategoryByIdResponse(rsp)
-}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminEnableUser")
+	}
+
+	response, err := handler(ctx, request)
 
-func (c *ClientWithResponses) UpdateCategoryByIdWithResponse(ctx context.Context, id int, body UpdateCategoryByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCategoryByIdResponse, error) {
-	rsp, err := c.UpdateCategoryById(ctx, id, body, reqEditors...)
 	if err != nil {
-		return nil, err
+		return err
+	} else if validResponse, ok := response.(AdminEnableUserResponseObject); ok {
+		return validResponse.VisitAdminEnableUserResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
-	return ParseUpdateCategoryByIdResponse(rsp)
+	return nil
 }
 
-// GetMonthlySummariesWithResponse request returning *GetMonthlySummariesResponse
-func (c *ClientWithResponses) GetMonthlySummariesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMonthlySummariesResponse, error) {
-	rsp, err := c.GetMonthlySummaries(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+// AdminImpersonateUser operation middleware
+func (sh *strictHandler) AdminImpersonateUser(ctx echo.Context, id int) error {
+	var request AdminImpersonateUserRequestObject
+
+	request.Id = id
+
+	var body AdminImpersonateUserJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
 	}
-	return ParseGetMonthlySummariesResponse(rsp)
-}
+	request.Body = &body
 
-// CreateMonthlySummaryWithBodyWithResponse request with arbitrary body returning *CreateMonthlySummaryResponse
-func (c *ClientWithResponses) CreateMonthlySummaryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMonthlySummaryResponse, error) {
-	rsp, err := c.CreateMonthlySummaryWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminImpersonateUser(ctx.Request().Context(), request.(AdminImpersonateUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminImpersonateUser")
 	}
-	return ParseCreateMonthlySummaryResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateMonthlySummaryWithResponse(ctx context.Context, body CreateMonthlySummaryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMonthlySummaryResponse, error) {
-	rsp, err := c.CreateMonthlySummary(ctx, body, reqEditors...)
+	response, err := handler(ctx, request)
+
 	if err != nil {
-		return nil, err
+		return err
+	} else if validResponse, ok := response.(AdminImpersonateUserResponseObject); ok {
+		return validResponse.VisitAdminImpersonateUserResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
-	return ParseCreateMonthlySummaryResponse(rsp)
+	return nil
 }
 
-// DeleteMonthlySummaryByIdWithResponse request returning *DeleteMonthlySummaryByIdResponse
-func (c *ClientWithResponses) DeleteMonthlySummaryByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteMonthlySummaryByIdResponse, error) {
-	rsp, err := c.DeleteMonthlySummaryById(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+// AdminForceLogout operation middleware
+func (sh *strictHandler) AdminForceLogout(ctx echo.Context, id int) error {
+	var request AdminForceLogoutRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminForceLogout(ctx.Request().Context(), request.(AdminForceLogoutRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminForceLogout")
 	}
-	return ParseDeleteMonthlySummaryByIdResponse(rsp)
-}
 
-// GetMonthlySummaryByIdWithResponse request returning *GetMonthlySummaryByIdResponse
-func (c *ClientWithResponses) GetMonthlySummaryByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetMonthlySummaryByIdResponse, error) {
-	rsp, err := c.GetMonthlySummaryById(ctx, id, reqEditors...)
+	response, err := handler(ctx, request)
+
 	if err != nil {
-		return nil, err
+		return err
+	} else if validResponse, ok := response.(AdminForceLogoutResponseObject); ok {
+		return validResponse.VisitAdminForceLogoutResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
-	return ParseGetMonthlySummaryByIdResponse(rsp)
+	return nil
 }
 
-// UpdateMonthlySummaryByIdWithBodyWithResponse request with arbitrary body returning *UpdateMonthlySummaryByIdResponse
-func (c *ClientWithResponses) UpdateMonthlySummaryByIdWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMonthlySummaryByIdResponse, error) {
-	rsp, err := c.UpdateMonthlySummaryByIdWithBody(ctx, id, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// AdminUpdateUserRole operation middleware
+func (sh *strictHandler) AdminUpdateUserRole(ctx echo.Context, id int) error {
+	var request AdminUpdateUserRoleRequestObject
+
+	request.Id = id
+
+	var body AdminUpdateUserRoleJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
 	}
-	return ParseUpdateMonthlySummaryByIdResponse(rsp)
-}
+	request.Body = &body
 
-func (c *ClientWithResponses) UpdateMonthlySummaryByIdWithResponse(ctx context.Context, id int, body UpdateMonthlySummaryByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMonthlySummaryByIdResponse, error) {
-	rsp, err := c.UpdateMonthlySummaryById(ctx, id, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminUpdateUserRole(ctx.Request().Context(), request.(AdminUpdateUserRoleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminUpdateUserRole")
 	}
-	return ParseUpdateMonthlySummaryByIdResponse(rsp)
-}
 
-// GetTransactionsWithResponse request returning *GetTransactionsResponse
-func (c *ClientWithResponses) GetTransactionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTransactionsResponse, error) {
-	rsp, err := c.GetTransactions(ctx, reqEditors...)
+	response, err := handler(ctx, request)
+
 	if err != nil {
-		return nil, err
+		return err
+	} else if validResponse, ok := response.(AdminUpdateUserRoleResponseObject); ok {
+		return validResponse.VisitAdminUpdateUserRoleResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
-	return ParseGetTransactionsResponse(rsp)
+	return nil
 }
 
-// CreateTransactionWithBodyWithResponse request with arbitrary body returning *CreateTransactionResponse
-func (c *ClientWithResponses) CreateTransactionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTransactionResponse, error) {
-	rsp, err := c.CreateTransactionWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// ConfirmAccountDeletion operation middleware
+func (sh *strictHandler) ConfirmAccountDeletion(ctx echo.Context) error {
+	var request ConfirmAccountDeletionRequestObject
+
+	var body ConfirmAccountDeletionJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
 	}
-	return ParseCreateTransactionResponse(rsp)
-}
+	request.Body = &body
 
-func (c *ClientWithResponses) CreateTransactionWithResponse(ctx context.Context, body CreateTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTransactionResponse, error) {
-	rsp, err := c.CreateTransaction(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ConfirmAccountDeletion(ctx.Request().Context(), request.(ConfirmAccountDeletionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ConfirmAccountDeletion")
 	}
-	return ParseCreateTransactionResponse(rsp)
-}
 
-// DeleteTransactionByIdWithResponse request returning *DeleteTransactionByIdResponse
-func (c *ClientWithResponses) DeleteTransactionByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteTransactionByIdResponse, error) {
-	rsp, err := c.DeleteTransactionById(ctx, id, reqEditors...)
+	response, err := handler(ctx, request)
+
 	if err != nil {
-		return nil, err
+		return err
+	} else if validResponse, ok := response.(ConfirmAccountDeletionResponseObject); ok {
+		return validResponse.VisitConfirmAccountDeletionResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
-	return ParseDeleteTransactionByIdResponse(rsp)
+	return nil
 }
 
-// GetTransactionByIdWithResponse request returning *GetTransactionByIdResponse
-func (c *ClientWithResponses) GetTransactionByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetTransactionByIdResponse, error) {
-	rsp, err := c.GetTransactionById(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+// GetCsrfToken operation middleware
+func (sh *strictHandler) GetCsrfToken(ctx echo.Context) error {
+	var request GetCsrfTokenRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCsrfToken(ctx.Request().Context(), request.(GetCsrfTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCsrfToken")
 	}
-	return ParseGetTransactionByIdResponse(rsp)
-}
 
-// UpdateTransactionByIdWithBodyWithResponse request with arbitrary body returning *UpdateTransactionByIdResponse
-func (c *ClientWithResponses) UpdateTransactionByIdWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTransactionByIdResponse, error) {
-	rsp, err := c.UpdateTransactionByIdWithBody(ctx, id, contentType, body, reqEditors...)
+	response, err := handler(ctx, request)
+
 	if err != nil {
-		return nil, err
+		return err
+	} else if validResponse, ok := response.(GetCsrfTokenResponseObject); ok {
+		return validResponse.VisitGetCsrfTokenResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
-	return ParseUpdateTransactionByIdResponse(rsp)
+	return nil
 }
 
-func (c *ClientWithResponses) UpdateTransactionByIdWithResponse(ctx context.Context, id int, body UpdateTransactionByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTransactionByIdResponse, error) {
-	rsp, err := c.UpdateTransactionById(ctx, id, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// LoginUser operation middleware
+func (sh *strictHandler) LoginUser(ctx echo.Context) error {
+	var request LoginUserRequestObject
+
+	var body LoginUserJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
 	}
-	return ParseUpdateTransactionByIdResponse(rsp)
-}
+	request.Body = &body
 
-// DeleteCurrentUserWithResponse request returning *DeleteCurrentUserResponse
-func (c *ClientWithResponses) DeleteCurrentUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteCurrentUserResponse, error) {
-	rsp, err := c.DeleteCurrentUser(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.LoginUser(ctx.Request().Context(), request.(LoginUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "LoginUser")
 	}
-	return ParseDeleteCurrentUserResponse(rsp)
-}
 
-// GetCurrentUserWithResponse request returning *GetCurrentUserResponse
-func (c *ClientWithResponses) GetCurrentUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCurrentUserResponse, error) {
-	rsp, err := c.GetCurrentUser(ctx, reqEditors...)
+	response, err := handler(ctx, request)
+
 	if err != nil {
-		return nil, err
+		return err
+	} else if validResponse, ok := response.(LoginUserResponseObject); ok {
+		return validResponse.VisitLoginUserResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
-	return ParseGetCurrentUserResponse(rsp)
+	return nil
 }
 
-// UpdateCurrentUserWithBodyWithResponse request with arbitrary body returning *UpdateCurrentUserResponse
-func (c *ClientWithResponses) UpdateCurrentUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCurrentUserResponse, error) {
-	rsp, err := c.UpdateCurrentUserWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// LoginUserWithTOTP operation middleware
+func (sh *strictHandler) LoginUserWithTOTP(ctx echo.Context) error {
+	var request LoginUserWithTOTPRequestObject
+
+	var body LoginUserWithTOTPJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
 	}
-	return ParseUpdateCurrentUserResponse(rsp)
-}
+	request.Body = &body
 
-func (c *ClientWithResponses) UpdateCurrentUserWithResponse(ctx context.Context, body UpdateCurrentUserJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCurrentUserResponse, error) {
-	rsp, err := c.UpdateCurrentUser(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.LoginUserWithTOTP(ctx.Request().Context(), request.(LoginUserWithTOTPRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "LoginUserWithTOTP")
 	}
-	return ParseUpdateCurrentUserResponse(rsp)
-}
 
-// ParseGetCsrfTokenResponse parses an HTTP response from a GetCsrfTokenWithResponse call
-func ParseGetCsrfTokenResponse(rsp *http.Response) (*GetCsrfTokenResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	response, err := handler(ctx, request)
+
 	if err != nil {
-		return nil, err
+		return err
+	} else if validResponse, ok := response.(LoginUserWithTOTPResponseObject); ok {
+		return validResponse.VisitLoginUserWithTOTPResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
+	return nil
+}
 
-	response := &GetCsrfTokenResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// LogoutUser operation middleware
+func (sh *strictHandler) LogoutUser(ctx echo.Context) error {
+	var request LogoutUserRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.LogoutUser(ctx.Request().Context(), request.(LogoutUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "LogoutUser")
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			CsrfToken *string `json:"csrf_token,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	response, err := handler(ctx, request)
 
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(LogoutUserResponseObject); ok {
+		return validResponse.VisitLogoutUserResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
-
-	return response, nil
+	return nil
 }
 
-// ParseLoginUserResponse parses an HTTP response from a LoginUserWithResponse call
-func ParseLoginUserResponse(rsp *http.Response) (*LoginUserResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// GetOIDCProviders operation middleware
+func (sh *strictHandler) GetOIDCProviders(ctx echo.Context) error {
+	var request GetOIDCProvidersRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOIDCProviders(ctx.Request().Context(), request.(GetOIDCProvidersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOIDCProviders")
 	}
 
-	response := &LoginUserResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetOIDCProvidersResponseObject); ok {
+		return validResponse.VisitGetOIDCProvidersResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
+	return nil
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			CsrfToken *string `json:"csrf_token,omitempty"`
-			Message   string  `json:"message"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+// HandleOIDCCallback operation middleware
+func (sh *strictHandler) HandleOIDCCallback(ctx echo.Context, provider string, params HandleOIDCCallbackParams) error {
+	var request HandleOIDCCallbackRequestObject
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	request.Provider = provider
+	request.Params = params
 
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.HandleOIDCCallback(ctx.Request().Context(), request.(HandleOIDCCallbackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "HandleOIDCCallback")
 	}
 
-	return response, nil
-}
+	response, err := handler(ctx, request)
 
-// ParseLogoutUserResponse parses an HTTP response from a LogoutUserWithResponse call
-func ParseLogoutUserResponse(rsp *http.Response) (*LogoutUserResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
-		return nil, err
-	}
-
-	response := &LogoutUserResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+		return err
+	} else if validResponse, ok := response.(HandleOIDCCallbackResponseObject); ok {
+		return validResponse.VisitHandleOIDCCallbackResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
+	return nil
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Message string `json:"message"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+// StartOIDCLogin operation middleware
+func (sh *strictHandler) StartOIDCLogin(ctx echo.Context, provider string) error {
+	var request StartOIDCLoginRequestObject
 
+	request.Provider = provider
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.StartOIDCLogin(ctx.Request().Context(), request.(StartOIDCLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StartOIDCLogin")
 	}
 
-	return response, nil
-}
+	response, err := handler(ctx, request)
 
-// ParseCreateUserResponse parses an HTTP response from a CreateUserWithResponse call
-func ParseCreateUserResponse(rsp *http.Response) (*CreateUserResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
-		return nil, err
-	}
-
-	response := &CreateUserResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+		return err
+	} else if validResponse, ok := response.(StartOIDCLoginResponseObject); ok {
+		return validResponse.VisitStartOIDCLoginResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
+	return nil
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest UserResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+// ConfirmPasswordReset operation middleware
+func (sh *strictHandler) ConfirmPasswordReset(ctx echo.Context) error {
+	var request ConfirmPasswordResetRequestObject
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	var body ConfirmPasswordResetJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ConfirmPasswordReset(ctx.Request().Context(), request.(ConfirmPasswordResetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ConfirmPasswordReset")
 	}
 
-	return response, nil
-}
+	response, err := handler(ctx, request)
 
-// ParseGetCategoriesResponse parses an HTTP response from a GetCategoriesWithResponse call
-func ParseGetCategoriesResponse(rsp *http.Response) (*GetCategoriesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
-		return nil, err
+		return err
+	} else if validResponse, ok := response.(ConfirmPasswordResetResponseObject); ok {
+		return validResponse.VisitConfirmPasswordResetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
+	return nil
+}
 
-	response := &GetCategoriesResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// RequestPasswordReset operation middleware
+func (sh *strictHandler) RequestPasswordReset(ctx echo.Context) error {
+	var request RequestPasswordResetRequestObject
+
+	var body RequestPasswordResetJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
 	}
+	request.Body = &body
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CategoryResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RequestPasswordReset(ctx.Request().Context(), request.(RequestPasswordResetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RequestPasswordReset")
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	response, err := handler(ctx, request)
 
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(RequestPasswordResetResponseObject); ok {
+		return validResponse.VisitRequestPasswordResetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
-
-	return response, nil
+	return nil
 }
 
-// ParseCreateCategoryResponse parses an HTTP response from a CreateCategoryWithResponse call
-func ParseCreateCategoryResponse(rsp *http.Response) (*CreateCategoryResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// CreateUser operation middleware
+func (sh *strictHandler) CreateUser(ctx echo.Context) error {
+	var request CreateUserRequestObject
+
+	var body CreateUserJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
 	}
+	request.Body = &body
 
-	response := &CreateCategoryResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateUser(ctx.Request().Context(), request.(CreateUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateUser")
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest CategoryResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	response, err := handler(ctx, request)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(CreateUserResponseObject); ok {
+		return validResponse.VisitCreateUserResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
 
+// UnlockAccount operation middleware
+func (sh *strictHandler) UnlockAccount(ctx echo.Context) error {
+	var request UnlockAccountRequestObject
+
+	var body UnlockAccountJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
 	}
+	request.Body = &body
 
-	return response, nil
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UnlockAccount(ctx.Request().Context(), request.(UnlockAccountRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UnlockAccount")
+	}
+
+	response, err := handler(ctx, request)
 
-// ParseDeleteCategoryByIdResponse parses an HTTP response from a DeleteCategoryByIdWithResponse call
-func ParseDeleteCategoryByIdResponse(rsp *http.Response) (*DeleteCategoryByIdResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
-		return nil, err
+		return err
+	} else if validResponse, ok := response.(UnlockAccountResponseObject); ok {
+		return validResponse.VisitUnlockAccountResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
+	return nil
+}
 
-	response := &DeleteCategoryByIdResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// VerifyEmail operation middleware
+func (sh *strictHandler) VerifyEmail(ctx echo.Context) error {
+	var request VerifyEmailRequestObject
+
+	var body VerifyEmailJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
 	}
+	request.Body = &body
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.VerifyEmail(ctx.Request().Context(), request.(VerifyEmailRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "VerifyEmail")
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	response, err := handler(ctx, request)
 
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(VerifyEmailResponseObject); ok {
+		return validResponse.VisitVerifyEmailResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
-
-	return response, nil
+	return nil
 }
 
-// ParseGetCategoryByIdResponse parses an HTTP response from a GetCategoryByIdWithResponse call
-func ParseGetCategoryByIdResponse(rsp *http.Response) (*GetCategoryByIdResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// GetCategories operation middleware
+func (sh *strictHandler) GetCategories(ctx echo.Context) error {
+	var request GetCategoriesRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCategories(ctx.Request().Context(), request.(GetCategoriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCategories")
 	}
 
-	response := &GetCategoryByIdResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCategoriesResponseObject); ok {
+		return validResponse.VisitGetCategoriesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
+	return nil
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CategoryResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+// CreateCategory operation middleware
+func (sh *strictHandler) CreateCategory(ctx echo.Context, params CreateCategoryParams) error {
+	var request CreateCategoryRequestObject
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	request.Params = params
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	var body CreateCategoryJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateCategory(ctx.Request().Context(), request.(CreateCategoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateCategory")
 	}
 
-	return response, nil
-}
+	response, err := handler(ctx, request)
 
-// ParseUpdateCategoryByIdResponse parses an HTTP response from a UpdateCategoryByIdWithResponse call
-func ParseUpdateCategoryByIdResponse(rsp *http.Response) (*UpdateCategoryByIdResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
-		return nil, err
+		return err
+	} else if validResponse, ok := response.(CreateCategoryResponseObject); ok {
+		return validResponse.VisitCreateCategoryResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
+	return nil
+}
 
-	response := &UpdateCategoryByIdResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// DeleteCategoryById operation middleware
+func (sh *strictHandler) DeleteCategoryById(ctx echo.Context, id int, params DeleteCategoryByIdParams) error {
+	var request DeleteCategoryByIdRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCategoryById(ctx.Request().Context(), request.(DeleteCategoryByIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCategoryById")
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CategoryResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	response, err := handler(ctx, request)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteCategoryByIdResponseObject); ok {
+		return validResponse.VisitDeleteCategoryByIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+// GetCategoryById operation middleware
+func (sh *strictHandler) GetCategoryById(ctx echo.Context, id int) error {
+	var request GetCategoryByIdRequestObject
 
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCategoryById(ctx.Request().Context(), request.(GetCategoryByIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCategoryById")
 	}
 
-	return response, nil
-}
+	response, err := handler(ctx, request)
 
-// ParseGetMonthlySummariesResponse parses an HTTP response from a GetMonthlySummariesWithResponse call
-func ParseGetMonthlySummariesResponse(rsp *http.Response) (*GetMonthlySummariesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
-		return nil, err
+		return err
+	} else if validResponse, ok := response.(GetCategoryByIdResponseObject); ok {
+		return validResponse.VisitGetCategoryByIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
+	return nil
+}
 
-	response := &GetMonthlySummariesResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+// UpdateCategoryById operation middleware
+func (sh *strictHandler) UpdateCategoryById(ctx echo.Context, id int, params UpdateCategoryByIdParams) error {
+	var request UpdateCategoryByIdRequestObject
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MonthlySummaryResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	request.Id = id
+	request.Params = params
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	var body UpdateCategoryByIdJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateCategoryById(ctx.Request().Context(), request.(UpdateCategoryByIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateCategoryById")
 	}
 
-	return response, nil
-}
+	response, err := handler(ctx, request)
 
-// ParseCreateMonthlySummaryResponse parses an HTTP response from a CreateMonthlySummaryWithResponse call
-func ParseCreateMonthlySummaryResponse(rsp *http.Response) (*CreateMonthlySummaryResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
-		return nil, err
+		return err
+	} else if validResponse, ok := response.(UpdateCategoryByIdResponseObject); ok {
+		return validResponse.VisitUpdateCategoryByIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
+	return nil
+}
 
-	response := &CreateMonthlySummaryResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StreamEvents operation middleware
+func (sh *strictHandler) StreamEvents(ctx echo.Context, params StreamEventsParams) error {
+	var request StreamEventsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.StreamEvents(ctx.Request().Context(), request.(StreamEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StreamEvents")
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest MonthlySummaryResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	response, err := handler(ctx, request)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(StreamEventsResponseObject); ok {
+		return validResponse.VisitStreamEventsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
 
+// GetMonthlySummaries operation middleware
+func (sh *strictHandler) GetMonthlySummaries(ctx echo.Context) error {
+	var request GetMonthlySummariesRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMonthlySummaries(ctx.Request().Context(), request.(GetMonthlySummariesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMonthlySummaries")
 	}
 
-	return response, nil
-}
+	response, err := handler(ctx, request)
 
-// ParseDeleteMonthlySummaryByIdResponse parses an HTTP response from a DeleteMonthlySummaryByIdWithResponse call
-func ParseDeleteMonthlySummaryByIdResponse(rsp *http.Response) (*DeleteMonthlySummaryByIdResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
-		return nil, err
+		return err
+	} else if validResponse, ok := response.(GetMonthlySummariesResponseObject); ok {
+		return validResponse.VisitGetMonthlySummariesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
+	return nil
+}
 
-	response := &DeleteMonthlySummaryByIdResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+// CreateMonthlySummary operation middleware
+func (sh *strictHandler) CreateMonthlySummary(ctx echo.Context, params CreateMonthlySummaryParams) error {
+	var request CreateMonthlySummaryRequestObject
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	request.Params = params
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	var body CreateMonthlySummaryJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateMonthlySummary(ctx.Request().Context(), request.(CreateMonthlySummaryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateMonthlySummary")
 	}
 
-	return response, nil
-}
+	response, err := handler(ctx, request)
 
-// ParseGetMonthlySummaryByIdResponse parses an HTTP response from a GetMonthlySummaryByIdWithResponse call
-func ParseGetMonthlySummaryByIdResponse(rsp *http.Response) (*GetMonthlySummaryByIdResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
-		return nil, err
+		return err
+	} else if validResponse, ok := response.(CreateMonthlySummaryResponseObject); ok {
+		return validResponse.VisitCreateMonthlySummaryResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
+	return nil
+}
 
-	response := &GetMonthlySummaryByIdResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// DeleteMonthlySummaryById operation middleware
+func (sh *strictHandler) DeleteMonthlySummaryById(ctx echo.Context, id int, params DeleteMonthlySummaryByIdParams) error {
+	var request DeleteMonthlySummaryByIdRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteMonthlySummaryById(ctx.Request().Context(), request.(DeleteMonthlySummaryByIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteMonthlySummaryById")
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MonthlySummaryResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	response, err := handler(ctx, request)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteMonthlySummaryByIdResponseObject); ok {
+		return validResponse.VisitDeleteMonthlySummaryByIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+// GetMonthlySummaryById operation middleware
+func (sh *strictHandler) GetMonthlySummaryById(ctx echo.Context, id int) error {
+	var request GetMonthlySummaryByIdRequestObject
 
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMonthlySummaryById(ctx.Request().Context(), request.(GetMonthlySummaryByIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMonthlySummaryById")
 	}
 
-	return response, nil
-}
+	response, err := handler(ctx, request)
 
-// ParseUpdateMonthlySummaryByIdResponse parses an HTTP response from a UpdateMonthlySummaryByIdWithResponse call
-func ParseUpdateMonthlySummaryByIdResponse(rsp *http.Response) (*UpdateMonthlySummaryByIdResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
-		return nil, err
+		return err
+	} else if validResponse, ok := response.(GetMonthlySummaryByIdResponseObject); ok {
+		return validResponse.VisitGetMonthlySummaryByIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
+	return nil
+}
 
-	response := &UpdateMonthlySummaryByIdResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// UpdateMonthlySummaryById operation middleware
+func (sh *strictHandler) UpdateMonthlySummaryById(ctx echo.Context, id int, params UpdateMonthlySummaryByIdParams) error {
+	var request UpdateMonthlySummaryByIdRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	var body UpdateMonthlySummaryByIdJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
 	}
+	request.Body = &body
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MonthlySummaryResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateMonthlySummaryById(ctx.Request().Context(), request.(UpdateMonthlySummaryByIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateMonthlySummaryById")
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	response, err := handler(ctx, request)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(UpdateMonthlySummaryByIdResponseObject); ok {
+		return validResponse.VisitUpdateMonthlySummaryByIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetTransactions operation middleware
+func (sh *strictHandler) GetTransactions(ctx echo.Context) error {
+	var request GetTransactionsRequestObject
 
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTransactions(ctx.Request().Context(), request.(GetTransactionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTransactions")
 	}
 
-	return response, nil
-}
+	response, err := handler(ctx, request)
 
-// ParseGetTransactionsResponse parses an HTTP response from a GetTransactionsWithResponse call
-func ParseGetTransactionsResponse(rsp *http.Response) (*GetTransactionsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
-		return nil, err
+		return err
+	} else if validResponse, ok := response.(GetTransactionsResponseObject); ok {
+		return validResponse.VisitGetTransactionsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
+	return nil
+}
 
-	response := &GetTransactionsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+// CreateTransaction operation middleware
+func (sh *strictHandler) CreateTransaction(ctx echo.Context, params CreateTransactionParams) error {
+	var request CreateTransactionRequestObject
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TransactionResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	request.Params = params
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	var body CreateTransactionJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateTransaction(ctx.Request().Context(), request.(CreateTransactionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateTransaction")
 	}
 
-	return response, nil
-}
+	response, err := handler(ctx, request)
 
-// ParseCreateTransactionResponse parses an HTTP response from a CreateTransactionWithResponse call
-func ParseCreateTransactionResponse(rsp *http.Response) (*CreateTransactionResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
-		return nil, err
+		return err
+	} else if validResponse, ok := response.(CreateTransactionResponseObject); ok {
+		return validResponse.VisitCreateTransactionResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
+	return nil
+}
 
-	response := &CreateTransactionResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+// BulkTransactions operation middleware
+func (sh *strictHandler) BulkTransactions(ctx echo.Context, params BulkTransactionsParams) error {
+	var request BulkTransactionsRequestObject
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest TransactionResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	request.Params = params
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	var body BulkTransactionsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.BulkTransactions(ctx.Request().Context(), request.(BulkTransactionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "BulkTransactions")
 	}
 
-	return response, nil
-}
+	response, err := handler(ctx, request)
 
-// ParseDeleteTransactionByIdResponse parses an HTTP response from a DeleteTransactionByIdWithResponse call
-func ParseDeleteTransactionByIdResponse(rsp *http.Response) (*DeleteTransactionByIdResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
-		return nil, err
-	}
-
-	response := &DeleteTransactionByIdResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+		return err
+	} else if validResponse, ok := response.(BulkTransactionsResponseObject); ok {
+		return validResponse.VisitBulkTransactionsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
+	return nil
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+// DeleteTransactionById operation middleware
+func (sh *strictHandler) DeleteTransactionById(ctx echo.Context, id int, params DeleteTransactionByIdParams) error {
+	var request DeleteTransactionByIdRequestObject
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	request.Id = id
+	request.Params = params
 
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTransactionById(ctx.Request().Context(), request.(DeleteTransactionByIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTransactionById")
 	}
 
-	return response, nil
-}
+	response, err := handler(ctx, request)
 
-// ParseGetTransactionByIdResponse parses an HTTP response from a GetTransactionByIdWithResponse call
-func ParseGetTransactionByIdResponse(rsp *http.Response) (*GetTransactionByIdResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
-		return nil, err
+		return err
+	} else if validResponse, ok := response.(DeleteTransactionByIdResponseObject); ok {
+		return validResponse.VisitDeleteTransactionByIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
+	return nil
+}
 
-	response := &GetTransactionByIdResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// GetTransactionById operation middleware
+func (sh *strictHandler) GetTransactionById(ctx echo.Context, id int) error {
+	var request GetTransactionByIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTransactionById(ctx.Request().Context(), request.(GetTransactionByIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTransactionById")
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TransactionResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	response, err := handler(ctx, request)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTransactionByIdResponseObject); ok {
+		return validResponse.VisitGetTransactionByIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+// UpdateTransactionById operation middleware
+func (sh *strictHandler) UpdateTransactionById(ctx echo.Context, id int, params UpdateTransactionByIdParams) error {
+	var request UpdateTransactionByIdRequestObject
+
+	request.Id = id
+	request.Params = params
 
+	var body UpdateTransactionByIdJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
 	}
+	request.Body = &body
 
-	return response, nil
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateTransactionById(ctx.Request().Context(), request.(UpdateTransactionByIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateTransactionById")
+	}
+
+	response, err := handler(ctx, request)
 
-// ParseUpdateTransactionByIdResponse parses an HTTP response from a UpdateTransactionByIdWithResponse call
-func ParseUpdateTransactionByIdResponse(rsp *http.Response) (*UpdateTransactionByIdResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
-		return nil, err
+		return err
+	} else if validResponse, ok := response.(UpdateTransactionByIdResponseObject); ok {
+		return validResponse.VisitUpdateTransactionByIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
+	return nil
+}
 
-	response := &UpdateTransactionByIdResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+// DeleteCurrentUser operation middleware
+func (sh *strictHandler) DeleteCurrentUser(ctx echo.Context) error {
+	var request DeleteCurrentUserRequestObject
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TransactionResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCurrentUser(ctx.Request().Context(), request.(DeleteCurrentUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCurrentUser")
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	response, err := handler(ctx, request)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteCurrentUserResponseObject); ok {
+		return validResponse.VisitDeleteCurrentUserResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCurrentUser operation middleware
+func (sh *strictHandler) GetCurrentUser(ctx echo.Context) error {
+	var request GetCurrentUserRequestObject
 
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCurrentUser(ctx.Request().Context(), request.(GetCurrentUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCurrentUser")
 	}
 
-	return response, nil
-}
+	response, err := handler(ctx, request)
 
-// ParseDeleteCurrentUserResponse parses an HTTP response from a DeleteCurrentUserWithResponse call
-func ParseDeleteCurrentUserResponse(rsp *http.Response) (*DeleteCurrentUserResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
-		return nil, err
-	}
-
-	response := &DeleteCurrentUserResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+		return err
+	} else if validResponse, ok := response.(GetCurrentUserResponseObject); ok {
+		return validResponse.VisitGetCurrentUserResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
+	return nil
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+// UpdateCurrentUser operation middleware
+func (sh *strictHandler) UpdateCurrentUser(ctx echo.Context) error {
+	var request UpdateCurrentUserRequestObject
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	var body UpdateCurrentUserJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateCurrentUser(ctx.Request().Context(), request.(UpdateCurrentUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateCurrentUser")
 	}
 
-	return response, nil
-}
+	response, err := handler(ctx, request)
 
-// ParseGetCurrentUserResponse parses an HTTP response from a GetCurrentUserWithResponse call
-func ParseGetCurrentUserResponse(rsp *http.Response) (*GetCurrentUserResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
-		return nil, err
+		return err
+	} else if validResponse, ok := response.(UpdateCurrentUserResponseObject); ok {
+		return validResponse.VisitUpdateCurrentUserResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
+	return nil
+}
 
-	response := &GetCurrentUserResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// CancelAccountDeletion operation middleware
+func (sh *strictHandler) CancelAccountDeletion(ctx echo.Context) error {
+	var request CancelAccountDeletionRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CancelAccountDeletion(ctx.Request().Context(), request.(CancelAccountDeletionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CancelAccountDeletion")
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest UserResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	response, err := handler(ctx, request)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(CancelAccountDeletionResponseObject); ok {
+		return validResponse.VisitCancelAccountDeletionResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+// GetAccountDeletion operation middleware
+func (sh *strictHandler) GetAccountDeletion(ctx echo.Context) error {
+	var request GetAccountDeletionRequestObject
 
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAccountDeletion(ctx.Request().Context(), request.(GetAccountDeletionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAccountDeletion")
 	}
 
-	return response, nil
-}
+	response, err := handler(ctx, request)
 
-// ParseUpdateCurrentUserResponse parses an HTTP response from a UpdateCurrentUserWithResponse call
-func ParseUpdateCurrentUserResponse(rsp *http.Response) (*UpdateCurrentUserResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
-		return nil, err
+		return err
+	} else if validResponse, ok := response.(GetAccountDeletionResponseObject); ok {
+		return validResponse.VisitGetAccountDeletionResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
+	return nil
+}
 
-	response := &UpdateCurrentUserResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// RequestDataExport operation middleware
+func (sh *strictHandler) RequestDataExport(ctx echo.Context) error {
+	var request RequestDataExportRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RequestDataExport(ctx.Request().Context(), request.(RequestDataExportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RequestDataExport")
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest UserResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	response, err := handler(ctx, request)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(RequestDataExportResponseObject); ok {
+		return validResponse.VisitRequestDataExportResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+// GetDataExports operation middleware
+func (sh *strictHandler) GetDataExports(ctx echo.Context) error {
+	var request GetDataExportsRequestObject
 
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDataExports(ctx.Request().Context(), request.(GetDataExportsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDataExports")
 	}
 
-	return response, nil
-}
+	response, err := handler(ctx, request)
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Get a CSRF token
-	// (GET /auth/csrf)
-	GetCsrfToken(ctx echo.Context) error
-	// Log in a user
-	// (POST /auth/login)
-	LoginUser(ctx echo.Context) error
-	// Log out a user
-	// (POST /auth/logout)
-	LogoutUser(ctx echo.Context) error
-	// Create a new user
-	// (POST /auth/signup)
-	CreateUser(ctx echo.Context) error
-	// Get all categories
-	// (GET /categories)
-	GetCategories(ctx echo.Context) error
-	// Create a new category
-	// (POST /categories)
-	CreateCategory(ctx echo.Context) error
-	// Delete a category
-	// (DELETE /categories/{id})
-	DeleteCategoryById(ctx echo.Context, id int) error
-	// Get a category by ID
-	// (GET /categories/{id})
-	GetCategoryById(ctx echo.Context, id int) error
-	// Update a category
-	// (PATCH /categories/{id})
-	UpdateCategoryById(ctx echo.Context, id int) error
-	// Get all monthly summaries for the current user
-	// (GET /monthly-summaries)
-	GetMonthlySummaries(ctx echo.Context) error
-	// Create a new monthly summary
-	// (POST /monthly-summaries)
-	CreateMonthlySummary(ctx echo.Context) error
-	// Delete a monthly summary by ID
-	// (DELETE /monthly-summaries/{id})
-	DeleteMonthlySummaryById(ctx echo.Context, id int) error
-	// Get a monthly summary by ID
-	// (GET /monthly-summaries/{id})
-	GetMonthlySummaryById(ctx echo.Context, id int) error
-	// Update a monthly summary by ID
-	// (PATCH /monthly-summaries/{id})
-	UpdateMonthlySummaryById(ctx echo.Context, id int) error
-	// Get all transactions for the current user
-	// (GET /transactions)
-	GetTransactions(ctx echo.Context) error
-	// Create a new transaction
-	// (POST /transactions)
-	CreateTransaction(ctx echo.Context) error
-	// Delete a transaction
-	// (DELETE /transactions/{id})
-	DeleteTransactionById(ctx echo.Context, id int) error
-	// Get a transaction by ID
-	// (GET /transactions/{id})
-	GetTransactionById(ctx echo.Context, id int) error
-	// Update a transaction
-	// (PATCH /transactions/{id})
-	UpdateTransactionById(ctx echo.Context, id int) error
-	// Delete the current user
-	// (DELETE /users)
-	DeleteCurrentUser(ctx echo.Context) error
-	// Get the current user's information
-	// (GET /users)
-	GetCurrentUser(ctx echo.Context) error
-	// Update the current user
-	// (PATCH /users)
-	UpdateCurrentUser(ctx echo.Context) error
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetDataExportsResponseObject); ok {
+		return validResponse.VisitGetDataExportsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// ServerInterfaceWrapper converts echo contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler ServerInterface
-}
+// GetDataExport operation middleware
+func (sh *strictHandler) GetDataExport(ctx echo.Context, id int) error {
+	var request GetDataExportRequestObject
 
-// GetCsrfToken converts echo context to params.
-func (w *ServerInterfaceWrapper) GetCsrfToken(ctx echo.Context) error {
-	var err error
+	request.Id = id
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetCsrfToken(ctx)
-	return err
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDataExport(ctx.Request().Context(), request.(GetDataExportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDataExport")
+	}
 
-// LoginUser converts echo context to params.
-func (w *ServerInterfaceWrapper) LoginUser(ctx echo.Context) error {
-	var err error
+	response, err := handler(ctx, request)
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.LoginUser(ctx)
-	return err
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetDataExportResponseObject); ok {
+		return validResponse.VisitGetDataExportResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// LogoutUser converts echo context to params.
-func (w *ServerInterfaceWrapper) LogoutUser(ctx echo.Context) error {
-	var err error
-
-	ctx.Set(CsrfAuthScopes, []string{})
+// DownloadDataExport operation middleware
+func (sh *strictHandler) DownloadDataExport(ctx echo.Context, id int) error {
+	var request DownloadDataExportRequestObject
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.LogoutUser(ctx)
-	return err
-}
+	request.Id = id
 
-// CreateUser converts echo context to params.
-func (w *ServerInterfaceWrapper) CreateUser(ctx echo.Context) error {
-	var err error
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DownloadDataExport(ctx.Request().Context(), request.(DownloadDataExportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DownloadDataExport")
+	}
 
-	ctx.Set(CsrfAuthScopes, []string{})
+	response, err := handler(ctx, request)
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CreateUser(ctx)
-	return err
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DownloadDataExportResponseObject); ok {
+		return validResponse.VisitDownloadDataExportResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// GetCategories converts echo context to params.
-func (w *ServerInterfaceWrapper) GetCategories(ctx echo.Context) error {
-	var err error
+// GetUserIdentities operation middleware
+func (sh *strictHandler) GetUserIdentities(ctx echo.Context) error {
+	var request GetUserIdentitiesRequestObject
 
-	ctx.Set(CsrfAuthScopes, []string{})
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUserIdentities(ctx.Request().Context(), request.(GetUserIdentitiesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUserIdentities")
+	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetCategories(ctx)
-	return err
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetUserIdentitiesResponseObject); ok {
+		return validResponse.VisitGetUserIdentitiesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// CreateCategory converts echo context to params.
-func (w *ServerInterfaceWrapper) CreateCategory(ctx echo.Context) error {
-	var err error
+// UnlinkUserIdentity operation middleware
+func (sh *strictHandler) UnlinkUserIdentity(ctx echo.Context, provider string) error {
+	var request UnlinkUserIdentityRequestObject
 
-	ctx.Set(CsrfAuthScopes, []string{})
+	request.Provider = provider
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CreateCategory(ctx)
-	return err
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UnlinkUserIdentity(ctx.Request().Context(), request.(UnlinkUserIdentityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UnlinkUserIdentity")
+	}
 
-// DeleteCategoryById converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteCategoryById(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "id" -------------
-	var id int
+	response, err := handler(ctx, request)
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+		return err
+	} else if validResponse, ok := response.(UnlinkUserIdentityResponseObject); ok {
+		return validResponse.VisitUnlinkUserIdentityResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
+	return nil
+}
 
-	ctx.Set(CsrfAuthScopes, []string{})
+// LinkUserIdentity operation middleware
+func (sh *strictHandler) LinkUserIdentity(ctx echo.Context, provider string) error {
+	var request LinkUserIdentityRequestObject
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteCategoryById(ctx, id)
-	return err
+	request.Provider = provider
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.LinkUserIdentity(ctx.Request().Context(), request.(LinkUserIdentityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "LinkUserIdentity")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(LinkUserIdentityResponseObject); ok {
+		return validResponse.VisitLinkUserIdentityResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// GetCategoryById converts echo context to params.
-func (w *ServerInterfaceWrapper) GetCategoryById(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "id" -------------
-	var id int
+// GetPersonalAccessTokens operation middleware
+func (sh *strictHandler) GetPersonalAccessTokens(ctx echo.Context) error {
+	var request GetPersonalAccessTokensRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPersonalAccessTokens(ctx.Request().Context(), request.(GetPersonalAccessTokensRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPersonalAccessTokens")
+	}
+
+	response, err := handler(ctx, request)
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+		return err
+	} else if validResponse, ok := response.(GetPersonalAccessTokensResponseObject); ok {
+		return validResponse.VisitGetPersonalAccessTokensResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
+	return nil
+}
 
-	ctx.Set(CsrfAuthScopes, []string{})
+// CreatePersonalAccessToken operation middleware
+func (sh *strictHandler) CreatePersonalAccessToken(ctx echo.Context) error {
+	var request CreatePersonalAccessTokenRequestObject
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetCategoryById(ctx, id)
-	return err
-}
+	var body CreatePersonalAccessTokenJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-// UpdateCategoryById converts echo context to params.
-func (w *ServerInterfaceWrapper) UpdateCategoryById(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "id" -------------
-	var id int
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreatePersonalAccessToken(ctx.Request().Context(), request.(CreatePersonalAccessTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreatePersonalAccessToken")
+	}
+
+	response, err := handler(ctx, request)
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+		return err
+	} else if validResponse, ok := response.(CreatePersonalAccessTokenResponseObject); ok {
+		return validResponse.VisitCreatePersonalAccessTokenResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
+	return nil
+}
 
-	ctx.Set(CsrfAuthScopes, []string{})
+// DeletePersonalAccessToken operation middleware
+func (sh *strictHandler) DeletePersonalAccessToken(ctx echo.Context, id int) error {
+	var request DeletePersonalAccessTokenRequestObject
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateCategoryById(ctx, id)
-	return err
-}
+	request.Id = id
 
-// GetMonthlySummaries converts echo context to params.
-func (w *ServerInterfaceWrapper) GetMonthlySummaries(ctx echo.Context) error {
-	var err error
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeletePersonalAccessToken(ctx.Request().Context(), request.(DeletePersonalAccessTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeletePersonalAccessToken")
+	}
 
-	ctx.Set(CsrfAuthScopes, []string{})
+	response, err := handler(ctx, request)
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetMonthlySummaries(ctx)
-	return err
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeletePersonalAccessTokenResponseObject); ok {
+		return validResponse.VisitDeletePersonalAccessTokenResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// CreateMonthlySummary converts echo context to params.
-func (w *ServerInterfaceWrapper) CreateMonthlySummary(ctx echo.Context) error {
-	var err error
+// ConfirmTOTP operation middleware
+func (sh *strictHandler) ConfirmTOTP(ctx echo.Context) error {
+	var request ConfirmTOTPRequestObject
 
-	ctx.Set(CsrfAuthScopes, []string{})
+	var body ConfirmTOTPJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CreateMonthlySummary(ctx)
-	return err
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ConfirmTOTP(ctx.Request().Context(), request.(ConfirmTOTPRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ConfirmTOTP")
+	}
 
-// DeleteMonthlySummaryById converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteMonthlySummaryById(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "id" -------------
-	var id int
+	response, err := handler(ctx, request)
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+		return err
+	} else if validResponse, ok := response.(ConfirmTOTPResponseObject); ok {
+		return validResponse.VisitConfirmTOTPResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
+	return nil
+}
 
-	ctx.Set(CsrfAuthScopes, []string{})
+// DisableTOTP operation middleware
+func (sh *strictHandler) DisableTOTP(ctx echo.Context) error {
+	var request DisableTOTPRequestObject
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteMonthlySummaryById(ctx, id)
-	return err
-}
+	var body DisableTOTPJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-// GetMonthlySummaryById converts echo context to params.
-func (w *ServerInterfaceWrapper) GetMonthlySummaryById(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "id" -------------
-	var id int
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DisableTOTP(ctx.Request().Context(), request.(DisableTOTPRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DisableTOTP")
+	}
+
+	response, err := handler(ctx, request)
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+		return err
+	} else if validResponse, ok := response.(DisableTOTPResponseObject); ok {
+		return validResponse.VisitDisableTOTPResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
+	return nil
+}
 
-	ctx.Set(CsrfAuthScopes, []string{})
+// EnrollTOTP operation middleware
+func (sh *strictHandler) EnrollTOTP(ctx echo.Context) error {
+	var request EnrollTOTPRequestObject
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetMonthlySummaryById(ctx, id)
-	return err
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.EnrollTOTP(ctx.Request().Context(), request.(EnrollTOTPRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EnrollTOTP")
+	}
 
-// UpdateMonthlySummaryById converts echo context to params.
-func (w *ServerInterfaceWrapper) UpdateMonthlySummaryById(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "id" -------------
-	var id int
+	response, err := handler(ctx, request)
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+		return err
+	} else if validResponse, ok := response.(EnrollTOTPResponseObject); ok {
+		return validResponse.VisitEnrollTOTPResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
+	return nil
+}
 
-	ctx.Set(CsrfAuthScopes, []string{})
+// RegenerateRecoveryCodes operation middleware
+func (sh *strictHandler) RegenerateRecoveryCodes(ctx echo.Context) error {
+	var request RegenerateRecoveryCodesRequestObject
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateMonthlySummaryById(ctx, id)
-	return err
-}
+	var body RegenerateRecoveryCodesJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-// GetTransactions converts echo context to params.
-func (w *ServerInterfaceWrapper) GetTransactions(ctx echo.Context) error {
-	var err error
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RegenerateRecoveryCodes(ctx.Request().Context(), request.(RegenerateRecoveryCodesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RegenerateRecoveryCodes")
+	}
 
-	ctx.Set(CsrfAuthScopes, []string{})
+	response, err := handler(ctx, request)
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTransactions(ctx)
-	return err
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(RegenerateRecoveryCodesResponseObject); ok {
+		return validResponse.VisitRegenerateRecoveryCodesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// CreateTransaction converts echo context to params.
-func (w *ServerInterfaceWrapper) CreateTransaction(ctx echo.Context) error {
-	var err error
+// GetWebhooks operation middleware
+func (sh *strictHandler) GetWebhooks(ctx echo.Context) error {
+	var request GetWebhooksRequestObject
 
-	ctx.Set(CsrfAuthScopes, []string{})
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetWebhooks(ctx.Request().Context(), request.(GetWebhooksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetWebhooks")
+	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CreateTransaction(ctx)
-	return err
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetWebhooksResponseObject); ok {
+		return validResponse.VisitGetWebhooksResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// DeleteTransactionById converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteTransactionById(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "id" -------------
-	var id int
+// CreateWebhook operation middleware
+func (sh *strictHandler) CreateWebhook(ctx echo.Context, params CreateWebhookParams) error {
+	var request CreateWebhookRequestObject
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	request.Params = params
+
+	var body CreateWebhookJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
 	}
+	request.Body = &body
 
-	ctx.Set(CsrfAuthScopes, []string{})
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateWebhook(ctx.Request().Context(), request.(CreateWebhookRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateWebhook")
+	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteTransactionById(ctx, id)
-	return err
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(CreateWebhookResponseObject); ok {
+		return validResponse.VisitCreateWebhookResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// GetTransactionById converts echo context to params.
-func (w *ServerInterfaceWrapper) GetTransactionById(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "id" -------------
-	var id int
+// DeleteWebhookById operation middleware
+func (sh *strictHandler) DeleteWebhookById(ctx echo.Context, id int) error {
+	var request DeleteWebhookByIdRequestObject
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteWebhookById(ctx.Request().Context(), request.(DeleteWebhookByIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteWebhookById")
 	}
 
-	ctx.Set(CsrfAuthScopes, []string{})
+	response, err := handler(ctx, request)
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTransactionById(ctx, id)
-	return err
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteWebhookByIdResponseObject); ok {
+		return validResponse.VisitDeleteWebhookByIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// UpdateTransactionById converts echo context to params.
-func (w *ServerInterfaceWrapper) UpdateTransactionById(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "id" -------------
-	var id int
+// GetWebhookById operation middleware
+func (sh *strictHandler) GetWebhookById(ctx echo.Context, id int) error {
+	var request GetWebhookByIdRequestObject
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetWebhookById(ctx.Request().Context(), request.(GetWebhookByIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetWebhookById")
 	}
 
-	ctx.Set(CsrfAuthScopes, []string{})
+	response, err := handler(ctx, request)
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateTransactionById(ctx, id)
-	return err
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetWebhookByIdResponseObject); ok {
+		return validResponse.VisitGetWebhookByIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// DeleteCurrentUser converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteCurrentUser(ctx echo.Context) error {
-	var err error
+// UpdateWebhookById operation middleware
+func (sh *strictHandler) UpdateWebhookById(ctx echo.Context, id int) error {
+	var request UpdateWebhookByIdRequestObject
 
-	ctx.Set(CsrfAuthScopes, []string{})
+	request.Id = id
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteCurrentUser(ctx)
-	return err
-}
+	var body UpdateWebhookByIdJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-// GetCurrentUser converts echo context to params.
-func (w *ServerInterfaceWrapper) GetCurrentUser(ctx echo.Context) error {
-	var err error
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateWebhookById(ctx.Request().Context(), request.(UpdateWebhookByIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateWebhookById")
+	}
 
-	ctx.Set(CsrfAuthScopes, []string{})
+	response, err := handler(ctx, request)
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetCurrentUser(ctx)
-	return err
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(UpdateWebhookByIdResponseObject); ok {
+		return validResponse.VisitUpdateWebhookByIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// UpdateCurrentUser converts echo context to params.
-func (w *ServerInterfaceWrapper) UpdateCurrentUser(ctx echo.Context) error {
-	var err error
+// GetWebhookDeliveries operation middleware
+func (sh *strictHandler) GetWebhookDeliveries(ctx echo.Context, id int) error {
+	var request GetWebhookDeliveriesRequestObject
 
-	ctx.Set(CsrfAuthScopes, []string{})
+	request.Id = id
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateCurrentUser(ctx)
-	return err
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetWebhookDeliveries(ctx.Request().Context(), request.(GetWebhookDeliveriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetWebhookDeliveries")
+	}
 
-// This is a simple interface which specifies echo.Route addition functions which
-// are present on both echo.Echo and echo.Group, since we want to allow using
-// either of them for path registration
-type EchoRouter interface {
-	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-}
+	response, err := handler(ctx, request)
 
-// RegisterHandlers adds each server route to the EchoRouter.
-func RegisterHandlers(router EchoRouter, si ServerInterface) {
-	RegisterHandlersWithBaseURL(router, si, "")
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetWebhookDeliveriesResponseObject); ok {
+		return validResponse.VisitGetWebhookDeliveriesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// Registers handlers, and prepends BaseURL to the paths, so that the paths
-// can be served under a prefix.
-func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+// ReplayWebhookDelivery operation middleware
+func (sh *strictHandler) ReplayWebhookDelivery(ctx echo.Context, id int, deliveryId int) error {
+	var request ReplayWebhookDeliveryRequestObject
 
-	wrapper := ServerInterfaceWrapper{
-		Handler: si,
+	request.Id = id
+	request.DeliveryId = deliveryId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ReplayWebhookDelivery(ctx.Request().Context(), request.(ReplayWebhookDeliveryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReplayWebhookDelivery")
 	}
 
-	router.GET(baseURL+"/auth/csrf", wrapper.GetCsrfToken)
-	router.POST(baseURL+"/auth/login", wrapper.LoginUser)
-	router.POST(baseURL+"/auth/logout", wrapper.LogoutUser)
-	router.POST(baseURL+"/auth/signup", wrapper.CreateUser)
-	router.GET(baseURL+"/categories", wrapper.GetCategories)
-	router.POST(baseURL+"/categories", wrapper.CreateCategory)
-	router.DELETE(baseURL+"/categories/:id", wrapper.DeleteCategoryById)
-	router.GET(baseURL+"/categories/:id", wrapper.GetCategoryById)
-	router.PATCH(baseURL+"/categories/:id", wrapper.UpdateCategoryById)
-	router.GET(baseURL+"/monthly-summaries", wrapper.GetMonthlySummaries)
-	router.POST(baseURL+"/monthly-summaries", wrapper.CreateMonthlySummary)
-	router.DELETE(baseURL+"/monthly-summaries/:id", wrapper.DeleteMonthlySummaryById)
-	router.GET(baseURL+"/monthly-summaries/:id", wrapper.GetMonthlySummaryById)
-	router.PATCH(baseURL+"/monthly-summaries/:id", wrapper.UpdateMonthlySummaryById)
-	router.GET(baseURL+"/transactions", wrapper.GetTransactions)
-	router.POST(baseURL+"/transactions", wrapper.CreateTransaction)
-	router.DELETE(baseURL+"/transactions/:id", wrapper.DeleteTransactionById)
-	router.GET(baseURL+"/transactions/:id", wrapper.GetTransactionById)
-	router.PATCH(baseURL+"/transactions/:id", wrapper.UpdateTransactionById)
-	router.DELETE(baseURL+"/users", wrapper.DeleteCurrentUser)
-	router.GET(baseURL+"/users", wrapper.GetCurrentUser)
-	router.PATCH(baseURL+"/users", wrapper.UpdateCurrentUser)
+	response, err := handler(ctx, request)
 
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ReplayWebhookDeliveryResponseObject); ok {
+		return validResponse.VisitReplayWebhookDeliveryResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+Ra32/bNhD+VwRuwF6U2GkLrNBbm3ZD1nYbmhQbEAQFI51tthKpklQ6I9D/PpCULFG/",
-	"rchKvKEvqUTyvvvueN+J9D3yWRQzClQK5N0jDt8SEPI1CwjoB+dYwprx7TkHLOHj7vVWvfQZlUCl+hPH",
-	"cUh8LAmjiy+CUfVM+BuIsPrrRw4r5KEfFoW1hXkrFo0WUJqmqbuz/ikODmzdspBZ/8Co3ITbyySK8CEZ",
-	"6LDTiORwbHTYyZBccUwF9tXahyOkzUgdw+GoaDOSYfgkgB+OgNrqJauHc7m2uraauoiDiBkVdkX4mD2c",
-	"fCuWjLsoAOFzEqvlkLez7eSIUOqit5wzPgpNzFkMXGalLgIh8FqvILcxIA8JyQldI0PBt4RwCJB3vRt4",
-	"4+YD2e0X8BsRa3AWXHuPTc5idflWLrOBjjAjLYyl5J8coLV2K7rSKAuZytHJIZlFW7Go1yUQqZst2y6Q",
-	"9eSiOGrKrDyD7hHQJFLJRajPIkAugn9iUPaKLCsmJQL4ZxKUFiRUwhp4LVe13WyFYl49c11U3X41F5rt",
-	"uZO6VkFPAuRaLnQBt0vXQSPQQXITwi6Br+G8xSGmvka0YjzCEnloFTIs0W5lmkS3hvsc4qDBmWODxnZk",
-	"mIu2gPnnSDnVXyzzhaxZbp1kd+d4P4OPwV0LFftwug9tEzPWszeeRs7txU87G9ZCTdS0tpc1XnDEEiMu",
-	"/ej9rAy17pqSVNVqkIqOZUY/eFDlLzZeGZmbr5y51sPPETHTtvhwxjQ9k9DWs93+n2lV/6ipEQMRJqEF",
-	"2DxpQNwq5zEW4jvjQX8NyWQ7N7Gb2AZ+Cth7NlEd/ZAx0Ya1JwOfKtGqtwY/4URuL1WPnX1yCr56lRhZ",
-	"IKoj3wAOgOdceOjvk/PLj7+cXP3x7u3vhQ84Ju9gazp6QldMAyUyVO+usPjqfMAUryECKp1Xf14gF90B",
-	"F6bnPztdni6VlywGimOCPPT8dHn6XMOXGw1rgRO5WfiCr9T/1qB5Vizrb5CLAHnoV5AK/BX7ChRVPqSf",
-	"LZcP+FpVZj9LvW4v/wpnNnbIN6vi0tHDHQ6SE7iDwITGNBPGLwc7xUD92vARsjXRmGImGhh5r16rDEVu",
-	"6bRx+wAm9sjl4Sk7JFetKZInkM4XYXf/4wp33zzQoXJE4vsgxCpRdJiNp+Fdgjw5Z+wr0RDsmZcg1D5y",
-	"GHd+++vKyYa5JVerkJX1F8uzti/1Ha0L+7THzsr3bO0Q6mBHiZSdkiyRnTnJErlLyskieJATJQO2HJZy",
-	"1UTedbleXt+kN1WG1OwaRYKsaRK3U2SEu3nfNgesdJGwaD4trW+XAfG3DoB00ixHJc0wvgxoBzsUvpco",
-	"y1qfLMytlb8Y1ZxW3aBrB60H91ZX9TB0Su6potmREDnGMUnRfrU0KjHmp8tKjrwbribI4p4EqamQIZhu",
-	"3CbyjX6eo3+9vQi07HAcgdSl9jprelTfUbQ8uhW05aehvhZd/E2N0xf1ur07Xzdgg7EcqlkvDsi84czB",
-	"BesuknityEKl5L1J3b7NOSfhM+75QwfA9H85+87t1rl40x6DGEt/U4+C+UaZJxAja1P9si39L4fVuDtg",
-	"X6kaF5kTxhMzvUcLrePIsYrYcnU2my5G1pUZAeGsGHfkBhw/4Vx9RiamQco5q00w26FDUG0Xx8hq368W",
-	"RonrYzFvSazN5raH58YUHajGtruPq8nVe9pjkeZKtGoa0bw3BtWPJyba026OeaR7XHQ6hXzOED2oJk4k",
-	"6scV9p20j4m8qqWyuN7oVPqr8rgxvDb9+GQ2iS972afuFiN9wl7yaoyqd/7ubpSkPwrNlp5Li5IWVqup",
-	"N1DBS949rnyXf8h0LNI9KDDukArwtIR6wpSfR6VLlNfqdL36dInzbDEZX9cmkuUjCvJOkwdXQqVAYsBp",
-	"ohGs5huNhiKlf+F4HNWpQ48NOZ2Hfl28LOe5fJijclRJ+kk4hJrrUTvFCsq6z+gqvI24/plodx9DBLJt",
-	"PSBTzaL8Li/CCQ+RhzZSxt5isTzV/7yXy5fLBY7J4u4MpW5lUMh8HG6YkN3Dzp79rFc7s4fdpP8GAAD/",
-	"/5rsTV4BNAAA",
+	"H4sIAAAAAAAC/+x9e3MbN5L4V0HNb6s22R9fUuxNoq37Q5GUrDZ27JLk9dVZPhmaaZJYDYEJgJHE9em7",
+	"X+E1T8yDFEnJ51T+iMXBo9HdaPQLjc9ByBYJo0ClCA4+B3PAEXD9z5MLPFP/j0CEnCSSMBocBEcp50Al",
+	"ugUuCKOITZGcA+IgWMpDCAaBCOewwKqnXCYQHARCckJnwcPDIDgDyZfDw6kEXh/6HEJGI4EkQ3eYSHQN",
+	"U8bV0JIv1QCeoQmVMAMePKjBE8zxAqQF/zSCRcIk0HD5Kyzrs72j5PcU0A0s1YQCTyFemrnsgn5PQcgR",
+	"OrQ/3hE5118EXphuHGTKqTA/SsYhUmhIGBUwCgYBUbMYfAaDgOKFArgA1VCBVVzTAt+/AjqT8+Bg/+XL",
+	"gQd9p9PXWIbz+mIUraqksPBBhK6X6JeTixG6DP5yGSBxQxIDtKNhOIfwZoTeA75BaiSBvnk//hZRuAWO",
+	"FmpGhGlkx0Mv9vablzcdGggHgUIg4RAFB5Kn0MYWD6YxCPkTiwho6h2GIUupPIYY1BKPGJ0SvjjLmmmC",
+	"hoxKoFL9EydJTEKs2o7/JRROPhdm/BOHaXAQ/L9xzuxj81WMTxaYxP8ETqa2v51EAfYwcIC8ozELb55i",
+	"/mhB6DsB/IzF8C6JsIRtQNE8jYXjCEuYMb484rAlELwzVGbfHgK8M9jZm0i0Sz44XSTABaNbm780gY8G",
+	"r9iM0Is3F2+3MXt1cDvna0blPF6ep4sF3ibvtczjhWR7fNgyj4XkLRbijvHoDATILQrGlnl8kGwdhMrc",
+	"hlnjwzAEIS7YDdDtsUfXZBYmxb9HLNoKCJWx3YwcU4FDNd5PabyV88k/RX3+7WG/aZI6DNvblk2TWBjU",
+	"ybk9BNRGL8y6vSXXRrezvofrOWM321uub4Ly3NtbtG8CPbdWUo1y71NQz+y3zSlk1fEzUAYV3d+2RJFt",
+	"mhkhQUl93DiA+ciNoKnPGThoyjjCqhcRkmPJuChBKLYHouiCUQDm4byEOacQbhyqfOBGmFyTHKCBzzL3",
+	"zWKbjXUbPfQxlvjkPmFcbnwpxaEbF6MaIdCtSgg+4Zz1YcuEs+sYFv9/xSPb9PIBpCfO2ZJQbQyf/XyE",
+	"vv9h8j2y86EIJCaxUGy7wDKo6+AbRqZPw/eBX2qHhMRcQlRmkHOQwyPGbgjUXQU4lfMrqVQYFOomzm9A",
+	"snEhQqnQZn2L0f4wCP7x/tfzjaPBDNq4+rfpdUxC5X/RjqJbZTAtkVoUUGlnRHp5ooyTIxzOYXjEqOQs",
+	"LoPjW5s2SI7mOI6BzmDjq6wO37xeqwIjHIaQSIiUR0opg0jzcMhugS9RyCJARCDndFGoUbPGIEETN1bz",
+	"BTU7ZuPrqg7fuC7bEAnTciOS7s3p8dFhKueMk39vZ496Zmg+l4vtnD9REUbR400C9PQYHTFKIZRK5twS",
+	"40nruY0ZicIrIbEEt40lQ9eERnr4EMfxNQ5vzHREoGvO7vpsabXAtxYYsRX0FUZvRF0DdrS+4LHFNg6n",
+	"d47mLWpb6y0qhJE+paPuzO5TZcBtHquV0RvhPCtKCzFCF3NYIswBiTm7o4jReIkYDTXE50shYXEusdw8",
+	"vKWxG6E1rdT5JomQJBQljCoJeEI5i+MF0M0rNtXhG6HUkhiylmUYGXuNqROC4ilUnQvG0ALTpRM/YoR0",
+	"CAbhqQSuRQVNF9fAlRIgbPyFUFSM05REUiWA0yaXi039zopNE602fhrLV8RPONUCybwHYglwJ6pVRy1s",
+	"CkNuE9w2BiuAuIkzciu24CpmYJCb8ccQEyWQNg5PbfxG0GxLFNmmPjC3BV4PsHJoHtzJ7fc+mJEOPgcJ",
+	"V4wsbRgtNG5biK6w/mptmYMgwhKGkiwgqMUZB0GoPS+r9SGRLyxrgI7SOButYorRyJkfM45DQAlwwpSO",
+	"G8coUoYjEcaxAVEms4hACgoVhOwHmzpDUo0PoOkiOPgQJECjPKSs4QsGQYhpCLH9t9We1b+nmKgfP/pC",
+	"snmY84PCQTZZCYt5T3b9Lwhl5vc4TCMiX7FZIwHNzi+Cngrgo4gIfB2rxeo/gRb/mjIewlXMZiyV7rdU",
+	"O7SuOMubFew9z9IGgXbVXDXRdR0eMRa1R/VsZh+SXOEo4iCEt5vEfAbySq2nAVIfibKVDRx+ayNlwJZA",
+	"6EfUojSs78h18GaoXVzfNWMxYFr8utKQsLCUyJqbX5qaXmlDmzTB0EQ+kxbgIZxmxD6nimrnJaIeepCB",
+	"XYHSzlDA3Qq0q4XAa1R8FPi6cysEonFiySSO63L0t0yJ0+kahM60B0e4HJqYLIjUSRxsOhUgtdqvDzAj",
+	"4BwTECr/+iIYeCiph1MTEwkLsbpzOBsTc46XNZyY4Qd2fT7k+NMDaghyHFdMppkMggWh7s89D4+bH3Ih",
+	"S2jIDHvdJ0CFX0D2lzqWV3WrvF/bKhvXt/JOW2tpNjOor0Atr891bltfx/7aGRVbCOUDv+7w9qhcVnHY",
+	"usoFnDPupTncJ4SD8KpcF3NAKthBbpWbiKJrQBG7ozHDkfL7UkniXMfqrWJNSQxXgvwbSuA3i5NGdbFF",
+	"VUs4C0EI84dHP3PrXllTy4HvPCQas3Q8kvoGqD8LsgiJaeab6mcCcXTiaFwefKq+qX/APVZ4UOOHLAHh",
+	"o80ChMAzKDdP6Q1VXh/dLejCl5kvH8oHb0sCUf38BGwtpordMzfZl0pEKtW/GJMYIBjNRggjkSY6mCRJ",
+	"eAPSOjCCQUlYvHzZtSQLQudKGtdQ3mT9tola1xqB1fpZGZQ2uW8R/zh/89t7uPbmvuZBFO3sUZGvl3vf",
+	"20jXCJ2dH5oAyxzfAqJab4ABOon2X77c+7HwKeS3+uP9KBhUsIPjWXEXn53vv/xrMAhOouPzQ++RE/Jb",
+	"vyzz/npDIv/vclme9jAYBG9+feudknqHSIV/yvvuzaxmN7CZYQYaDV7qFKJcNc5SCO6tahXo3KVk6XF9",
+	"0PijUfWjzbW4yoRbPVilQg5jFY8b65jTWDKZmORp7I9bdQqf6rSNKyjmD/YBvs6DCpzaqv46jMiMSAO9",
+	"aqKWgB+5CDuZby1tyYi1ZV3jGNOwfOxOY4ZlDpEVkA+5HuRrrPIyFmrXTDwdrSa1cr8WFXkQLAHzq4Va",
+	"bPlo2p/svxxO9oJBkGApgSsa/PeHyfDHj59fPAy/mXzYG/748X/2PkyG+x+//VMn5nOTvjDhoK4cDjJc",
+	"dhNl++Sok6DJQ9JMmtoYLWp9lRw99Ke+6Gy3CNoSXr8wbt8RQ7eiugCDD9uNAey657HY6irlcTdX1Ls0",
+	"gVALAtemz2O+xYOwweRrOO/yMXxwtOU318Gxjf1ArKLqD/KxOqFqBKe3+64CgmnlnbcrxblD/60E8JW7",
+	"Sef72Wi4nGOJIgYCUSaR6drbuPR4A/YmE09DawP1VZ30Ks9Vn05Wso4BO0FPBG7UCbyOudF0ZsRYaFf3",
+	"ahA0upg2iPbCZqq7LfSnEXpD42V+ue1uDiaBzzAaEchid+Rbg250lXCYkvv6JK8AK18DCueY41ACFy46",
+	"ZbmYIQlxbP4SCCeYy6CXr8E5lorTD3KbvcPr4CL8NYCbkhZr5lgeeqkE4O6TGLtUwgRC5dfIkoZYGOrb",
+	"nmGWI2inCQaF060YRFebe8pSGjX6q0QdhmMDs5oDcDhHhN7imERIOx2q5FZCRX82MNsxB/04r+BS8XAe",
+	"oUI67aKCZpu8lWA5VwzHoYgNh6eohJYxTsj4dm9cQI8Y77WHJ8uz/v3i4i0yH52NkQ3/YvLC51aTRMYe",
+	"+M/njMss1a5MS2S9tjnkvzGJfm4iovOxViL8Z6eIwxQMs2hhTyKgUgVjRPts+Jql8uA6xvSmcyvZzmaV",
+	"GeJ8O8abE+VxQ5lWVwq9j9E0KgP5IPJkPdV1Lh2Y1KH9nn7ULPinrZxVelK4q3SqJ20IxGFGhAQOERJE",
+	"0faT/t+nfkEjDiFQeVXYAld4UYMxYqmJXtcUak//BmgLaTJCJQ5AhBjVdrpOGlgJat22/7G49uq8y+oZ",
+	"iluhg88cNr397DMosWGNUXxwe5HQQr42znD49+2h6iU4T/Blew6cJneNP1ewBhqTiU69TznxK1EQcpDd",
+	"VoRtNygN6IUrV7MKHlEOODoonknBILjjREL1R90wNOE6Anmz0k+6EYeEcZm3yP/Wn+9MLlP+vfCDbgC3",
+	"QKXwemgrKX1vXI6eR3Qu/ILhdSqkVkuuAU10zisSZEbRlMUxuzNnkwB+C/zPwnxJWEzC5d9QwgSRKkpm",
+	"hhYmQfZ6iSKY4jSWA8VFFGa41EjpJ9YuRzmqci3VjI6Imcxoqd2uDDvSsjkdJ89Lq6ePYFkXZ83mQkWw",
+	"6ryYUqbk6bHKIrUXDdR6TXKRjgSYXK2RV26xpMiIRuUNBoHprYSR7tsVj67prxAqaV+pR1KAtwvYv2lR",
+	"YBQ85admqUREZtU9XkwmI/Tekc9Nk5myOt9ioL/lGaRTrc+qwZz+ZotmdMhmlvh3sv8Kbm0PLDLppxk0",
+	"OAiwZAsSBoMM69kP1yDkFUynjEsvxrPFrGDXNW3WBx2bOzVjvJyYQL79c69DsyrA0Qs1Io09mGkOlRMa",
+	"wb1/T7Gk8Ptq6nqZHe5YGkcmYpbZMYwiomTKHTWyQflLdBjbhjlf7O1bB4pjOZXLGZNQ+nWWVLsd/NlZ",
+	"hc2wVlJy1f2oMKbRk8/bmzY6H9uXNLEgUjall7mM7DU50czcqcTnQOQzdqyrw0X2x6H0+EOpf5ZVHugp",
+	"QjZwI1tidFC0By2f13HdYB80fVwxs6sfTtsjPI21E/7YL1/ofqkXpFg/QtHiV24Juvjd826K1giLAv5U",
+	"O6fkcrMe+kVTdnt2vbNzIVnLfDEdvuHWbPOvJM27ES92+uLdCeff8Gq99ZonXxBje+umeG+U3IKfvtYS",
+	"76tm2QlPVC+fQz3lZUSlnHRna3BN5qpLoLbK6t2uzezg5oxaBVCj4DZfnWu890Gd4KVKu/X24ZDEeOkn",
+	"kzEyrpyza0VrwLpfVrgvU+hRwERp2fliyuDlsGRL6pRoJbYq7N6/lP2MIztM5Vdj31d/tXfICmddoXv2",
+	"U943+ynvuDCJKlc2jFLoX/2SD1P94kbzyZ7KrcCVNu5azL7Zzd7E5Llfs2Iuvz480uqTCriaRo2hXcuA",
+	"XcHddeSN0XKLQmfgMN2XUbuU2i9I4GZrry/XkDLlRC7PFThmcT8B5sBVRpEnG9RXF2GEjs7Pfs6D9Uqr",
+	"d+CMXHkKjSU9cg7+XMpE3yARfOrm89ag/c+hmmF48ebXk9/y7jghOkX2Qft8pkzTw0RLgwssbtBrTPEM",
+	"9CX+w7enBZPmINgbTUYT6xSjOCHBQfDdaDL6ziR0zTUixqM7iOOhTqof/+vuRozcneCZ4f7MHXQaBQfB",
+	"LyBVCnBQqSq2P5k08UDWblwqu2PookwfERx8+GxYQCPrYDyOWYjjORPy4IfJD5Pg4eMgsJLIgGCMmf4V",
+	"ddA3au5vg5XmfBgEY61xjbG67TqM2Uw04kUnv/8C0l2MFUG5rvOHWqZRLjGQi/8xavIXrLqnueT3FPgy",
+	"Z5Lc9mmpKT347O2rL9OVemYO15cT7eo0KYL71tNp/trzeH8/+snf+5J5/7t41YvGdV+Yp35NRKQqHFS6",
+	"E/9i8l03h5ZLexVFh6ZgvoU/fPTzJM7mZtNyzTiUR6gkVoz0wanzDzmjCYllN48VIvJr7UNfjZSdYEhU",
+	"S6O0IiO7udmMjHNd/k5H/Lv221vMpQuyaKsE2cvR2pFirBXfpvm9vTK8f6fZO7wrFKGwdqR/uOzCVz5g",
+	"FhdxR352X9h3h2zb8qBhAnNn1z/DxJuT3Cli2rnTU5NRM/ZkZcbe9nYwnIuy68Ptu2D8mUQPnXLhnTk2",
+	"KttAE0Yd9zldSNSnzv2maPFYpKpeL7YsmbA7c/sQYmz3mlaZmWiiyLFp9QdVKr1+3CItLc4tPc0FQBoh",
+	"HMdW9hOOBAjReRIXqA20B7FP6B+0XptqBnkII3eIrbQbi/VgCkSqZsImMQ5tTme9lKi958cBR0MdabFM",
+	"4jQGXXhGx2/M9VtTszJkXN09J7Ss+43QK6UApqZwhVIGCUV4holOxAZb6tDOMAoGPn7Kr/JumalKpaj9",
+	"9C08cTJufM7hYR0O9Rem3emR/Tzl2CvLNMJJMhXZs/fH++4LW0ipXW79zHgIr0zL3QiuF74HlIxEVnsD",
+	"oucrpcxB4s4PbeKtJKlcVClJm8hhXHKZRfBcdn3HYz4PGzydvu59fzTHdGYuaShm6WYxdV/dFssbukL6",
+	"zafgRfG6kQAqVUaBsYYzn7UbxZVlBmHd1mV2tRcPKwX9gnWYq/PJrPX4q+GhgzW5bAUamgUgrBx7tScO",
+	"8tw0SzzBp23eVjXPhb2A+SifWyXKKPj0quf9z0Jbj3u9/ghA7ifnIDmBW4gM/iomV96wgA9T8Lvx0NL1",
+	"EqxGxNd8R2PtuHj/8HePuPfDQ1VsP2yUwoXqOe2wNtfGqdNWox/Z0Og0jfvX/T53CjVH/3h/gWyzrrLe",
+	"+5P97p3aUPJeb/S93R0n+z0OhqbSyg8PPu3PSP7K3tCFSXpskPdEztUVj3VEsvfJtAb2fFoGWVdh2HsS",
+	"Cvc9QOwDCBjJOzYUEhLzDkJ7OZoSn7Rq/kbfz6Tosxc5ypReh6VOTFm1ur3fwVar2Wna0K9uVUaicFyq",
+	"BdF0wJfKSqwVz/G/fVA/cfUDC0o5maUKK82PFZRX8dl9eRi75xkK66m6WSLCIZTCvRcx5ZqV9HVoy8TO",
+	"A+N8L1oVNQmtlWJD6pM6QSG6pJKhUmEmra7eseEUhzq6Vg77EoGM2y4aIb2Pha4cagZTZrUCAtQHpGMW",
+	"KLOzRpf0kCK4J0KqBA+nwZVf0S0HkIiwT87GhN4YdmMLrGCJ4+Xfyu9a5DdsLqme/z9Up6vs/RNsvUPa",
+	"6J9jjUfnQVLOJEJv3LVkTROF4IV1bCacqYqAo0ta09b/jmkUg2KUI0fCPrZlIauz/7O4LUEs2MRALj+r",
+	"rZ//4aT8WeESCgkVErAu6G3H9k2rqbXavCrECoq5c+sKex9XucOi8CCSnj0TVHb6/N2UVhiq/pbvjALl",
+	"36XVTVqWrK9YmN1ZbFn0xg/1ktw6yjaO5fMmsdUitTKTwiuCzxXe1c7Qqsv2tsValHEz/VlUOAdolDBC",
+	"5eZJtqUHetb1zeURU0UnZVRXGMAcKt+U0aMvS09jdmcE99tfj06+LXCIM8uGHARI50BpVpisSV+qcbSO",
+	"Xt31BO5DH2+pGwRp4Lfvz9DAGm50M2enoTmmS56kZjTzQqZhQ5DGXF3dn+wriSnnwHV2BpOFtA1SLO5Q",
+	"90tZdG6WVO002u+ikYVco4lMm9ayfUqe61goSjzAFaimclvTFgvT5On7fTDdePW/OFtHag8DbRPO4xWs",
+	"Mg00wojCXVXbT/Ur+4/zuzpNkwikBvPxtnnM3zo2H+FqNeOsLHhsb2RWuwuGNYAWPalmZlsdhUOi85zt",
+	"GaB065RD0YAxSaHDzMfn52hdF3t5Yn12K2O19Zn9XpjVI6DsdtDW8WoW7MmF6xbshTIabV7rcrGNbaeK",
+	"1p6m7c4SdV1QTISsZIpuGPWDcrq5N/8ojgsXNhUUbbLXwV5XVn1A503GpxEsEiaBhkudVL5OLND7nsX6",
+	"Qrz2XPEjnHo/ruXU298ptUvHiLutU91aWb6fLS1S4wMd1cr44KflabSVWPGgm6Omr1UhkaBfoD/bdO4+",
+	"0SOIvVbwdm9/LRb5YacsYoiLcM4eefC3ICTUBYUO+bs9zlgrIW2Te/3F7mV0Rg91JJ4eN1Ml0VuiRheT",
+	"OPHsNu2a8n9DaSBPyxPri4QvQZC8syWjOgWJOn3MTbqxkBzwosVbpj6fuFt3rVcuTo9d+mSMtY4VArmF",
+	"COmZRsgMYlV5Yp720gqnSZXU7tHQOHlGl1S3RqfHxpGPhSlg4d4JMyVwBkgwM3p23xKliXJVYbQgNJXg",
+	"2tenwwKpi3DGee69n/cKCznUYAz15l9dOhbrZMC9NCgf5hhv8aBV1VeDDtN1hE5U+Vk9mokZzEFFpa7Z",
+	"vf3x9Fit7xOJPpmKX+ZXNckl/UaXbfLcT/5W99FNP2VxiVAnKGnXAUt5qCiB1Ese6FOEJf40uqSORJRJ",
+	"FbUwlEGMRzYfG1M7/QIv9SNO5kI8RGjBdH1aTPVrzpqYYUw0NcVcl6IiM6ranB6LSyrVK9C6NhWOOeBo",
+	"mTNYyaG/SLBCooJ+MbqkuiJaPtPLycRxzB1wQAsiBEQD9VoPobMY0CcOAuQnC3NmH8VYgpA5ep1db6e+",
+	"pJbzzXh2ioFGQGVNHNT1cxvCUUhULPgEZojZ2ZbADid2FaacsrRJ9QKd60uUw3P1WyYLnGRxNRC0VLEX",
+	"yYdmnrLVWHnYmAO+sUWs6QwObCnnrBhZqvCoXNG2ZE6EsETj8kV1AmJ0SS+0q1LOEWV3pVI7THsUHesK",
+	"TY1UqH+g+TJRjPFNHeBvR5fOoM+imiyOzAz2AueLyQtf1O0XkKVXInZlDvvf/OhhFNuOWcXlZ2MbL0qA",
+	"ETCljqqsWWDCWofgY8Go/j/PecbKLPPBk3gLWt4EWt9nUOXvr9BzUObvZQfneyWxx8mwlX2h59n45tCj",
+	"PmKHGCO7zElfjB+lKqf/cKf0cadU9kzNivefGdtUVp7n1qiqLc/Mi7R56f8UvqT1mDHzLH1F7Gi8GM9U",
+	"Uj9KHdqQC+05bIivxJG2zq5VylfpjYKWuOlF9S2DbZuKvrrZ3XZiodfzsRGLOO4yD4ttS5ahz4wqrPZJ",
+	"bKimut3rG1Alqn+F1pMsUbSBKar7dnydxjfNSSSqYHtl+z4pqxReXFj/aKmN94yZpRHWx7PNoP76Rlnc",
+	"EJp7jJUP9xoLWJPL+oX+i4v9UqzW4qnxh8Xax2LtxUGDPtrE8zLhNnz+PIX9ViBCTQusaxdtCQHPcSuv",
+	"f+xsyKZ5cg75Sgya3mdUVo2xyVusksuVI8BeprDXVFTi6Agd6hh/tbqEbanDrXGsz019wKpLbKJYeemS",
+	"Yg7uyMgzpmcch4AS4ITpMl/CvJgF9uaeDuvfzUkMKKXZXDrpXUGaJb+OLukl7etBcYe/hQyRxQIigiXE",
+	"Sw27dWXsa1fGqdQeFGZKvTrnRxELeRd968GUmNIDC4mXAqWmnFlKJYlVz0vqRaC95fLm/AL5S4Jk8fs6",
+	"zhp940fGdPJfE95/ZAGOH7d6YcXcqssW74liF5jdcHZrNl0bJia7uaiwiyqIVST9WSm2pixGWT7kKGtP",
+	"davgbY2rIhs6Tr4ECliZ3INTM3k8Lpb8aTIYjjANIfYV6ulS2F1jFOohYqevbxMLBtrSjZhsH0epy+Qp",
+	"ybGV9nInHh5bWmgXm9RmHzWVF+op8HI2gvuEcdl+f0nVq1VvTX2j073UeXJ0/s9v1TF0nZJYIiyWNJxz",
+	"Rlkq4uUIvWWxIaMZ3J5hRJqDy1S4aL6/d4wlPrm35e5WP3vy7ptwPuym7Ic7tjDNcM2muWa0Hk1bvc05",
+	"lnbjbC5SpbevWXVyPFTxNa++caJ8MLEuRtuLMZfQ+nws7qYNsf3CygWU98buOGJ31D0x5EXzsW3wNLhu",
+	"2Bb/Jkl5V2Qlxa4JtVk6XYm9/3X61m3/8t3+IxzOYXjEqOQs7rrgf2QAHB4TYV4S7KoJ8LDTgojKuN7m",
+	"/UbHHUaNKAjT/OTpzZfEPLnXceGx8DjfrrI8fe8B9pCnr0yRmsKy1iyItZoggHsJXD2qYyde5gWHXN0c",
+	"yVaTx/kSCtU+2jThd1TNVMTb7sp9eBVsB4a6Uq1xsAMrQ8/kqaNRqyjUbS83VXFwNUzm4MqDKNqWKnRc",
+	"pTw23o5sXp7VjsoLjOQFRwbKlRPONa+IonlQVyFfPSGRe5brOiziYncnsqmholCoPWTNPLDqVjTPLLUJ",
+	"SPeo1qEu43Zh2u9CTHomXkFaet8Ce7wemviGFb0dVT2qTLDSi3TEvizlYB4hXQtEXYMS6DIoseMBMp5j",
+	"dJlOJt+FekT9T7gMRg2Z5h4kr1V0pT7MhjIevFyw89IhXrL32Fg9g8J+KjxRUfcLWwH4lu3kXDvTE62J",
+	"Y5n0rgG1bklV1e+IRY93ZZ7Zop9qMPE0CRmr18JWq0dAOYvjRXaPDtsyXU7fKNRwZBzhJOmmWudLPPZB",
+	"mO1Szcf9jaUps4e6dp5Dsxs3lsV4c3HObqoaRmkm6on+ntF05djym4u3Jxkv7m4zGO2ruhWUJ3Vm1QLz",
+	"nKxOXsS0iLa++8FVBB6qnSWaMXgGM6DqByiJk69dsO3S2esIUK7iLEboLYdbop3oiAiRQmQ+qCAMogzF",
+	"jM6Aq1R+FZNuYAn78HCrRv7etdmFFl55JLqH5m17PJ/8YofTrtxi164zr9iu8EkSRX1v7q+vXWfU/Tpy",
+	"ic9szUabTXyX0dHDAsXd2FONt9jcYcqeR4Fx2+9JEjU3kjzZSpZBl1h8XgmTG9xgT5EsaRFfS5Qsi8q2",
+	"VJKdUGU9GbqhFJUvlsRZLuFqcnBsC790BFYsWo7zxk8f53ucDmSXslxDF7IoW6I5EZLxJeJfHLdkkfjq",
+	"UnR4bm0WGn92A56qh/YgifGyzfxR3yv02FbitWeUHNbHsml/5Sxnuy+NZfKy1TJnmwQvdYRXp/k28Iue",
+	"lN86cqY8Dg6CuZTJwXg8Gen/Dn6Y/DAZ44SMb/eCh0GlUcxCHM+ZkO3N9va/16PtlZt9fPjfAQCcctz7",
+	"ptwAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
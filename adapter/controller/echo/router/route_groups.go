@@ -0,0 +1,115 @@
+package router
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	mymiddleware "household-account-backend/adapter/controller/echo/middleware"
+	"household-account-backend/pkg/logger"
+)
+
+// wellKnownPath はAPIのパスの外に公開するエンドポイントのパス
+const wellKnownPath = "/.well-known"
+
+// routeGroup はパスの先頭が一致する操作に設定するミドルウェア
+type routeGroup struct {
+	prefix     string
+	middleware []echo.MiddlewareFunc
+}
+
+// groupRouter はOpenAPIの定義から生成した登録処理を受け取り、パスに応じたグループのミドルウェアを付けてechoに登録する
+// 定義にあってグループに属さない操作は、認証などを付け忘れたまま公開しないよう起動時にエラーにする
+type groupRouter struct {
+	echo   *echo.Echo
+	groups []routeGroup
+	// routes は特定の操作だけに追加するミドルウェア。キーは"METHOD パス"
+	routes map[string][]echo.MiddlewareFunc
+}
+
+func newGroupRouter(e *echo.Echo) *groupRouter {
+	return &groupRouter{
+		echo:   e,
+		routes: map[string][]echo.MiddlewareFunc{},
+	}
+}
+
+// Group はprefixで始まる操作にミドルウェアを設定する
+func (r *groupRouter) Group(prefix string, middleware ...echo.MiddlewareFunc) {
+	r.groups = append(r.groups, routeGroup{prefix: prefix, middleware: middleware})
+}
+
+// Route は1つの操作だけにミドルウェアを追加する
+func (r *groupRouter) Route(method, path string, middleware ...echo.MiddlewareFunc) {
+	key := method + " " + path
+	r.routes[key] = append(r.routes[key], middleware...)
+}
+
+func (r *groupRouter) add(method, path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	// JWKSなどはAPIのバージョンに依存しないパスで公開する
+	if strings.HasPrefix(path, mymiddleware.APIBasePath+wellKnownPath+"/") {
+		path = strings.TrimPrefix(path, mymiddleware.APIBasePath)
+	}
+
+	group, ok := r.match(path)
+	if !ok {
+		logger.Fatal("no route group for " + method + " " + path)
+	}
+
+	middleware := append([]echo.MiddlewareFunc{}, group.middleware...)
+	middleware = append(middleware, r.routes[method+" "+path]...)
+	middleware = append(middleware, m...)
+	return r.echo.Add(method, path, h, middleware...)
+}
+
+// match はパスの先頭が最も長く一致するグループを返す
+func (r *groupRouter) match(path string) (routeGroup, bool) {
+	var matched routeGroup
+	found := false
+	for _, group := range r.groups {
+		if path != group.prefix && !strings.HasPrefix(path, group.prefix+"/") {
+			continue
+		}
+		if !found || len(group.prefix) > len(matched.prefix) {
+			matched, found = group, true
+		}
+	}
+	return matched, found
+}
+
+func (r *groupRouter) CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.add(http.MethodConnect, path, h, m...)
+}
+
+func (r *groupRouter) DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.add(http.MethodDelete, path, h, m...)
+}
+
+func (r *groupRouter) GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.add(http.MethodGet, path, h, m...)
+}
+
+func (r *groupRouter) HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.add(http.MethodHead, path, h, m...)
+}
+
+func (r *groupRouter) OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.add(http.MethodOptions, path, h, m...)
+}
+
+func (r *groupRouter) PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.add(http.MethodPatch, path, h, m...)
+}
+
+func (r *groupRouter) POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.add(http.MethodPost, path, h, m...)
+}
+
+func (r *groupRouter) PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.add(http.MethodPut, path, h, m...)
+}
+
+func (r *groupRouter) TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.add(http.MethodTrace, path, h, m...)
+}
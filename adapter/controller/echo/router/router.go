@@ -3,62 +3,65 @@ package router
 import (
 	"encoding/json"
 	"net/http"
-	"os"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	echoSwagger "github.com/swaggo/echo-swagger"
 	"github.com/swaggo/swag"
-	"gorm.io/gorm"
 
 	mymiddleware "household-account-backend/adapter/controller/echo/middleware"
 	"household-account-backend/adapter/controller/echo/handler"
+	"household-account-backend/adapter/controller/container"
 	"household-account-backend/adapter/controller/echo/presenter"
-	"household-account-backend/adapter/gateway"
-	"household-account-backend/pkg"
+	"household-account-backend/entity"
+	"household-account-backend/pkg/config"
 	"household-account-backend/pkg/logger"
-	"household-account-backend/usecase"
 )
 
 // Swagger の設定
-func setupSwagger(router *echo.Echo) (*openapi3.T, error) {
-	swagger, err := presenter.GetSwagger()
-	if err != nil {
-		return nil, err
-	}
-
-	env := pkg.GetEnvDefault("APP_ENV", "development")
+// 開発環境ではSwagger UIで定義を確認できるようにする
+func setupSwagger(router *echo.Echo, swagger *openapi3.T, env string) {
 	if env == "development" {
 		swaggerJson, _ := json.Marshal(swagger)
 		var SwaggerInfo = &swag.Spec{
 			InfoInstanceName: "swagger",
 			SwaggerTemplate:  string(swaggerJson),
 		}
-		swag.Register(SwaggerInfo.InstanceName(), SwaggerInfo)
+		// ルーターを複数作成する場合も定義は1度だけ登録する
+		if swag.GetSwagger(SwaggerInfo.InstanceName()) == nil {
+			swag.Register(SwaggerInfo.InstanceName(), SwaggerInfo)
+		}
 		router.GET("/swagger/*", echoSwagger.WrapHandler)
 	}
-
-	return swagger, nil
 }
 
 // Echo 用のルータを作成。
-func NewEchoRouter(db *gorm.DB) *echo.Echo {
+// ハンドラーとユースケースはcontainerで組み立てたものを使い、ginのルーターと同じものになる
+func NewEchoRouter(deps *container.Container) *echo.Echo {
 	router := echo.New()
+	// エラーはすべてRFC 7807のproblem+jsonで返す
+	router.HTTPErrorHandler = handler.HTTPErrorHandler
+	// ログ・レート制限・監査ログのIPアドレスは信頼するプロキシを経由した場合のみヘッダーから取得する
+	router.IPExtractor = mymiddleware.NewIPExtractor(deps.TrustedProxies)
 
 	// ミドルウェア設定
 	router.Use(mymiddleware.CustomRequestLogger())
 	router.Use(mymiddleware.CustomRecovery())
 	router.Use(middleware.CORSWithConfig(middleware.CORSConfig{
-		AllowOrigins:     []string{"http://localhost:3000", os.Getenv("FE_URL")},
-		AllowHeaders:     []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAccessControlAllowHeaders, echo.HeaderXCSRFToken},
+		AllowOrigins:     []string{"http://localhost:3000", deps.Config.FrontendURL},
+		AllowHeaders:     []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAccessControlAllowHeaders, echo.HeaderXCSRFToken, mymiddleware.IdempotencyKeyHeader, "If-Match"},
+		ExposeHeaders:    []string{"ETag", mymiddleware.IdempotentReplayedHeader, mymiddleware.RateLimitLimitHeader, mymiddleware.RateLimitRemainingHeader, mymiddleware.RateLimitResetHeader, mymiddleware.RateLimitPolicyHeader, "Retry-After"},
 		AllowMethods:     []string{"GET", "PUT", "PATCH", "POST", "DELETE"},
 		// AllowMethods:     []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete},
 		AllowCredentials: true,
 	}))
 	router.Use(middleware.CSRFWithConfig(middleware.CSRFConfig{
+		// パーソナルアクセストークンはブラウザが自動で送信しないためCSRFトークンを要求しない
+		// Authorizationヘッダーはクロスオリジンでは許可していないため、ブラウザからは付与できない
+		Skipper:        mymiddleware.HasBearerToken,
 		CookiePath:     "/",
-		CookieDomain:   os.Getenv("API_DOMAIN"),
+		CookieDomain:   deps.Config.APIDomain,
 		CookieHTTPOnly: true,
 		// CookieSameSite: http.SameSiteNoneMode,
 		CookieSameSite: http.SameSiteDefaultMode,
@@ -66,71 +69,64 @@ func NewEchoRouter(db *gorm.DB) *echo.Echo {
 	}))
 
 	// Swagger の設定
-	_, err := setupSwagger(router)
+	setupSwagger(router, deps.Swagger, deps.Config.Env)
+	// リクエストはOpenAPIの定義で検証してからハンドラーに渡す
+	requestValidation, err := mymiddleware.OpenAPIValidationMiddleware(deps.Swagger)
 	if err != nil {
-		logger.Warn("Swagger setup error: " + err.Error())
+		logger.Fatal("failed to build request validation: " + err.Error())
 	}
+	idempotencyMiddleware := mymiddleware.IdempotencyMiddleware(deps.IdempotencyUseCase)
 
-	userRepository := gateway.NewUserRepository(db)
-	userUseCase := usecase.NewUserUseCase(userRepository)
-	userHandler := handler.NewUserHandler(userUseCase)
-
-	categoryRepository := gateway.NewCategoryRepository(db)
-	categoryUseCase := usecase.NewCategoryUseCase(categoryRepository)
-	categoryHandler := handler.NewCategoryHandler(categoryUseCase)
-
-	transactionRepository := gateway.NewTransactionRepository(db)
-	transactionUseCase := usecase.NewTransactionUseCase(transactionRepository)
-	transactionHandler := handler.NewTransactionHandler(transactionUseCase)
+	// jwtMiddleware はパーソナルアクセストークンでのアクセスにscopesを要求する
+	jwtMiddleware := func(scopes mymiddleware.TokenScopes) echo.MiddlewareFunc {
+		return mymiddleware.JWTMiddleware(deps.KeyManager, deps.PersonalAccessTokenUseCase, deps.SessionUseCase, scopes)
+	}
 
-	monthlySummaryRepository := gateway.NewMonthlySummaryRepository(db)
-	monthlySummaryUseCase := usecase.NewMonthlySummaryUseCase(monthlySummaryRepository)
-	monthlySummaryHandler := handler.NewMonthlySummaryHandler(monthlySummaryUseCase)
+	// レート制限はグループごとに別のバケットで数える
+	rateLimit := func(name string, limit config.RateLimitConfig) echo.MiddlewareFunc {
+		return mymiddleware.RateLimitMiddleware(deps.RateLimitStore, mymiddleware.RateLimitConfig{
+			Name:   name,
+			Limit:  limit.Limit,
+			Period: limit.Period,
+		})
+	}
 
+	// OpenAPIの定義の操作は生成したハンドラーで登録し、パスの先頭に応じてミドルウェアを設定する
+	api := newGroupRouter(router)
 	// ユーザー用エンドポイント
-	users := router.Group("/api/v1/users")
-	users.Use(mymiddleware.JWTMiddleware())
-	users.GET("", userHandler.GetCurrentUser)
-	users.PATCH("", userHandler.UpdateUser)
-	users.DELETE("", userHandler.DeleteUser)
-
+	// アカウントやトークンの管理はパーソナルアクセストークンでは行えない
+	api.Group(mymiddleware.APIBasePath+"/users", jwtMiddleware(mymiddleware.TokenScopes{}), rateLimit("users", deps.Config.RateLimitDefault), requestValidation)
+	// アーカイブの作成は負荷が高いため、他のエンドポイントとは別に回数を制限する
+	api.Route(http.MethodPost, mymiddleware.APIBasePath+"/users/export", rateLimit("exports", deps.Config.RateLimitExports))
 	// 認証用エンドポイント
-	auth := router.Group("/api/v1/auth")
-	auth.POST("/login", userHandler.Login)
-	auth.POST("/signup", userHandler.Signup)
-	auth.POST("/logout", userHandler.Logout)
-	auth.GET("/csrf", userHandler.CsrfToken)
-
+	api.Group(mymiddleware.APIBasePath+"/auth", rateLimit("auth", deps.Config.RateLimitAuth), requestValidation)
 	// カテゴリー用エンドポイント
-	categories := router.Group("/api/v1/categories")
-	categories.Use(mymiddleware.JWTMiddleware())
-	categories.GET("", categoryHandler.GetCategoriesByUserID)
-	categories.POST("", categoryHandler.CreateCategory)
-	categories.GET("/:id", categoryHandler.GetCategoryByID)
-	categories.PATCH("/:id", categoryHandler.UpdateCategory)
-	categories.DELETE("/:id", categoryHandler.DeleteCategory)
-
+	api.Group(mymiddleware.APIBasePath+"/categories", jwtMiddleware(mymiddleware.TokenScopes{Read: entity.ScopeReadCategories, Write: entity.ScopeWriteCategories}), rateLimit("categories", deps.Config.RateLimitDefault), requestValidation, idempotencyMiddleware)
 	// 取引用エンドポイント
-	transactions := router.Group("/api/v1/transactions")
-	transactions.Use(mymiddleware.JWTMiddleware())
-	transactions.GET("", transactionHandler.GetTransactionsByUserID)
-	transactions.POST("", transactionHandler.CreateTransaction)
-	transactions.GET("/:id", transactionHandler.GetTransactionByID)
-	transactions.PATCH("/:id", transactionHandler.UpdateTransaction)
-	transactions.DELETE("/:id", transactionHandler.DeleteTransaction)
-
+	api.Group(mymiddleware.APIBasePath+"/transactions", jwtMiddleware(mymiddleware.TokenScopes{Read: entity.ScopeReadTransactions, Write: entity.ScopeWriteTransactions}), rateLimit("transactions", deps.Config.RateLimitTransactions), requestValidation, idempotencyMiddleware)
 	// 月次集計用エンドポイント
-	monthlySummaries := router.Group("/api/v1/monthly_summaries")
-	monthlySummaries.Use(mymiddleware.JWTMiddleware())
-	monthlySummaries.GET("", monthlySummaryHandler.GetMonthlySummariesByUserID)
-	monthlySummaries.POST("", monthlySummaryHandler.CreateMonthlySummary)
-	monthlySummaries.GET("/:id", monthlySummaryHandler.GetMonthlySummaryByID)
-	monthlySummaries.PATCH("/:id", monthlySummaryHandler.UpdateMonthlySummary)
-	monthlySummaries.DELETE("/:id", monthlySummaryHandler.DeleteMonthlySummary)
+	api.Group(mymiddleware.APIBasePath+"/monthly-summaries", jwtMiddleware(mymiddleware.TokenScopes{Read: entity.ScopeReadReports, Write: entity.ScopeWriteReports}), rateLimit("monthly_summaries", deps.Config.RateLimitDefault), requestValidation, idempotencyMiddleware)
+	// Webhook用エンドポイント
+	api.Group(mymiddleware.APIBasePath+"/webhooks", jwtMiddleware(mymiddleware.TokenScopes{Read: entity.ScopeReadWebhooks, Write: entity.ScopeWriteWebhooks}), rateLimit("webhooks", deps.Config.RateLimitDefault), requestValidation, idempotencyMiddleware)
+	// イベント配信用エンドポイント
+	api.Group(mymiddleware.APIBasePath+"/events", jwtMiddleware(mymiddleware.TokenScopes{Read: entity.ScopeReadEvents}), rateLimit("events", deps.Config.RateLimitDefault), requestValidation)
+	// 管理者用エンドポイント
+	api.Group(mymiddleware.APIBasePath+"/admin", jwtMiddleware(mymiddleware.TokenScopes{}), mymiddleware.RequireRole(entity.UserRoleAdmin), rateLimit("admin", deps.Config.RateLimitDefault), requestValidation)
+	// 公開鍵はAPIのパスの外で公開する
+	api.Group(wellKnownPath)
+
+	presenter.RegisterHandlersWithBaseURL(api, handler.NewStrictHandler(deps.Server), mymiddleware.APIBasePath)
+
+	// GraphQLはRESTと同じユースケースを使い、認証もCookieのJWTを使う
+	// 複数のスコープにまたがるため、パーソナルアクセストークンでは利用できない
+	graphqlHandler := handler.NewGraphQLHandler(deps.GraphQL)
+	router.POST("/graphql", graphqlHandler.Query, jwtMiddleware(mymiddleware.TokenScopes{}), rateLimit("graphql", deps.Config.RateLimitDefault))
 
 	// Swagger やその他のルート
 	// router.GET("/", handler.Index)
 	router.GET("/health", handler.Health)
+	// レディネスはデータベースに接続できるかを確認し、接続プールの統計も返す
+	router.GET("/health/ready", handler.NewHealthHandler(deps.HealthUseCase).Ready)
 
 	return router
 }
\ No newline at end of file
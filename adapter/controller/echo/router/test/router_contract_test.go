@@ -0,0 +1,123 @@
+package router_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+
+	"household-account-backend/adapter/controller/container"
+	mymiddleware "household-account-backend/adapter/controller/echo/middleware"
+	"household-account-backend/adapter/controller/echo/presenter"
+	"household-account-backend/adapter/controller/echo/router"
+	"household-account-backend/pkg/config"
+	"household-account-backend/pkg/tester"
+)
+
+// RouterContractSuite はルーターに登録したルートとOpenAPIの定義の操作が一致することを検証する
+// 定義だけを変更した場合や、定義にないルートを追加した場合に失敗する
+type RouterContractSuite struct {
+	tester.DBSQLiteSuite
+	router *echo.Echo
+}
+
+func TestRouterContractSuite(t *testing.T) {
+	suite.Run(t, new(RouterContractSuite))
+}
+
+func (suite *RouterContractSuite) SetupSuite() {
+	suite.DBSQLiteSuite.SetupSuite()
+	configs := config.NewConfig()
+	configs.Secret = "router-contract-test"
+	deps, err := container.New(suite.DB, configs)
+	suite.Require().NoError(err)
+	suite.router = router.NewEchoRouter(deps)
+}
+
+func (suite *RouterContractSuite) TearDownSuite() {
+	suite.DBSQLiteSuite.TearDownSuite()
+}
+
+// specRoutes は定義の操作を"METHOD パス"の形で返す。パスはechoの形式にする
+func (suite *RouterContractSuite) specRoutes() map[string]bool {
+	swagger, err := presenter.GetSwagger()
+	suite.Require().NoError(err)
+
+	routes := map[string]bool{}
+	for path, item := range swagger.Paths.Map() {
+		echoPath := strings.NewReplacer("{", ":", "}", "").Replace(path)
+		if !strings.HasPrefix(path, "/.well-known/") {
+			echoPath = mymiddleware.APIBasePath + echoPath
+		}
+		for method := range item.Operations() {
+			routes[method+" "+echoPath] = true
+		}
+	}
+	return routes
+}
+
+// apiRoutes はルーターに登録したAPIのルートを返す。ヘルスチェックやSwagger UIは定義の対象外
+func (suite *RouterContractSuite) apiRoutes() map[string]bool {
+	routes := map[string]bool{}
+	for _, route := range suite.router.Routes() {
+		if route.Method == echo.RouteNotFound {
+			continue
+		}
+		if !strings.HasPrefix(route.Path, mymiddleware.APIBasePath+"/") && !strings.HasPrefix(route.Path, "/.well-known/") {
+			continue
+		}
+		routes[route.Method+" "+route.Path] = true
+	}
+	return routes
+}
+
+func (suite *RouterContractSuite) TestEveryOperationIsRouted() {
+	routes := suite.apiRoutes()
+	var missing []string
+	for route := range suite.specRoutes() {
+		if !routes[route] {
+			missing = append(missing, route)
+		}
+	}
+	sort.Strings(missing)
+	suite.Assert().Empty(missing, "operations defined in the API spec but not routed")
+}
+
+func (suite *RouterContractSuite) TestEveryRouteIsDefined() {
+	spec := suite.specRoutes()
+	var undefined []string
+	for route := range suite.apiRoutes() {
+		if !spec[route] {
+			undefined = append(undefined, route)
+		}
+	}
+	sort.Strings(undefined)
+	suite.Assert().Empty(undefined, "routes not defined in the API spec")
+}
+
+func (suite *RouterContractSuite) TestGroupMiddlewareIsApplied() {
+	// 認証が必要な操作はトークンがなければ401になる
+	req := httptest.NewRequest(http.MethodGet, mymiddleware.APIBasePath+"/monthly-summaries", nil)
+	rec := httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, req)
+	suite.Assert().Equal(http.StatusUnauthorized, rec.Code)
+
+	// 公開鍵はAPIのパスの外で認証なしに取得できる
+	req = httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil)
+	rec = httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, req)
+	suite.Assert().Equal(http.StatusOK, rec.Code)
+	suite.Assert().Equal("public, max-age=300", rec.Header().Get("Cache-Control"))
+
+	// GraphQLは定義の外だが、RESTのAPIと同じく認証が必要
+	req = httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{"query":"{ me { id } }"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer invalid")
+	rec = httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, req)
+	suite.Assert().Equal(http.StatusUnauthorized, rec.Code)
+}
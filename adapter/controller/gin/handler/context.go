@@ -0,0 +1,26 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"household-account-backend/adapter/controller/echo/handler"
+	"household-account-backend/adapter/controller/gin/middleware"
+	"household-account-backend/adapter/controller/gin/presenter"
+)
+
+// RequestContextMiddleware はミドルウェアがginのコンテキストに設定した値を、ハンドラーが受け取るcontext.Contextに移す
+// echoのサーバーと同じhandler.NewRequestContextを使い、ハンドラーからは同じ値が見えるようにする
+func RequestContextMiddleware(f presenter.GinStrictHandlerFunc, operationID string) presenter.GinStrictHandlerFunc {
+	return func(c *gin.Context, request interface{}) (interface{}, error) {
+		info := handler.RequestInfo{ClientIP: c.ClientIP()}
+		info.UserID, _ = middleware.AuthenticatedUserID(c)
+		info.CSRFToken = c.GetString(middleware.CSRFContextKey)
+		c.Request = c.Request.WithContext(handler.NewRequestContext(c.Request.Context(), info))
+		return f(c, request)
+	}
+}
+
+// NewStrictHandler はechoのサーバーと共通のハンドラーをginのルーターに登録できる形にする
+func NewStrictHandler(server *handler.Server) presenter.GinServerInterface {
+	return presenter.NewGinStrictHandler(server, []presenter.GinStrictMiddlewareFunc{RequestContextMiddleware})
+}
@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"household-account-backend/adapter/controller/gin/middleware"
+	"household-account-backend/adapter/controller/graphql"
+)
+
+// GraphQLHandler はGraphQLのクエリを実行する
+// エラーはGraphQLの形式でレスポンスのerrorsに含めるため、クエリを実行できた場合は常に200を返す
+type GraphQLHandler struct {
+	executor *graphql.Executor
+}
+
+func NewGraphQLHandler(executor *graphql.Executor) *GraphQLHandler {
+	return &GraphQLHandler{executor: executor}
+}
+
+func (h *GraphQLHandler) Query(c *gin.Context) {
+	userID, ok := middleware.AuthenticatedUserID(c)
+	if !ok {
+		_ = c.Error(middleware.NewHTTPError(http.StatusUnauthorized, "Authentication required"))
+		return
+	}
+
+	var request graphql.Request
+	if err := json.NewDecoder(c.Request.Body).Decode(&request); err != nil || request.Query == "" {
+		_ = c.Error(middleware.NewHTTPError(http.StatusBadRequest, "Request body must be a JSON object with a query"))
+		return
+	}
+
+	c.JSON(http.StatusOK, h.executor.Execute(c.Request.Context(), userID, request))
+}
@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"household-account-backend/usecase"
+)
+
+// Health はプロセスが動いているかを返す。依存するサービスは確認しない
+func Health(c *gin.Context) {
+	c.JSON(http.StatusOK, &struct {
+		Status string `json:"status"`
+	}{Status: "ok"})
+}
+
+type HealthHandler struct {
+	healthUseCase usecase.HealthUseCase
+}
+
+func NewHealthHandler(healthUseCase usecase.HealthUseCase) *HealthHandler {
+	return &HealthHandler{healthUseCase: healthUseCase}
+}
+
+// Ready はデータベースに接続できる場合に200、できない場合は503を返す
+func (h *HealthHandler) Ready(c *gin.Context) {
+	readiness := h.healthUseCase.Readiness(c.Request.Context())
+	status := http.StatusOK
+	if !readiness.Ready() {
+		status = http.StatusServiceUnavailable
+	}
+	c.JSON(status, readiness)
+}
@@ -0,0 +1,88 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// CSRFTokenHeader はクライアントがCSRFトークンを送るヘッダー
+	CSRFTokenHeader = "X-CSRF-Token"
+	// CSRFContextKey はCSRFトークンを保存するキー。echoのCSRFミドルウェアと同じ名前にする
+	CSRFContextKey = "csrf"
+	csrfCookieName = "_csrf"
+	csrfCookieTTL  = 24 * time.Hour
+)
+
+// CSRFConfig はCSRFトークンのCookieの設定
+type CSRFConfig struct {
+	// Skipper がtrueを返すリクエストはトークンを検証しない
+	Skipper        func(c *gin.Context) bool
+	CookiePath     string
+	CookieDomain   string
+	CookieHTTPOnly bool
+	CookieSameSite http.SameSite
+}
+
+// CSRFMiddleware はechoのCSRFミドルウェアと同じく、Cookieのトークンとヘッダーのトークンが一致することを確認する
+// 安全なメソッド(RFC 7231)のリクエストは検証せず、トークンのCookieを発行する
+func CSRFMiddleware(config CSRFConfig) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if config.Skipper != nil && config.Skipper(c) {
+			return
+		}
+
+		token, err := c.Cookie(csrfCookieName)
+		if err != nil || token == "" {
+			token = newCSRFToken()
+		}
+
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace:
+		default:
+			clientToken := c.GetHeader(CSRFTokenHeader)
+			if clientToken == "" {
+				abort(c, NewHTTPError(http.StatusBadRequest, "missing csrf token in request header"))
+				return
+			}
+			if subtle.ConstantTimeCompare([]byte(token), []byte(clientToken)) != 1 {
+				abort(c, NewHTTPError(http.StatusForbidden, "invalid csrf token"))
+				return
+			}
+		}
+
+		cookie := &http.Cookie{
+			Name:     csrfCookieName,
+			Value:    token,
+			Path:     config.CookiePath,
+			Domain:   config.CookieDomain,
+			Expires:  time.Now().Add(csrfCookieTTL),
+			HttpOnly: config.CookieHTTPOnly,
+		}
+		if config.CookieSameSite != http.SameSiteDefaultMode {
+			cookie.SameSite = config.CookieSameSite
+		}
+		if config.CookieSameSite == http.SameSiteNoneMode {
+			cookie.Secure = true
+		}
+		http.SetCookie(c.Writer, cookie)
+
+		c.Set(CSRFContextKey, token)
+		// トークンを含むレスポンスをキャッシュさせない
+		c.Writer.Header().Add("Vary", "Cookie")
+	}
+}
+
+// newCSRFToken はechoのトークンと同じ32文字のランダムな文字列を返す
+func newCSRFToken() string {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -0,0 +1,86 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"household-account-backend/adapter/controller/echo/handler"
+	"household-account-backend/adapter/controller/echo/presenter"
+	"household-account-backend/pkg/logger"
+)
+
+// HTTPError はミドルウェアがステータスを指定してリクエストを中断する場合のエラー
+type HTTPError struct {
+	Code    int
+	Message string
+}
+
+func (e *HTTPError) Error() string {
+	return e.Message
+}
+
+func NewHTTPError(code int, message string) *HTTPError {
+	return &HTTPError{Code: code, Message: message}
+}
+
+// abort はエラーを記録して後続の処理を中断する。レスポンスはErrorHandlerが書き込む
+func abort(c *gin.Context, err error) {
+	_ = c.Error(err)
+	c.Abort()
+}
+
+// ErrorHandler はハンドラーやミドルウェアが記録したエラーをRFC 7807のproblem+jsonで返す
+// echoのサーバーと同じレスポンスになるよう、HTTPError以外のエラーはechoのハンドラーと同じ変換を使う
+// 他のミドルウェアより前に登録する
+func ErrorHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		err := c.Errors.Last()
+		if err == nil || c.Writer.Written() {
+			return
+		}
+
+		writeProblem(c, err.Err)
+	}
+}
+
+// writeProblem はエラーをproblem+jsonに変換してレスポンスに書き込む
+func writeProblem(c *gin.Context, err error) {
+	problem := newProblem(err)
+	instance := c.Request.URL.Path
+	problem.Instance = &instance
+	if problem.Status >= http.StatusInternalServerError {
+		logger.Error("request failed", "method", c.Request.Method, "path", instance, "error", err.Error())
+	}
+
+	c.Status(problem.Status)
+	if c.Request.Method == http.MethodHead {
+		c.Writer.WriteHeaderNow()
+		return
+	}
+	body, marshalErr := json.Marshal(problem)
+	if marshalErr == nil {
+		c.Data(problem.Status, handler.ProblemContentType, body)
+		return
+	}
+	logger.Error("failed to write error response", "error", marshalErr.Error())
+}
+
+func newProblem(err error) *presenter.Problem {
+	httpError, ok := err.(*HTTPError)
+	if !ok {
+		return handler.NewProblem(err)
+	}
+	problem := &presenter.Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(httpError.Code),
+		Status: httpError.Code,
+	}
+	if httpError.Message != "" {
+		problem.Detail = &httpError.Message
+	}
+	return problem
+}
@@ -0,0 +1,119 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	echomiddleware "household-account-backend/adapter/controller/echo/middleware"
+	"household-account-backend/entity"
+	"household-account-backend/pkg/logger"
+	"household-account-backend/usecase"
+)
+
+// 再送時に復元するレスポンスヘッダー
+var idempotentResponseHeaders = []string{"Content-Type", "Location", "ETag"}
+
+// idempotencyResponseRecorder はクライアントへ返すレスポンスボディを保存用に複製する
+type idempotencyResponseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *idempotencyResponseRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
+
+func (r *idempotencyResponseRecorder) WriteString(s string) (int, error) {
+	r.body.WriteString(s)
+	return r.ResponseWriter.WriteString(s)
+}
+
+// IdempotencyMiddleware はIdempotency-Keyヘッダー付きのPOSTリクエストを1度だけ処理する
+// 同じキーでの再送には保存したレスポンスを返し、異なるボディでのキーの再利用は422で拒否する
+// JWTMiddlewareの後に登録し、キーはユーザーごとに管理する
+func IdempotencyMiddleware(idempotencyUseCase usecase.IdempotencyUseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req := c.Request
+		key := req.Header.Get(echomiddleware.IdempotencyKeyHeader)
+		if req.Method != http.MethodPost || key == "" {
+			return
+		}
+
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			abort(c, NewHTTPError(http.StatusBadRequest, "Invalid request body"))
+			return
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+
+		userID, _ := AuthenticatedUserID(c)
+		requestHash := usecase.HashIdempotentRequest(req.Method, req.URL.Path, body)
+		record, replay, err := idempotencyUseCase.Begin(userID, key, requestHash)
+		if err != nil {
+			abort(c, err)
+			return
+		}
+		if replay {
+			replayIdempotentResponse(c, record)
+			c.Abort()
+			return
+		}
+
+		recorder := &idempotencyResponseRecorder{ResponseWriter: c.Writer}
+		c.Writer = recorder
+		c.Next()
+		// エラーもレスポンスに変換してから記録し、4xxのエラーは再送時に同じ内容を返す
+		if err := c.Errors.Last(); err != nil && !c.Writer.Written() {
+			writeProblem(c, err.Err)
+		}
+		c.Writer = recorder.ResponseWriter
+
+		// サーバーエラーは保存せず、同じキーでの再試行を許可する
+		status := c.Writer.Status()
+		if !c.Writer.Written() || status >= http.StatusInternalServerError {
+			if releaseErr := idempotencyUseCase.Release(record); releaseErr != nil {
+				logger.Error("failed to release idempotency key", "error", releaseErr.Error())
+			}
+			return
+		}
+
+		headers := map[string]string{}
+		for _, name := range idempotentResponseHeaders {
+			if value := c.Writer.Header().Get(name); value != "" {
+				headers[name] = value
+			}
+		}
+		if err := idempotencyUseCase.Complete(record, status, headers, recorder.body.Bytes()); err != nil {
+			logger.Error("failed to save idempotent response", "error", err.Error())
+		}
+	}
+}
+
+func replayIdempotentResponse(c *gin.Context, record *entity.IdempotencyRecord) {
+	headers := map[string]string{}
+	if record.ResponseHeaders != "" {
+		if err := json.Unmarshal([]byte(record.ResponseHeaders), &headers); err != nil {
+			logger.Warn("invalid stored idempotent response headers", "error", err.Error())
+		}
+	}
+	for name, value := range headers {
+		c.Header(name, value)
+	}
+	c.Header(echomiddleware.IdempotentReplayedHeader, "true")
+
+	if record.ResponseBody == "" {
+		c.Status(record.StatusCode)
+		c.Writer.WriteHeaderNow()
+		return
+	}
+	contentType := headers["Content-Type"]
+	if contentType == "" {
+		contentType = "application/json; charset=UTF-8"
+	}
+	c.Data(record.StatusCode, contentType, []byte(record.ResponseBody))
+}
@@ -0,0 +1,168 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v4"
+
+	"household-account-backend/pkg/jwtkeys"
+	"household-account-backend/pkg/logger"
+	"household-account-backend/usecase"
+)
+
+// UserContextKey は認証したユーザーの*jwt.Tokenを保存するキー。echoのJWTミドルウェアと同じ名前にする
+const UserContextKey = "user"
+
+// PersonalAccessTokenContextKey はトークンで認証した場合にパーソナルアクセストークンを保存するキー
+const PersonalAccessTokenContextKey = "personal_access_token"
+
+// CurrentUserContextKey は認証したユーザーの *entity.User を保存するキー
+const CurrentUserContextKey = "current_user"
+
+// TokenScopes はパーソナルアクセストークンでアクセスする場合に必要なスコープ
+// Readは参照系(GET/HEAD)、Writeはそれ以外のメソッドで必要になり、空の場合はトークンでのアクセスを許可しない
+type TokenScopes struct {
+	Read  string
+	Write string
+}
+
+// JWTMiddleware はCookieのJWT、またはAuthorization: Bearerのパーソナルアクセストークンでユーザーを認証する
+// echoのJWTミドルウェアと同じ検証を行い、後続の処理では c.Get("user") の *jwt.Token からuser_idを取得できる
+func JWTMiddleware(keyManager *jwtkeys.KeyManager, personalAccessTokenUseCase usecase.PersonalAccessTokenUseCase, sessionUseCase usecase.SessionUseCase, scopes TokenScopes) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// Authorizationヘッダーがある場合はCookieを使わずトークンのみで認証する
+		if token, ok := bearerToken(c); ok {
+			if err := authenticatePersonalAccessToken(c, personalAccessTokenUseCase, sessionUseCase, token, scopes); err != nil {
+				abort(c, err)
+			}
+			return
+		}
+
+		if err := authenticateCookie(c, keyManager, sessionUseCase); err != nil {
+			abort(c, err)
+		}
+	}
+}
+
+func authenticateCookie(c *gin.Context, keyManager *jwtkeys.KeyManager, sessionUseCase usecase.SessionUseCase) error {
+	cookie, err := c.Cookie("auth_token")
+	if err != nil {
+		return NewHTTPError(http.StatusUnauthorized, "Missing auth_token cookie")
+	}
+
+	// kidヘッダーで選んだ鍵で署名を検証し、iss・audも確認する
+	token, err := keyManager.Parse(cookie)
+	if err != nil || !token.Valid {
+		return NewHTTPError(http.StatusUnauthorized, "Invalid token")
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		logger.Error("Invalid token claims")
+		return NewHTTPError(http.StatusUnauthorized, "Invalid Claims")
+	}
+	userID, ok := claims["user_id"].(float64)
+	if !ok {
+		return NewHTTPError(http.StatusUnauthorized, "Invalid token")
+	}
+	// iatがない場合は強制ログアウトの前に発行したものとして扱う
+	var issuedAt time.Time
+	if iat, ok := claims["iat"].(float64); ok {
+		issuedAt = time.Unix(int64(iat), 0)
+	}
+	if err := authorizeUser(c, sessionUseCase, int(userID), &issuedAt); err != nil {
+		return err
+	}
+
+	// なりすましのセッションでは参照のみ許可する
+	if _, impersonated := claims[usecase.ImpersonatorClaim]; impersonated && !readOnlyMethod(c.Request.Method) {
+		return NewHTTPError(http.StatusForbidden, "Impersonated sessions are read-only")
+	}
+
+	c.Set(UserContextKey, token)
+	return nil
+}
+
+// HasBearerToken はAuthorizationヘッダーでトークンが送られているかを返す
+// ブラウザが自動で付与するCookieに依存しないため、CSRF対策の対象外にできる
+func HasBearerToken(c *gin.Context) bool {
+	_, ok := bearerToken(c)
+	return ok
+}
+
+func bearerToken(c *gin.Context) (string, bool) {
+	scheme, token, ok := strings.Cut(c.GetHeader("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	return strings.TrimSpace(token), true
+}
+
+// authorizeUser はユーザーが利用できる状態かを確かめ、コンテキストに保存する
+func authorizeUser(c *gin.Context, sessionUseCase usecase.SessionUseCase, userID int, issuedAt *time.Time) error {
+	user, err := sessionUseCase.Authorize(userID, issuedAt)
+	switch {
+	case errors.Is(err, usecase.ErrAccountDisabled):
+		return NewHTTPError(http.StatusForbidden, "Account is disabled")
+	case errors.Is(err, usecase.ErrSessionRevoked):
+		return NewHTTPError(http.StatusUnauthorized, "Session has been revoked")
+	case err != nil:
+		return fmt.Errorf("session authorization failed for user %d: %w", userID, err)
+	}
+	c.Set(CurrentUserContextKey, user)
+	return nil
+}
+
+func readOnlyMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead
+}
+
+func authenticatePersonalAccessToken(c *gin.Context, personalAccessTokenUseCase usecase.PersonalAccessTokenUseCase, sessionUseCase usecase.SessionUseCase, token string, scopes TokenScopes) error {
+	accessToken, err := personalAccessTokenUseCase.Authenticate(token)
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidAccessToken) {
+			c.Header("WWW-Authenticate", `Bearer error="invalid_token"`)
+			return NewHTTPError(http.StatusUnauthorized, "Invalid token")
+		}
+		return fmt.Errorf("personal access token lookup failed: %w", err)
+	}
+
+	required := scopes.Write
+	if readOnlyMethod(c.Request.Method) {
+		required = scopes.Read
+	}
+	if required == "" {
+		return NewHTTPError(http.StatusForbidden, "Personal access tokens cannot access this endpoint")
+	}
+	if !accessToken.HasScope(required) {
+		c.Header("WWW-Authenticate", fmt.Sprintf(`Bearer error="insufficient_scope", scope="%s"`, required))
+		return NewHTTPError(http.StatusForbidden, "Token does not have the required scope: "+required)
+	}
+
+	if err := authorizeUser(c, sessionUseCase, accessToken.UserID, nil); err != nil {
+		return err
+	}
+
+	// ハンドラーはCookie認証と同じくJWTのクレームからユーザーIDを取得する
+	c.Set(UserContextKey, &jwt.Token{Claims: jwt.MapClaims{"user_id": float64(accessToken.UserID)}, Valid: true})
+	c.Set(PersonalAccessTokenContextKey, accessToken)
+	return nil
+}
+
+// AuthenticatedUserID はJWTMiddlewareが設定したトークンからユーザーのIDを返す
+func AuthenticatedUserID(c *gin.Context) (int, bool) {
+	token, ok := c.Value(UserContextKey).(*jwt.Token)
+	if !ok {
+		return 0, false
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return 0, false
+	}
+	userID, ok := claims["user_id"].(float64)
+	return int(userID), ok
+}
@@ -0,0 +1,100 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"household-account-backend/pkg/logger"
+)
+
+// カラーコード
+var (
+	green  = "\033[32m"
+	blue   = "\033[34m"
+	red    = "\033[31m"
+	yellow = "\033[33m"
+	reset  = "\033[0m"
+)
+
+// CustomRequestLogger はリクエスト情報をログ出力するミドルウェアです。
+// 記録するステータスがエラーのレスポンスと一致するよう、ErrorHandlerより前に登録する
+func CustomRequestLogger() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+		stop := time.Now()
+
+		status := c.Writer.Status()
+		method := c.Request.Method
+
+		// ログの出力
+		logger.ZapLogger.Info("Request",
+			zap.String("method", method),
+			zap.String("path", c.Request.URL.Path),
+			zap.Int("status", status),
+			zap.String("latency", stop.Sub(start).String()),
+			zap.String("client_ip", c.ClientIP()),
+			zap.String("user_agent", c.Request.UserAgent()),
+		)
+
+		// カラフルな出力
+		fmt.Printf("[%s%s%s] %s%3d%s | %13v | %15s | %s%-7s%s %s\n",
+			blue, time.Now().Format("2006/01/02 - 15:04:05"), reset,
+			getStatusColor(status), status, reset,
+			stop.Sub(start),
+			c.ClientIP(),
+			getMethodColor(method), method, reset,
+			c.Request.URL.Path,
+		)
+	}
+}
+
+// CustomRecovery はパニックをログに記録し、500のエラーにするミドルウェアです。
+// エラーのレスポンスを書き込めるよう、ErrorHandlerより後に登録する
+func CustomRecovery() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		defer func() {
+			if r := recover(); r != nil {
+				logger.ZapLogger.Error("Panic recovered",
+					zap.Any("error", r),
+				)
+				abort(c, fmt.Errorf("panic recovered: %v", r))
+			}
+		}()
+		c.Next()
+	}
+}
+
+// ステータスコードに応じた色を返す関数
+func getStatusColor(status int) string {
+	switch {
+	case status >= 200 && status < 300:
+		return green
+	case status >= 300 && status < 400:
+		return blue
+	case status >= 400 && status < 500:
+		return yellow
+	default:
+		return red
+	}
+}
+
+// HTTPメソッドに応じた色を返す関数
+func getMethodColor(method string) string {
+	switch method {
+	case http.MethodGet:
+		return blue
+	case http.MethodPost:
+		return green
+	case http.MethodPut:
+		return yellow
+	case http.MethodDelete:
+		return red
+	default:
+		return reset
+	}
+}
@@ -0,0 +1,23 @@
+package middleware
+
+import (
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gin-gonic/gin"
+
+	echomiddleware "household-account-backend/adapter/controller/echo/middleware"
+)
+
+// OpenAPIValidationMiddleware はechoのサーバーと同じ検証でリクエストのパラメータとボディを検証する
+// 定義に合わないリクエストはハンドラーを呼ばずに、項目ごとのエラーを含む422を返す
+func OpenAPIValidationMiddleware(swagger *openapi3.T) (gin.HandlerFunc, error) {
+	validate, err := echomiddleware.NewRequestValidator(swagger)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(c *gin.Context) {
+		if err := validate(c.Request); err != nil {
+			abort(c, err)
+		}
+	}, nil
+}
@@ -0,0 +1,57 @@
+package middleware
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	echomiddleware "household-account-backend/adapter/controller/echo/middleware"
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/pkg/logger"
+)
+
+// RateLimitConfig はルートグループごとのレート制限の設定
+type RateLimitConfig = echomiddleware.RateLimitConfig
+
+// RateLimitMiddleware はechoのサーバーと同じヘッダーとバケットのキーでリクエスト数を制限する
+// JWTMiddlewareの後に登録した場合はユーザーごと、それ以外はIPアドレスごとに制限する
+// Limitが0以下の場合は制限しない
+func RateLimitMiddleware(rateLimitStore gateway.RateLimitStore, config RateLimitConfig) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if config.Limit <= 0 || config.Period <= 0 {
+			return
+		}
+
+		result, err := rateLimitStore.Take(rateLimitKey(c, config.Name), config.Limit, config.Period, time.Now())
+		if err != nil {
+			// バックエンドの障害でAPI全体を止めないよう制限せずに通す
+			logger.Error("rate limit lookup failed", "group", config.Name, "error", err.Error())
+			return
+		}
+
+		c.Header(echomiddleware.RateLimitLimitHeader, strconv.Itoa(config.Limit))
+		c.Header(echomiddleware.RateLimitRemainingHeader, strconv.Itoa(result.Remaining))
+		c.Header(echomiddleware.RateLimitResetHeader, strconv.Itoa(ceilSeconds(result.ResetAfter)))
+		c.Header(echomiddleware.RateLimitPolicyHeader, fmt.Sprintf("%d;w=%d", config.Limit, ceilSeconds(config.Period)))
+		if !result.Allowed {
+			c.Header("Retry-After", strconv.Itoa(ceilSeconds(result.RetryAfter)))
+			abort(c, NewHTTPError(http.StatusTooManyRequests, "Too many requests"))
+		}
+	}
+}
+
+func rateLimitKey(c *gin.Context, name string) string {
+	if userID, ok := AuthenticatedUserID(c); ok {
+		return fmt.Sprintf("%s:user:%d", name, userID)
+	}
+	return name + ":ip:" + c.ClientIP()
+}
+
+// ceilSeconds は1秒未満でも0にならないよう秒数を切り上げる
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
@@ -0,0 +1,20 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"household-account-backend/entity"
+)
+
+// RequireRole はJWTMiddlewareで認証したユーザーが指定のロールを持つ場合のみ許可する
+// JWTMiddlewareより後に設定する
+func RequireRole(role string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user, ok := c.Value(CurrentUserContextKey).(*entity.User)
+		if !ok || user.Role != role {
+			abort(c, NewHTTPError(http.StatusForbidden, "Insufficient role"))
+		}
+	}
+}
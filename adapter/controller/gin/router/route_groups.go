@@ -0,0 +1,108 @@
+package router
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	echomiddleware "household-account-backend/adapter/controller/echo/middleware"
+	"household-account-backend/pkg/logger"
+)
+
+// wellKnownPath はAPIのパスの外に公開するエンドポイントのパス
+const wellKnownPath = "/.well-known"
+
+// routeGroup はパスの先頭が一致する操作に設定するミドルウェア
+type routeGroup struct {
+	prefix     string
+	middleware []gin.HandlerFunc
+}
+
+// groupRouter はOpenAPIの定義から生成した登録処理を受け取り、パスに応じたグループのミドルウェアを付けてginに登録する
+// echoのルーターと同じく、定義にあってグループに属さない操作は起動時にエラーにする
+// 生成した登録処理が呼ぶメソッドだけを上書きし、それ以外はginのルーターのものを使う
+type groupRouter struct {
+	gin.IRouter
+	groups []routeGroup
+	// routes は特定の操作だけに追加するミドルウェア。キーは"METHOD パス"
+	routes map[string][]gin.HandlerFunc
+}
+
+func newGroupRouter(router gin.IRouter) *groupRouter {
+	return &groupRouter{
+		IRouter: router,
+		routes:  map[string][]gin.HandlerFunc{},
+	}
+}
+
+// AddGroup はprefixで始まる操作にミドルウェアを設定する
+func (r *groupRouter) AddGroup(prefix string, middleware ...gin.HandlerFunc) {
+	r.groups = append(r.groups, routeGroup{prefix: prefix, middleware: middleware})
+}
+
+// Route は1つの操作だけにミドルウェアを追加する
+func (r *groupRouter) Route(method, path string, middleware ...gin.HandlerFunc) {
+	key := method + " " + path
+	r.routes[key] = append(r.routes[key], middleware...)
+}
+
+func (r *groupRouter) Handle(method, path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	// JWKSなどはAPIのバージョンに依存しないパスで公開する
+	if strings.HasPrefix(path, echomiddleware.APIBasePath+wellKnownPath+"/") {
+		path = strings.TrimPrefix(path, echomiddleware.APIBasePath)
+	}
+
+	group, ok := r.match(path)
+	if !ok {
+		logger.Fatal("no route group for " + method + " " + path)
+	}
+
+	chain := append([]gin.HandlerFunc{}, group.middleware...)
+	chain = append(chain, r.routes[method+" "+path]...)
+	chain = append(chain, handlers...)
+	return r.IRouter.Handle(method, path, chain...)
+}
+
+// match はパスの先頭が最も長く一致するグループを返す
+func (r *groupRouter) match(path string) (routeGroup, bool) {
+	var matched routeGroup
+	found := false
+	for _, group := range r.groups {
+		if path != group.prefix && !strings.HasPrefix(path, group.prefix+"/") {
+			continue
+		}
+		if !found || len(group.prefix) > len(matched.prefix) {
+			matched, found = group, true
+		}
+	}
+	return matched, found
+}
+
+func (r *groupRouter) DELETE(path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return r.Handle(http.MethodDelete, path, handlers...)
+}
+
+func (r *groupRouter) GET(path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return r.Handle(http.MethodGet, path, handlers...)
+}
+
+func (r *groupRouter) HEAD(path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return r.Handle(http.MethodHead, path, handlers...)
+}
+
+func (r *groupRouter) OPTIONS(path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return r.Handle(http.MethodOptions, path, handlers...)
+}
+
+func (r *groupRouter) PATCH(path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return r.Handle(http.MethodPatch, path, handlers...)
+}
+
+func (r *groupRouter) POST(path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return r.Handle(http.MethodPost, path, handlers...)
+}
+
+func (r *groupRouter) PUT(path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return r.Handle(http.MethodPut, path, handlers...)
+}
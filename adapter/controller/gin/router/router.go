@@ -0,0 +1,142 @@
+package router
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gin-gonic/gin"
+	"github.com/labstack/echo/v4"
+	echoSwagger "github.com/swaggo/echo-swagger"
+	"github.com/swaggo/swag"
+
+	"household-account-backend/adapter/controller/container"
+	echomiddleware "household-account-backend/adapter/controller/echo/middleware"
+	"household-account-backend/adapter/controller/gin/handler"
+	mymiddleware "household-account-backend/adapter/controller/gin/middleware"
+	"household-account-backend/adapter/controller/gin/presenter"
+	"household-account-backend/entity"
+	"household-account-backend/pkg/config"
+	"household-account-backend/pkg/logger"
+)
+
+// Swagger の設定
+// 開発環境ではechoのサーバーと同じSwagger UIで定義を確認できるようにする
+func setupSwagger(router *gin.Engine, swagger *openapi3.T, env string) {
+	if env == "development" {
+		swaggerJson, _ := json.Marshal(swagger)
+		var SwaggerInfo = &swag.Spec{
+			InfoInstanceName: "swagger",
+			SwaggerTemplate:  string(swaggerJson),
+		}
+		// ルーターを複数作成する場合も定義は1度だけ登録する
+		if swag.GetSwagger(SwaggerInfo.InstanceName()) == nil {
+			swag.Register(SwaggerInfo.InstanceName(), SwaggerInfo)
+		}
+		swaggerUI := echo.New()
+		swaggerUI.GET("/swagger/*", echoSwagger.WrapHandler)
+		router.GET("/swagger/*any", gin.WrapH(swaggerUI))
+	}
+}
+
+// Gin 用のルータを作成。
+// ハンドラーとユースケースはechoのルーターと共通で、同じリクエストに同じレスポンスを返す
+func NewGinRouter(deps *container.Container) *gin.Engine {
+	// 開発環境以外ではルートの一覧などのデバッグ用の出力をしない
+	if deps.Config.Env != "development" {
+		gin.SetMode(gin.ReleaseMode)
+	}
+	router := gin.New()
+	// X-Forwarded-Forは信頼するプロキシから転送された場合のみ参照する。指定がない場合は接続元のアドレスを使う
+	var trustedProxies []string
+	for _, network := range deps.TrustedProxies {
+		trustedProxies = append(trustedProxies, network.String())
+	}
+	if err := router.SetTrustedProxies(trustedProxies); err != nil {
+		logger.Fatal("failed to set trusted proxies: " + err.Error())
+	}
+	// ハンドラーはリクエストのcontext.Contextから認証したユーザーなどを参照する
+	router.ContextWithFallback = true
+	router.HandleMethodNotAllowed = true
+	router.NoRoute(func(c *gin.Context) {
+		_ = c.Error(mymiddleware.NewHTTPError(http.StatusNotFound, "Not Found"))
+	})
+	router.NoMethod(func(c *gin.Context) {
+		_ = c.Error(mymiddleware.NewHTTPError(http.StatusMethodNotAllowed, "Method Not Allowed"))
+	})
+
+	// ミドルウェア設定
+	// エラーはすべてRFC 7807のproblem+jsonで返す
+	router.Use(mymiddleware.CustomRequestLogger())
+	router.Use(mymiddleware.ErrorHandler())
+	router.Use(mymiddleware.CustomRecovery())
+	router.Use(mymiddleware.CORSMiddleware(mymiddleware.CORSConfig{
+		AllowOrigins:     []string{"http://localhost:3000", deps.Config.FrontendURL},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Access-Control-Allow-Headers", mymiddleware.CSRFTokenHeader, echomiddleware.IdempotencyKeyHeader, "If-Match"},
+		ExposeHeaders:    []string{"ETag", echomiddleware.IdempotentReplayedHeader, echomiddleware.RateLimitLimitHeader, echomiddleware.RateLimitRemainingHeader, echomiddleware.RateLimitResetHeader, echomiddleware.RateLimitPolicyHeader, "Retry-After"},
+		AllowMethods:     []string{"GET", "PUT", "PATCH", "POST", "DELETE"},
+		AllowCredentials: true,
+	}))
+	router.Use(mymiddleware.CSRFMiddleware(mymiddleware.CSRFConfig{
+		// パーソナルアクセストークンはブラウザが自動で送信しないためCSRFトークンを要求しない
+		Skipper:        mymiddleware.HasBearerToken,
+		CookiePath:     "/",
+		CookieDomain:   deps.Config.APIDomain,
+		CookieHTTPOnly: true,
+		CookieSameSite: http.SameSiteDefaultMode,
+	}))
+
+	// Swagger の設定
+	setupSwagger(router, deps.Swagger, deps.Config.Env)
+	// リクエストはOpenAPIの定義で検証してからハンドラーに渡す
+	requestValidation, err := mymiddleware.OpenAPIValidationMiddleware(deps.Swagger)
+	if err != nil {
+		logger.Fatal("failed to build request validation: " + err.Error())
+	}
+	idempotencyMiddleware := mymiddleware.IdempotencyMiddleware(deps.IdempotencyUseCase)
+
+	// jwtMiddleware はパーソナルアクセストークンでのアクセスにscopesを要求する
+	jwtMiddleware := func(scopes mymiddleware.TokenScopes) gin.HandlerFunc {
+		return mymiddleware.JWTMiddleware(deps.KeyManager, deps.PersonalAccessTokenUseCase, deps.SessionUseCase, scopes)
+	}
+
+	// レート制限はグループごとに別のバケットで数える
+	rateLimit := func(name string, limit config.RateLimitConfig) gin.HandlerFunc {
+		return mymiddleware.RateLimitMiddleware(deps.RateLimitStore, mymiddleware.RateLimitConfig{
+			Name:   name,
+			Limit:  limit.Limit,
+			Period: limit.Period,
+		})
+	}
+
+	// OpenAPIの定義の操作は生成したハンドラーで登録し、パスの先頭に応じてミドルウェアを設定する
+	// グループとミドルウェアの順序はechoのルーターと同じにする
+	api := newGroupRouter(router)
+	api.AddGroup(echomiddleware.APIBasePath+"/users", jwtMiddleware(mymiddleware.TokenScopes{}), rateLimit("users", deps.Config.RateLimitDefault), requestValidation)
+	api.Route(http.MethodPost, echomiddleware.APIBasePath+"/users/export", rateLimit("exports", deps.Config.RateLimitExports))
+	api.AddGroup(echomiddleware.APIBasePath+"/auth", rateLimit("auth", deps.Config.RateLimitAuth), requestValidation)
+	api.AddGroup(echomiddleware.APIBasePath+"/categories", jwtMiddleware(mymiddleware.TokenScopes{Read: entity.ScopeReadCategories, Write: entity.ScopeWriteCategories}), rateLimit("categories", deps.Config.RateLimitDefault), requestValidation, idempotencyMiddleware)
+	api.AddGroup(echomiddleware.APIBasePath+"/transactions", jwtMiddleware(mymiddleware.TokenScopes{Read: entity.ScopeReadTransactions, Write: entity.ScopeWriteTransactions}), rateLimit("transactions", deps.Config.RateLimitTransactions), requestValidation, idempotencyMiddleware)
+	api.AddGroup(echomiddleware.APIBasePath+"/monthly-summaries", jwtMiddleware(mymiddleware.TokenScopes{Read: entity.ScopeReadReports, Write: entity.ScopeWriteReports}), rateLimit("monthly_summaries", deps.Config.RateLimitDefault), requestValidation, idempotencyMiddleware)
+	api.AddGroup(echomiddleware.APIBasePath+"/webhooks", jwtMiddleware(mymiddleware.TokenScopes{Read: entity.ScopeReadWebhooks, Write: entity.ScopeWriteWebhooks}), rateLimit("webhooks", deps.Config.RateLimitDefault), requestValidation, idempotencyMiddleware)
+	api.AddGroup(echomiddleware.APIBasePath+"/events", jwtMiddleware(mymiddleware.TokenScopes{Read: entity.ScopeReadEvents}), rateLimit("events", deps.Config.RateLimitDefault), requestValidation)
+	api.AddGroup(echomiddleware.APIBasePath+"/admin", jwtMiddleware(mymiddleware.TokenScopes{}), mymiddleware.RequireRole(entity.UserRoleAdmin), rateLimit("admin", deps.Config.RateLimitDefault), requestValidation)
+	api.AddGroup(wellKnownPath)
+
+	presenter.RegisterGinHandlersWithOptions(api, handler.NewStrictHandler(deps.Server), presenter.GinServerOptions{
+		BaseURL: echomiddleware.APIBasePath,
+		// パスやクエリのパラメータを変換できない場合もproblem+jsonで返す
+		ErrorHandler: func(c *gin.Context, err error, statusCode int) {
+			_ = c.Error(mymiddleware.NewHTTPError(statusCode, err.Error()))
+		},
+	})
+
+	graphqlHandler := handler.NewGraphQLHandler(deps.GraphQL)
+	router.POST("/graphql", jwtMiddleware(mymiddleware.TokenScopes{}), rateLimit("graphql", deps.Config.RateLimitDefault), graphqlHandler.Query)
+
+	router.GET("/health", handler.Health)
+	// レディネスはデータベースに接続できるかを確認し、接続プールの統計も返す
+	router.GET("/health/ready", handler.NewHealthHandler(deps.HealthUseCase).Ready)
+
+	return router
+}
@@ -0,0 +1,160 @@
+package router_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+
+	"household-account-backend/adapter/controller/container"
+	echomiddleware "household-account-backend/adapter/controller/echo/middleware"
+	"household-account-backend/adapter/controller/echo/presenter"
+	"household-account-backend/adapter/controller/gin/router"
+	"household-account-backend/pkg/config"
+	"household-account-backend/pkg/tester"
+)
+
+// RouterContractSuite はginのルーターに登録したルートとOpenAPIの定義の操作が一致することを検証する
+// 定義だけを変更した場合や、定義にないルートを追加した場合に失敗する
+type RouterContractSuite struct {
+	tester.DBSQLiteSuite
+	router *gin.Engine
+}
+
+func TestRouterContractSuite(t *testing.T) {
+	suite.Run(t, new(RouterContractSuite))
+}
+
+func (suite *RouterContractSuite) SetupSuite() {
+	suite.DBSQLiteSuite.SetupSuite()
+	configs := config.NewConfig()
+	configs.Secret = "gin-router-contract-test"
+	deps, err := container.New(suite.DB, configs)
+	suite.Require().NoError(err)
+	suite.router = router.NewGinRouter(deps)
+}
+
+func (suite *RouterContractSuite) TearDownSuite() {
+	suite.DBSQLiteSuite.TearDownSuite()
+}
+
+// specRoutes は定義の操作を"METHOD パス"の形で返す。パスはginの形式にする
+func (suite *RouterContractSuite) specRoutes() map[string]bool {
+	swagger, err := presenter.GetSwagger()
+	suite.Require().NoError(err)
+
+	routes := map[string]bool{}
+	for path, item := range swagger.Paths.Map() {
+		ginPath := strings.NewReplacer("{", ":", "}", "").Replace(path)
+		if !strings.HasPrefix(path, "/.well-known/") {
+			ginPath = echomiddleware.APIBasePath + ginPath
+		}
+		for method := range item.Operations() {
+			routes[method+" "+ginPath] = true
+		}
+	}
+	return routes
+}
+
+// apiRoutes はルーターに登録したAPIのルートを返す。ヘルスチェックやSwagger UIは定義の対象外
+func (suite *RouterContractSuite) apiRoutes() map[string]bool {
+	routes := map[string]bool{}
+	for _, route := range suite.router.Routes() {
+		if !strings.HasPrefix(route.Path, echomiddleware.APIBasePath+"/") && !strings.HasPrefix(route.Path, "/.well-known/") {
+			continue
+		}
+		routes[route.Method+" "+route.Path] = true
+	}
+	return routes
+}
+
+func (suite *RouterContractSuite) TestEveryOperationIsRouted() {
+	routes := suite.apiRoutes()
+	var missing []string
+	for route := range suite.specRoutes() {
+		if !routes[route] {
+			missing = append(missing, route)
+		}
+	}
+	sort.Strings(missing)
+	suite.Assert().Empty(missing, "operations defined in the API spec but not routed")
+}
+
+func (suite *RouterContractSuite) TestEveryRouteIsDefined() {
+	spec := suite.specRoutes()
+	var undefined []string
+	for route := range suite.apiRoutes() {
+		if !spec[route] {
+			undefined = append(undefined, route)
+		}
+	}
+	sort.Strings(undefined)
+	suite.Assert().Empty(undefined, "routes not defined in the API spec")
+}
+
+func (suite *RouterContractSuite) TestClientIPIgnoresForwardedHeaders() {
+	ip := func(engine *gin.Engine, remoteAddr string) string {
+		req := httptest.NewRequest(http.MethodGet, "/client-ip", nil)
+		req.RemoteAddr = remoteAddr
+		req.Header.Set("X-Forwarded-For", "203.0.113.1")
+		req.Header.Set("X-Real-IP", "203.0.113.2")
+		rec := httptest.NewRecorder()
+		engine.ServeHTTP(rec, req)
+		return rec.Body.String()
+	}
+	clientIP := func(c *gin.Context) { c.String(http.StatusOK, c.ClientIP()) }
+
+	// プロキシを設定しない場合はループバックやプライベートアドレスからのヘッダーも信頼しない
+	suite.router.GET("/client-ip", clientIP)
+	suite.Assert().Equal("127.0.0.1", ip(suite.router, "127.0.0.1:12345"))
+	suite.Assert().Equal("10.0.0.1", ip(suite.router, "10.0.0.1:12345"))
+
+	configs := config.NewConfig()
+	configs.Secret = "gin-router-contract-test"
+	configs.TrustedProxies = []string{"10.0.0.1"}
+	deps, err := container.New(suite.DB, configs)
+	suite.Require().NoError(err)
+	proxied := router.NewGinRouter(deps)
+	proxied.GET("/client-ip", clientIP)
+	suite.Assert().Equal("203.0.113.1", ip(proxied, "10.0.0.1:12345"))
+	suite.Assert().Equal("10.0.0.2", ip(proxied, "10.0.0.2:12345"))
+}
+
+func (suite *RouterContractSuite) TestReleaseModeOutsideDevelopment() {
+	defer gin.SetMode(gin.Mode())
+
+	configs := config.NewConfig()
+	configs.Secret = "gin-router-contract-test"
+	configs.Env = "production"
+	deps, err := container.New(suite.DB, configs)
+	suite.Require().NoError(err)
+	router.NewGinRouter(deps)
+	suite.Assert().Equal(gin.ReleaseMode, gin.Mode())
+}
+
+func (suite *RouterContractSuite) TestGroupMiddlewareIsApplied() {
+	// 認証が必要な操作はトークンがなければ401になる
+	req := httptest.NewRequest(http.MethodGet, echomiddleware.APIBasePath+"/monthly-summaries", nil)
+	rec := httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, req)
+	suite.Assert().Equal(http.StatusUnauthorized, rec.Code)
+
+	// 公開鍵はAPIのパスの外で認証なしに取得できる
+	req = httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil)
+	rec = httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, req)
+	suite.Assert().Equal(http.StatusOK, rec.Code)
+	suite.Assert().Equal("public, max-age=300", rec.Header().Get("Cache-Control"))
+
+	// GraphQLは定義の外だが、RESTのAPIと同じく認証が必要
+	req = httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{"query":"{ me { id } }"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer invalid")
+	rec = httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, req)
+	suite.Assert().Equal(http.StatusUnauthorized, rec.Code)
+}
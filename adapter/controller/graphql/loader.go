@@ -0,0 +1,117 @@
+package graphql
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/graph-gophers/dataloader/v7"
+
+	"household-account-backend/entity"
+)
+
+// loaderWait は最初のキーを受け取ってから取得を始めるまでの待ち時間
+// 兄弟の要素のキーはまとめて登録するため、並行して解決されるフィールドを待つための短い時間でよい
+const loaderWait = 2 * time.Millisecond
+
+// loaders はリクエストごとのデータローダー
+// リスト内の要素の関連を1件ずつ取得するとN+1回の問い合わせになるため、キーを集めて1回で取得する
+type loaders struct {
+	category             *dataloader.Loader[int, *entity.Category]
+	categoryTransactions *dataloader.Loader[categoryTransactionsKey, []entity.Transaction]
+}
+
+// categoryTransactionsKey はカテゴリーの取引を取得するキー。期間の指定ごとにまとめて取得する
+type categoryTransactionsKey struct {
+	categoryID int
+	from       string
+	to         string
+}
+
+func (r *Resolver) newLoaders(userID int) *loaders {
+	return &loaders{
+		category: dataloader.NewBatchedLoader(func(ctx context.Context, ids []int) []*dataloader.Result[*entity.Category] {
+			categories, err := r.categoryUseCase.GetCategoriesByIDs(userID, ids)
+			results := make([]*dataloader.Result[*entity.Category], len(ids))
+			byID := map[int]*entity.Category{}
+			for i := range categories {
+				byID[categories[i].ID] = &categories[i]
+			}
+			for i, id := range ids {
+				// 存在しないカテゴリーはエラーにせずnullを返す
+				results[i] = &dataloader.Result[*entity.Category]{Data: byID[id], Error: err}
+			}
+			return results
+		}, dataloader.WithWait[int, *entity.Category](loaderWait)),
+
+		categoryTransactions: dataloader.NewBatchedLoader(func(ctx context.Context, keys []categoryTransactionsKey) []*dataloader.Result[[]entity.Transaction] {
+			// 期間が同じキーのカテゴリーをまとめて1回で取得する
+			type period struct{ from, to string }
+			categoryIDs := map[period][]int{}
+			for _, key := range keys {
+				p := period{key.from, key.to}
+				categoryIDs[p] = append(categoryIDs[p], key.categoryID)
+			}
+			transactions := map[categoryTransactionsKey][]entity.Transaction{}
+			errs := map[period]error{}
+			for p, ids := range categoryIDs {
+				filter := entity.TransactionFilter{CategoryIDs: ids, From: parseDate(p.from), To: parseDate(p.to)}
+				found, err := r.transactionUseCase.FindTransactions(userID, filter)
+				errs[p] = err
+				for _, transaction := range found {
+					key := categoryTransactionsKey{categoryID: transaction.CategoryID, from: p.from, to: p.to}
+					transactions[key] = append(transactions[key], transaction)
+				}
+			}
+
+			results := make([]*dataloader.Result[[]entity.Transaction], len(keys))
+			for i, key := range keys {
+				results[i] = &dataloader.Result[[]entity.Transaction]{Data: transactions[key], Error: errs[period{key.from, key.to}]}
+			}
+			return results
+		}, dataloader.WithWait[categoryTransactionsKey, []entity.Transaction](loaderWait)),
+	}
+}
+
+// siblings は同じリストの要素が参照するID
+// リストの要素は並行数の上限まで順に解決されるため、要素ごとにキーを登録すると上限の数ずつの取得に分かれてしまう
+// 最初に関連を解決する要素が全要素のキーを登録し、1回の取得にまとめる
+type siblings struct {
+	ids    []int
+	mu     sync.Mutex
+	primed map[string]bool
+}
+
+func newSiblings(ids []int) *siblings {
+	return &siblings{ids: ids, primed: map[string]bool{}}
+}
+
+// prime は関連ごとに最初の1回だけregisterに全要素のIDを渡す。nameには引数も含めて関連を区別する値を指定する
+func (s *siblings) prime(name string, register func(ids []int)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.primed[name] {
+		return
+	}
+	s.primed[name] = true
+	register(s.ids)
+}
+
+// parseDate はローダーのキーにした日付を戻す。空の場合は期間を指定しない
+func parseDate(value string) *time.Time {
+	if value == "" {
+		return nil
+	}
+	t, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+func formatDate(date *Date) string {
+	if date == nil {
+		return ""
+	}
+	return date.Format(time.DateOnly)
+}
@@ -0,0 +1,205 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"household-account-backend/entity"
+	"household-account-backend/usecase"
+)
+
+// Resolver はQueryのフィールドを解決する
+// どのフィールドもログイン中のユーザーのデータだけを返す
+type Resolver struct {
+	userUseCase           usecase.UserUseCase
+	categoryUseCase       usecase.CategoryUseCase
+	transactionUseCase    usecase.TransactionUseCase
+	monthlySummaryUseCase usecase.MonthlySummaryUseCase
+}
+
+type categoriesArgs struct {
+	Type *string
+}
+
+type transactionsArgs struct {
+	Filter *transactionFilterInput
+}
+
+type transactionFilterInput struct {
+	From        *Date
+	To          *Date
+	CategoryIds *[]graphql.ID
+	Type        *string
+}
+
+type monthlySummariesArgs struct {
+	From *string
+	To   *string
+}
+
+func (r *Resolver) Me(ctx context.Context) (*userResolver, error) {
+	user, err := r.userUseCase.GetCurrentUser(currentUserID(ctx))
+	if err != nil {
+		return nil, resolverError(err)
+	}
+	return &userResolver{root: r, user: user}, nil
+}
+
+func (r *Resolver) Categories(ctx context.Context, args categoriesArgs) ([]*categoryResolver, error) {
+	categories, err := r.categoryUseCase.GetCategoriesByUserID(currentUserID(ctx))
+	if err != nil {
+		return nil, resolverError(err)
+	}
+	if args.Type != nil {
+		categories = filterCategories(categories, categoryType(*args.Type))
+	}
+	sort.Slice(categories, func(i, j int) bool { return categories[i].ID < categories[j].ID })
+	return newCategoryResolvers(ctx, categories), nil
+}
+
+func (r *Resolver) Category(ctx context.Context, args struct{ ID graphql.ID }) (*categoryResolver, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	category, err := r.categoryUseCase.GetCategoryByID(currentUserID(ctx), id)
+	var notFound *usecase.NotFoundError
+	if errors.As(err, &notFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, resolverError(err)
+	}
+	return newCategoryResolvers(ctx, []entity.Category{*category})[0], nil
+}
+
+func (r *Resolver) Transactions(ctx context.Context, args transactionsArgs) ([]*transactionResolver, error) {
+	userID := currentUserID(ctx)
+	filter := entity.TransactionFilter{}
+	if input := args.Filter; input != nil {
+		if input.From != nil {
+			filter.From = &input.From.Time
+		}
+		if input.To != nil {
+			filter.To = &input.To.Time
+		}
+		if input.CategoryIds != nil {
+			filter.CategoryIDs = []int{}
+			for _, id := range *input.CategoryIds {
+				categoryID, err := parseID(id)
+				if err != nil {
+					return nil, err
+				}
+				filter.CategoryIDs = append(filter.CategoryIDs, categoryID)
+			}
+		}
+		// 種類の指定はその種類のカテゴリーの指定に置き換える。categoryIdsと両方ある場合は両方に一致するもの
+		if input.Type != nil {
+			categories, err := r.categoryUseCase.GetCategoriesByUserID(userID)
+			if err != nil {
+				return nil, resolverError(err)
+			}
+			ofType := []int{}
+			for _, category := range filterCategories(categories, categoryType(*input.Type)) {
+				if filter.CategoryIDs == nil || containsID(filter.CategoryIDs, category.ID) {
+					ofType = append(ofType, category.ID)
+				}
+			}
+			filter.CategoryIDs = ofType
+		}
+	}
+
+	transactions, err := r.transactionUseCase.FindTransactions(userID, filter)
+	if err != nil {
+		return nil, resolverError(err)
+	}
+	return newTransactionResolvers(transactions), nil
+}
+
+func (r *Resolver) Transaction(ctx context.Context, args struct{ ID graphql.ID }) (*transactionResolver, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	transaction, err := r.transactionUseCase.GetTransactionByID(currentUserID(ctx), id)
+	var notFound *usecase.NotFoundError
+	if errors.As(err, &notFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, resolverError(err)
+	}
+	return newTransactionResolvers([]entity.Transaction{*transaction})[0], nil
+}
+
+func (r *Resolver) MonthlySummaries(ctx context.Context, args monthlySummariesArgs) ([]*monthlySummaryResolver, error) {
+	for _, yearMonth := range []*string{args.From, args.To} {
+		if yearMonth == nil {
+			continue
+		}
+		if _, err := time.Parse("2006-01", *yearMonth); err != nil {
+			return nil, fmt.Errorf("year month must be in YYYY-MM format: %s", *yearMonth)
+		}
+	}
+
+	summaries, err := r.monthlySummaryUseCase.GetMonthlySummariesByUserID(currentUserID(ctx))
+	if err != nil {
+		return nil, resolverError(err)
+	}
+	// YYYY-MM形式は文字列の順序が月の順序と一致する
+	resolvers := []*monthlySummaryResolver{}
+	for _, summary := range summaries {
+		if args.From != nil && summary.YearMonth < *args.From {
+			continue
+		}
+		if args.To != nil && summary.YearMonth > *args.To {
+			continue
+		}
+		resolvers = append(resolvers, &monthlySummaryResolver{summary: summary})
+	}
+	sort.Slice(resolvers, func(i, j int) bool { return resolvers[i].summary.YearMonth < resolvers[j].summary.YearMonth })
+	return resolvers, nil
+}
+
+// categoryType はGraphQLの列挙型の値をカテゴリーの種類にする
+func categoryType(value string) string {
+	return strings.ToLower(value)
+}
+
+func filterCategories(categories []entity.Category, categoryType string) []entity.Category {
+	filtered := []entity.Category{}
+	for _, category := range categories {
+		if category.Type == categoryType {
+			filtered = append(filtered, category)
+		}
+	}
+	return filtered
+}
+
+func containsID(ids []int, id int) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
+
+func parseID(id graphql.ID) (int, error) {
+	value, err := strconv.Atoi(string(id))
+	if err != nil {
+		return 0, fmt.Errorf("invalid id: %s", id)
+	}
+	return value, nil
+}
+
+func toID(id int) graphql.ID {
+	return graphql.ID(strconv.Itoa(id))
+}
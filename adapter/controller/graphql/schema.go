@@ -0,0 +1,121 @@
+// Package graphql はダッシュボードなど複数のリソースを1回で取得するためのGraphQLのAPI
+// リゾルバーはRESTのハンドラーと同じユースケースを使い、関連の取得はリクエストごとのデータローダーでまとめる
+package graphql
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"household-account-backend/pkg/logger"
+	"household-account-backend/usecase"
+)
+
+//go:embed schema.graphql
+var schemaString string
+
+const (
+	// maxDepth はクエリのネストの上限。カテゴリーと取引を交互にたどる重いクエリを防ぐ
+	maxDepth = 6
+	// maxParallelism はリスト内のフィールドを並行して解決する数の上限
+	maxParallelism = 20
+)
+
+// Request はGraphQLのリクエスト
+type Request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// Executor はスキーマとリゾルバーを持ち、ユーザーごとにクエリを実行する
+type Executor struct {
+	schema   *graphql.Schema
+	resolver *Resolver
+}
+
+func NewExecutor(userUseCase usecase.UserUseCase, categoryUseCase usecase.CategoryUseCase, transactionUseCase usecase.TransactionUseCase, monthlySummaryUseCase usecase.MonthlySummaryUseCase) *Executor {
+	resolver := &Resolver{
+		userUseCase:           userUseCase,
+		categoryUseCase:       categoryUseCase,
+		transactionUseCase:    transactionUseCase,
+		monthlySummaryUseCase: monthlySummaryUseCase,
+	}
+	schema := graphql.MustParseSchema(schemaString, resolver,
+		graphql.UseStringDescriptions(),
+		graphql.MaxDepth(maxDepth),
+		graphql.MaxParallelism(maxParallelism),
+	)
+	return &Executor{schema: schema, resolver: resolver}
+}
+
+// Execute は認証したユーザーとしてクエリを実行する
+// データローダーはリクエストごとに作り、他のリクエストとキャッシュを共有しない
+func (e *Executor) Execute(ctx context.Context, userID int, request Request) *graphql.Response {
+	ctx = context.WithValue(ctx, userIDContextKey, userID)
+	ctx = context.WithValue(ctx, loadersContextKey, e.resolver.newLoaders(userID))
+	return e.schema.Exec(ctx, request.Query, request.OperationName, request.Variables)
+}
+
+type contextKey int
+
+const (
+	userIDContextKey contextKey = iota
+	loadersContextKey
+)
+
+func currentUserID(ctx context.Context) int {
+	userID, _ := ctx.Value(userIDContextKey).(int)
+	return userID
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersContextKey).(*loaders)
+}
+
+// resolverError はユースケースのドメインエラーをそのまま返し、それ以外は詳細を含めないエラーにする
+// RESTのエラーハンドラーと同じく、内部の情報はログにのみ記録する
+func resolverError(err error) error {
+	var (
+		validation   *usecase.ValidationError
+		notFound     *usecase.NotFoundError
+		conflict     *usecase.ConflictError
+		unauthorized *usecase.UnauthorizedError
+		forbidden    *usecase.ForbiddenError
+	)
+	if errors.As(err, &validation) || errors.As(err, &notFound) || errors.As(err, &conflict) || errors.As(err, &unauthorized) || errors.As(err, &forbidden) {
+		return err
+	}
+	logger.Error("graphql resolver failed", "error", err.Error())
+	return errors.New("internal server error")
+}
+
+// Date はYYYY-MM-DD形式の日付のスカラー
+type Date struct {
+	time.Time
+}
+
+func (Date) ImplementsGraphQLType(name string) bool {
+	return name == "Date"
+}
+
+func (d *Date) UnmarshalGraphQL(input interface{}) error {
+	value, ok := input.(string)
+	if !ok {
+		return fmt.Errorf("wrong type for Date: %T", input)
+	}
+	t, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return fmt.Errorf("date must be in YYYY-MM-DD format: %s", value)
+	}
+	d.Time = t
+	return nil
+}
+
+func (d Date) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + d.Format(time.DateOnly) + `"`), nil
+}
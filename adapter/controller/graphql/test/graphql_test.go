@@ -0,0 +1,224 @@
+package graphql_test
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"household-account-backend/adapter/controller/graphql"
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/entity"
+	"household-account-backend/pkg/tester"
+	"household-account-backend/usecase"
+)
+
+// countingCategoryUseCase はデータローダーがカテゴリーをまとめて取得した回数を数える
+type countingCategoryUseCase struct {
+	usecase.CategoryUseCase
+	calls atomic.Int32
+}
+
+func (c *countingCategoryUseCase) GetCategoriesByIDs(userID int, categoryIDs []int) ([]entity.Category, error) {
+	c.calls.Add(1)
+	return c.CategoryUseCase.GetCategoriesByIDs(userID, categoryIDs)
+}
+
+// countingTransactionUseCase は取引を検索した回数を数える
+type countingTransactionUseCase struct {
+	usecase.TransactionUseCase
+	calls atomic.Int32
+}
+
+func (c *countingTransactionUseCase) FindTransactions(userID int, filter entity.TransactionFilter) ([]entity.Transaction, error) {
+	c.calls.Add(1)
+	return c.TransactionUseCase.FindTransactions(userID, filter)
+}
+
+// stubUserUseCase はログイン中のユーザーだけを返す
+type stubUserUseCase struct {
+	usecase.UserUseCase
+	repository gateway.UserRepository
+}
+
+func (s *stubUserUseCase) GetCurrentUser(userID int) (*entity.User, error) {
+	return s.repository.GetCurrentUser(userID)
+}
+
+type GraphQLSuite struct {
+	tester.DBSQLiteSuite
+	executor     *graphql.Executor
+	categories   *countingCategoryUseCase
+	transactions *countingTransactionUseCase
+	user         *entity.User
+	other        *entity.User
+	food         entity.Category
+	salary       entity.Category
+}
+
+func TestGraphQLSuite(t *testing.T) {
+	suite.Run(t, new(GraphQLSuite))
+}
+
+func (suite *GraphQLSuite) SetupSuite() {
+	suite.DBSQLiteSuite.SetupSuite()
+
+	categoryRepository := gateway.NewCategoryRepository(suite.DB)
+	suite.categories = &countingCategoryUseCase{CategoryUseCase: usecase.NewCategoryUseCase(categoryRepository, nil)}
+	suite.transactions = &countingTransactionUseCase{TransactionUseCase: usecase.NewTransactionUseCase(gateway.NewTransactionRepository(suite.DB), categoryRepository, usecase.SignPolicyPositive, nil)}
+	users := &stubUserUseCase{repository: gateway.NewUserRepository(suite.DB)}
+	suite.executor = graphql.NewExecutor(users, suite.categories, suite.transactions, usecase.NewMonthlySummaryUseCase(gateway.NewMonthlySummaryRepository(suite.DB), nil))
+
+	suite.user = &entity.User{Email: "graphql@example.com", Name: "GraphQL", Password: "hashed"}
+	suite.other = &entity.User{Email: "other@example.com", Name: "Other", Password: "hashed"}
+	suite.Require().NoError(suite.DB.Create(suite.user).Error)
+	suite.Require().NoError(suite.DB.Create(suite.other).Error)
+
+	suite.food = entity.Category{UserID: suite.user.ID, Name: "Food", Type: entity.CategoryTypeExpense, Version: 1}
+	suite.salary = entity.Category{UserID: suite.user.ID, Name: "Salary", Type: entity.CategoryTypeIncome, Version: 1}
+	otherCategory := entity.Category{UserID: suite.other.ID, Name: "Other", Type: entity.CategoryTypeExpense, Version: 1}
+	suite.Require().NoError(suite.DB.Create(&suite.food).Error)
+	suite.Require().NoError(suite.DB.Create(&suite.salary).Error)
+	suite.Require().NoError(suite.DB.Create(&otherCategory).Error)
+
+	date := func(month time.Month, day int) time.Time { return time.Date(2025, month, day, 0, 0, 0, 0, time.UTC) }
+	transactions := []entity.Transaction{
+		{UserID: suite.user.ID, CategoryID: suite.salary.ID, Date: date(time.January, 25), Amount: 3000, Version: 1},
+		{UserID: suite.user.ID, CategoryID: suite.food.ID, Date: date(time.January, 10), Amount: 120, Content: "lunch", Version: 1},
+		{UserID: suite.user.ID, CategoryID: suite.food.ID, Date: date(time.February, 1), Amount: 80, Version: 1},
+		{UserID: suite.other.ID, CategoryID: otherCategory.ID, Date: date(time.January, 5), Amount: 999, Version: 1},
+	}
+	suite.Require().NoError(suite.DB.Create(&transactions).Error)
+
+	summaries := []entity.MonthlySummary{
+		{UserID: suite.user.ID, YearMonth: "2025-02", Income: 0, Expense: 80, Balance: -80, Version: 1},
+		{UserID: suite.user.ID, YearMonth: "2025-01", Income: 3000, Expense: 120, Balance: 2880, Version: 1},
+	}
+	suite.Require().NoError(suite.DB.Create(&summaries).Error)
+}
+
+func (suite *GraphQLSuite) SetupTest() {
+	suite.categories.calls.Store(0)
+	suite.transactions.calls.Store(0)
+}
+
+// execute はログイン中のユーザーとしてクエリを実行し、dataを読み込む
+func (suite *GraphQLSuite) execute(userID int, query string, variables map[string]interface{}, data interface{}) {
+	response := suite.executor.Execute(context.Background(), userID, graphql.Request{Query: query, Variables: variables})
+	suite.Require().Empty(response.Errors)
+	suite.Require().NoError(json.Unmarshal(response.Data, data), string(response.Data))
+}
+
+func (suite *GraphQLSuite) TestDashboardQueryBatchesRelations() {
+	var data struct {
+		Me struct {
+			Name       string
+			Categories []struct {
+				Name         string
+				Type         string
+				Transactions []struct{ Amount float64 }
+			}
+		}
+		Transactions []struct {
+			Date     string
+			Content  *string
+			Category *struct{ Name string }
+		}
+		MonthlySummaries []struct {
+			YearMonth string
+			Balance   float64
+		}
+	}
+	suite.execute(suite.user.ID, `{
+		me {
+			name
+			categories { name type transactions { amount } }
+		}
+		transactions { date content category { name } }
+		monthlySummaries { yearMonth balance }
+	}`, nil, &data)
+
+	suite.Assert().Equal("GraphQL", data.Me.Name)
+	suite.Require().Len(data.Me.Categories, 2)
+	suite.Assert().Equal("EXPENSE", data.Me.Categories[0].Type)
+	suite.Assert().Len(data.Me.Categories[0].Transactions, 2)
+	suite.Assert().Len(data.Me.Categories[1].Transactions, 1)
+
+	suite.Require().Len(data.Transactions, 3)
+	suite.Assert().Equal("2025-01-10", data.Transactions[0].Date)
+	suite.Assert().Equal("lunch", *data.Transactions[0].Content)
+	suite.Assert().Nil(data.Transactions[1].Content)
+	suite.Assert().Equal("Salary", data.Transactions[1].Category.Name)
+
+	suite.Require().Len(data.MonthlySummaries, 2)
+	suite.Assert().Equal("2025-01", data.MonthlySummaries[0].YearMonth)
+
+	// カテゴリーの取引はまとめて1回、トップレベルの取引は1回
+	// 取引のカテゴリーは一覧で取得済みであれば再び取得せず、そうでなくてもまとめて1回で取得する
+	suite.Assert().Equal(int32(2), suite.transactions.calls.Load())
+	suite.Assert().LessOrEqual(suite.categories.calls.Load(), int32(1))
+}
+
+func (suite *GraphQLSuite) TestTransactionCategoriesAreLoadedInOneBatch() {
+	var data struct {
+		Transactions []struct {
+			Category struct {
+				Name         string
+				Transactions []struct{ ID string }
+			}
+		}
+	}
+	suite.execute(suite.user.ID, `{ transactions { category { name transactions { id } } } }`, nil, &data)
+
+	suite.Require().Len(data.Transactions, 3)
+	suite.Assert().Len(data.Transactions[0].Category.Transactions, 2)
+	suite.Assert().Equal(int32(1), suite.categories.calls.Load())
+	suite.Assert().Equal(int32(2), suite.transactions.calls.Load())
+}
+
+func (suite *GraphQLSuite) TestTransactionFilter() {
+	var data struct {
+		Transactions []struct{ Amount float64 }
+	}
+	suite.execute(suite.user.ID, `query($filter: TransactionFilter) { transactions(filter: $filter) { amount } }`, map[string]interface{}{
+		"filter": map[string]interface{}{"from": "2025-01-01", "to": "2025-01-31", "type": "EXPENSE"},
+	}, &data)
+	suite.Require().Len(data.Transactions, 1)
+	suite.Assert().Equal(120.0, data.Transactions[0].Amount)
+
+	var summaries struct {
+		MonthlySummaries []struct{ YearMonth string }
+	}
+	suite.execute(suite.user.ID, `{ monthlySummaries(from: "2025-02") { yearMonth } }`, nil, &summaries)
+	suite.Require().Len(summaries.MonthlySummaries, 1)
+	suite.Assert().Equal("2025-02", summaries.MonthlySummaries[0].YearMonth)
+}
+
+func (suite *GraphQLSuite) TestOtherUsersDataIsNotReturned() {
+	var data struct {
+		Category     *struct{ Name string }
+		Transactions []struct{ Amount float64 }
+	}
+	suite.execute(suite.other.ID, `query($id: ID!, $ids: [ID!]) {
+		category(id: $id) { name }
+		transactions(filter: { categoryIds: $ids }) { amount }
+	}`, map[string]interface{}{
+		"id":  strconv.Itoa(suite.food.ID),
+		"ids": []interface{}{strconv.Itoa(suite.food.ID), strconv.Itoa(suite.salary.ID)},
+	}, &data)
+	suite.Assert().Nil(data.Category)
+	suite.Assert().Empty(data.Transactions)
+}
+
+func (suite *GraphQLSuite) TestInvalidArguments() {
+	response := suite.executor.Execute(context.Background(), suite.user.ID, graphql.Request{Query: `{ transactions(filter: { from: "2025/01/01" }) { id } }`})
+	suite.Assert().NotEmpty(response.Errors)
+
+	response = suite.executor.Execute(context.Background(), suite.user.ID, graphql.Request{Query: `{ monthlySummaries(from: "2025") { id } }`})
+	suite.Require().NotEmpty(response.Errors)
+	suite.Assert().Contains(response.Errors[0].Message, "YYYY-MM")
+}
@@ -0,0 +1,192 @@
+package graphql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"household-account-backend/entity"
+)
+
+type userResolver struct {
+	root *Resolver
+	user *entity.User
+}
+
+func (u *userResolver) ID() graphql.ID {
+	return toID(u.user.ID)
+}
+
+func (u *userResolver) Name() string {
+	return u.user.Name
+}
+
+func (u *userResolver) Email() string {
+	return u.user.Email
+}
+
+func (u *userResolver) EmailVerified() bool {
+	return u.user.EmailVerified()
+}
+
+func (u *userResolver) Categories(ctx context.Context, args categoriesArgs) ([]*categoryResolver, error) {
+	return u.root.Categories(ctx, args)
+}
+
+func (u *userResolver) Transactions(ctx context.Context, args transactionsArgs) ([]*transactionResolver, error) {
+	return u.root.Transactions(ctx, args)
+}
+
+func (u *userResolver) MonthlySummaries(ctx context.Context, args monthlySummariesArgs) ([]*monthlySummaryResolver, error) {
+	return u.root.MonthlySummaries(ctx, args)
+}
+
+type categoryResolver struct {
+	category entity.Category
+	// siblings は同じリストのカテゴリーのID。取引をまとめて取得するために使う
+	siblings *siblings
+}
+
+// newCategoryResolvers は取得済みのカテゴリーをローダーに登録し、取引から参照された場合に再び取得しないようにする
+func newCategoryResolvers(ctx context.Context, categories []entity.Category) []*categoryResolver {
+	ids := make([]int, 0, len(categories))
+	for _, category := range categories {
+		ids = append(ids, category.ID)
+	}
+	s := newSiblings(ids)
+
+	loader := loadersFrom(ctx).category
+	resolvers := make([]*categoryResolver, 0, len(categories))
+	for i := range categories {
+		loader.Prime(ctx, categories[i].ID, &categories[i])
+		resolvers = append(resolvers, &categoryResolver{category: categories[i], siblings: s})
+	}
+	return resolvers
+}
+
+func (c *categoryResolver) ID() graphql.ID {
+	return toID(c.category.ID)
+}
+
+func (c *categoryResolver) Name() string {
+	return c.category.Name
+}
+
+func (c *categoryResolver) Type() string {
+	return strings.ToUpper(c.category.Type)
+}
+
+func (c *categoryResolver) Version() int32 {
+	return int32(c.category.Version)
+}
+
+func (c *categoryResolver) Transactions(ctx context.Context, args struct{ From, To *Date }) ([]*transactionResolver, error) {
+	loader := loadersFrom(ctx).categoryTransactions
+	from, to := formatDate(args.From), formatDate(args.To)
+	c.siblings.prime("transactions:"+from+":"+to, func(ids []int) {
+		for _, id := range ids {
+			loader.Load(ctx, categoryTransactionsKey{categoryID: id, from: from, to: to})
+		}
+	})
+
+	transactions, err := loader.Load(ctx, categoryTransactionsKey{categoryID: c.category.ID, from: from, to: to})()
+	if err != nil {
+		return nil, resolverError(err)
+	}
+	return newTransactionResolvers(transactions), nil
+}
+
+type transactionResolver struct {
+	transaction entity.Transaction
+	// categories は同じリストの取引が参照するカテゴリーのID。カテゴリーをまとめて取得するために使う
+	categories *siblings
+}
+
+func newTransactionResolvers(transactions []entity.Transaction) []*transactionResolver {
+	ids := []int{}
+	seen := map[int]bool{}
+	for _, transaction := range transactions {
+		if !seen[transaction.CategoryID] {
+			seen[transaction.CategoryID] = true
+			ids = append(ids, transaction.CategoryID)
+		}
+	}
+	s := newSiblings(ids)
+
+	resolvers := make([]*transactionResolver, 0, len(transactions))
+	for _, transaction := range transactions {
+		resolvers = append(resolvers, &transactionResolver{transaction: transaction, categories: s})
+	}
+	return resolvers
+}
+
+func (t *transactionResolver) ID() graphql.ID {
+	return toID(t.transaction.ID)
+}
+
+func (t *transactionResolver) Date() Date {
+	return Date{Time: t.transaction.Date}
+}
+
+func (t *transactionResolver) Amount() float64 {
+	return float64(t.transaction.Amount)
+}
+
+func (t *transactionResolver) Content() *string {
+	if t.transaction.Content == "" {
+		return nil
+	}
+	return &t.transaction.Content
+}
+
+func (t *transactionResolver) Version() int32 {
+	return int32(t.transaction.Version)
+}
+
+func (t *transactionResolver) Category(ctx context.Context) (*categoryResolver, error) {
+	loader := loadersFrom(ctx).category
+	t.categories.prime("category", func(ids []int) {
+		for _, id := range ids {
+			loader.Load(ctx, id)
+		}
+	})
+
+	category, err := loader.Load(ctx, t.transaction.CategoryID)()
+	if err != nil {
+		return nil, resolverError(err)
+	}
+	if category == nil {
+		return nil, nil
+	}
+	// 同じリストの取引から参照されたカテゴリーどうしで、カテゴリーの取引もまとめて取得する
+	return &categoryResolver{category: *category, siblings: t.categories}, nil
+}
+
+type monthlySummaryResolver struct {
+	summary entity.MonthlySummary
+}
+
+func (m *monthlySummaryResolver) ID() graphql.ID {
+	return toID(m.summary.ID)
+}
+
+func (m *monthlySummaryResolver) YearMonth() string {
+	return m.summary.YearMonth
+}
+
+func (m *monthlySummaryResolver) Income() float64 {
+	return m.summary.Income
+}
+
+func (m *monthlySummaryResolver) Expense() float64 {
+	return m.summary.Expense
+}
+
+func (m *monthlySummaryResolver) Balance() float64 {
+	return m.summary.Balance
+}
+
+func (m *monthlySummaryResolver) Version() int32 {
+	return int32(m.summary.Version)
+}
@@ -0,0 +1,64 @@
+package grpc
+
+import (
+	"context"
+	"sort"
+
+	"household-account-backend/adapter/controller/grpc/pb"
+	"household-account-backend/entity"
+	"household-account-backend/usecase"
+)
+
+type CategoryService struct {
+	pb.UnimplementedCategoryServiceServer
+	categoryUseCase usecase.CategoryUseCase
+}
+
+func NewCategoryService(categoryUseCase usecase.CategoryUseCase) *CategoryService {
+	return &CategoryService{categoryUseCase: categoryUseCase}
+}
+
+func (s *CategoryService) ListCategories(ctx context.Context, req *pb.ListCategoriesRequest) (*pb.ListCategoriesResponse, error) {
+	categories, err := s.categoryUseCase.GetCategoriesByUserID(authenticatedUserID(ctx))
+	if err != nil {
+		return nil, statusError(err)
+	}
+	sort.Slice(categories, func(i, j int) bool { return categories[i].ID < categories[j].ID })
+
+	response := &pb.ListCategoriesResponse{Categories: make([]*pb.Category, 0, len(categories))}
+	for i := range categories {
+		category := toCategory(&categories[i])
+		if req.GetType() != pb.CategoryType_CATEGORY_TYPE_UNSPECIFIED && category.Type != req.GetType() {
+			continue
+		}
+		response.Categories = append(response.Categories, category)
+	}
+	return response, nil
+}
+
+func (s *CategoryService) GetCategory(ctx context.Context, req *pb.GetCategoryRequest) (*pb.Category, error) {
+	category, err := s.categoryUseCase.GetCategoryByID(authenticatedUserID(ctx), int(req.GetId()))
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return toCategory(category), nil
+}
+
+func toCategory(category *entity.Category) *pb.Category {
+	return &pb.Category{
+		Id:      int64(category.ID),
+		Name:    category.Name,
+		Type:    toCategoryType(category.Type),
+		Version: int64(category.Version),
+	}
+}
+
+func toCategoryType(categoryType string) pb.CategoryType {
+	switch categoryType {
+	case entity.CategoryTypeIncome:
+		return pb.CategoryType_CATEGORY_TYPE_INCOME
+	case entity.CategoryTypeExpense:
+		return pb.CategoryType_CATEGORY_TYPE_EXPENSE
+	}
+	return pb.CategoryType_CATEGORY_TYPE_UNSPECIFIED
+}
@@ -0,0 +1,39 @@
+package grpc
+
+import (
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"household-account-backend/pkg/logger"
+	"household-account-backend/usecase"
+)
+
+// statusError はユースケースのドメインエラーを種類に応じたステータスコードに変換する
+// それ以外のエラーは内部の情報を返さないよう詳細を含めずにInternalとし、ログに記録する
+func statusError(err error) error {
+	var (
+		validation   *usecase.ValidationError
+		notFound     *usecase.NotFoundError
+		conflict     *usecase.ConflictError
+		unauthorized *usecase.UnauthorizedError
+		forbidden    *usecase.ForbiddenError
+	)
+	switch {
+	case errors.Is(err, usecase.ErrVersionConflict):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.As(err, &validation):
+		return status.Error(codes.InvalidArgument, validation.Message)
+	case errors.As(err, &notFound):
+		return status.Error(codes.NotFound, notFound.Message)
+	case errors.As(err, &conflict):
+		return status.Error(codes.Aborted, conflict.Message)
+	case errors.As(err, &unauthorized):
+		return status.Error(codes.Unauthenticated, unauthorized.Message)
+	case errors.As(err, &forbidden):
+		return status.Error(codes.PermissionDenied, forbidden.Message)
+	}
+	logger.Error("grpc request failed", "error", err.Error())
+	return status.Error(codes.Internal, "internal server error")
+}
@@ -0,0 +1,159 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"household-account-backend/adapter/controller/grpc/pb"
+	"household-account-backend/entity"
+	"household-account-backend/pkg/logger"
+	"household-account-backend/usecase"
+)
+
+// serviceScopes はサービスごとに必要なパーソナルアクセストークンのスコープ
+// 登録していないサービスは認証に成功しても利用できない
+var serviceScopes = map[string]string{
+	pb.TransactionService_ServiceDesc.ServiceName: entity.ScopeReadTransactions,
+	pb.CategoryService_ServiceDesc.ServiceName:    entity.ScopeReadCategories,
+	pb.SummaryService_ServiceDesc.ServiceName:     entity.ScopeReadReports,
+}
+
+// publicServices は認証せずに利用できるサービス。開発環境でのみ登録するリフレクションが該当する
+var publicServices = map[string]bool{
+	"grpc.reflection.v1.ServerReflection":      true,
+	"grpc.reflection.v1alpha.ServerReflection": true,
+}
+
+type contextKey int
+
+const userIDContextKey contextKey = iota
+
+// Authenticator はauthorizationメタデータのBearerトークンでユーザーを認証するインターセプター
+// RESTのAPIのBearerトークンと同じく、パーソナルアクセストークンのスコープと無効にされたユーザーを確認する
+type Authenticator struct {
+	personalAccessTokenUseCase usecase.PersonalAccessTokenUseCase
+	sessionUseCase             usecase.SessionUseCase
+}
+
+func NewAuthenticator(personalAccessTokenUseCase usecase.PersonalAccessTokenUseCase, sessionUseCase usecase.SessionUseCase) *Authenticator {
+	return &Authenticator{
+		personalAccessTokenUseCase: personalAccessTokenUseCase,
+		sessionUseCase:             sessionUseCase,
+	}
+}
+
+func (a *Authenticator) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := a.authenticate(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (a *Authenticator) StreamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := a.authenticate(stream.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authenticatedStream{ServerStream: stream, ctx: ctx})
+}
+
+// authenticate はトークンを検証し、認証したユーザーのIDをコンテキストに保存する
+// fullMethodは "/household.v1.TransactionService/ListTransactions" の形式
+func (a *Authenticator) authenticate(ctx context.Context, fullMethod string) (context.Context, error) {
+	service, _, _ := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if publicServices[service] {
+		return ctx, nil
+	}
+
+	token, ok := bearerToken(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+	accessToken, err := a.personalAccessTokenUseCase.Authenticate(token)
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidAccessToken) {
+			return nil, status.Error(codes.Unauthenticated, "invalid token")
+		}
+		return nil, statusError(fmt.Errorf("personal access token lookup failed: %w", err))
+	}
+
+	required, ok := serviceScopes[service]
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "personal access tokens cannot access this service")
+	}
+	if !accessToken.HasScope(required) {
+		return nil, status.Error(codes.PermissionDenied, "token does not have the required scope: "+required)
+	}
+
+	_, err = a.sessionUseCase.Authorize(accessToken.UserID, nil)
+	switch {
+	case errors.Is(err, usecase.ErrAccountDisabled):
+		return nil, status.Error(codes.PermissionDenied, "account is disabled")
+	case err != nil:
+		return nil, statusError(fmt.Errorf("session authorization failed for user %d: %w", accessToken.UserID, err))
+	}
+	return context.WithValue(ctx, userIDContextKey, accessToken.UserID), nil
+}
+
+func bearerToken(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+	for _, value := range md.Get("authorization") {
+		scheme, token, ok := strings.Cut(value, " ")
+		if ok && strings.EqualFold(scheme, "Bearer") {
+			return strings.TrimSpace(token), true
+		}
+	}
+	return "", false
+}
+
+// authenticatedUserID は認証インターセプターが保存したユーザーのIDを返す
+func authenticatedUserID(ctx context.Context) int {
+	userID, _ := ctx.Value(userIDContextKey).(int)
+	return userID
+}
+
+// authenticatedStream は認証したユーザーを保存したコンテキストをストリームのハンドラーに渡す
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+// recoveryUnaryInterceptor はハンドラーのパニックでサーバーが停止しないようにInternalのエラーにする
+func recoveryUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = recovered(info.FullMethod, r)
+		}
+	}()
+	return handler(ctx, req)
+}
+
+func recoveryStreamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = recovered(info.FullMethod, r)
+		}
+	}()
+	return handler(srv, stream)
+}
+
+func recovered(fullMethod string, r interface{}) error {
+	logger.Error("grpc handler panicked", "method", fullMethod, "panic", fmt.Sprint(r), "stack", string(debug.Stack()))
+	return status.Error(codes.Internal, "internal server error")
+}
@@ -0,0 +1,31 @@
+// Package grpc は社内の他のサービスが家計簿のデータを参照するためのgRPCのAPI
+// CookieやCSRFトークンを使わず、パーソナルアクセストークンで認証する
+// サービスはRESTのハンドラーと同じユースケースを使い、参照のみを提供する
+package grpc
+
+import (
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+
+	"household-account-backend/adapter/controller/container"
+	"household-account-backend/adapter/controller/grpc/pb"
+)
+
+// NewServer はサービスを登録したgRPCのサーバーを作成する
+// ユースケースはRESTのルーターと同じコンテナのものを使う
+// 開発環境ではgrpcurlなどで定義を確認できるようにリフレクションを有効にする
+func NewServer(deps *container.Container) *grpc.Server {
+	authenticator := NewAuthenticator(deps.PersonalAccessTokenUseCase, deps.SessionUseCase)
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(recoveryUnaryInterceptor, authenticator.UnaryInterceptor),
+		grpc.ChainStreamInterceptor(recoveryStreamInterceptor, authenticator.StreamInterceptor),
+	)
+	pb.RegisterTransactionServiceServer(server, NewTransactionService(deps.TransactionUseCase))
+	pb.RegisterCategoryServiceServer(server, NewCategoryService(deps.CategoryUseCase))
+	pb.RegisterSummaryServiceServer(server, NewSummaryService(deps.MonthlySummaryUseCase))
+
+	if deps.Config.Env == "development" {
+		reflection.Register(server)
+	}
+	return server
+}
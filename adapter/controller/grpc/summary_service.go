@@ -0,0 +1,80 @@
+package grpc
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"household-account-backend/adapter/controller/grpc/pb"
+	"household-account-backend/entity"
+	"household-account-backend/usecase"
+)
+
+type SummaryService struct {
+	pb.UnimplementedSummaryServiceServer
+	monthlySummaryUseCase usecase.MonthlySummaryUseCase
+}
+
+func NewSummaryService(monthlySummaryUseCase usecase.MonthlySummaryUseCase) *SummaryService {
+	return &SummaryService{monthlySummaryUseCase: monthlySummaryUseCase}
+}
+
+func (s *SummaryService) ListMonthlySummaries(ctx context.Context, req *pb.ListMonthlySummariesRequest) (*pb.ListMonthlySummariesResponse, error) {
+	if err := validateYearMonthArgument("from", req.GetFrom()); err != nil {
+		return nil, err
+	}
+	if err := validateYearMonthArgument("to", req.GetTo()); err != nil {
+		return nil, err
+	}
+
+	summaries, err := s.monthlySummaryUseCase.GetMonthlySummariesByUserID(authenticatedUserID(ctx))
+	if err != nil {
+		return nil, statusError(err)
+	}
+	// YYYY-MM形式は文字列の順序が月の順序と一致する
+	sort.Slice(summaries, func(i, j int) bool { return summaries[i].YearMonth < summaries[j].YearMonth })
+	response := &pb.ListMonthlySummariesResponse{MonthlySummaries: []*pb.MonthlySummary{}}
+	for i := range summaries {
+		if req.GetFrom() != "" && summaries[i].YearMonth < req.GetFrom() {
+			continue
+		}
+		if req.GetTo() != "" && summaries[i].YearMonth > req.GetTo() {
+			continue
+		}
+		response.MonthlySummaries = append(response.MonthlySummaries, toMonthlySummary(&summaries[i]))
+	}
+	return response, nil
+}
+
+func (s *SummaryService) GetMonthlySummary(ctx context.Context, req *pb.GetMonthlySummaryRequest) (*pb.MonthlySummary, error) {
+	summary, err := s.monthlySummaryUseCase.GetMonthlySummaryByID(authenticatedUserID(ctx), int(req.GetId()))
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return toMonthlySummary(summary), nil
+}
+
+func toMonthlySummary(summary *entity.MonthlySummary) *pb.MonthlySummary {
+	return &pb.MonthlySummary{
+		Id:        int64(summary.ID),
+		YearMonth: summary.YearMonth,
+		Income:    summary.Income,
+		Expense:   summary.Expense,
+		Balance:   summary.Balance,
+		Version:   int64(summary.Version),
+	}
+}
+
+// validateYearMonthArgument はYYYY-MM形式であることを確かめる。空の場合は指定なしとして扱う
+func validateYearMonthArgument(name string, value string) error {
+	if value == "" {
+		return nil
+	}
+	if _, err := time.Parse("2006-01", value); err != nil {
+		return status.Errorf(codes.InvalidArgument, "%s must be in YYYY-MM format: %s", name, value)
+	}
+	return nil
+}
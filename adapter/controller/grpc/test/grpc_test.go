@@ -0,0 +1,223 @@
+package grpc_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"household-account-backend/adapter/controller/container"
+	grpcserver "household-account-backend/adapter/controller/grpc"
+	"household-account-backend/adapter/controller/grpc/pb"
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/entity"
+	"household-account-backend/pkg/config"
+	"household-account-backend/pkg/tester"
+	"household-account-backend/usecase"
+)
+
+// GRPCSuite はbufconnで起動したサーバーにクライアントから接続して検証する
+type GRPCSuite struct {
+	tester.DBSQLiteSuite
+	server       *grpc.Server
+	conn         *grpc.ClientConn
+	user         *entity.User
+	other        *entity.User
+	food         entity.Category
+	salary       entity.Category
+	otherFood    entity.Category
+	readAllToken string
+	// categoryToken はカテゴリーの参照のみを許可したトークン
+	categoryToken string
+	otherToken    string
+}
+
+func TestGRPCSuite(t *testing.T) {
+	suite.Run(t, new(GRPCSuite))
+}
+
+func (suite *GRPCSuite) SetupSuite() {
+	suite.DBSQLiteSuite.SetupSuite()
+
+	listener := bufconn.Listen(1024 * 1024)
+	deps, err := container.New(suite.DB, config.NewConfig())
+	suite.Require().NoError(err)
+	suite.server = grpcserver.NewServer(deps)
+	go suite.server.Serve(listener)
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	suite.Require().NoError(err)
+	suite.conn = conn
+
+	suite.user = &entity.User{Email: "grpc@example.com", Name: "gRPC", Password: "hashed"}
+	suite.other = &entity.User{Email: "grpc-other@example.com", Name: "Other", Password: "hashed"}
+	suite.Require().NoError(suite.DB.Create(suite.user).Error)
+	suite.Require().NoError(suite.DB.Create(suite.other).Error)
+
+	suite.food = entity.Category{UserID: suite.user.ID, Name: "Food", Type: entity.CategoryTypeExpense, Version: 1}
+	suite.salary = entity.Category{UserID: suite.user.ID, Name: "Salary", Type: entity.CategoryTypeIncome, Version: 1}
+	suite.otherFood = entity.Category{UserID: suite.other.ID, Name: "Food", Type: entity.CategoryTypeExpense, Version: 1}
+	suite.Require().NoError(suite.DB.Create(&suite.food).Error)
+	suite.Require().NoError(suite.DB.Create(&suite.salary).Error)
+	suite.Require().NoError(suite.DB.Create(&suite.otherFood).Error)
+
+	date := func(month time.Month, day int) time.Time { return time.Date(2025, month, day, 0, 0, 0, 0, time.UTC) }
+	transactions := []entity.Transaction{
+		{UserID: suite.user.ID, CategoryID: suite.salary.ID, Date: date(time.January, 25), Amount: 3000, Version: 1},
+		{UserID: suite.user.ID, CategoryID: suite.food.ID, Date: date(time.January, 10), Amount: 120, Content: "lunch", Version: 1},
+		{UserID: suite.user.ID, CategoryID: suite.food.ID, Date: date(time.February, 1), Amount: 80, Version: 1},
+		{UserID: suite.other.ID, CategoryID: suite.otherFood.ID, Date: date(time.January, 5), Amount: 999, Version: 1},
+	}
+	suite.Require().NoError(suite.DB.Create(&transactions).Error)
+
+	summaries := []entity.MonthlySummary{
+		{UserID: suite.user.ID, YearMonth: "2025-02", Income: 0, Expense: 80, Balance: -80, Version: 1},
+		{UserID: suite.user.ID, YearMonth: "2025-01", Income: 3000, Expense: 120, Balance: 2880, Version: 1},
+	}
+	suite.Require().NoError(suite.DB.Create(&summaries).Error)
+
+	tokens := usecase.NewPersonalAccessTokenUseCase(gateway.NewPersonalAccessTokenRepository(suite.DB))
+	createToken := func(userID int, scopes string) string {
+		_, token, err := tokens.CreateToken(&entity.PersonalAccessToken{UserID: userID, Name: "grpc", Scopes: scopes})
+		suite.Require().NoError(err)
+		return token
+	}
+	suite.readAllToken = createToken(suite.user.ID, entity.ScopeReadTransactions+","+entity.ScopeReadCategories+","+entity.ScopeReadReports)
+	suite.categoryToken = createToken(suite.user.ID, entity.ScopeReadCategories)
+	suite.otherToken = createToken(suite.other.ID, entity.ScopeReadTransactions+","+entity.ScopeReadCategories)
+}
+
+func (suite *GRPCSuite) TearDownSuite() {
+	suite.conn.Close()
+	suite.server.Stop()
+	suite.DBSQLiteSuite.TearDownSuite()
+}
+
+// withToken はauthorizationメタデータにトークンを付けたコンテキストを返す
+func withToken(token string) context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+}
+
+func (suite *GRPCSuite) assertCode(expected codes.Code, err error) {
+	suite.Require().Error(err)
+	suite.Assert().Equal(expected, status.Code(err), err.Error())
+}
+
+func (suite *GRPCSuite) TestListTransactions() {
+	client := pb.NewTransactionServiceClient(suite.conn)
+
+	response, err := client.ListTransactions(withToken(suite.readAllToken), &pb.ListTransactionsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(response.Transactions, 3)
+	suite.Assert().Equal("2025-01-10", response.Transactions[0].Date)
+	suite.Assert().Equal("lunch", response.Transactions[0].Content)
+
+	response, err = client.ListTransactions(withToken(suite.readAllToken), &pb.ListTransactionsRequest{
+		From:        "2025-01-01",
+		To:          "2025-01-31",
+		CategoryIds: []int64{int64(suite.food.ID)},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(response.Transactions, 1)
+	suite.Assert().Equal(120.0, response.Transactions[0].Amount)
+
+	_, err = client.ListTransactions(withToken(suite.readAllToken), &pb.ListTransactionsRequest{From: "2025/01/01"})
+	suite.assertCode(codes.InvalidArgument, err)
+}
+
+func (suite *GRPCSuite) TestGetTransactionOfOtherUserIsNotFound() {
+	client := pb.NewTransactionServiceClient(suite.conn)
+	response, err := client.ListTransactions(withToken(suite.otherToken), &pb.ListTransactionsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(response.Transactions, 1)
+
+	transaction, err := client.GetTransaction(withToken(suite.otherToken), &pb.GetTransactionRequest{Id: response.Transactions[0].Id})
+	suite.Require().NoError(err)
+	suite.Assert().Equal(999.0, transaction.Amount)
+
+	_, err = client.GetTransaction(withToken(suite.readAllToken), &pb.GetTransactionRequest{Id: response.Transactions[0].Id})
+	suite.assertCode(codes.NotFound, err)
+}
+
+func (suite *GRPCSuite) TestCategories() {
+	client := pb.NewCategoryServiceClient(suite.conn)
+
+	response, err := client.ListCategories(withToken(suite.categoryToken), &pb.ListCategoriesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(response.Categories, 2)
+	suite.Assert().Equal(pb.CategoryType_CATEGORY_TYPE_EXPENSE, response.Categories[0].Type)
+
+	response, err = client.ListCategories(withToken(suite.categoryToken), &pb.ListCategoriesRequest{Type: pb.CategoryType_CATEGORY_TYPE_INCOME})
+	suite.Require().NoError(err)
+	suite.Require().Len(response.Categories, 1)
+	suite.Assert().Equal("Salary", response.Categories[0].Name)
+
+	category, err := client.GetCategory(withToken(suite.categoryToken), &pb.GetCategoryRequest{Id: int64(suite.food.ID)})
+	suite.Require().NoError(err)
+	suite.Assert().Equal("Food", category.Name)
+
+	_, err = client.GetCategory(withToken(suite.categoryToken), &pb.GetCategoryRequest{Id: int64(suite.otherFood.ID)})
+	suite.assertCode(codes.NotFound, err)
+}
+
+func (suite *GRPCSuite) TestMonthlySummaries() {
+	client := pb.NewSummaryServiceClient(suite.conn)
+
+	response, err := client.ListMonthlySummaries(withToken(suite.readAllToken), &pb.ListMonthlySummariesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(response.MonthlySummaries, 2)
+	suite.Assert().Equal("2025-01", response.MonthlySummaries[0].YearMonth)
+	suite.Assert().Equal(2880.0, response.MonthlySummaries[0].Balance)
+
+	response, err = client.ListMonthlySummaries(withToken(suite.readAllToken), &pb.ListMonthlySummariesRequest{From: "2025-02"})
+	suite.Require().NoError(err)
+	suite.Require().Len(response.MonthlySummaries, 1)
+
+	_, err = client.ListMonthlySummaries(withToken(suite.readAllToken), &pb.ListMonthlySummariesRequest{To: "2025"})
+	suite.assertCode(codes.InvalidArgument, err)
+}
+
+func (suite *GRPCSuite) TestAuthentication() {
+	client := pb.NewTransactionServiceClient(suite.conn)
+
+	_, err := client.ListTransactions(context.Background(), &pb.ListTransactionsRequest{})
+	suite.assertCode(codes.Unauthenticated, err)
+
+	_, err = client.ListTransactions(withToken("hha_invalid"), &pb.ListTransactionsRequest{})
+	suite.assertCode(codes.Unauthenticated, err)
+
+	// スコープのないサービスは利用できない
+	_, err = client.ListTransactions(withToken(suite.categoryToken), &pb.ListTransactionsRequest{})
+	suite.assertCode(codes.PermissionDenied, err)
+	_, err = pb.NewSummaryServiceClient(suite.conn).ListMonthlySummaries(withToken(suite.otherToken), &pb.ListMonthlySummariesRequest{})
+	suite.assertCode(codes.PermissionDenied, err)
+}
+
+func (suite *GRPCSuite) TestReflectionIsEnabledInDevelopment() {
+	stream, err := reflectionpb.NewServerReflectionClient(suite.conn).ServerReflectionInfo(context.Background())
+	suite.Require().NoError(err)
+	suite.Require().NoError(stream.Send(&reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{},
+	}))
+	response, err := stream.Recv()
+	suite.Require().NoError(err)
+
+	var services []string
+	for _, service := range response.GetListServicesResponse().GetService() {
+		services = append(services, service.Name)
+	}
+	suite.Assert().Contains(services, "household.v1.TransactionService")
+	suite.Assert().Contains(services, "household.v1.CategoryService")
+	suite.Assert().Contains(services, "household.v1.SummaryService")
+}
@@ -0,0 +1,77 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"household-account-backend/adapter/controller/grpc/pb"
+	"household-account-backend/entity"
+	"household-account-backend/usecase"
+)
+
+type TransactionService struct {
+	pb.UnimplementedTransactionServiceServer
+	transactionUseCase usecase.TransactionUseCase
+}
+
+func NewTransactionService(transactionUseCase usecase.TransactionUseCase) *TransactionService {
+	return &TransactionService{transactionUseCase: transactionUseCase}
+}
+
+func (s *TransactionService) ListTransactions(ctx context.Context, req *pb.ListTransactionsRequest) (*pb.ListTransactionsResponse, error) {
+	filter := entity.TransactionFilter{}
+	var err error
+	if filter.From, err = parseDateArgument("from", req.GetFrom()); err != nil {
+		return nil, err
+	}
+	if filter.To, err = parseDateArgument("to", req.GetTo()); err != nil {
+		return nil, err
+	}
+	for _, id := range req.GetCategoryIds() {
+		filter.CategoryIDs = append(filter.CategoryIDs, int(id))
+	}
+
+	transactions, err := s.transactionUseCase.FindTransactions(authenticatedUserID(ctx), filter)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	response := &pb.ListTransactionsResponse{Transactions: make([]*pb.Transaction, 0, len(transactions))}
+	for i := range transactions {
+		response.Transactions = append(response.Transactions, toTransaction(&transactions[i]))
+	}
+	return response, nil
+}
+
+func (s *TransactionService) GetTransaction(ctx context.Context, req *pb.GetTransactionRequest) (*pb.Transaction, error) {
+	transaction, err := s.transactionUseCase.GetTransactionByID(authenticatedUserID(ctx), int(req.GetId()))
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return toTransaction(transaction), nil
+}
+
+func toTransaction(transaction *entity.Transaction) *pb.Transaction {
+	return &pb.Transaction{
+		Id:         int64(transaction.ID),
+		CategoryId: int64(transaction.CategoryID),
+		Date:       transaction.Date.Format(time.DateOnly),
+		Amount:     float64(transaction.Amount),
+		Content:    transaction.Content,
+		Version:    int64(transaction.Version),
+	}
+}
+
+// parseDateArgument はYYYY-MM-DD形式の日付を読み込む。空の場合は指定なしとしてnilを返す
+func parseDateArgument(name string, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	date, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s must be in YYYY-MM-DD format: %s", name, value)
+	}
+	return &date, nil
+}
@@ -0,0 +1,364 @@
+package controller_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/suite"
+
+	"household-account-backend/adapter/controller/container"
+	"household-account-backend/adapter/controller/echo/presenter"
+	echorouter "household-account-backend/adapter/controller/echo/router"
+	ginrouter "household-account-backend/adapter/controller/gin/router"
+	"household-account-backend/entity"
+	"household-account-backend/pkg/client"
+	"household-account-backend/pkg/config"
+	"household-account-backend/pkg/tester"
+	"household-account-backend/usecase"
+)
+
+// ServerBehaviourSuite はechoとginのルーターに同じリクエストを送り、同じレスポンスになることを検証する
+// どちらのサーバーでも通るテストだけを書き、フレームワークごとの差はルーター側で吸収する
+type ServerBehaviourSuite struct {
+	tester.DBSQLiteSuite
+	newRouter func(deps *container.Container) http.Handler
+	server    *httptest.Server
+}
+
+func TestEchoServerBehaviour(t *testing.T) {
+	suite.Run(t, &ServerBehaviourSuite{newRouter: func(deps *container.Container) http.Handler {
+		return echorouter.NewEchoRouter(deps)
+	}})
+}
+
+func TestGinServerBehaviour(t *testing.T) {
+	suite.Run(t, &ServerBehaviourSuite{newRouter: func(deps *container.Container) http.Handler {
+		return ginrouter.NewGinRouter(deps)
+	}})
+}
+
+func (suite *ServerBehaviourSuite) SetupSuite() {
+	suite.DBSQLiteSuite.SetupSuite()
+	configs := config.NewConfig()
+	configs.Secret = "server-behaviour-test"
+	// 全てのテストが同じIPアドレスからユーザーを作成するため、認証の制限を緩める
+	configs.RateLimitAuth = config.RateLimitConfig{Limit: 100, Period: time.Minute}
+	deps, err := container.New(suite.DB, configs)
+	suite.Require().NoError(err)
+	suite.server = httptest.NewServer(suite.newRouter(deps))
+}
+
+func (suite *ServerBehaviourSuite) TearDownSuite() {
+	suite.server.Close()
+	suite.DBSQLiteSuite.TearDownSuite()
+}
+
+// signup はユーザーを作成してログインしたクライアントと、そのCookieを持つJarを返す
+func (suite *ServerBehaviourSuite) signup(email string) (*client.Client, http.CookieJar) {
+	ctx := context.Background()
+	jar, err := cookiejar.New(nil)
+	suite.Require().NoError(err)
+	c, err := client.New(client.Config{ServerURL: suite.server.URL, HTTPClient: &http.Client{Jar: jar}, RetryWait: time.Millisecond})
+	suite.Require().NoError(err)
+
+	_, err = c.CreateUserWithResponse(ctx, presenter.CreateUserJSONRequestBody{
+		Email:    types.Email(email),
+		Name:     "behaviour",
+		Password: "password123",
+	})
+	suite.Require().NoError(err)
+	suite.Require().NoError(c.Login(ctx, email, "password123"))
+	return c, jar
+}
+
+func (suite *ServerBehaviourSuite) do(req *http.Request, jar http.CookieJar) *http.Response {
+	res, err := (&http.Client{Jar: jar}).Do(req)
+	suite.Require().NoError(err)
+	suite.T().Cleanup(func() { res.Body.Close() })
+	return res
+}
+
+// assertProblem はレスポンスがproblem+jsonで、ステータスとdetailが期待どおりかを確認する
+func (suite *ServerBehaviourSuite) assertProblem(res *http.Response, status int, detail string) {
+	suite.Assert().Equal(status, res.StatusCode)
+	suite.Assert().Equal("application/problem+json", res.Header.Get("Content-Type"))
+
+	var problem presenter.Problem
+	suite.Require().NoError(json.NewDecoder(res.Body).Decode(&problem))
+	suite.Assert().Equal(status, problem.Status)
+	suite.Assert().Equal(http.StatusText(status), problem.Title)
+	if detail != "" && suite.Assert().NotNil(problem.Detail) {
+		suite.Assert().Equal(detail, *problem.Detail)
+	}
+	if suite.Assert().NotNil(problem.Instance) {
+		suite.Assert().Equal(res.Request.URL.Path, *problem.Instance)
+	}
+}
+
+func (suite *ServerBehaviourSuite) TestHealth() {
+	req, _ := http.NewRequest(http.MethodGet, suite.server.URL+"/health", nil)
+	res := suite.do(req, nil)
+
+	suite.Assert().Equal(http.StatusOK, res.StatusCode)
+	var body map[string]string
+	suite.Require().NoError(json.NewDecoder(res.Body).Decode(&body))
+	suite.Assert().Equal("ok", body["status"])
+}
+
+func (suite *ServerBehaviourSuite) TestReadiness() {
+	req, _ := http.NewRequest(http.MethodGet, suite.server.URL+"/health/ready", nil)
+	res := suite.do(req, nil)
+
+	suite.Assert().Equal(http.StatusOK, res.StatusCode)
+	var body entity.Readiness
+	suite.Require().NoError(json.NewDecoder(res.Body).Decode(&body))
+	suite.Assert().Equal(entity.HealthStatusOK, body.Status)
+	suite.Assert().Equal(entity.HealthStatusOK, body.Database.Status)
+	suite.Assert().NotNil(body.Database.Pool)
+}
+
+func (suite *ServerBehaviourSuite) TestUnknownRoute() {
+	req, _ := http.NewRequest(http.MethodGet, suite.server.URL+"/api/v1/unknown", nil)
+	suite.assertProblem(suite.do(req, nil), http.StatusNotFound, "Not Found")
+}
+
+func (suite *ServerBehaviourSuite) TestMethodNotAllowed() {
+	// CSRFの検証はルートより先に行うため、トークンで送って対象外にする
+	req, _ := http.NewRequest(http.MethodDelete, suite.server.URL+"/health", nil)
+	req.Header.Set("Authorization", "Bearer hha_token")
+	suite.assertProblem(suite.do(req, nil), http.StatusMethodNotAllowed, "Method Not Allowed")
+}
+
+func (suite *ServerBehaviourSuite) TestAuthenticationRequired() {
+	req, _ := http.NewRequest(http.MethodGet, suite.server.URL+"/api/v1/categories", nil)
+	suite.assertProblem(suite.do(req, nil), http.StatusUnauthorized, "Missing auth_token cookie")
+
+	req, _ = http.NewRequest(http.MethodGet, suite.server.URL+"/api/v1/categories", nil)
+	req.Header.Set("Authorization", "Bearer hha_invalid")
+	res := suite.do(req, nil)
+	suite.Assert().Equal(`Bearer error="invalid_token"`, res.Header.Get("WWW-Authenticate"))
+	suite.assertProblem(res, http.StatusUnauthorized, "Invalid token")
+}
+
+func (suite *ServerBehaviourSuite) TestCSRFTokenRequired() {
+	body := `{"email":"csrf@example.com","password":"password123"}`
+	req, _ := http.NewRequest(http.MethodPost, suite.server.URL+"/api/v1/auth/login", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	suite.assertProblem(suite.do(req, nil), http.StatusBadRequest, "missing csrf token in request header")
+
+	req, _ = http.NewRequest(http.MethodPost, suite.server.URL+"/api/v1/auth/login", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-CSRF-Token", "forged")
+	req.AddCookie(&http.Cookie{Name: "_csrf", Value: "original"})
+	suite.assertProblem(suite.do(req, nil), http.StatusForbidden, "invalid csrf token")
+}
+
+func (suite *ServerBehaviourSuite) TestCategoryCRUD() {
+	ctx := context.Background()
+	c, _ := suite.signup("crud@example.com")
+
+	created, err := c.CreateCategoryWithResponse(ctx, &presenter.CreateCategoryParams{}, presenter.CreateCategoryJSONRequestBody{
+		Name: "Groceries",
+		Type: presenter.CategoryCreateRequestTypeExpense,
+	})
+	suite.Require().NoError(err)
+	suite.Require().NotNil(created.JSON201)
+	suite.Assert().Equal(`"1"`, created.HTTPResponse.Header.Get("ETag"))
+
+	// 古いバージョンを指定した更新は412になる
+	_, err = c.UpdateCategoryByIdWithResponse(ctx, created.JSON201.Id, &presenter.UpdateCategoryByIdParams{IfMatch: `"2"`}, presenter.UpdateCategoryByIdJSONRequestBody{
+		Name: "Food",
+		Type: presenter.Expense,
+	})
+	var apiError *client.APIError
+	suite.Require().True(errors.As(err, &apiError))
+	suite.Assert().Equal(http.StatusPreconditionFailed, apiError.StatusCode)
+
+	updated, err := c.UpdateCategoryByIdWithResponse(ctx, created.JSON201.Id, &presenter.UpdateCategoryByIdParams{IfMatch: `"1"`}, presenter.UpdateCategoryByIdJSONRequestBody{
+		Name: "Food",
+		Type: presenter.Expense,
+	})
+	suite.Require().NoError(err)
+	suite.Assert().Equal(`"2"`, updated.HTTPResponse.Header.Get("ETag"))
+
+	categories, err := c.GetCategoriesWithResponse(ctx)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(categories.JSON200)
+	suite.Assert().Len(*categories.JSON200, 1)
+	suite.Assert().Equal("Food", (*categories.JSON200)[0].Name)
+
+	_, err = c.DeleteCategoryByIdWithResponse(ctx, created.JSON201.Id, &presenter.DeleteCategoryByIdParams{IfMatch: "*"})
+	suite.Require().NoError(err)
+	_, err = c.GetCategoryByIdWithResponse(ctx, created.JSON201.Id)
+	suite.Assert().ErrorIs(err, client.ErrNotFound)
+}
+
+func (suite *ServerBehaviourSuite) TestInvalidPathParameter() {
+	_, jar := suite.signup("path@example.com")
+
+	req, _ := http.NewRequest(http.MethodGet, suite.server.URL+"/api/v1/categories/abc", nil)
+	res := suite.do(req, jar)
+	suite.Assert().Equal(http.StatusUnprocessableEntity, res.StatusCode)
+	suite.Assert().Equal("application/problem+json", res.Header.Get("Content-Type"))
+}
+
+func (suite *ServerBehaviourSuite) TestValidationError() {
+	c, _ := suite.signup("validation@example.com")
+
+	_, err := c.CreateCategoryWithResponse(context.Background(), &presenter.CreateCategoryParams{}, presenter.CreateCategoryJSONRequestBody{
+		Name: "Invalid",
+		Type: "unknown",
+	})
+	suite.Assert().ErrorIs(err, client.ErrValidation)
+	var apiError *client.APIError
+	suite.Require().True(errors.As(err, &apiError))
+	suite.Assert().NotEmpty(apiError.FieldErrors())
+}
+
+func (suite *ServerBehaviourSuite) TestIdempotentReplay() {
+	ctx := context.Background()
+	c, _ := suite.signup("idempotency@example.com")
+
+	key := presenter.IdempotencyKey("create-groceries")
+	params := &presenter.CreateCategoryParams{IdempotencyKey: &key}
+	body := presenter.CreateCategoryJSONRequestBody{Name: "Groceries", Type: presenter.CategoryCreateRequestTypeExpense}
+
+	first, err := c.CreateCategoryWithResponse(ctx, params, body)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(first.JSON201)
+	suite.Assert().Empty(first.HTTPResponse.Header.Get("Idempotent-Replayed"))
+
+	second, err := c.CreateCategoryWithResponse(ctx, params, body)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(second.JSON201)
+	suite.Assert().Equal("true", second.HTTPResponse.Header.Get("Idempotent-Replayed"))
+	suite.Assert().Equal(first.JSON201.Id, second.JSON201.Id)
+	suite.Assert().Equal(first.HTTPResponse.Header.Get("ETag"), second.HTTPResponse.Header.Get("ETag"))
+
+	body.Name = "Other"
+	_, err = c.CreateCategoryWithResponse(ctx, params, body)
+	suite.Assert().ErrorIs(err, client.ErrValidation)
+}
+
+func (suite *ServerBehaviourSuite) TestPersonalAccessTokenScopes() {
+	ctx := context.Background()
+	c, _ := suite.signup("token@example.com")
+
+	created, err := c.CreatePersonalAccessTokenWithResponse(ctx, presenter.CreatePersonalAccessTokenJSONRequestBody{
+		Name:   "read only",
+		Scopes: []presenter.TokenScope{presenter.ReadCategories},
+	})
+	suite.Require().NoError(err)
+	suite.Require().NotNil(created.JSON201)
+	suite.Require().NotNil(created.JSON201.Token)
+
+	tokenClient, err := client.New(client.Config{ServerURL: suite.server.URL, Token: *created.JSON201.Token, RetryWait: time.Millisecond})
+	suite.Require().NoError(err)
+
+	categories, err := tokenClient.GetCategoriesWithResponse(ctx)
+	suite.Require().NoError(err)
+	suite.Assert().NotNil(categories.JSON200)
+	suite.Assert().NotEmpty(categories.HTTPResponse.Header.Get("RateLimit-Limit"))
+
+	// トークンではCSRFトークンを要求しないが、スコープのない操作は拒否する
+	_, err = tokenClient.CreateCategoryWithResponse(ctx, &presenter.CreateCategoryParams{}, presenter.CreateCategoryJSONRequestBody{Name: "Groceries", Type: presenter.CategoryCreateRequestTypeExpense})
+	suite.Assert().ErrorIs(err, client.ErrForbidden)
+
+	_, err = tokenClient.GetMonthlySummariesWithResponse(ctx)
+	suite.Assert().ErrorIs(err, client.ErrForbidden)
+}
+
+func (suite *ServerBehaviourSuite) TestPasswordResetRevokesSessions() {
+	ctx := context.Background()
+	c, _ := suite.signup("reset@example.com")
+
+	created, err := c.CreatePersonalAccessTokenWithResponse(ctx, presenter.CreatePersonalAccessTokenJSONRequestBody{
+		Name:   "read only",
+		Scopes: []presenter.TokenScope{presenter.ReadCategories},
+	})
+	suite.Require().NoError(err)
+	suite.Require().NotNil(created.JSON201)
+	tokenClient, err := client.New(client.Config{ServerURL: suite.server.URL, Token: *created.JSON201.Token, RetryWait: time.Millisecond})
+	suite.Require().NoError(err)
+
+	// メールで送られるリセット用のトークンを直接作成する
+	var user entity.User
+	suite.Require().NoError(suite.DB.Where("email = ?", "reset@example.com").First(&user).Error)
+	suite.Require().NoError(suite.DB.Create(&entity.UserToken{
+		UserID:    user.ID,
+		Purpose:   entity.UserTokenPurposePasswordReset,
+		TokenHash: usecase.HashUserToken("reset-token"),
+		ExpiresAt: time.Now().Add(time.Hour),
+	}).Error)
+
+	_, err = c.ConfirmPasswordResetWithResponse(ctx, presenter.ConfirmPasswordResetJSONRequestBody{Token: "reset-token", Password: "newpassword123"})
+	suite.Require().NoError(err)
+
+	// リセット前に発行したCookieとパーソナルアクセストークンは使えない
+	_, err = c.GetCategoriesWithResponse(ctx)
+	suite.Assert().ErrorIs(err, client.ErrUnauthorized)
+	_, err = tokenClient.GetCategoriesWithResponse(ctx)
+	suite.Assert().ErrorIs(err, client.ErrUnauthorized)
+}
+
+func (suite *ServerBehaviourSuite) TestDeleteCurrentUserRequestsDeletion() {
+	ctx := context.Background()
+	c, _ := suite.signup("delete@example.com")
+
+	// 以前は即座に削除して204を返していたが、確認メールを送って202を返す
+	res, err := c.DeleteCurrentUserWithResponse(ctx)
+	suite.Require().NoError(err)
+	suite.Assert().Equal(http.StatusAccepted, res.StatusCode())
+	if suite.Assert().NotNil(res.JSON202) {
+		suite.Assert().Equal(entity.AccountDeletionStatusPending, string(res.JSON202.Status))
+	}
+
+	// 削除が確定するまではユーザーを使い続けられる
+	user, err := c.GetCurrentUserWithResponse(ctx)
+	suite.Require().NoError(err)
+	suite.Assert().NotNil(user.JSON200)
+}
+
+func (suite *ServerBehaviourSuite) TestGraphQL() {
+	_, jar := suite.signup("graphql@example.com")
+
+	serverURL, err := url.Parse(suite.server.URL)
+	suite.Require().NoError(err)
+	var csrfToken string
+	for _, cookie := range jar.Cookies(serverURL) {
+		if cookie.Name == "_csrf" {
+			csrfToken = cookie.Value
+		}
+	}
+	suite.Require().NotEmpty(csrfToken)
+
+	req, _ := http.NewRequest(http.MethodPost, suite.server.URL+"/graphql", strings.NewReader(`{"query":"{ me { email } }"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-CSRF-Token", csrfToken)
+	res := suite.do(req, jar)
+	suite.Require().Equal(http.StatusOK, res.StatusCode)
+
+	var body struct {
+		Data struct {
+			Me struct {
+				Email string `json:"email"`
+			} `json:"me"`
+		} `json:"data"`
+	}
+	suite.Require().NoError(json.NewDecoder(res.Body).Decode(&body))
+	suite.Assert().Equal("graphql@example.com", body.Data.Me.Email)
+
+	req, _ = http.NewRequest(http.MethodPost, suite.server.URL+"/graphql", strings.NewReader(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-CSRF-Token", csrfToken)
+	suite.assertProblem(suite.do(req, jar), http.StatusBadRequest, "Request body must be a JSON object with a query")
+}
@@ -0,0 +1,137 @@
+package gateway
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"household-account-backend/entity"
+)
+
+// userDataTables はユーザーのデータを持つテーブルと、そのユーザーの行を絞り込む条件
+// DBの外部キー制約に頼らずに削除するため、テーブルを追加した場合はここにも追加する
+// 外部キーの参照元から順に削除する
+var userDataTables = []struct {
+	Table string
+	Where string
+}{
+	{"webhook_deliveries", "webhook_id IN (SELECT id FROM webhooks WHERE user_id = @user_id)"},
+	{"webhooks", "user_id = @user_id"},
+	{"outbox_events", "user_id = @user_id"},
+	{"transactions", "user_id = @user_id"},
+	{"monthly_summaries", "user_id = @user_id"},
+	{"categories", "user_id = @user_id"},
+	{"idempotency_records", "user_id = @user_id"},
+	{"user_tokens", "user_id = @user_id"},
+	{"recovery_codes", "user_id = @user_id"},
+	{"totp_credentials", "user_id = @user_id"},
+	{"user_identities", "user_id = @user_id"},
+	{"oidc_login_states", "link_user_id = @user_id"},
+	{"login_attempts", "attempt_key = @account_key"},
+	{"personal_access_tokens", "user_id = @user_id"},
+	{"data_exports", "user_id = @user_id"},
+	{"users", "id = @user_id"},
+}
+
+type AccountDeletionRepository interface {
+	CreateDeletion(deletion *entity.AccountDeletion) (*entity.AccountDeletion, error)
+	// GetActiveDeletion は確認待ち・削除予定の依頼を返す。存在しない場合はnilを返す
+	GetActiveDeletion(userID int) (*entity.AccountDeletion, error)
+	// GetLatestDeletion はユーザーの最新の削除依頼を返す。存在しない場合はnilを返す
+	GetLatestDeletion(userID int) (*entity.AccountDeletion, error)
+	UpdateDeletion(deletion *entity.AccountDeletion) error
+	// GetDueDeletions は猶予期間が終了した削除予定の依頼を返す
+	GetDueDeletions(now time.Time, limit int) ([]entity.AccountDeletion, error)
+	// EraseUserData はユーザーの行を全てのテーブルから削除し、テーブルごとの削除件数を返す
+	EraseUserData(userID int, email string) (map[string]int64, error)
+	// CountUserData はユーザーの行をテーブルごとに数える。削除後の検証に使う
+	CountUserData(userID int, email string) (map[string]int64, error)
+}
+
+type accountDeletionRepository struct {
+	db *gorm.DB
+}
+
+func NewAccountDeletionRepository(db *gorm.DB) AccountDeletionRepository {
+	return &accountDeletionRepository{db}
+}
+
+func (dr *accountDeletionRepository) CreateDeletion(deletion *entity.AccountDeletion) (*entity.AccountDeletion, error) {
+	if err := dr.db.Create(deletion).Error; err != nil {
+		return nil, err
+	}
+	return deletion, nil
+}
+
+func (dr *accountDeletionRepository) GetActiveDeletion(userID int) (*entity.AccountDeletion, error) {
+	var deletions []entity.AccountDeletion
+	if err := dr.db.Where("user_id = ? AND status IN ?", userID, []string{entity.AccountDeletionStatusPending, entity.AccountDeletionStatusScheduled}).
+		Order("id DESC").Limit(1).Find(&deletions).Error; err != nil {
+		return nil, err
+	}
+	if len(deletions) == 0 {
+		return nil, nil
+	}
+	return &deletions[0], nil
+}
+
+func (dr *accountDeletionRepository) GetLatestDeletion(userID int) (*entity.AccountDeletion, error) {
+	var deletions []entity.AccountDeletion
+	if err := dr.db.Where("user_id = ?", userID).Order("id DESC").Limit(1).Find(&deletions).Error; err != nil {
+		return nil, err
+	}
+	if len(deletions) == 0 {
+		return nil, nil
+	}
+	return &deletions[0], nil
+}
+
+func (dr *accountDeletionRepository) UpdateDeletion(deletion *entity.AccountDeletion) error {
+	return dr.db.Save(deletion).Error
+}
+
+func (dr *accountDeletionRepository) GetDueDeletions(now time.Time, limit int) ([]entity.AccountDeletion, error) {
+	var deletions []entity.AccountDeletion
+	if err := dr.db.Where("status = ? AND scheduled_at <= ?", entity.AccountDeletionStatusScheduled, now).
+		Order("id").Limit(limit).Find(&deletions).Error; err != nil {
+		return nil, err
+	}
+	return deletions, nil
+}
+
+func (dr *accountDeletionRepository) EraseUserData(userID int, email string) (map[string]int64, error) {
+	deleted := map[string]int64{}
+	err := dr.db.Transaction(func(tx *gorm.DB) error {
+		for _, t := range userDataTables {
+			result := tx.Exec("DELETE FROM "+t.Table+" WHERE "+t.Where, userDataArgs(userID, email))
+			if result.Error != nil {
+				return result.Error
+			}
+			deleted[t.Table] = result.RowsAffected
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deleted, nil
+}
+
+func (dr *accountDeletionRepository) CountUserData(userID int, email string) (map[string]int64, error) {
+	counts := map[string]int64{}
+	for _, t := range userDataTables {
+		var count int64
+		if err := dr.db.Raw("SELECT COUNT(*) FROM "+t.Table+" WHERE "+t.Where, userDataArgs(userID, email)).Scan(&count).Error; err != nil {
+			return nil, err
+		}
+		counts[t.Table] = count
+	}
+	return counts, nil
+}
+
+func userDataArgs(userID int, email string) map[string]interface{} {
+	return map[string]interface{}{
+		"user_id":     userID,
+		"account_key": entity.LoginAttemptAccountKey(email),
+	}
+}
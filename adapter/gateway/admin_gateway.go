@@ -0,0 +1,156 @@
+package gateway
+
+import (
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"household-account-backend/entity"
+)
+
+// ユーザー検索で指定できる状態
+const (
+	UserStatusActive   = "active"
+	UserStatusDisabled = "disabled"
+)
+
+// UserSearchQuery は管理者がユーザーを検索する条件。空の項目では絞り込まない
+type UserSearchQuery struct {
+	// Query はメールアドレスまたは名前に部分一致する文字列
+	Query  string
+	Role   string
+	Status string
+	Limit  int
+	Offset int
+}
+
+// likeEscape はLIKEのパターンでワイルドカードを文字として扱うためのエスケープ文字
+const likeEscape = "\\"
+
+type AdminRepository interface {
+	// SearchUsers は条件に一致するユーザーと、ページングする前の総件数を返す
+	SearchUsers(query UserSearchQuery) ([]entity.User, int64, error)
+	// SetUserDisabled はユーザーを無効・有効にする。無効にする場合は発行済みの認証トークンも無効にする
+	SetUserDisabled(userID int, disabledAt *time.Time) error
+	// RevokeSessions はrevokedAt以前に発行した認証トークンを無効にし、パーソナルアクセストークンを削除する
+	RevokeSessions(userID int, revokedAt time.Time) error
+	UpdateRole(userID int, role string) error
+	// GetSystemStats は利用状況を集計する。sinceは直近の件数を数える期間の開始日時
+	GetSystemStats(since time.Time) (*entity.SystemStats, error)
+	CreateAuditLog(log *entity.AdminAuditLog) error
+	// GetAuditLogs は新しい順に監査ログを返す。targetUserIDが0の場合は全てのユーザーを対象にする
+	GetAuditLogs(targetUserID int, limit int) ([]entity.AdminAuditLog, error)
+}
+
+type adminRepository struct {
+	db *gorm.DB
+}
+
+func NewAdminRepository(db *gorm.DB) AdminRepository {
+	return &adminRepository{db}
+}
+
+func (ar *adminRepository) SearchUsers(query UserSearchQuery) ([]entity.User, int64, error) {
+	db := ar.db.Model(&entity.User{})
+	if query.Query != "" {
+		// PostgreSQLのLIKEは大文字と小文字を区別するため、MySQLと同じく区別せずに検索する
+		// 入力に含まれる%と_はワイルドカードではなく文字として検索する
+		// MySQLは文字列リテラル中のバックスラッシュをエスケープとして扱うため、エスケープ文字はパラメータで渡す
+		pattern := "%" + escapeLike(strings.ToLower(query.Query)) + "%"
+		db = db.Where("LOWER(email) LIKE ? ESCAPE ? OR LOWER(name) LIKE ? ESCAPE ?", pattern, likeEscape, pattern, likeEscape)
+	}
+	if query.Role != "" {
+		db = db.Where("role = ?", query.Role)
+	}
+	switch query.Status {
+	case UserStatusActive:
+		db = db.Where("disabled_at IS NULL")
+	case UserStatusDisabled:
+		db = db.Where("disabled_at IS NOT NULL")
+	}
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var users []entity.User
+	if err := db.Order("id").Limit(query.Limit).Offset(query.Offset).Find(&users).Error; err != nil {
+		return nil, 0, err
+	}
+	return users, total, nil
+}
+
+func (ar *adminRepository) SetUserDisabled(userID int, disabledAt *time.Time) error {
+	updates := map[string]interface{}{"disabled_at": disabledAt}
+	if disabledAt != nil {
+		updates["sessions_revoked_at"] = *disabledAt
+	}
+	return ar.db.Model(&entity.User{}).Where("id = ?", userID).Updates(updates).Error
+}
+
+func (ar *adminRepository) RevokeSessions(userID int, revokedAt time.Time) error {
+	return ar.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&entity.User{}).Where("id = ?", userID).Update("sessions_revoked_at", revokedAt).Error; err != nil {
+			return err
+		}
+		return tx.Where("user_id = ?", userID).Delete(&entity.PersonalAccessToken{}).Error
+	})
+}
+
+func (ar *adminRepository) UpdateRole(userID int, role string) error {
+	return ar.db.Model(&entity.User{}).Where("id = ?", userID).Update("role", role).Error
+}
+
+func (ar *adminRepository) GetSystemStats(since time.Time) (*entity.SystemStats, error) {
+	stats := &entity.SystemStats{Since: since}
+	users := func() *gorm.DB { return ar.db.Model(&entity.User{}) }
+	if err := users().Count(&stats.UserCount).Error; err != nil {
+		return nil, err
+	}
+	if err := users().Where("disabled_at IS NOT NULL").Count(&stats.DisabledUserCount).Error; err != nil {
+		return nil, err
+	}
+	if err := users().Where("role = ?", entity.UserRoleAdmin).Count(&stats.AdminCount).Error; err != nil {
+		return nil, err
+	}
+	if err := users().Where("created_at >= ?", since).Count(&stats.NewUserCount).Error; err != nil {
+		return nil, err
+	}
+
+	var volume struct {
+		Count  int64
+		Amount float64
+	}
+	if err := ar.db.Model(&entity.Transaction{}).Select("COUNT(*) AS count, COALESCE(SUM(amount), 0) AS amount").Scan(&volume).Error; err != nil {
+		return nil, err
+	}
+	stats.TransactionCount, stats.TransactionAmount = volume.Count, volume.Amount
+	if err := ar.db.Model(&entity.Transaction{}).Select("COUNT(*) AS count, COALESCE(SUM(amount), 0) AS amount").
+		Where("date >= ?", since).Scan(&volume).Error; err != nil {
+		return nil, err
+	}
+	stats.RecentTransactionCount, stats.RecentTransactionAmount = volume.Count, volume.Amount
+	return stats, nil
+}
+
+func (ar *adminRepository) CreateAuditLog(log *entity.AdminAuditLog) error {
+	return ar.db.Create(log).Error
+}
+
+func (ar *adminRepository) GetAuditLogs(targetUserID int, limit int) ([]entity.AdminAuditLog, error) {
+	db := ar.db.Order("id DESC").Limit(limit)
+	if targetUserID != 0 {
+		db = db.Where("target_user_id = ?", targetUserID)
+	}
+	var logs []entity.AdminAuditLog
+	if err := db.Find(&logs).Error; err != nil {
+		return nil, err
+	}
+	return logs, nil
+}
+
+// escapeLike はLIKEのパターンで特別な意味を持つ文字をエスケープする
+func escapeLike(value string) string {
+	return strings.NewReplacer(likeEscape, likeEscape+likeEscape, "%", likeEscape+"%", "_", likeEscape+"_").Replace(value)
+}
@@ -11,8 +11,12 @@ type CategoryRepository interface {
 	CreateCategory(category *entity.Category) (*entity.Category, error)
 	GetCategoryByID(userID int, categoryID int) (*entity.Category, error)
 	GetCategoriesByUserID(userID int) ([]entity.Category, error)
+	// GetCategoriesByIDs はユーザーのカテゴリーのうちIDが一致するものを返す。存在しないIDは結果に含めない
+	GetCategoriesByIDs(userID int, categoryIDs []int) ([]entity.Category, error)
 	UpdateCategory(category *entity.Category) (*entity.Category, error)
-	DeleteCategory(userID int, categoryID int) error
+	DeleteCategory(userID int, categoryID int, version int) error
+	// RunInTransaction はfnに渡したリポジトリとアウトボックスの操作を1つのDBトランザクションで実行する
+	RunInTransaction(fn func(repository CategoryRepository, outbox OutboxRepository) error) error
 }
 
 type categoryRepository struct {
@@ -24,6 +28,7 @@ func NewCategoryRepository(db *gorm.DB) CategoryRepository {
 }
 
 func (cr *categoryRepository) CreateCategory(category *entity.Category) (*entity.Category, error) {
+	category.Version = 1
 	if err := cr.db.Create(category).Error; err != nil {
 		return nil, err
 	}
@@ -40,7 +45,18 @@ func (cr *categoryRepository) GetCategoryByID(userID int, categoryID int) (*enti
 
 func (cr *categoryRepository) GetCategoriesByUserID(userID int) ([]entity.Category, error) {
 	var categories []entity.Category
-	if err := cr.db.Where("user_id = ?", userID).Find(&categories).Error; err != nil {
+	if err := cr.db.Where("user_id = ?", userID).Order("id").Find(&categories).Error; err != nil {
+		return nil, err
+	}
+	return categories, nil
+}
+
+func (cr *categoryRepository) GetCategoriesByIDs(userID int, categoryIDs []int) ([]entity.Category, error) {
+	var categories []entity.Category
+	if len(categoryIDs) == 0 {
+		return categories, nil
+	}
+	if err := cr.db.Where("user_id = ? AND id IN ?", userID, categoryIDs).Order("id").Find(&categories).Error; err != nil {
 		return nil, err
 	}
 	return categories, nil
@@ -53,22 +69,54 @@ func (cr *categoryRepository) UpdateCategory(category *entity.Category) (*entity
 		return nil, err
 	}
 
+	// バージョンが指定されていない場合は取得時点のバージョンを条件にする
+	expectedVersion := category.Version
+	if expectedVersion == 0 {
+		expectedVersion = selectedCategory.Version
+	}
+
 	// フィールドをコピー（空の値を無視）
 	if err := copier.CopyWithOption(selectedCategory, category, copier.Option{IgnoreEmpty: true, DeepCopy: true}); err != nil {
 		return nil, err
 	}
+	selectedCategory.Version = expectedVersion + 1
 
-	// 更新
-	if err := cr.db.Save(selectedCategory).Error; err != nil {
-		return nil, err
+	// バージョンが一致する場合のみ更新
+	result := cr.db.Model(&entity.Category{}).
+		Where("id = ? AND user_id = ? AND version = ?", selectedCategory.ID, selectedCategory.UserID, expectedVersion).
+		Select("*").Updates(selectedCategory)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, ErrVersionConflict
 	}
 
 	return selectedCategory, nil
 }
 
-func (cr *categoryRepository) DeleteCategory(userID int, categoryID int) error {
-	if err := cr.db.Where("id = ? AND user_id = ?", categoryID, userID).Delete(&entity.Category{}).Error; err != nil {
-		return err
+func (cr *categoryRepository) DeleteCategory(userID int, categoryID int, version int) error {
+	query := cr.db.Where("id = ? AND user_id = ?", categoryID, userID)
+	if version != 0 {
+		query = query.Where("version = ?", version)
+	}
+	result := query.Delete(&entity.Category{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		// 存在しない場合は "*" の指定でもGetと同じエラーを返す
+		if _, err := cr.GetCategoryByID(userID, categoryID); err != nil {
+			return err
+		}
+		return ErrVersionConflict
 	}
 	return nil
 }
+
+// RunInTransaction はデータの変更とイベントの保存を同じDBトランザクションで行い、どちらかが失敗した場合は両方をロールバックする
+func (cr *categoryRepository) RunInTransaction(fn func(repository CategoryRepository, outbox OutboxRepository) error) error {
+	return cr.db.Transaction(func(tx *gorm.DB) error {
+		return fn(&categoryRepository{tx}, &outboxRepository{tx})
+	})
+}
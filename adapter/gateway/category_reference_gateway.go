@@ -0,0 +1,36 @@
+package gateway
+
+import (
+	"gorm.io/gorm"
+
+	"household-account-backend/entity"
+)
+
+type CategoryReferenceRepository interface {
+	// GetCategoryReferences は取引のIDがafterIDより大きい取引と参照先のカテゴリーを、ID順にlimit件まで返す
+	GetCategoryReferences(afterID int, limit int) ([]entity.CategoryReference, error)
+}
+
+type categoryReferenceRepository struct {
+	db *gorm.DB
+}
+
+func NewCategoryReferenceRepository(db *gorm.DB) CategoryReferenceRepository {
+	return &categoryReferenceRepository{db}
+}
+
+func (cr *categoryReferenceRepository) GetCategoryReferences(afterID int, limit int) ([]entity.CategoryReference, error) {
+	var references []entity.CategoryReference
+	err := cr.db.Table("transactions").
+		Select("transactions.id AS transaction_id, transactions.user_id AS transaction_user_id, transactions.category_id, "+
+			"categories.user_id AS category_user_id, COALESCE(categories.type, '') AS category_type, transactions.amount").
+		Joins("LEFT JOIN categories ON categories.id = transactions.category_id").
+		Where("transactions.id > ?", afterID).
+		Order("transactions.id").
+		Limit(limit).
+		Scan(&references).Error
+	if err != nil {
+		return nil, err
+	}
+	return references, nil
+}
@@ -0,0 +1,109 @@
+package gateway
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"household-account-backend/entity"
+)
+
+type DataExportRepository interface {
+	CreateExport(export *entity.DataExport) (*entity.DataExport, error)
+	// GetExportByID はエクスポートが存在しない場合はnilを返す
+	GetExportByID(userID int, exportID int) (*entity.DataExport, error)
+	GetExportsByUserID(userID int) ([]entity.DataExport, error)
+	// HasActiveExport は作成待ち・作成中のエクスポートがあるかを返す
+	HasActiveExport(userID int) (bool, error)
+	// ClaimExport は作成待ちのエクスポートを作成中にして返す
+	// staleBefore より前に作成を開始したまま終わっていないものは、ワーカーが停止したとみなして再度作成する
+	// 対象がない場合はnilを返す
+	ClaimExport(now time.Time, staleBefore time.Time) (*entity.DataExport, error)
+	UpdateExport(export *entity.DataExport) error
+	// GetExpiredExports はダウンロード期限を過ぎたエクスポートを返す
+	GetExpiredExports(now time.Time, limit int) ([]entity.DataExport, error)
+}
+
+type dataExportRepository struct {
+	db *gorm.DB
+}
+
+func NewDataExportRepository(db *gorm.DB) DataExportRepository {
+	return &dataExportRepository{db}
+}
+
+func (er *dataExportRepository) CreateExport(export *entity.DataExport) (*entity.DataExport, error) {
+	if err := er.db.Create(export).Error; err != nil {
+		return nil, err
+	}
+	return export, nil
+}
+
+func (er *dataExportRepository) GetExportByID(userID int, exportID int) (*entity.DataExport, error) {
+	var exports []entity.DataExport
+	if err := er.db.Where("id = ? AND user_id = ?", exportID, userID).Limit(1).Find(&exports).Error; err != nil {
+		return nil, err
+	}
+	if len(exports) == 0 {
+		return nil, nil
+	}
+	return &exports[0], nil
+}
+
+func (er *dataExportRepository) GetExportsByUserID(userID int) ([]entity.DataExport, error) {
+	var exports []entity.DataExport
+	if err := er.db.Where("user_id = ?", userID).Order("id DESC").Find(&exports).Error; err != nil {
+		return nil, err
+	}
+	return exports, nil
+}
+
+func (er *dataExportRepository) HasActiveExport(userID int) (bool, error) {
+	var count int64
+	err := er.db.Model(&entity.DataExport{}).
+		Where("user_id = ? AND status IN ?", userID, []string{entity.DataExportStatusPending, entity.DataExportStatusProcessing}).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (er *dataExportRepository) ClaimExport(now time.Time, staleBefore time.Time) (*entity.DataExport, error) {
+	var exports []entity.DataExport
+	if err := er.db.
+		Where("status = ? OR (status = ? AND started_at < ?)", entity.DataExportStatusPending, entity.DataExportStatusProcessing, staleBefore).
+		Order("id").Limit(1).Find(&exports).Error; err != nil {
+		return nil, err
+	}
+	if len(exports) == 0 {
+		return nil, nil
+	}
+	export := exports[0]
+
+	// 他のワーカーが同時に取得した場合は状態が変わっているため更新されない
+	query := er.db.Model(&entity.DataExport{}).Where("id = ? AND status = ?", export.ID, export.Status)
+	if export.StartedAt != nil {
+		query = query.Where("started_at = ?", *export.StartedAt)
+	}
+	result := query.Updates(map[string]interface{}{"status": entity.DataExportStatusProcessing, "started_at": now})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+	export.Status = entity.DataExportStatusProcessing
+	export.StartedAt = &now
+	return &export, nil
+}
+
+func (er *dataExportRepository) UpdateExport(export *entity.DataExport) error {
+	return er.db.Save(export).Error
+}
+
+func (er *dataExportRepository) GetExpiredExports(now time.Time, limit int) ([]entity.DataExport, error) {
+	var exports []entity.DataExport
+	if err := er.db.Where("status = ? AND expires_at < ?", entity.DataExportStatusCompleted, now).
+		Order("id").Limit(limit).Find(&exports).Error; err != nil {
+		return nil, err
+	}
+	return exports, nil
+}
@@ -0,0 +1,21 @@
+package gateway
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+// ErrVersionConflict は条件付き更新・削除でバージョンが一致しなかった場合に返される
+var ErrVersionConflict = errors.New("version conflict")
+
+// ErrRecordNotFound は取得対象のレコードが存在しない場合に返される
+// ユースケース層がgormに依存せずに判定できるようにする
+var ErrRecordNotFound = gorm.ErrRecordNotFound
+
+// ErrDuplicateKey は一意制約に違反した場合に返される
+// DB接続でTranslateErrorを有効にし、MySQL・SQLiteのドライバ固有のエラーをこのエラーに変換する
+var ErrDuplicateKey = gorm.ErrDuplicatedKey
+
+// ErrFileNotFound はファイルストレージに指定したキーのファイルが存在しない場合に返される
+var ErrFileNotFound = errors.New("file not found")
@@ -0,0 +1,79 @@
+package gateway
+
+import (
+	"sync"
+
+	"household-account-backend/entity"
+)
+
+// EventHub はユーザーごとのデータ変更イベントを購読者へ配信するpub/sub
+// 複数インスタンス構成ではRedisなどを使った実装に差し替える
+type EventHub interface {
+	Publish(event *entity.OutboxEvent)
+	// Subscribe はイベントを受け取るチャネルと購読解除関数を返す
+	// 受信が追いつかない場合はチャネルが閉じられるため、再接続してLast-Event-IDから再開する
+	Subscribe(userID int) (<-chan entity.OutboxEvent, func())
+}
+
+type inMemoryEventHub struct {
+	mu          sync.RWMutex
+	subscribers map[int]map[*eventSubscriber]struct{}
+	bufferSize  int
+}
+
+type eventSubscriber struct {
+	events chan entity.OutboxEvent
+	once   sync.Once
+}
+
+func (s *eventSubscriber) close() {
+	s.once.Do(func() { close(s.events) })
+}
+
+// NewInMemoryEventHub は単一プロセス内でイベントを配信するEventHubを返す
+func NewInMemoryEventHub(bufferSize int) EventHub {
+	return &inMemoryEventHub{
+		subscribers: map[int]map[*eventSubscriber]struct{}{},
+		bufferSize:  bufferSize,
+	}
+}
+
+func (h *inMemoryEventHub) Publish(event *entity.OutboxEvent) {
+	h.mu.RLock()
+	var slow []*eventSubscriber
+	for subscriber := range h.subscribers[event.UserID] {
+		select {
+		case subscriber.events <- *event:
+		default:
+			slow = append(slow, subscriber)
+		}
+	}
+	h.mu.RUnlock()
+
+	for _, subscriber := range slow {
+		h.unsubscribe(event.UserID, subscriber)
+	}
+}
+
+func (h *inMemoryEventHub) Subscribe(userID int) (<-chan entity.OutboxEvent, func()) {
+	subscriber := &eventSubscriber{events: make(chan entity.OutboxEvent, h.bufferSize)}
+
+	h.mu.Lock()
+	if h.subscribers[userID] == nil {
+		h.subscribers[userID] = map[*eventSubscriber]struct{}{}
+	}
+	h.subscribers[userID][subscriber] = struct{}{}
+	h.mu.Unlock()
+
+	return subscriber.events, func() { h.unsubscribe(userID, subscriber) }
+}
+
+func (h *inMemoryEventHub) unsubscribe(userID int, subscriber *eventSubscriber) {
+	h.mu.Lock()
+	delete(h.subscribers[userID], subscriber)
+	if len(h.subscribers[userID]) == 0 {
+		delete(h.subscribers, userID)
+	}
+	h.mu.Unlock()
+	subscriber.close()
+}
@@ -0,0 +1,139 @@
+package gateway
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// FileStorage はエクスポートのアーカイブなどユーザーのファイルを保存する
+// キーは "/" 区切りで、ユーザーのファイルは UserFilePrefix 以下に保存する
+type FileStorage interface {
+	Put(key string, r io.Reader) (int64, error)
+	Open(key string) (io.ReadCloser, error)
+	Delete(key string) error
+	// List はprefix以下のキーを返す
+	List(prefix string) ([]string, error)
+	// DeletePrefix はprefix以下のファイルを全て削除し、削除した件数を返す
+	DeletePrefix(prefix string) (int, error)
+}
+
+// UserFilePrefix はユーザーのファイルを保存するキーの接頭辞を返す
+// アカウント削除ではこの接頭辞以下を全て削除する
+func UserFilePrefix(userID int) string {
+	return fmt.Sprintf("users/%d/", userID)
+}
+
+type localFileStorage struct {
+	dir string
+}
+
+// NewLocalFileStorage はローカルのディレクトリにファイルを保存するFileStorageを返す
+func NewLocalFileStorage(dir string) FileStorage {
+	return &localFileStorage{dir: dir}
+}
+
+func (s *localFileStorage) path(key string) (string, error) {
+	cleaned := filepath.Clean(filepath.FromSlash(key))
+	if key == "" || filepath.IsAbs(cleaned) || cleaned == ".." || strings.HasPrefix(cleaned, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid file key: %q", key)
+	}
+	return filepath.Join(s.dir, cleaned), nil
+}
+
+func (s *localFileStorage) Put(key string, r io.Reader) (int64, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return 0, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return 0, err
+	}
+
+	// 書き込み途中のファイルを読まれないよう、一時ファイルに書いてから置き換える
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return 0, err
+	}
+	defer os.Remove(tmp.Name())
+	size, err := io.Copy(tmp, r)
+	if err != nil {
+		tmp.Close()
+		return 0, err
+	}
+	if err := tmp.Close(); err != nil {
+		return 0, err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return 0, err
+	}
+	return size, nil
+}
+
+func (s *localFileStorage) Open(key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrFileNotFound
+	}
+	return file, err
+}
+
+func (s *localFileStorage) Delete(key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (s *localFileStorage) List(prefix string) ([]string, error) {
+	root, err := s.path(prefix)
+	if err != nil {
+		return nil, err
+	}
+	keys := []string{}
+	err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() || strings.HasPrefix(d.Name(), ".tmp-") {
+			return nil
+		}
+		rel, err := filepath.Rel(s.dir, path)
+		if err != nil {
+			return err
+		}
+		keys = append(keys, filepath.ToSlash(rel))
+		return nil
+	})
+	return keys, err
+}
+
+func (s *localFileStorage) DeletePrefix(prefix string) (int, error) {
+	keys, err := s.List(prefix)
+	if err != nil {
+		return 0, err
+	}
+	root, err := s.path(prefix)
+	if err != nil {
+		return 0, err
+	}
+	if err := os.RemoveAll(root); err != nil {
+		return 0, err
+	}
+	return len(keys), nil
+}
@@ -0,0 +1,51 @@
+package gateway
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"household-account-backend/entity"
+)
+
+type HealthRepository interface {
+	// Ping はデータベースに接続できるかを確認する
+	Ping(ctx context.Context) error
+	// PoolStats は接続プールの統計を返す
+	PoolStats() (*entity.DatabasePoolStats, error)
+}
+
+type healthRepository struct {
+	db *gorm.DB
+}
+
+func NewHealthRepository(db *gorm.DB) HealthRepository {
+	return &healthRepository{db}
+}
+
+func (hr *healthRepository) Ping(ctx context.Context) error {
+	sqlDB, err := hr.db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.PingContext(ctx)
+}
+
+func (hr *healthRepository) PoolStats() (*entity.DatabasePoolStats, error) {
+	sqlDB, err := hr.db.DB()
+	if err != nil {
+		return nil, err
+	}
+	stats := sqlDB.Stats()
+	return &entity.DatabasePoolStats{
+		MaxOpenConnections: stats.MaxOpenConnections,
+		OpenConnections:    stats.OpenConnections,
+		InUse:              stats.InUse,
+		Idle:               stats.Idle,
+		WaitCount:          stats.WaitCount,
+		WaitDurationMs:     stats.WaitDuration.Milliseconds(),
+		MaxIdleClosed:      stats.MaxIdleClosed,
+		MaxIdleTimeClosed:  stats.MaxIdleTimeClosed,
+		MaxLifetimeClosed:  stats.MaxLifetimeClosed,
+	}, nil
+}
@@ -0,0 +1,62 @@
+package gateway
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"household-account-backend/entity"
+)
+
+type IdempotencyRepository interface {
+	CreateRecord(record *entity.IdempotencyRecord) (*entity.IdempotencyRecord, error)
+	// GetRecord はキーが存在しない場合はnilを返す
+	GetRecord(userID int, idempotencyKey string) (*entity.IdempotencyRecord, error)
+	SaveResponse(record *entity.IdempotencyRecord) error
+	DeleteRecord(recordID int) error
+	DeleteExpiredRecords(now time.Time) (int64, error)
+}
+
+type idempotencyRepository struct {
+	db *gorm.DB
+}
+
+func NewIdempotencyRepository(db *gorm.DB) IdempotencyRepository {
+	return &idempotencyRepository{db}
+}
+
+func (ir *idempotencyRepository) CreateRecord(record *entity.IdempotencyRecord) (*entity.IdempotencyRecord, error) {
+	if err := ir.db.Create(record).Error; err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+func (ir *idempotencyRepository) GetRecord(userID int, idempotencyKey string) (*entity.IdempotencyRecord, error) {
+	var records []entity.IdempotencyRecord
+	if err := ir.db.Where("user_id = ? AND idempotency_key = ?", userID, idempotencyKey).Limit(1).Find(&records).Error; err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+	return &records[0], nil
+}
+
+func (ir *idempotencyRepository) SaveResponse(record *entity.IdempotencyRecord) error {
+	return ir.db.Model(&entity.IdempotencyRecord{}).Where("id = ?", record.ID).
+		Updates(map[string]interface{}{
+			"status_code":      record.StatusCode,
+			"response_headers": record.ResponseHeaders,
+			"response_body":    record.ResponseBody,
+		}).Error
+}
+
+func (ir *idempotencyRepository) DeleteRecord(recordID int) error {
+	return ir.db.Where("id = ?", recordID).Delete(&entity.IdempotencyRecord{}).Error
+}
+
+func (ir *idempotencyRepository) DeleteExpiredRecords(now time.Time) (int64, error) {
+	result := ir.db.Where("expires_at <= ?", now).Delete(&entity.IdempotencyRecord{})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,93 @@
+package gateway
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"household-account-backend/entity"
+)
+
+type loginAttemptRepository struct {
+	db *gorm.DB
+}
+
+// NewLoginAttemptRepository はDBに記録するLoginAttemptStoreを返す
+func NewLoginAttemptRepository(db *gorm.DB) LoginAttemptStore {
+	return &loginAttemptRepository{db}
+}
+
+func (lr *loginAttemptRepository) GetAttempt(key string) (*entity.LoginAttempt, error) {
+	var attempts []entity.LoginAttempt
+	if err := lr.db.Where("attempt_key = ?", key).Limit(1).Find(&attempts).Error; err != nil {
+		return nil, err
+	}
+	if len(attempts) == 0 {
+		return nil, nil
+	}
+	return &attempts[0], nil
+}
+
+func (lr *loginAttemptRepository) RecordFailure(key string, failedAt time.Time, resetBefore time.Time) (*entity.LoginAttempt, error) {
+	var attempt entity.LoginAttempt
+	err := lr.db.Transaction(func(tx *gorm.DB) error {
+		updated, err := incrementLoginFailures(tx, key, failedAt, resetBefore)
+		if err != nil {
+			return err
+		}
+		if !updated {
+			// PostgreSQLはエラーになった文以降のトランザクションを中断するため、セーブポイントで作成する
+			createErr := tx.Transaction(func(savepoint *gorm.DB) error {
+				return savepoint.Create(&entity.LoginAttempt{AttemptKey: key, Failures: 1, LastFailedAt: failedAt}).Error
+			})
+			if createErr != nil {
+				// 同時に最初の失敗を記録した場合は一意制約で失敗するため、更新をやり直す
+				updated, err := incrementLoginFailures(tx, key, failedAt, resetBefore)
+				if err != nil {
+					return err
+				}
+				if !updated {
+					return createErr
+				}
+			}
+		}
+		return tx.Where("attempt_key = ?", key).First(&attempt).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &attempt, nil
+}
+
+// incrementLoginFailures は記録があれば失敗回数を1増やし、更新できたかを返す
+func incrementLoginFailures(tx *gorm.DB, key string, failedAt time.Time, resetBefore time.Time) (bool, error) {
+	// mapのキーはカラム名順にSETされるため、failuresは更新前のlast_failed_atで判定される
+	result := tx.Model(&entity.LoginAttempt{}).
+		Where("attempt_key = ?", key).
+		Updates(map[string]interface{}{
+			"failures":       gorm.Expr("CASE WHEN last_failed_at < ? THEN 1 ELSE failures + 1 END", resetBefore),
+			"last_failed_at": failedAt,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (lr *loginAttemptRepository) Lock(key string, until time.Time) error {
+	result := lr.db.Model(&entity.LoginAttempt{}).
+		Where("attempt_key = ?", key).
+		Update("locked_until", until)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		// last_failed_at はTIMESTAMP型でゼロ値を保存できないため現在時刻にする
+		return lr.db.Create(&entity.LoginAttempt{AttemptKey: key, LastFailedAt: time.Now(), LockedUntil: &until}).Error
+	}
+	return nil
+}
+
+func (lr *loginAttemptRepository) Reset(key string) error {
+	return lr.db.Where("attempt_key = ?", key).Delete(&entity.LoginAttempt{}).Error
+}
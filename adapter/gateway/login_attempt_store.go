@@ -0,0 +1,102 @@
+package gateway
+
+import (
+	"sync"
+	"time"
+
+	"household-account-backend/entity"
+)
+
+// LoginAttemptStore はログイン失敗の記録を保持する
+// 単一プロセスではインメモリ、複数インスタンス構成ではDBの実装を使う
+type LoginAttemptStore interface {
+	// GetAttempt は記録がない場合はnilを返す
+	GetAttempt(key string) (*entity.LoginAttempt, error)
+	// RecordFailure は失敗回数を1増やした記録を返す
+	// 最後の失敗がresetBeforeより前の場合は失敗回数を1からやり直す
+	RecordFailure(key string, failedAt time.Time, resetBefore time.Time) (*entity.LoginAttempt, error)
+	Lock(key string, until time.Time) error
+	// Reset は失敗回数とロックを解除する
+	Reset(key string) error
+}
+
+// inMemorySweepInterval はこの回数の失敗ごとに不要になった記録を削除する
+const inMemorySweepInterval = 1000
+
+type inMemoryLoginAttemptStore struct {
+	mu       sync.Mutex
+	attempts map[string]*entity.LoginAttempt
+	writes   int
+}
+
+// NewInMemoryLoginAttemptStore はプロセス内のメモリに記録するLoginAttemptStoreを返す
+func NewInMemoryLoginAttemptStore() LoginAttemptStore {
+	return &inMemoryLoginAttemptStore{attempts: map[string]*entity.LoginAttempt{}}
+}
+
+func (s *inMemoryLoginAttemptStore) GetAttempt(key string) (*entity.LoginAttempt, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	attempt, ok := s.attempts[key]
+	if !ok {
+		return nil, nil
+	}
+	copied := *attempt
+	return &copied, nil
+}
+
+func (s *inMemoryLoginAttemptStore) RecordFailure(key string, failedAt time.Time, resetBefore time.Time) (*entity.LoginAttempt, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.writes++
+	if s.writes%inMemorySweepInterval == 0 {
+		s.sweep(failedAt, resetBefore)
+	}
+
+	attempt, ok := s.attempts[key]
+	if !ok {
+		attempt = &entity.LoginAttempt{AttemptKey: key}
+		s.attempts[key] = attempt
+	}
+	if attempt.LastFailedAt.Before(resetBefore) {
+		attempt.Failures = 0
+	}
+	attempt.Failures++
+	attempt.LastFailedAt = failedAt
+	attempt.UpdatedAt = failedAt
+
+	copied := *attempt
+	return &copied, nil
+}
+
+func (s *inMemoryLoginAttemptStore) Lock(key string, until time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	attempt, ok := s.attempts[key]
+	if !ok {
+		attempt = &entity.LoginAttempt{AttemptKey: key}
+		s.attempts[key] = attempt
+	}
+	attempt.LockedUntil = &until
+	return nil
+}
+
+func (s *inMemoryLoginAttemptStore) Reset(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.attempts, key)
+	return nil
+}
+
+// sweep はリセット済みかつロックされていない記録を削除する
+func (s *inMemoryLoginAttemptStore) sweep(now time.Time, resetBefore time.Time) {
+	for key, attempt := range s.attempts {
+		if attempt.LastFailedAt.Before(resetBefore) && !attempt.Locked(now) {
+			delete(s.attempts, key)
+		}
+	}
+}
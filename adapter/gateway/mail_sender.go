@@ -0,0 +1,23 @@
+package gateway
+
+import (
+	"household-account-backend/pkg/logger"
+)
+
+// MailSender はユーザーへメールを送信する
+// 本番環境ではSMTPやメール配信サービスを使った実装に差し替える
+type MailSender interface {
+	Send(to string, subject string, body string) error
+}
+
+type logMailSender struct{}
+
+// NewLogMailSender はメールを送信せずログに出力するだけの開発用MailSenderを返す
+func NewLogMailSender() MailSender {
+	return &logMailSender{}
+}
+
+func (s *logMailSender) Send(to string, subject string, body string) error {
+	logger.Info("mail sent", "to", to, "subject", subject, "body", body)
+	return nil
+}
@@ -11,7 +11,9 @@ type MonthlySummaryRepository interface {
 	GetMonthlySummaryByID(userID int, summaryID int) (*entity.MonthlySummary, error)
 	GetMonthlySummariesByUserID(userID int) ([]entity.MonthlySummary, error)
 	UpdateMonthlySummary(summary *entity.MonthlySummary) (*entity.MonthlySummary, error)
-	DeleteMonthlySummary(userID int, summaryID int) error
+	DeleteMonthlySummary(userID int, summaryID int, version int) error
+	// RunInTransaction はfnに渡したリポジトリとアウトボックスの操作を1つのDBトランザクションで実行する
+	RunInTransaction(fn func(repository MonthlySummaryRepository, outbox OutboxRepository) error) error
 }
 
 type monthlySummaryRepository struct {
@@ -23,6 +25,7 @@ func NewMonthlySummaryRepository(db *gorm.DB) MonthlySummaryRepository {
 }
 
 func (msr *monthlySummaryRepository) CreateMonthlySummary(summary *entity.MonthlySummary) (*entity.MonthlySummary, error) {
+	summary.Version = 1
 	if err := msr.db.Create(summary).Error; err != nil {
 		return nil, err
 	}
@@ -39,22 +42,63 @@ func (msr *monthlySummaryRepository) GetMonthlySummaryByID(userID int, summaryID
 
 func (msr *monthlySummaryRepository) GetMonthlySummariesByUserID(userID int) ([]entity.MonthlySummary, error) {
 	var summaries []entity.MonthlySummary
-	if err := msr.db.Where("user_id = ?", userID).Find(&summaries).Error; err != nil {
+	if err := msr.db.Where("user_id = ?", userID).Order("id").Find(&summaries).Error; err != nil {
 		return nil, err
 	}
 	return summaries, nil
 }
 
 func (msr *monthlySummaryRepository) UpdateMonthlySummary(summary *entity.MonthlySummary) (*entity.MonthlySummary, error) {
-	if err := msr.db.Save(summary).Error; err != nil {
-		return nil, err
+	// バージョンが指定されていない場合は現在のバージョンを条件にする
+	expectedVersion := summary.Version
+	if expectedVersion == 0 {
+		selectedSummary, err := msr.GetMonthlySummaryByID(summary.UserID, summary.ID)
+		if err != nil {
+			return nil, err
+		}
+		expectedVersion = selectedSummary.Version
+	}
+	summary.Version = expectedVersion + 1
+
+	// バージョンが一致する場合のみ更新
+	result := msr.db.Model(&entity.MonthlySummary{}).
+		Where("id = ? AND user_id = ? AND version = ?", summary.ID, summary.UserID, expectedVersion).
+		Select("*").Updates(summary)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		// 存在しない場合はGetと同じエラーを返す
+		if _, err := msr.GetMonthlySummaryByID(summary.UserID, summary.ID); err != nil {
+			return nil, err
+		}
+		return nil, ErrVersionConflict
 	}
 	return summary, nil
 }
 
-func (msr *monthlySummaryRepository) DeleteMonthlySummary(userID int, summaryID int) error {
-	if err := msr.db.Where("id = ? AND user_id = ?", summaryID, userID).Delete(&entity.MonthlySummary{}).Error; err != nil {
-		return err
+func (msr *monthlySummaryRepository) DeleteMonthlySummary(userID int, summaryID int, version int) error {
+	query := msr.db.Where("id = ? AND user_id = ?", summaryID, userID)
+	if version != 0 {
+		query = query.Where("version = ?", version)
+	}
+	result := query.Delete(&entity.MonthlySummary{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 && version != 0 {
+		// 存在しない場合はGetと同じエラーを返す
+		if _, err := msr.GetMonthlySummaryByID(userID, summaryID); err != nil {
+			return err
+		}
+		return ErrVersionConflict
 	}
 	return nil
 }
+
+// RunInTransaction はデータの変更とイベントの保存を同じDBトランザクションで行い、どちらかが失敗した場合は両方をロールバックする
+func (msr *monthlySummaryRepository) RunInTransaction(fn func(repository MonthlySummaryRepository, outbox OutboxRepository) error) error {
+	return msr.db.Transaction(func(tx *gorm.DB) error {
+		return fn(&monthlySummaryRepository{tx}, &outboxRepository{tx})
+	})
+}
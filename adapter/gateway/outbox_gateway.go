@@ -0,0 +1,103 @@
+package gateway
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"household-account-backend/entity"
+)
+
+type OutboxRepository interface {
+	CreateEvent(event *entity.OutboxEvent) (*entity.OutboxEvent, error)
+	ClaimPendingEvents(limit int, maxAttempts int, claimTTL time.Duration) ([]entity.OutboxEvent, error)
+	// GetEventsAfter はafterEventIDより後のイベントをID順に返す
+	// IDはINSERT時に採番されるため、afterEventIDのイベントの作成前commitGrace以内に作成されたイベントは
+	// 後からコミットされた可能性があるものとしてIDが小さくても含める
+	GetEventsAfter(userID int, afterEventID int, commitGrace time.Duration, limit int) ([]entity.OutboxEvent, error)
+	// GetLatestEventID はユーザーの最新のイベントIDを返す。イベントがない場合は0を返す
+	GetLatestEventID(userID int) (int, error)
+	MarkEventProcessed(eventID int) error
+	IncrementEventAttempts(eventID int) error
+}
+
+type outboxRepository struct {
+	db *gorm.DB
+}
+
+func NewOutboxRepository(db *gorm.DB) OutboxRepository {
+	return &outboxRepository{db}
+}
+
+func (or *outboxRepository) CreateEvent(event *entity.OutboxEvent) (*entity.OutboxEvent, error) {
+	if err := or.db.Create(event).Error; err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// ClaimPendingEvents は未配信のイベントを取得し、claimTTLの間は他のワーカーが取得できないようにする
+// 複数のワーカーが同じイベントを読み込んでも、locked_untilを更新できたワーカーだけがイベントを返す
+func (or *outboxRepository) ClaimPendingEvents(limit int, maxAttempts int, claimTTL time.Duration) ([]entity.OutboxEvent, error) {
+	now := time.Now()
+	var candidates []entity.OutboxEvent
+	if err := or.db.Where("processed_at IS NULL AND attempts < ? AND (locked_until IS NULL OR locked_until < ?)", maxAttempts, now).
+		Order("id").Limit(limit).Find(&candidates).Error; err != nil {
+		return nil, err
+	}
+
+	lockedUntil := now.Add(claimTTL)
+	events := make([]entity.OutboxEvent, 0, len(candidates))
+	for _, event := range candidates {
+		result := or.db.Model(&entity.OutboxEvent{}).
+			Where("id = ? AND processed_at IS NULL AND (locked_until IS NULL OR locked_until < ?)", event.ID, now).
+			Update("locked_until", lockedUntil)
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		if result.RowsAffected == 0 {
+			continue
+		}
+		event.LockedUntil = &lockedUntil
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+func (or *outboxRepository) GetEventsAfter(userID int, afterEventID int, commitGrace time.Duration, limit int) ([]entity.OutboxEvent, error) {
+	query := or.db.Where("user_id = ? AND id > ?", userID, afterEventID)
+
+	var after entity.OutboxEvent
+	err := or.db.Select("created_at").Where("id = ? AND user_id = ?", afterEventID, userID).First(&after).Error
+	if err == nil {
+		query = or.db.Where("user_id = ? AND (id > ? OR created_at >= ?)", userID, afterEventID, after.CreatedAt.Add(-commitGrace))
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	var events []entity.OutboxEvent
+	if err := query.Order("id").Limit(limit).Find(&events).Error; err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+func (or *outboxRepository) GetLatestEventID(userID int) (int, error) {
+	var latestID int
+	if err := or.db.Model(&entity.OutboxEvent{}).Where("user_id = ?", userID).
+		Select("COALESCE(MAX(id), 0)").Scan(&latestID).Error; err != nil {
+		return 0, err
+	}
+	return latestID, nil
+}
+
+func (or *outboxRepository) MarkEventProcessed(eventID int) error {
+	return or.db.Model(&entity.OutboxEvent{}).Where("id = ?", eventID).
+		Updates(map[string]interface{}{"processed_at": time.Now(), "locked_until": nil}).Error
+}
+
+func (or *outboxRepository) IncrementEventAttempts(eventID int) error {
+	return or.db.Model(&entity.OutboxEvent{}).Where("id = ?", eventID).
+		Updates(map[string]interface{}{"attempts": gorm.Expr("attempts + ?", 1), "locked_until": nil}).Error
+}
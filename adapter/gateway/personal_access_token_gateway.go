@@ -0,0 +1,69 @@
+package gateway
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"household-account-backend/entity"
+)
+
+type PersonalAccessTokenRepository interface {
+	CreateToken(token *entity.PersonalAccessToken) (*entity.PersonalAccessToken, error)
+	// GetTokenByHash はトークンが存在しない場合はnilを返す
+	GetTokenByHash(tokenHash string) (*entity.PersonalAccessToken, error)
+	GetTokensByUserID(userID int) ([]entity.PersonalAccessToken, error)
+	DeleteToken(userID int, tokenID int) error
+	UpdateLastUsedAt(tokenID int, usedAt time.Time) error
+}
+
+type personalAccessTokenRepository struct {
+	db *gorm.DB
+}
+
+func NewPersonalAccessTokenRepository(db *gorm.DB) PersonalAccessTokenRepository {
+	return &personalAccessTokenRepository{db}
+}
+
+func (pr *personalAccessTokenRepository) CreateToken(token *entity.PersonalAccessToken) (*entity.PersonalAccessToken, error) {
+	if err := pr.db.Create(token).Error; err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+func (pr *personalAccessTokenRepository) GetTokenByHash(tokenHash string) (*entity.PersonalAccessToken, error) {
+	var tokens []entity.PersonalAccessToken
+	if err := pr.db.Where("token_hash = ?", tokenHash).Limit(1).Find(&tokens).Error; err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+	return &tokens[0], nil
+}
+
+func (pr *personalAccessTokenRepository) GetTokensByUserID(userID int) ([]entity.PersonalAccessToken, error) {
+	var tokens []entity.PersonalAccessToken
+	if err := pr.db.Where("user_id = ?", userID).Order("id").Find(&tokens).Error; err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+func (pr *personalAccessTokenRepository) DeleteToken(userID int, tokenID int) error {
+	result := pr.db.Where("id = ? AND user_id = ?", tokenID, userID).Delete(&entity.PersonalAccessToken{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrRecordNotFound
+	}
+	return nil
+}
+
+func (pr *personalAccessTokenRepository) UpdateLastUsedAt(tokenID int, usedAt time.Time) error {
+	return pr.db.Model(&entity.PersonalAccessToken{}).
+		Where("id = ?", tokenID).
+		Update("last_used_at", usedAt).Error
+}
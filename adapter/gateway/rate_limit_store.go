@@ -0,0 +1,92 @@
+package gateway
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// RateLimitResult はトークンバケットからトークンを取り出した結果
+type RateLimitResult struct {
+	Allowed   bool
+	Remaining int
+	// ResetAfter はバケットが満杯に戻るまでの時間
+	ResetAfter time.Duration
+	// RetryAfter は次のトークンが補充されるまでの時間。Allowedがtrueの場合は0
+	RetryAfter time.Duration
+}
+
+// RateLimitStore はキーごとのトークンバケットを保持する
+// 単一プロセスではインメモリ、複数インスタンス構成ではRedisなどを使った実装に差し替える
+type RateLimitStore interface {
+	// Take はperiodあたりlimit個補充される容量limitのバケットからトークンを1つ取り出す
+	Take(key string, limit int, period time.Duration, now time.Time) (*RateLimitResult, error)
+}
+
+// rateLimitSweepInterval はこの回数の取り出しごとに満杯に戻ったバケットを削除する
+const rateLimitSweepInterval = 10000
+
+type tokenBucket struct {
+	tokens    float64
+	updatedAt time.Time
+	period    time.Duration
+}
+
+type inMemoryRateLimitStore struct {
+	mu      sync.Mutex
+	buckets map[string]*tokenBucket
+	takes   int
+}
+
+// NewInMemoryRateLimitStore はプロセス内のメモリでバケットを管理するRateLimitStoreを返す
+func NewInMemoryRateLimitStore() RateLimitStore {
+	return &inMemoryRateLimitStore{buckets: map[string]*tokenBucket{}}
+}
+
+func (s *inMemoryRateLimitStore) Take(key string, limit int, period time.Duration, now time.Time) (*RateLimitResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.takes++
+	if s.takes%rateLimitSweepInterval == 0 {
+		s.sweep(now)
+	}
+
+	capacity := float64(limit)
+	// 1秒あたりに補充されるトークン数
+	rate := capacity / period.Seconds()
+
+	bucket, ok := s.buckets[key]
+	if !ok {
+		bucket = &tokenBucket{tokens: capacity, updatedAt: now}
+		s.buckets[key] = bucket
+	} else if now.After(bucket.updatedAt) {
+		bucket.tokens = math.Min(capacity, bucket.tokens+now.Sub(bucket.updatedAt).Seconds()*rate)
+		bucket.updatedAt = now
+	}
+	bucket.period = period
+
+	result := &RateLimitResult{}
+	if bucket.tokens >= 1 {
+		bucket.tokens--
+		result.Allowed = true
+	} else {
+		result.RetryAfter = secondsToDuration((1 - bucket.tokens) / rate)
+	}
+	result.Remaining = int(bucket.tokens)
+	result.ResetAfter = secondsToDuration((capacity - bucket.tokens) / rate)
+	return result, nil
+}
+
+// sweep は最後の取り出しから満杯に戻るまでの時間が経過したバケットを削除する
+func (s *inMemoryRateLimitStore) sweep(now time.Time) {
+	for key, bucket := range s.buckets {
+		if now.Sub(bucket.updatedAt) >= bucket.period {
+			delete(s.buckets, key)
+		}
+	}
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
@@ -0,0 +1,130 @@
+package gateway_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/entity"
+	"household-account-backend/infrastructure/database"
+	"household-account-backend/pkg/tester"
+)
+
+type AccountDeletionRepositorySuite struct {
+	tester.DBSuite
+	repository gateway.AccountDeletionRepository
+}
+
+func TestAccountDeletionRepositorySuite(t *testing.T) {
+	suite.Run(t, new(AccountDeletionRepositorySuite))
+}
+
+func TestAccountDeletionRepositorySuitePostgreSQL(t *testing.T) {
+	suite.Run(t, &AccountDeletionRepositorySuite{DBSuite: tester.DBSuite{Driver: database.DriverPostgreSQL}})
+}
+
+func (suite *AccountDeletionRepositorySuite) SetupSuite() {
+	suite.DBSuite.SetupSuite()
+	suite.repository = gateway.NewAccountDeletionRepository(suite.DB)
+}
+
+// createUserData は全てのテーブルにユーザーの行を作成する
+func (suite *AccountDeletionRepositorySuite) createUserData(email string) *entity.User {
+	now := time.Now()
+	user := &entity.User{Email: email, Password: "hash", Name: "test"}
+	suite.Require().Nil(suite.DB.Create(user).Error)
+
+	category := &entity.Category{UserID: user.ID, Name: "食費", Type: "expense"}
+	suite.Require().Nil(suite.DB.Create(category).Error)
+	webhook := &entity.Webhook{UserID: user.ID, URL: "https://example.com/hook", Secret: "secret", Events: "transaction.created", Active: true}
+	suite.Require().Nil(suite.DB.Create(webhook).Error)
+	records := []interface{}{
+		&entity.Transaction{UserID: user.ID, CategoryID: category.ID, Date: now, Amount: 100},
+		&entity.MonthlySummary{UserID: user.ID, YearMonth: "2026-10"},
+		&entity.OutboxEvent{UserID: user.ID, Type: "transaction.created", Payload: "{}"},
+		&entity.WebhookDelivery{WebhookID: webhook.ID, EventID: 1},
+		&entity.IdempotencyRecord{UserID: user.ID, IdempotencyKey: "key", ExpiresAt: now},
+		&entity.UserToken{UserID: user.ID, Purpose: entity.UserTokenPurposePasswordReset, TokenHash: email, ExpiresAt: now},
+		&entity.TOTPCredential{UserID: user.ID, Secret: "secret"},
+		&entity.RecoveryCode{UserID: user.ID, CodeHash: "hash"},
+		&entity.UserIdentity{UserID: user.ID, Provider: "google", Subject: email, Email: email},
+		&entity.OIDCLoginState{StateHash: email, Provider: "google", LinkUserID: user.ID, ExpiresAt: now},
+		&entity.LoginAttempt{AttemptKey: entity.LoginAttemptAccountKey(email), Failures: 1, LastFailedAt: now},
+		&entity.PersonalAccessToken{UserID: user.ID, Name: "script", TokenPrefix: "hha_", TokenHash: email, Scopes: entity.ScopeReadReports},
+		&entity.DataExport{UserID: user.ID, Status: entity.DataExportStatusCompleted},
+	}
+	for _, record := range records {
+		suite.Require().Nil(suite.DB.Create(record).Error)
+	}
+	return user
+}
+
+func (suite *AccountDeletionRepositorySuite) TestEraseUserData() {
+	user := suite.createUserData("erase@example.com")
+	other := suite.createUserData("other@example.com")
+
+	counts, err := suite.repository.CountUserData(user.ID, user.Email)
+	suite.Require().Nil(err)
+	for table, count := range counts {
+		suite.Assert().Equal(int64(1), count, table)
+	}
+
+	deleted, err := suite.repository.EraseUserData(user.ID, user.Email)
+	suite.Require().Nil(err)
+	suite.Assert().Len(deleted, len(counts))
+	for table, count := range deleted {
+		suite.Assert().Equal(int64(1), count, table)
+	}
+
+	remaining, err := suite.repository.CountUserData(user.ID, user.Email)
+	suite.Require().Nil(err)
+	for table, count := range remaining {
+		suite.Assert().Zero(count, table)
+	}
+
+	// 他のユーザーのデータは削除しない
+	otherCounts, err := suite.repository.CountUserData(other.ID, other.Email)
+	suite.Require().Nil(err)
+	for table, count := range otherCounts {
+		suite.Assert().Equal(int64(1), count, table)
+	}
+}
+
+func (suite *AccountDeletionRepositorySuite) TestDeletionLifecycle() {
+	deletion, err := suite.repository.CreateDeletion(&entity.AccountDeletion{
+		UserID: 100,
+		Email:  "delete@example.com",
+		Status: entity.AccountDeletionStatusPending,
+	})
+	suite.Require().Nil(err)
+
+	active, err := suite.repository.GetActiveDeletion(100)
+	suite.Require().Nil(err)
+	suite.Assert().Equal(deletion.ID, active.ID)
+
+	now := time.Now()
+	future := now.Add(time.Hour)
+	deletion.Status = entity.AccountDeletionStatusScheduled
+	deletion.ScheduledAt = &future
+	suite.Require().Nil(suite.repository.UpdateDeletion(deletion))
+
+	due, err := suite.repository.GetDueDeletions(now, 10)
+	suite.Require().Nil(err)
+	suite.Assert().Empty(due)
+	due, err = suite.repository.GetDueDeletions(future.Add(time.Second), 10)
+	suite.Require().Nil(err)
+	suite.Require().Len(due, 1)
+	suite.Assert().Equal(deletion.ID, due[0].ID)
+
+	deletion.Status = entity.AccountDeletionStatusCancelled
+	suite.Require().Nil(suite.repository.UpdateDeletion(deletion))
+	active, err = suite.repository.GetActiveDeletion(100)
+	suite.Assert().Nil(err)
+	suite.Assert().Nil(active)
+
+	latest, err := suite.repository.GetLatestDeletion(100)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(entity.AccountDeletionStatusCancelled, latest.Status)
+}
@@ -0,0 +1,165 @@
+package gateway_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/entity"
+	"household-account-backend/infrastructure/database"
+	"household-account-backend/pkg/tester"
+)
+
+type AdminRepositorySuite struct {
+	tester.DBSuite
+	repository gateway.AdminRepository
+}
+
+func TestAdminRepositorySuite(t *testing.T) {
+	suite.Run(t, new(AdminRepositorySuite))
+}
+
+func TestAdminRepositorySuitePostgreSQL(t *testing.T) {
+	suite.Run(t, &AdminRepositorySuite{DBSuite: tester.DBSuite{Driver: database.DriverPostgreSQL}})
+}
+
+func (suite *AdminRepositorySuite) SetupSuite() {
+	suite.DBSuite.SetupSuite()
+	suite.repository = gateway.NewAdminRepository(suite.DB)
+}
+
+func (suite *AdminRepositorySuite) SetupTest() {
+	suite.DB.Where("1 = 1").Delete(&entity.Transaction{})
+	suite.DB.Where("1 = 1").Delete(&entity.PersonalAccessToken{})
+	suite.DB.Where("1 = 1").Delete(&entity.User{})
+}
+
+func (suite *AdminRepositorySuite) createUser(email string, name string, role string) *entity.User {
+	user := &entity.User{Email: email, Password: "hash", Name: name, Role: role}
+	suite.Require().Nil(suite.DB.Create(user).Error)
+	return user
+}
+
+func (suite *AdminRepositorySuite) TestSearchUsers() {
+	alice := suite.createUser("alice@example.com", "Alice", entity.UserRoleUser)
+	bob := suite.createUser("bob@example.com", "Bob", entity.UserRoleUser)
+	admin := suite.createUser("admin@example.com", "Admin", entity.UserRoleAdmin)
+	disabledAt := time.Now()
+	suite.Require().Nil(suite.repository.SetUserDisabled(bob.ID, &disabledAt))
+
+	users, total, err := suite.repository.SearchUsers(gateway.UserSearchQuery{Query: "ali", Limit: 10})
+	suite.Require().Nil(err)
+	suite.Assert().Equal(int64(1), total)
+	suite.Assert().Equal(alice.ID, users[0].ID)
+
+	users, total, err = suite.repository.SearchUsers(gateway.UserSearchQuery{Role: entity.UserRoleAdmin, Limit: 10})
+	suite.Require().Nil(err)
+	suite.Assert().Equal(int64(1), total)
+	suite.Assert().Equal(admin.ID, users[0].ID)
+
+	users, total, err = suite.repository.SearchUsers(gateway.UserSearchQuery{Status: gateway.UserStatusDisabled, Limit: 10})
+	suite.Require().Nil(err)
+	suite.Assert().Equal(int64(1), total)
+	suite.Assert().Equal(bob.ID, users[0].ID)
+	suite.Assert().NotNil(users[0].SessionsRevokedAt)
+
+	// 総件数はページングする前の件数を返す
+	users, total, err = suite.repository.SearchUsers(gateway.UserSearchQuery{Status: gateway.UserStatusActive, Limit: 1, Offset: 1})
+	suite.Require().Nil(err)
+	suite.Assert().Equal(int64(2), total)
+	suite.Require().Len(users, 1)
+	suite.Assert().Equal(admin.ID, users[0].ID)
+}
+
+func (suite *AdminRepositorySuite) TestSearchUsersEscapesWildcards() {
+	percent := suite.createUser("100%@example.com", "Percent", entity.UserRoleUser)
+	underscore := suite.createUser("a_b@example.com", "Underscore", entity.UserRoleUser)
+	suite.createUser("axb@example.com", "Other", entity.UserRoleUser)
+
+	// %と_はワイルドカードではなく文字として一致させる
+	users, total, err := suite.repository.SearchUsers(gateway.UserSearchQuery{Query: "%", Limit: 10})
+	suite.Require().Nil(err)
+	suite.Assert().Equal(int64(1), total)
+	suite.Assert().Equal(percent.ID, users[0].ID)
+
+	users, total, err = suite.repository.SearchUsers(gateway.UserSearchQuery{Query: "a_b", Limit: 10})
+	suite.Require().Nil(err)
+	suite.Assert().Equal(int64(1), total)
+	suite.Assert().Equal(underscore.ID, users[0].ID)
+
+	_, total, err = suite.repository.SearchUsers(gateway.UserSearchQuery{Query: `\`, Limit: 10})
+	suite.Require().Nil(err)
+	suite.Assert().Zero(total)
+}
+
+func (suite *AdminRepositorySuite) TestRevokeSessionsDeletesPersonalAccessTokens() {
+	user := suite.createUser("logout@example.com", "Logout", entity.UserRoleUser)
+	other := suite.createUser("other@example.com", "Other", entity.UserRoleUser)
+	suite.Require().Nil(suite.DB.Create(&entity.PersonalAccessToken{UserID: user.ID, Name: "cli", TokenHash: "logout-hash", Scopes: "read:categories"}).Error)
+	suite.Require().Nil(suite.DB.Create(&entity.PersonalAccessToken{UserID: other.ID, Name: "cli", TokenHash: "other-hash", Scopes: "read:categories"}).Error)
+
+	revokedAt := time.Now()
+	suite.Require().Nil(suite.repository.RevokeSessions(user.ID, revokedAt))
+
+	var revoked entity.User
+	suite.Require().Nil(suite.DB.First(&revoked, user.ID).Error)
+	suite.Assert().True(revoked.SessionRevoked(revokedAt.Add(-time.Minute)))
+
+	var count int64
+	suite.Require().Nil(suite.DB.Model(&entity.PersonalAccessToken{}).Where("user_id = ?", user.ID).Count(&count).Error)
+	suite.Assert().Zero(count)
+	// 他のユーザーのトークンは削除しない
+	suite.Require().Nil(suite.DB.Model(&entity.PersonalAccessToken{}).Where("user_id = ?", other.ID).Count(&count).Error)
+	suite.Assert().Equal(int64(1), count)
+}
+
+func (suite *AdminRepositorySuite) TestUserColumnDefaults() {
+	// ロールなどの列を追加する前に作成したユーザーは有効な一般ユーザーとして扱う
+	suite.Require().Nil(suite.DB.Exec("INSERT INTO users (email, password, name) VALUES (?, ?, ?)", "legacy@example.com", "hash", "Legacy").Error)
+
+	users, total, err := suite.repository.SearchUsers(gateway.UserSearchQuery{Role: entity.UserRoleUser, Status: gateway.UserStatusActive, Limit: 10})
+	suite.Require().Nil(err)
+	suite.Require().Equal(int64(1), total)
+	suite.Assert().Equal("legacy@example.com", users[0].Email)
+	suite.Assert().False(users[0].Disabled())
+	suite.Assert().Nil(users[0].SessionsRevokedAt)
+}
+
+func (suite *AdminRepositorySuite) TestGetSystemStats() {
+	user := suite.createUser("stats@example.com", "Stats", "")
+	suite.createUser("admin@example.com", "Admin", entity.UserRoleAdmin)
+	now := time.Now()
+	for _, transaction := range []*entity.Transaction{
+		{UserID: user.ID, CategoryID: 1, Date: now.AddDate(0, -3, 0), Amount: 1000},
+		{UserID: user.ID, CategoryID: 1, Date: now, Amount: 250},
+	} {
+		suite.Require().Nil(suite.DB.Create(transaction).Error)
+	}
+
+	stats, err := suite.repository.GetSystemStats(now.AddDate(0, 0, -30))
+	suite.Require().Nil(err)
+	suite.Assert().Equal(int64(2), stats.UserCount)
+	suite.Assert().Equal(int64(1), stats.AdminCount)
+	suite.Assert().Equal(int64(2), stats.NewUserCount)
+	suite.Assert().Equal(int64(2), stats.TransactionCount)
+	suite.Assert().Equal(float64(1250), stats.TransactionAmount)
+	suite.Assert().Equal(int64(1), stats.RecentTransactionCount)
+	suite.Assert().Equal(float64(250), stats.RecentTransactionAmount)
+}
+
+func (suite *AdminRepositorySuite) TestAuditLogs() {
+	suite.Require().Nil(suite.repository.CreateAuditLog(&entity.AdminAuditLog{AdminID: 1, Action: entity.AdminActionDisableUser, TargetUserID: 2}))
+	suite.Require().Nil(suite.repository.CreateAuditLog(&entity.AdminAuditLog{AdminID: 1, Action: entity.AdminActionImpersonate, TargetUserID: 3, Detail: "ticket-1"}))
+
+	logs, err := suite.repository.GetAuditLogs(0, 10)
+	suite.Require().Nil(err)
+	suite.Require().Len(logs, 2)
+	suite.Assert().Equal(entity.AdminActionImpersonate, logs[0].Action)
+
+	logs, err = suite.repository.GetAuditLogs(2, 10)
+	suite.Require().Nil(err)
+	suite.Require().Len(logs, 1)
+	suite.Assert().Equal(entity.AdminActionDisableUser, logs[0].Action)
+}
@@ -10,11 +10,12 @@ import (
 
 	"household-account-backend/adapter/gateway"
 	"household-account-backend/entity"
+	"household-account-backend/infrastructure/database"
 	"household-account-backend/pkg/tester"
 )
 
 type CategoryRepositorySuite struct {
-	tester.DBSQLiteSuite
+	tester.DBSuite
 	repository gateway.CategoryRepository
 }
 
@@ -22,8 +23,12 @@ func TestCategoryRepositorySuite(t *testing.T) {
 	suite.Run(t, new(CategoryRepositorySuite))
 }
 
+func TestCategoryRepositorySuitePostgreSQL(t *testing.T) {
+	suite.Run(t, &CategoryRepositorySuite{DBSuite: tester.DBSuite{Driver: database.DriverPostgreSQL}})
+}
+
 func (suite *CategoryRepositorySuite) SetupSuite() {
-	suite.DBSQLiteSuite.SetupSuite()
+	suite.DBSuite.SetupSuite()
 	suite.repository = gateway.NewCategoryRepository(suite.DB)
 }
 
@@ -49,7 +54,7 @@ func (suite *CategoryRepositorySuite) TestCategoryRepositoryCRUD() {
 	suite.Assert().Equal("Food", createdCategory.Name)
 	suite.Assert().Equal("expense", createdCategory.Type)
 
-	getCategory, err := suite.repository.GetCategoryByID(createdCategory.ID)
+	getCategory, err := suite.repository.GetCategoryByID(createdCategory.UserID, createdCategory.ID)
 	suite.Assert().Nil(err)
 	suite.Assert().Equal("Food", getCategory.Name)
 	suite.Assert().Equal("expense", getCategory.Type)
@@ -59,19 +64,52 @@ func (suite *CategoryRepositorySuite) TestCategoryRepositoryCRUD() {
 	suite.Assert().Nil(err)
 	suite.Assert().Equal("Groceries", updatedCategory.Name)
 	suite.Assert().Equal("expense", updatedCategory.Type)
+	suite.Assert().Equal(2, updatedCategory.Version)
 
-	err = suite.repository.DeleteCategory(createdCategory.ID)
+	err = suite.repository.DeleteCategory(createdCategory.UserID, createdCategory.ID, updatedCategory.Version)
 	suite.Assert().Nil(err)
-	deletedCategory, err := suite.repository.GetCategoryByID(createdCategory.ID)
+	deletedCategory, err := suite.repository.GetCategoryByID(createdCategory.UserID, createdCategory.ID)
 	suite.Assert().Nil(deletedCategory)
 	suite.Assert().Equal("record not found", err.Error())
 }
 
+func (suite *CategoryRepositorySuite) TestCategoryVersionDefault() {
+	// バージョンの列を追加する前に作成した行はバージョン1として扱う
+	suite.Require().Nil(suite.DB.Exec("INSERT INTO categories (user_id, name, type) VALUES (?, ?, ?)", 1, "Legacy", "expense").Error)
+	var legacy entity.Category
+	suite.Require().Nil(suite.DB.Where("name = ?", "Legacy").First(&legacy).Error)
+	suite.Assert().Equal(1, legacy.Version)
+
+	legacy.Name = "Updated"
+	updatedCategory, err := suite.repository.UpdateCategory(&legacy)
+	suite.Require().Nil(err)
+	suite.Assert().Equal(2, updatedCategory.Version)
+}
+
+func (suite *CategoryRepositorySuite) TestGetCategoriesByIDs() {
+	food, err := suite.repository.CreateCategory(&entity.Category{UserID: 301, Name: "Food", Type: "expense"})
+	suite.Require().NoError(err)
+	salary, err := suite.repository.CreateCategory(&entity.Category{UserID: 301, Name: "Salary", Type: "income"})
+	suite.Require().NoError(err)
+	other, err := suite.repository.CreateCategory(&entity.Category{UserID: 302, Name: "Other", Type: "expense"})
+	suite.Require().NoError(err)
+
+	// 他のユーザーのカテゴリーと存在しないIDは含めない
+	categories, err := suite.repository.GetCategoriesByIDs(301, []int{food.ID, salary.ID, other.ID, 999999})
+	suite.Require().NoError(err)
+	suite.Require().Len(categories, 2)
+	suite.Assert().ElementsMatch([]string{"Food", "Salary"}, []string{categories[0].Name, categories[1].Name})
+
+	categories, err = suite.repository.GetCategoriesByIDs(301, nil)
+	suite.Require().NoError(err)
+	suite.Assert().Empty(categories)
+}
+
 func (suite *CategoryRepositorySuite) TestCategoryCreateFailure() {
 	mockDB := suite.MockDB()
 	mockDB.ExpectBegin()
-	mockDB.ExpectExec(regexp.QuoteMeta("INSERT INTO `categories` (`user_id`,`name`,`type`) VALUES (?,?,?)")).
-		WithArgs(1, "Food", "expense").
+	mockDB.ExpectExec(regexp.QuoteMeta("INSERT INTO `categories` (`user_id`,`name`,`type`,`version`) VALUES (?,?,?,?)")).
+		WithArgs(1, "Food", "expense", 1).
 		WillReturnError(errors.New("create error"))
 	mockDB.ExpectRollback()
 
@@ -89,11 +127,11 @@ func (suite *CategoryRepositorySuite) TestCategoryCreateFailure() {
 
 func (suite *CategoryRepositorySuite) TestCategoryGetFailure() {
 	mockDB := suite.MockDB()
-	mockDB.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `categories` WHERE `categories`.`id` = ? ORDER BY `categories`.`id` LIMIT ?")).
-		WithArgs(1, 1).
+	mockDB.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `categories` WHERE id = ? AND user_id = ? ORDER BY `categories`.`id` LIMIT ?")).
+		WithArgs(1, 1, 1).
 		WillReturnError(errors.New("get error"))
 
-	category, err := suite.repository.GetCategoryByID(1)
+	category, err := suite.repository.GetCategoryByID(1, 1)
 	suite.Assert().Nil(category)
 	suite.Assert().NotNil(err)
 	suite.Assert().Equal("get error", err.Error())
@@ -101,14 +139,15 @@ func (suite *CategoryRepositorySuite) TestCategoryGetFailure() {
 
 func (suite *CategoryRepositorySuite) TestCategoryUpdateFailure() {
 	mockDB := suite.MockDB()
-	mockDB.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `categories` WHERE `categories`.`id` = ? ORDER BY `categories`.`id` LIMIT ?")).
-		WithArgs(1, 1).
+	mockDB.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `categories` WHERE id = ? AND user_id = ? ORDER BY `categories`.`id` LIMIT ?")).
+		WithArgs(1, 1, 1).
 		WillReturnError(errors.New("update error"))
 
 	category := &entity.Category{
-		ID:   1,
-		Name: "Groceries",
-		Type: "expense",
+		ID:     1,
+		UserID: 1,
+		Name:   "Groceries",
+		Type:   "expense",
 	}
 
 	updatedCategory, err := suite.repository.UpdateCategory(category)
@@ -120,11 +159,11 @@ func (suite *CategoryRepositorySuite) TestCategoryUpdateFailure() {
 func (suite *CategoryRepositorySuite) TestCategoryDeleteFailure() {
 	mockDB := suite.MockDB()
 	mockDB.ExpectBegin()
-	mockDB.ExpectExec(regexp.QuoteMeta("DELETE FROM `categories` WHERE `categories`.`id` = ?")).WithArgs(1).
+	mockDB.ExpectExec(regexp.QuoteMeta("DELETE FROM `categories` WHERE (id = ? AND user_id = ?) AND version = ?")).WithArgs(1, 1, 1).
 		WillReturnError(errors.New("delete error"))
 	mockDB.ExpectRollback()
 
-	err := suite.repository.DeleteCategory(1)
+	err := suite.repository.DeleteCategory(1, 1, 1)
 	suite.Assert().NotNil(err)
 	suite.Assert().Equal("delete error", err.Error())
 }
@@ -0,0 +1,57 @@
+package gateway_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/entity"
+	"household-account-backend/infrastructure/database"
+	"household-account-backend/pkg/tester"
+)
+
+type CategoryReferenceRepositorySuite struct {
+	tester.DBSuite
+	repository gateway.CategoryReferenceRepository
+}
+
+func TestCategoryReferenceRepositorySuite(t *testing.T) {
+	suite.Run(t, new(CategoryReferenceRepositorySuite))
+}
+
+func TestCategoryReferenceRepositorySuitePostgreSQL(t *testing.T) {
+	suite.Run(t, &CategoryReferenceRepositorySuite{DBSuite: tester.DBSuite{Driver: database.DriverPostgreSQL}})
+}
+
+func (suite *CategoryReferenceRepositorySuite) SetupSuite() {
+	suite.DBSuite.SetupSuite()
+	suite.repository = gateway.NewCategoryReferenceRepository(suite.DB)
+}
+
+func (suite *CategoryReferenceRepositorySuite) TestGetCategoryReferences() {
+	category := &entity.Category{UserID: 2, Name: "Food", Type: entity.CategoryTypeExpense}
+	suite.Require().Nil(suite.DB.Create(category).Error)
+	crossUser := &entity.Transaction{UserID: 1, CategoryID: category.ID, Date: time.Now(), Amount: 100}
+	suite.Require().Nil(suite.DB.Create(crossUser).Error)
+	missing := &entity.Transaction{UserID: 1, CategoryID: category.ID + 100, Date: time.Now(), Amount: 50}
+	suite.Require().Nil(suite.DB.Create(missing).Error)
+
+	references, err := suite.repository.GetCategoryReferences(0, 10)
+	suite.Require().Nil(err)
+	suite.Require().Len(references, 2)
+	suite.Assert().Equal(crossUser.ID, references[0].TransactionID)
+	suite.Require().NotNil(references[0].CategoryUserID)
+	suite.Assert().Equal(2, *references[0].CategoryUserID)
+	suite.Assert().Equal(entity.CategoryTypeExpense, references[0].CategoryType)
+	suite.Assert().Equal(float32(100), references[0].Amount)
+	suite.Assert().Nil(references[1].CategoryUserID)
+	suite.Assert().Equal("", references[1].CategoryType)
+
+	// 取引のIDより後から続きを読み込む
+	references, err = suite.repository.GetCategoryReferences(crossUser.ID, 10)
+	suite.Require().Nil(err)
+	suite.Require().Len(references, 1)
+	suite.Assert().Equal(missing.ID, references[0].TransactionID)
+}
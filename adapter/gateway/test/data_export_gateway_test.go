@@ -0,0 +1,98 @@
+package gateway_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/entity"
+	"household-account-backend/infrastructure/database"
+	"household-account-backend/pkg/tester"
+)
+
+type DataExportRepositorySuite struct {
+	tester.DBSuite
+	repository gateway.DataExportRepository
+}
+
+func TestDataExportRepositorySuite(t *testing.T) {
+	suite.Run(t, new(DataExportRepositorySuite))
+}
+
+func TestDataExportRepositorySuitePostgreSQL(t *testing.T) {
+	suite.Run(t, &DataExportRepositorySuite{DBSuite: tester.DBSuite{Driver: database.DriverPostgreSQL}})
+}
+
+func (suite *DataExportRepositorySuite) SetupSuite() {
+	suite.DBSuite.SetupSuite()
+	suite.repository = gateway.NewDataExportRepository(suite.DB)
+}
+
+func (suite *DataExportRepositorySuite) SetupTest() {
+	suite.DB.Where("1 = 1").Delete(&entity.DataExport{})
+}
+
+func (suite *DataExportRepositorySuite) TestClaimExport() {
+	export, err := suite.repository.CreateExport(&entity.DataExport{UserID: 1, Status: entity.DataExportStatusPending})
+	suite.Require().Nil(err)
+
+	active, err := suite.repository.HasActiveExport(1)
+	suite.Assert().Nil(err)
+	suite.Assert().True(active)
+
+	now := time.Now()
+	claimed, err := suite.repository.ClaimExport(now, now.Add(-time.Minute))
+	suite.Require().Nil(err)
+	suite.Require().NotNil(claimed)
+	suite.Assert().Equal(export.ID, claimed.ID)
+	suite.Assert().Equal(entity.DataExportStatusProcessing, claimed.Status)
+
+	// 作成中のものは二重に取得しない
+	claimed, err = suite.repository.ClaimExport(now, now.Add(-time.Minute))
+	suite.Assert().Nil(err)
+	suite.Assert().Nil(claimed)
+
+	// 作成を開始したまま終わらないものは再度取得する
+	later := now.Add(time.Hour)
+	claimed, err = suite.repository.ClaimExport(later, later.Add(-time.Minute))
+	suite.Require().Nil(err)
+	suite.Require().NotNil(claimed)
+	suite.Assert().Equal(export.ID, claimed.ID)
+
+	claimed.Status = entity.DataExportStatusCompleted
+	suite.Require().Nil(suite.repository.UpdateExport(claimed))
+	active, err = suite.repository.HasActiveExport(1)
+	suite.Assert().Nil(err)
+	suite.Assert().False(active)
+}
+
+func (suite *DataExportRepositorySuite) TestGetExportByID() {
+	export, err := suite.repository.CreateExport(&entity.DataExport{UserID: 1, Status: entity.DataExportStatusPending})
+	suite.Require().Nil(err)
+
+	found, err := suite.repository.GetExportByID(1, export.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(export.ID, found.ID)
+
+	// 他のユーザーのエクスポートは取得できない
+	found, err = suite.repository.GetExportByID(2, export.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Nil(found)
+}
+
+func (suite *DataExportRepositorySuite) TestGetExpiredExports() {
+	now := time.Now()
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+	expired, err := suite.repository.CreateExport(&entity.DataExport{UserID: 1, Status: entity.DataExportStatusCompleted, ExpiresAt: &past})
+	suite.Require().Nil(err)
+	_, err = suite.repository.CreateExport(&entity.DataExport{UserID: 1, Status: entity.DataExportStatusCompleted, ExpiresAt: &future})
+	suite.Require().Nil(err)
+
+	exports, err := suite.repository.GetExpiredExports(now, 10)
+	suite.Require().Nil(err)
+	suite.Require().Len(exports, 1)
+	suite.Assert().Equal(expired.ID, exports[0].ID)
+}
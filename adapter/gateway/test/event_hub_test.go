@@ -0,0 +1,51 @@
+package gateway_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/entity"
+)
+
+func TestInMemoryEventHubDeliversToUser(t *testing.T) {
+	hub := gateway.NewInMemoryEventHub(4)
+	events, unsubscribe := hub.Subscribe(1)
+	defer unsubscribe()
+	otherEvents, otherUnsubscribe := hub.Subscribe(2)
+	defer otherUnsubscribe()
+
+	hub.Publish(&entity.OutboxEvent{ID: 1, UserID: 1, Type: entity.EventTransactionCreated})
+
+	event := <-events
+	assert.Equal(t, 1, event.ID)
+	assert.Len(t, otherEvents, 0)
+}
+
+func TestInMemoryEventHubUnsubscribe(t *testing.T) {
+	hub := gateway.NewInMemoryEventHub(4)
+	events, unsubscribe := hub.Subscribe(1)
+	unsubscribe()
+	// 2回目の呼び出しでもパニックしない
+	unsubscribe()
+
+	hub.Publish(&entity.OutboxEvent{ID: 1, UserID: 1})
+	_, ok := <-events
+	assert.False(t, ok)
+}
+
+func TestInMemoryEventHubClosesSlowSubscriber(t *testing.T) {
+	hub := gateway.NewInMemoryEventHub(1)
+	events, unsubscribe := hub.Subscribe(1)
+	defer unsubscribe()
+
+	hub.Publish(&entity.OutboxEvent{ID: 1, UserID: 1})
+	hub.Publish(&entity.OutboxEvent{ID: 2, UserID: 1})
+
+	event, ok := <-events
+	assert.True(t, ok)
+	assert.Equal(t, 1, event.ID)
+	_, ok = <-events
+	assert.False(t, ok)
+}
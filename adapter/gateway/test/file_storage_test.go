@@ -0,0 +1,71 @@
+package gateway_test
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"household-account-backend/adapter/gateway"
+)
+
+type LocalFileStorageSuite struct {
+	suite.Suite
+	storage gateway.FileStorage
+}
+
+func TestLocalFileStorageSuite(t *testing.T) {
+	suite.Run(t, new(LocalFileStorageSuite))
+}
+
+func (suite *LocalFileStorageSuite) SetupTest() {
+	suite.storage = gateway.NewLocalFileStorage(suite.T().TempDir())
+}
+
+func (suite *LocalFileStorageSuite) TestPutAndOpen() {
+	size, err := suite.storage.Put("users/1/receipt.txt", strings.NewReader("hello"))
+	suite.Require().Nil(err)
+	suite.Assert().Equal(int64(5), size)
+
+	file, err := suite.storage.Open("users/1/receipt.txt")
+	suite.Require().Nil(err)
+	defer file.Close()
+	data, err := io.ReadAll(file)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal("hello", string(data))
+}
+
+func (suite *LocalFileStorageSuite) TestOpenNotFound() {
+	_, err := suite.storage.Open("users/1/missing.txt")
+	suite.Assert().ErrorIs(err, gateway.ErrFileNotFound)
+}
+
+func (suite *LocalFileStorageSuite) TestInvalidKey() {
+	_, err := suite.storage.Put("../outside.txt", strings.NewReader("x"))
+	suite.Assert().NotNil(err)
+	_, err = suite.storage.Put("/etc/outside.txt", strings.NewReader("x"))
+	suite.Assert().NotNil(err)
+}
+
+func (suite *LocalFileStorageSuite) TestListAndDeletePrefix() {
+	for _, key := range []string{"users/1/a.txt", "users/1/exports/1.zip", "users/2/b.txt"} {
+		_, err := suite.storage.Put(key, strings.NewReader(key))
+		suite.Require().Nil(err)
+	}
+
+	keys, err := suite.storage.List(gateway.UserFilePrefix(1))
+	suite.Require().Nil(err)
+	suite.Assert().ElementsMatch([]string{"users/1/a.txt", "users/1/exports/1.zip"}, keys)
+
+	deleted, err := suite.storage.DeletePrefix(gateway.UserFilePrefix(1))
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(2, deleted)
+
+	keys, err = suite.storage.List(gateway.UserFilePrefix(1))
+	suite.Assert().Nil(err)
+	suite.Assert().Empty(keys)
+	keys, err = suite.storage.List(gateway.UserFilePrefix(2))
+	suite.Assert().Nil(err)
+	suite.Assert().Equal([]string{"users/2/b.txt"}, keys)
+}
@@ -0,0 +1,48 @@
+package gateway_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/infrastructure/database"
+	"household-account-backend/pkg/tester"
+)
+
+type HealthRepositorySuite struct {
+	tester.DBSuite
+	repository gateway.HealthRepository
+}
+
+func TestHealthRepositorySuite(t *testing.T) {
+	suite.Run(t, new(HealthRepositorySuite))
+}
+
+func TestHealthRepositorySuitePostgreSQL(t *testing.T) {
+	suite.Run(t, &HealthRepositorySuite{DBSuite: tester.DBSuite{Driver: database.DriverPostgreSQL}})
+}
+
+func (suite *HealthRepositorySuite) SetupSuite() {
+	suite.DBSuite.SetupSuite()
+	suite.repository = gateway.NewHealthRepository(suite.DB)
+}
+
+func (suite *HealthRepositorySuite) TestPing() {
+	suite.Assert().Nil(suite.repository.Ping(context.Background()))
+
+	// 期限の切れたコンテキストでは確認できない
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	suite.Assert().NotNil(suite.repository.Ping(ctx))
+}
+
+func (suite *HealthRepositorySuite) TestPoolStats() {
+	suite.Require().Nil(suite.repository.Ping(context.Background()))
+
+	stats, err := suite.repository.PoolStats()
+	suite.Require().Nil(err)
+	suite.Assert().Positive(stats.OpenConnections)
+	suite.Assert().Equal(stats.OpenConnections, stats.InUse+stats.Idle)
+}
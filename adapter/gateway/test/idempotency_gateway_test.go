@@ -0,0 +1,98 @@
+package gateway_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/entity"
+	"household-account-backend/infrastructure/database"
+	"household-account-backend/pkg/tester"
+)
+
+type IdempotencyRepositorySuite struct {
+	tester.DBSuite
+	repository gateway.IdempotencyRepository
+}
+
+func TestIdempotencyRepositorySuite(t *testing.T) {
+	suite.Run(t, new(IdempotencyRepositorySuite))
+}
+
+func TestIdempotencyRepositorySuitePostgreSQL(t *testing.T) {
+	suite.Run(t, &IdempotencyRepositorySuite{DBSuite: tester.DBSuite{Driver: database.DriverPostgreSQL}})
+}
+
+func (suite *IdempotencyRepositorySuite) SetupSuite() {
+	suite.DBSuite.SetupSuite()
+	suite.repository = gateway.NewIdempotencyRepository(suite.DB)
+}
+
+func (suite *IdempotencyRepositorySuite) TestIdempotencyRepositoryCRUD() {
+	record, err := suite.repository.CreateRecord(&entity.IdempotencyRecord{
+		UserID:         1,
+		IdempotencyKey: "key-1",
+		RequestHash:    "hash",
+		ExpiresAt:      time.Now().Add(time.Hour),
+	})
+	suite.Assert().Nil(err)
+	suite.Assert().NotZero(record.ID)
+
+	// 他のユーザーの同じキーは別物として扱う
+	otherUserRecord, err := suite.repository.GetRecord(2, "key-1")
+	suite.Assert().Nil(err)
+	suite.Assert().Nil(otherUserRecord)
+
+	record.StatusCode = 201
+	record.ResponseHeaders = `{"Content-Type":"application/json"}`
+	record.ResponseBody = `{"id":1}`
+	suite.Assert().Nil(suite.repository.SaveResponse(record))
+
+	getRecord, err := suite.repository.GetRecord(1, "key-1")
+	suite.Assert().Nil(err)
+	suite.Assert().True(getRecord.Completed())
+	suite.Assert().Equal(`{"id":1}`, getRecord.ResponseBody)
+
+	suite.Assert().Nil(suite.repository.DeleteRecord(record.ID))
+	deletedRecord, err := suite.repository.GetRecord(1, "key-1")
+	suite.Assert().Nil(err)
+	suite.Assert().Nil(deletedRecord)
+}
+
+func (suite *IdempotencyRepositorySuite) TestCreateRecordDuplicateKey() {
+	newRecord := func(userID int) *entity.IdempotencyRecord {
+		return &entity.IdempotencyRecord{UserID: userID, IdempotencyKey: "duplicate-key", RequestHash: "hash", ExpiresAt: time.Now().Add(time.Hour)}
+	}
+	_, err := suite.repository.CreateRecord(newRecord(1))
+	suite.Require().Nil(err)
+
+	// 同時に同じキーで予約された場合は一意制約で片方を失敗させる
+	record, err := suite.repository.CreateRecord(newRecord(1))
+	suite.Assert().Nil(record)
+	suite.Assert().ErrorIs(err, gateway.ErrDuplicateKey)
+
+	_, err = suite.repository.CreateRecord(newRecord(2))
+	suite.Assert().Nil(err)
+}
+
+func (suite *IdempotencyRepositorySuite) TestDeleteExpiredRecords() {
+	now := time.Now()
+	_, err := suite.repository.CreateRecord(&entity.IdempotencyRecord{
+		UserID: 1, IdempotencyKey: "expired", RequestHash: "hash", ExpiresAt: now.Add(-time.Minute),
+	})
+	suite.Assert().Nil(err)
+	_, err = suite.repository.CreateRecord(&entity.IdempotencyRecord{
+		UserID: 1, IdempotencyKey: "active", RequestHash: "hash", ExpiresAt: now.Add(time.Hour),
+	})
+	suite.Assert().Nil(err)
+
+	deleted, err := suite.repository.DeleteExpiredRecords(now)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(int64(1), deleted)
+
+	activeRecord, err := suite.repository.GetRecord(1, "active")
+	suite.Assert().Nil(err)
+	suite.Assert().NotNil(activeRecord)
+}
@@ -0,0 +1,91 @@
+package gateway_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/infrastructure/database"
+	"household-account-backend/pkg/tester"
+)
+
+// testLoginAttemptStore はインメモリとDBのどちらの実装でも同じ振る舞いになることを確認する
+func testLoginAttemptStore(s *suite.Suite, store gateway.LoginAttemptStore) {
+	now := time.Now().Truncate(time.Second)
+
+	attempt, err := store.GetAttempt("account:test@example.com")
+	s.Assert().Nil(err)
+	s.Assert().Nil(attempt)
+
+	for i := 1; i <= 3; i++ {
+		attempt, err = store.RecordFailure("account:test@example.com", now.Add(time.Duration(i)*time.Second), now.Add(-time.Hour))
+		s.Require().Nil(err)
+		s.Assert().Equal(i, attempt.Failures)
+	}
+	s.Assert().False(attempt.Locked(now))
+
+	// 他のキーの失敗回数には影響しない
+	other, err := store.RecordFailure("ip:192.0.2.1", now, now.Add(-time.Hour))
+	s.Assert().Nil(err)
+	s.Assert().Equal(1, other.Failures)
+
+	// 最後の失敗がresetBeforeより前の場合は1からやり直す
+	attempt, err = store.RecordFailure("account:test@example.com", now.Add(2*time.Hour), now.Add(time.Hour))
+	s.Assert().Nil(err)
+	s.Assert().Equal(1, attempt.Failures)
+
+	s.Assert().Nil(store.Lock("account:test@example.com", now.Add(3*time.Hour)))
+	attempt, err = store.GetAttempt("account:test@example.com")
+	s.Assert().Nil(err)
+	s.Assert().True(attempt.Locked(now.Add(2 * time.Hour)))
+	s.Assert().False(attempt.Locked(now.Add(3 * time.Hour)))
+
+	s.Assert().Nil(store.Reset("account:test@example.com"))
+	attempt, err = store.GetAttempt("account:test@example.com")
+	s.Assert().Nil(err)
+	s.Assert().Nil(attempt)
+	other, err = store.GetAttempt("ip:192.0.2.1")
+	s.Assert().Nil(err)
+	s.Assert().Equal(1, other.Failures)
+}
+
+type InMemoryLoginAttemptStoreSuite struct {
+	suite.Suite
+}
+
+func TestInMemoryLoginAttemptStoreSuite(t *testing.T) {
+	suite.Run(t, new(InMemoryLoginAttemptStoreSuite))
+}
+
+func (suite *InMemoryLoginAttemptStoreSuite) TestLoginAttemptStore() {
+	testLoginAttemptStore(&suite.Suite, gateway.NewInMemoryLoginAttemptStore())
+}
+
+type LoginAttemptRepositorySuite struct {
+	tester.DBSuite
+}
+
+func TestLoginAttemptRepositorySuite(t *testing.T) {
+	suite.Run(t, new(LoginAttemptRepositorySuite))
+}
+
+func TestLoginAttemptRepositorySuitePostgreSQL(t *testing.T) {
+	suite.Run(t, &LoginAttemptRepositorySuite{DBSuite: tester.DBSuite{Driver: database.DriverPostgreSQL}})
+}
+
+func (suite *LoginAttemptRepositorySuite) TestLoginAttemptStore() {
+	testLoginAttemptStore(&suite.Suite, gateway.NewLoginAttemptRepository(suite.DB))
+}
+
+func (suite *LoginAttemptRepositorySuite) TestLockWithoutFailures() {
+	repository := gateway.NewLoginAttemptRepository(suite.DB)
+	until := time.Now().Add(time.Hour)
+
+	suite.Assert().Nil(repository.Lock("account:locked@example.com", until))
+	attempt, err := repository.GetAttempt("account:locked@example.com")
+	suite.Assert().Nil(err)
+	suite.Assert().True(attempt.Locked(time.Now()))
+	suite.Assert().Equal(0, attempt.Failures)
+}
@@ -8,11 +8,12 @@ import (
 
 	"household-account-backend/adapter/gateway"
 	"household-account-backend/entity"
+	"household-account-backend/infrastructure/database"
 	"household-account-backend/pkg/tester"
 )
 
 type MonthlySummaryRepositorySuite struct {
-	tester.DBSQLiteSuite
+	tester.DBSuite
 	repository gateway.MonthlySummaryRepository
 }
 
@@ -20,8 +21,12 @@ func TestMonthlySummaryRepositorySuite(t *testing.T) {
 	suite.Run(t, new(MonthlySummaryRepositorySuite))
 }
 
+func TestMonthlySummaryRepositorySuitePostgreSQL(t *testing.T) {
+	suite.Run(t, &MonthlySummaryRepositorySuite{DBSuite: tester.DBSuite{Driver: database.DriverPostgreSQL}})
+}
+
 func (suite *MonthlySummaryRepositorySuite) SetupSuite() {
-	suite.DBSQLiteSuite.SetupSuite()
+	suite.DBSuite.SetupSuite()
 	suite.repository = gateway.NewMonthlySummaryRepository(suite.DB)
 }
 
@@ -49,7 +54,7 @@ func (suite *MonthlySummaryRepositorySuite) TestMonthlySummaryRepositoryCRUD() {
 	suite.Assert().Equal(5000.00, createdSummary.Income)
 	suite.Assert().Equal(2000.00, createdSummary.Balance)
 
-	getSummary, err := suite.repository.GetMonthlySummaryByID(createdSummary.ID)
+	getSummary, err := suite.repository.GetMonthlySummaryByID(createdSummary.UserID, createdSummary.ID)
 	suite.Assert().Nil(err)
 	suite.Assert().Equal(5000.00, getSummary.Income)
 	suite.Assert().Equal(2000.00, getSummary.Balance)
@@ -58,10 +63,11 @@ func (suite *MonthlySummaryRepositorySuite) TestMonthlySummaryRepositoryCRUD() {
 	updatedSummary, err := suite.repository.UpdateMonthlySummary(getSummary)
 	suite.Assert().Nil(err)
 	suite.Assert().Equal(3500.00, updatedSummary.Expense)
+	suite.Assert().Equal(2, updatedSummary.Version)
 
-	err = suite.repository.DeleteMonthlySummary(createdSummary.ID)
+	err = suite.repository.DeleteMonthlySummary(createdSummary.UserID, createdSummary.ID, updatedSummary.Version)
 	suite.Assert().Nil(err)
-	deletedSummary, err := suite.repository.GetMonthlySummaryByID(createdSummary.ID)
+	deletedSummary, err := suite.repository.GetMonthlySummaryByID(createdSummary.UserID, createdSummary.ID)
 	suite.Assert().Nil(deletedSummary)
 	suite.Assert().Equal("record not found", err.Error())
 }
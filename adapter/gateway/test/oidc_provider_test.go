@@ -0,0 +1,68 @@
+package gateway_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/pkg/tester"
+)
+
+type OIDCProviderSuite struct {
+	suite.Suite
+	server   *tester.MockOIDCServer
+	provider gateway.OIDCProvider
+}
+
+func TestOIDCProviderSuite(t *testing.T) {
+	suite.Run(t, new(OIDCProviderSuite))
+}
+
+const testOIDCRedirectURL = "http://localhost:8080/api/v1/auth/oidc/mock/callback"
+
+func (suite *OIDCProviderSuite) SetupTest() {
+	suite.server = tester.NewMockOIDCServer()
+	suite.provider = gateway.NewOIDCProvider("mock", suite.server.URL, suite.server.ClientID, suite.server.ClientSecret, testOIDCRedirectURL, []string{"email", "profile"})
+}
+
+func (suite *OIDCProviderSuite) TearDownTest() {
+	suite.server.Close()
+}
+
+func (suite *OIDCProviderSuite) authorize(state string, nonce string, codeVerifier string) string {
+	authURL, err := suite.provider.AuthCodeURL(context.Background(), state, nonce, codeVerifier)
+	suite.Require().Nil(err)
+	suite.Assert().Contains(authURL, "code_challenge_method=S256")
+
+	callback, err := suite.server.Authorize(authURL)
+	suite.Require().Nil(err)
+	suite.Assert().Equal(state, callback.Query().Get("state"))
+	return callback.Query().Get("code")
+}
+
+func (suite *OIDCProviderSuite) TestExchange() {
+	code := suite.authorize("state", "nonce", "verifier-0123456789012345678901234567890123")
+
+	userInfo, err := suite.provider.Exchange(context.Background(), code, "verifier-0123456789012345678901234567890123", "nonce")
+	suite.Assert().Nil(err)
+	suite.Assert().Equal("mock-user", userInfo.Subject)
+	suite.Assert().Equal("mock@example.com", userInfo.Email)
+	suite.Assert().True(userInfo.EmailVerified)
+	suite.Assert().Equal("Mock User", userInfo.Name)
+}
+
+func (suite *OIDCProviderSuite) TestExchangeRejectsWrongCodeVerifier() {
+	code := suite.authorize("state", "nonce", "verifier-0123456789012345678901234567890123")
+
+	_, err := suite.provider.Exchange(context.Background(), code, "another-verifier-01234567890123456789012345", "nonce")
+	suite.Assert().NotNil(err)
+}
+
+func (suite *OIDCProviderSuite) TestExchangeRejectsWrongNonce() {
+	code := suite.authorize("state", "nonce", "verifier-0123456789012345678901234567890123")
+
+	_, err := suite.provider.Exchange(context.Background(), code, "verifier-0123456789012345678901234567890123", "another-nonce")
+	suite.Assert().ErrorIs(err, gateway.ErrInvalidIDToken)
+}
@@ -0,0 +1,130 @@
+package gateway_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/entity"
+	"household-account-backend/infrastructure/database"
+	"household-account-backend/pkg/tester"
+)
+
+type OutboxRepositorySuite struct {
+	tester.DBSuite
+	repository gateway.OutboxRepository
+}
+
+func TestOutboxRepositorySuite(t *testing.T) {
+	suite.Run(t, new(OutboxRepositorySuite))
+}
+
+func TestOutboxRepositorySuitePostgreSQL(t *testing.T) {
+	suite.Run(t, &OutboxRepositorySuite{DBSuite: tester.DBSuite{Driver: database.DriverPostgreSQL}})
+}
+
+func (suite *OutboxRepositorySuite) SetupSuite() {
+	suite.DBSuite.SetupSuite()
+	suite.repository = gateway.NewOutboxRepository(suite.DB)
+}
+
+func (suite *OutboxRepositorySuite) SetupTest() {
+	suite.Require().Nil(suite.DB.Where("1 = 1").Delete(&entity.OutboxEvent{}).Error)
+}
+
+func (suite *OutboxRepositorySuite) createEvents(count int) []*entity.OutboxEvent {
+	events := make([]*entity.OutboxEvent, count)
+	for i := range events {
+		event, err := suite.repository.CreateEvent(&entity.OutboxEvent{UserID: 1, Type: entity.EventTransactionCreated, Payload: "{}"})
+		suite.Require().Nil(err)
+		events[i] = event
+	}
+	return events
+}
+
+func (suite *OutboxRepositorySuite) TestClaimPendingEventsIsExclusive() {
+	suite.createEvents(3)
+
+	first, err := suite.repository.ClaimPendingEvents(2, 5, time.Minute)
+	suite.Assert().Nil(err)
+	suite.Assert().Len(first, 2)
+
+	// 他のワーカーは取得済みのイベントを取得しない
+	second, err := suite.repository.ClaimPendingEvents(10, 5, time.Minute)
+	suite.Assert().Nil(err)
+	suite.Assert().Len(second, 1)
+	suite.Assert().NotEqual(first[0].ID, second[0].ID)
+	suite.Assert().NotEqual(first[1].ID, second[0].ID)
+
+	third, err := suite.repository.ClaimPendingEvents(10, 5, time.Minute)
+	suite.Assert().Nil(err)
+	suite.Assert().Empty(third)
+}
+
+func (suite *OutboxRepositorySuite) TestClaimPendingEventsAfterRelease() {
+	events := suite.createEvents(2)
+
+	claimed, err := suite.repository.ClaimPendingEvents(10, 2, time.Minute)
+	suite.Assert().Nil(err)
+	suite.Assert().Len(claimed, 2)
+
+	// 配信に失敗したイベントは次の取得で再試行し、配信済みのイベントは取得しない
+	suite.Assert().Nil(suite.repository.IncrementEventAttempts(events[0].ID))
+	suite.Assert().Nil(suite.repository.MarkEventProcessed(events[1].ID))
+	retried, err := suite.repository.ClaimPendingEvents(10, 2, time.Minute)
+	suite.Assert().Nil(err)
+	suite.Assert().Len(retried, 1)
+	suite.Assert().Equal(events[0].ID, retried[0].ID)
+	suite.Assert().Equal(1, retried[0].Attempts)
+
+	// 試行回数の上限に達したイベントは取得しない
+	suite.Assert().Nil(suite.repository.IncrementEventAttempts(events[0].ID))
+	exhausted, err := suite.repository.ClaimPendingEvents(10, 2, time.Minute)
+	suite.Assert().Nil(err)
+	suite.Assert().Empty(exhausted)
+}
+
+func (suite *OutboxRepositorySuite) TestClaimPendingEventsExpiredClaim() {
+	suite.createEvents(1)
+
+	// ワーカーが停止して期限が切れたイベントは他のワーカーが取得できる
+	claimed, err := suite.repository.ClaimPendingEvents(10, 5, -time.Second)
+	suite.Assert().Nil(err)
+	suite.Assert().Len(claimed, 1)
+
+	reclaimed, err := suite.repository.ClaimPendingEvents(10, 5, time.Minute)
+	suite.Assert().Nil(err)
+	suite.Assert().Len(reclaimed, 1)
+}
+
+func (suite *OutboxRepositorySuite) TestGetEventsAfterIncludesLateCommits() {
+	events := suite.createEvents(4)
+	now := time.Now()
+	suite.Require().Nil(suite.DB.Model(&entity.OutboxEvent{}).Where("id = ?", events[0].ID).Update("created_at", now.Add(-time.Hour)).Error)
+	for _, event := range events[1:] {
+		suite.Require().Nil(suite.DB.Model(&entity.OutboxEvent{}).Where("id = ?", event.ID).Update("created_at", now).Error)
+	}
+
+	// events[1]はevents[2]の直前に作成されたため、後からコミットされた可能性があるものとして含める
+	replay, err := suite.repository.GetEventsAfter(1, events[2].ID, time.Minute, 10)
+	suite.Assert().Nil(err)
+	ids := []int{}
+	for _, event := range replay {
+		ids = append(ids, event.ID)
+	}
+	suite.Assert().Equal([]int{events[1].ID, events[2].ID, events[3].ID}, ids)
+
+	// 他のユーザーのイベントは含めない
+	replay, err = suite.repository.GetEventsAfter(2, events[2].ID, time.Minute, 10)
+	suite.Assert().Nil(err)
+	suite.Assert().Empty(replay)
+
+	latestID, err := suite.repository.GetLatestEventID(1)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(events[3].ID, latestID)
+	latestID, err = suite.repository.GetLatestEventID(2)
+	suite.Assert().Nil(err)
+	suite.Assert().Zero(latestID)
+}
@@ -0,0 +1,67 @@
+package gateway_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/entity"
+	"household-account-backend/infrastructure/database"
+	"household-account-backend/pkg/tester"
+)
+
+type PersonalAccessTokenRepositorySuite struct {
+	tester.DBSuite
+	repository gateway.PersonalAccessTokenRepository
+}
+
+func TestPersonalAccessTokenRepositorySuite(t *testing.T) {
+	suite.Run(t, new(PersonalAccessTokenRepositorySuite))
+}
+
+func TestPersonalAccessTokenRepositorySuitePostgreSQL(t *testing.T) {
+	suite.Run(t, &PersonalAccessTokenRepositorySuite{DBSuite: tester.DBSuite{Driver: database.DriverPostgreSQL}})
+}
+
+func (suite *PersonalAccessTokenRepositorySuite) SetupSuite() {
+	suite.DBSuite.SetupSuite()
+	suite.repository = gateway.NewPersonalAccessTokenRepository(suite.DB)
+}
+
+func (suite *PersonalAccessTokenRepositorySuite) TestPersonalAccessTokenCRUD() {
+	token, err := suite.repository.CreateToken(&entity.PersonalAccessToken{
+		UserID:      1,
+		Name:        "import script",
+		TokenPrefix: "hha_0123abcd",
+		TokenHash:   "hash-1",
+		Scopes:      entity.ScopeReadTransactions + "," + entity.ScopeWriteTransactions,
+	})
+	suite.Require().Nil(err)
+	suite.Assert().NotZero(token.ID)
+
+	found, err := suite.repository.GetTokenByHash("hash-1")
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(token.ID, found.ID)
+	suite.Assert().True(found.HasScope(entity.ScopeWriteTransactions))
+	suite.Assert().Nil(found.LastUsedAt)
+
+	missing, err := suite.repository.GetTokenByHash("unknown")
+	suite.Assert().Nil(err)
+	suite.Assert().Nil(missing)
+
+	usedAt := time.Now()
+	suite.Assert().Nil(suite.repository.UpdateLastUsedAt(token.ID, usedAt))
+	tokens, err := suite.repository.GetTokensByUserID(1)
+	suite.Assert().Nil(err)
+	suite.Require().Len(tokens, 1)
+	suite.Assert().WithinDuration(usedAt, *tokens[0].LastUsedAt, time.Second)
+
+	// 他のユーザーのトークンは削除できない
+	suite.Assert().ErrorIs(suite.repository.DeleteToken(2, token.ID), gateway.ErrRecordNotFound)
+	suite.Assert().Nil(suite.repository.DeleteToken(1, token.ID))
+	tokens, err = suite.repository.GetTokensByUserID(1)
+	suite.Assert().Nil(err)
+	suite.Assert().Empty(tokens)
+}
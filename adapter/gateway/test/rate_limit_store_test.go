@@ -0,0 +1,71 @@
+package gateway_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"household-account-backend/adapter/gateway"
+)
+
+type InMemoryRateLimitStoreSuite struct {
+	suite.Suite
+	store gateway.RateLimitStore
+}
+
+func TestInMemoryRateLimitStoreSuite(t *testing.T) {
+	suite.Run(t, new(InMemoryRateLimitStoreSuite))
+}
+
+func (suite *InMemoryRateLimitStoreSuite) SetupTest() {
+	suite.store = gateway.NewInMemoryRateLimitStore()
+}
+
+func (suite *InMemoryRateLimitStoreSuite) TestTakeUntilEmpty() {
+	now := time.Now()
+	for i := 2; i >= 0; i-- {
+		result, err := suite.store.Take("key", 3, time.Minute, now)
+		suite.Require().Nil(err)
+		suite.Assert().True(result.Allowed)
+		suite.Assert().Equal(i, result.Remaining)
+		suite.Assert().Zero(result.RetryAfter)
+	}
+
+	result, err := suite.store.Take("key", 3, time.Minute, now)
+	suite.Assert().Nil(err)
+	suite.Assert().False(result.Allowed)
+	suite.Assert().Equal(0, result.Remaining)
+	// 20秒ごとに1つ補充される
+	suite.Assert().Equal(20*time.Second, result.RetryAfter)
+	suite.Assert().Equal(time.Minute, result.ResetAfter)
+
+	// 他のキーには影響しない
+	other, err := suite.store.Take("other", 3, time.Minute, now)
+	suite.Assert().Nil(err)
+	suite.Assert().True(other.Allowed)
+}
+
+func (suite *InMemoryRateLimitStoreSuite) TestRefill() {
+	now := time.Now()
+	for i := 0; i < 3; i++ {
+		_, err := suite.store.Take("key", 3, time.Minute, now)
+		suite.Require().Nil(err)
+	}
+
+	result, err := suite.store.Take("key", 3, time.Minute, now.Add(10*time.Second))
+	suite.Assert().Nil(err)
+	suite.Assert().False(result.Allowed)
+	suite.Assert().Equal(10*time.Second, result.RetryAfter)
+
+	result, err = suite.store.Take("key", 3, time.Minute, now.Add(20*time.Second))
+	suite.Assert().Nil(err)
+	suite.Assert().True(result.Allowed)
+	suite.Assert().Equal(0, result.Remaining)
+
+	// 容量を超えて補充されない
+	result, err = suite.store.Take("key", 3, time.Minute, now.Add(time.Hour))
+	suite.Assert().Nil(err)
+	suite.Assert().True(result.Allowed)
+	suite.Assert().Equal(2, result.Remaining)
+}
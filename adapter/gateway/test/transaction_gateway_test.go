@@ -4,17 +4,19 @@ import (
 	"errors"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/suite"
 
 	"household-account-backend/adapter/gateway"
 	"household-account-backend/entity"
+	"household-account-backend/infrastructure/database"
 	"household-account-backend/pkg/tester"
 )
 
 type TransactionRepositorySuite struct {
-	tester.DBSQLiteSuite
+	tester.DBSuite
 	repository gateway.TransactionRepository
 }
 
@@ -22,8 +24,12 @@ func TestTransactionRepositorySuite(t *testing.T) {
 	suite.Run(t, new(TransactionRepositorySuite))
 }
 
+func TestTransactionRepositorySuitePostgreSQL(t *testing.T) {
+	suite.Run(t, &TransactionRepositorySuite{DBSuite: tester.DBSuite{Driver: database.DriverPostgreSQL}})
+}
+
 func (suite *TransactionRepositorySuite) SetupSuite() {
-	suite.DBSQLiteSuite.SetupSuite()
+	suite.DBSuite.SetupSuite()
 	suite.repository = gateway.NewTransactionRepository(suite.DB)
 }
 
@@ -41,46 +47,77 @@ func (suite *TransactionRepositorySuite) TestTransactionRepositoryCRUD() {
 	transaction := &entity.Transaction{
 		UserID:     1,
 		CategoryID: 1,
-		Date:       "2024-01-01",
+		Date:       time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
 		Amount:     100.00,
 		Content:    "Groceries",
 	}
 	createdTransaction, err := suite.repository.CreateTransaction(transaction)
 	suite.Assert().Nil(err)
 	suite.Assert().NotZero(createdTransaction.ID)
-	suite.Assert().Equal(100.00, createdTransaction.Amount)
+	suite.Assert().Equal(float32(100.00), createdTransaction.Amount)
 	suite.Assert().Equal("Groceries", createdTransaction.Content)
 
-	getTransaction, err := suite.repository.GetTransactionByID(createdTransaction.ID)
+	getTransaction, err := suite.repository.GetTransactionByID(createdTransaction.UserID, createdTransaction.ID)
 	suite.Assert().Nil(err)
-	suite.Assert().Equal(100.00, getTransaction.Amount)
+	suite.Assert().Equal(float32(100.00), getTransaction.Amount)
 	suite.Assert().Equal("Groceries", getTransaction.Content)
 
 	getTransaction.Amount = 150.00
 	updatedTransaction, err := suite.repository.UpdateTransaction(getTransaction)
 	suite.Assert().Nil(err)
-	suite.Assert().Equal(150.00, updatedTransaction.Amount)
+	suite.Assert().Equal(float32(150.00), updatedTransaction.Amount)
 	suite.Assert().Equal("Groceries", updatedTransaction.Content)
+	suite.Assert().Equal(2, updatedTransaction.Version)
 
-	err = suite.repository.DeleteTransaction(createdTransaction.ID)
+	err = suite.repository.DeleteTransaction(createdTransaction.UserID, createdTransaction.ID, updatedTransaction.Version)
 	suite.Assert().Nil(err)
-	deletedTransaction, err := suite.repository.GetTransactionByID(createdTransaction.ID)
+	deletedTransaction, err := suite.repository.GetTransactionByID(createdTransaction.UserID, createdTransaction.ID)
 	suite.Assert().Nil(deletedTransaction)
 	suite.Assert().Equal("record not found", err.Error())
 }
 
+func (suite *TransactionRepositorySuite) TestFindTransactions() {
+	const userID = 301
+	date := func(day int) time.Time { return time.Date(2024, time.March, day, 0, 0, 0, 0, time.UTC) }
+	for _, transaction := range []*entity.Transaction{
+		{UserID: userID, CategoryID: 1, Date: date(20), Amount: 300},
+		{UserID: userID, CategoryID: 2, Date: date(10), Amount: 200},
+		{UserID: userID, CategoryID: 1, Date: date(1), Amount: 100},
+		{UserID: userID + 1, CategoryID: 1, Date: date(10), Amount: 400},
+	} {
+		_, err := suite.repository.CreateTransaction(transaction)
+		suite.Require().NoError(err)
+	}
+
+	transactions, err := suite.repository.FindTransactions(userID, entity.TransactionFilter{})
+	suite.Require().NoError(err)
+	suite.Require().Len(transactions, 3)
+	suite.Assert().Equal(float32(100), transactions[0].Amount)
+	suite.Assert().Equal(float32(300), transactions[2].Amount)
+
+	from, to := date(5), date(20)
+	transactions, err = suite.repository.FindTransactions(userID, entity.TransactionFilter{From: &from, To: &to, CategoryIDs: []int{1}})
+	suite.Require().NoError(err)
+	suite.Require().Len(transactions, 1)
+	suite.Assert().Equal(float32(300), transactions[0].Amount)
+
+	transactions, err = suite.repository.FindTransactions(userID, entity.TransactionFilter{CategoryIDs: []int{}})
+	suite.Require().NoError(err)
+	suite.Assert().Empty(transactions)
+}
+
 func (suite *TransactionRepositorySuite) TestTransactionCreateFailure() {
 	mockDB := suite.MockDB()
 	mockDB.ExpectBegin()
-	mockDB.ExpectExec(regexp.QuoteMeta("INSERT INTO `transactions` (`user_id`,`category_id`,`date`,`amount`,`content`) VALUES (?,?,?,?,?)")).
-		WithArgs(1, 1, "2024-01-01", 100.00, "Groceries").
+	mockDB.ExpectExec(regexp.QuoteMeta("INSERT INTO `transactions` (`user_id`,`category_id`,`date`,`amount`,`content`,`version`) VALUES (?,?,?,?,?,?)")).
+		WithArgs(1, 1, time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), float32(100.00), "Groceries", 1).
 		WillReturnError(errors.New("create error"))
 	mockDB.ExpectRollback()
 
 	transaction := &entity.Transaction{
 		UserID:     1,
 		CategoryID: 1,
-		Date:       "2024-01-01",
+		Date:       time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
 		Amount:     100.00,
 		Content:    "Groceries",
 	}
@@ -93,11 +130,11 @@ func (suite *TransactionRepositorySuite) TestTransactionCreateFailure() {
 
 func (suite *TransactionRepositorySuite) TestTransactionGetFailure() {
 	mockDB := suite.MockDB()
-	mockDB.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `transactions` WHERE `transactions`.`id` = ? ORDER BY `transactions`.`id` LIMIT ?")).
-		WithArgs(1, 1).
+	mockDB.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `transactions` WHERE id = ? AND user_id = ? ORDER BY `transactions`.`id` LIMIT ?")).
+		WithArgs(1, 1, 1).
 		WillReturnError(errors.New("get error"))
 
-	transaction, err := suite.repository.GetTransactionByID(1)
+	transaction, err := suite.repository.GetTransactionByID(1, 1)
 	suite.Assert().Nil(transaction)
 	suite.Assert().NotNil(err)
 	suite.Assert().Equal("get error", err.Error())
@@ -105,15 +142,15 @@ func (suite *TransactionRepositorySuite) TestTransactionGetFailure() {
 
 func (suite *TransactionRepositorySuite) TestTransactionUpdateFailure() {
 	mockDB := suite.MockDB()
-	mockDB.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `transactions` WHERE `transactions`.`id` = ? ORDER BY `transactions`.`id` LIMIT ?")).
-		WithArgs(1, 1).
+	mockDB.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `transactions` WHERE id = ? AND user_id = ? ORDER BY `transactions`.`id` LIMIT ?")).
+		WithArgs(1, 1, 1).
 		WillReturnError(errors.New("update error"))
 
 	transaction := &entity.Transaction{
 		ID:         1,
 		UserID:     1,
 		CategoryID: 1,
-		Date:       "2024-01-01",
+		Date:       time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
 		Amount:     100.00,
 		Content:    "Groceries",
 	}
@@ -127,11 +164,130 @@ func (suite *TransactionRepositorySuite) TestTransactionUpdateFailure() {
 func (suite *TransactionRepositorySuite) TestTransactionDeleteFailure() {
 	mockDB := suite.MockDB()
 	mockDB.ExpectBegin()
-	mockDB.ExpectExec(regexp.QuoteMeta("DELETE FROM `transactions` WHERE `transactions`.`id` = ?")).WithArgs(1).
+	mockDB.ExpectExec(regexp.QuoteMeta("DELETE FROM `transactions` WHERE (id = ? AND user_id = ?) AND version = ?")).WithArgs(1, 1, 1).
 		WillReturnError(errors.New("delete error"))
 	mockDB.ExpectRollback()
 
-	err := suite.repository.DeleteTransaction(1)
+	err := suite.repository.DeleteTransaction(1, 1, 1)
 	suite.Assert().NotNil(err)
 	suite.Assert().Equal("delete error", err.Error())
 }
+
+func (suite *TransactionRepositorySuite) TestTransactionVersionConflict() {
+	transaction := &entity.Transaction{
+		UserID:     1,
+		CategoryID: 1,
+		Date:       time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC),
+		Amount:     100.00,
+		Content:    "Rent",
+	}
+	createdTransaction, err := suite.repository.CreateTransaction(transaction)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(1, createdTransaction.Version)
+
+	// 先に別のユーザーが更新する
+	first := &entity.Transaction{ID: createdTransaction.ID, UserID: 1, Amount: 200.00, Version: 1}
+	updatedTransaction, err := suite.repository.UpdateTransaction(first)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(2, updatedTransaction.Version)
+
+	// 古いバージョンでの更新・削除は競合となる
+	second := &entity.Transaction{ID: createdTransaction.ID, UserID: 1, Amount: 300.00, Version: 1}
+	conflictedTransaction, err := suite.repository.UpdateTransaction(second)
+	suite.Assert().Nil(conflictedTransaction)
+	suite.Assert().ErrorIs(err, gateway.ErrVersionConflict)
+
+	err = suite.repository.DeleteTransaction(1, createdTransaction.ID, 1)
+	suite.Assert().ErrorIs(err, gateway.ErrVersionConflict)
+
+	getTransaction, err := suite.repository.GetTransactionByID(1, createdTransaction.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(float32(200.00), getTransaction.Amount)
+
+	err = suite.repository.DeleteTransaction(1, createdTransaction.ID+100, 1)
+	suite.Assert().Equal("record not found", err.Error())
+
+	// "*" の指定でも存在しない場合は見つからないエラーとなる
+	err = suite.repository.DeleteTransaction(1, createdTransaction.ID+100, 0)
+	suite.Assert().ErrorIs(err, gateway.ErrRecordNotFound)
+	err = suite.repository.DeleteTransaction(2, createdTransaction.ID, 0)
+	suite.Assert().ErrorIs(err, gateway.ErrRecordNotFound)
+}
+
+func (suite *TransactionRepositorySuite) TestTransactionRunInTransactionRollback() {
+	err := suite.repository.RunInTransaction(func(repository gateway.TransactionRepository, outbox gateway.OutboxRepository) error {
+		_, err := repository.CreateTransaction(&entity.Transaction{
+			UserID:     2,
+			CategoryID: 1,
+			Date:       time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC),
+			Amount:     300.00,
+			Content:    "Rolled back",
+		})
+		suite.Assert().Nil(err)
+		return errors.New("abort")
+	})
+	suite.Assert().Equal("abort", err.Error())
+
+	transactions, err := suite.repository.GetTransactionsByUserID(2)
+	suite.Assert().Nil(err)
+	suite.Assert().Empty(transactions)
+}
+
+func (suite *TransactionRepositorySuite) TestTransactionRunInTransactionSavepoint() {
+	err := suite.repository.RunInTransaction(func(repository gateway.TransactionRepository, outbox gateway.OutboxRepository) error {
+		_, err := repository.CreateTransaction(&entity.Transaction{
+			UserID:     3,
+			CategoryID: 1,
+			Date:       time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC),
+			Amount:     100.00,
+			Content:    "Committed",
+		})
+		suite.Assert().Nil(err)
+
+		// ネストしたトランザクションの失敗は外側のトランザクションに影響しない
+		nestedErr := repository.RunInTransaction(func(nested gateway.TransactionRepository, nestedOutbox gateway.OutboxRepository) error {
+			_, err := nested.CreateTransaction(&entity.Transaction{
+				UserID:     3,
+				CategoryID: 1,
+				Date:       time.Date(2024, time.February, 2, 0, 0, 0, 0, time.UTC),
+				Amount:     200.00,
+				Content:    "Rolled back",
+			})
+			suite.Assert().Nil(err)
+			return errors.New("abort")
+		})
+		suite.Assert().NotNil(nestedErr)
+		return nil
+	})
+	suite.Assert().Nil(err)
+
+	transactions, err := suite.repository.GetTransactionsByUserID(3)
+	suite.Assert().Nil(err)
+	suite.Assert().Len(transactions, 1)
+	suite.Assert().Equal("Committed", transactions[0].Content)
+}
+
+func (suite *TransactionRepositorySuite) TestTransactionRunInTransactionRollbackOutbox() {
+	err := suite.repository.RunInTransaction(func(repository gateway.TransactionRepository, outbox gateway.OutboxRepository) error {
+		created, err := repository.CreateTransaction(&entity.Transaction{
+			UserID:     4,
+			CategoryID: 1,
+			Date:       time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC),
+			Amount:     100.00,
+			Content:    "Rolled back with event",
+		})
+		suite.Assert().Nil(err)
+		_, err = outbox.CreateEvent(&entity.OutboxEvent{UserID: created.UserID, Type: entity.EventTransactionCreated, Payload: "{}"})
+		suite.Assert().Nil(err)
+		return errors.New("abort")
+	})
+	suite.Assert().Equal("abort", err.Error())
+
+	// 取引とイベントは同じトランザクションでロールバックされる
+	var count int64
+	suite.Assert().Nil(suite.DB.Model(&entity.OutboxEvent{}).Where("user_id = ?", 4).Count(&count).Error)
+	suite.Assert().Zero(count)
+	transactions, err := suite.repository.GetTransactionsByUserID(4)
+	suite.Assert().Nil(err)
+	suite.Assert().Empty(transactions)
+}
@@ -0,0 +1,77 @@
+package gateway_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/entity"
+	"household-account-backend/infrastructure/database"
+	"household-account-backend/pkg/tester"
+)
+
+type TwoFactorRepositorySuite struct {
+	tester.DBSuite
+	repository gateway.TwoFactorRepository
+}
+
+func TestTwoFactorRepositorySuite(t *testing.T) {
+	suite.Run(t, new(TwoFactorRepositorySuite))
+}
+
+func TestTwoFactorRepositorySuitePostgreSQL(t *testing.T) {
+	suite.Run(t, &TwoFactorRepositorySuite{DBSuite: tester.DBSuite{Driver: database.DriverPostgreSQL}})
+}
+
+func (suite *TwoFactorRepositorySuite) SetupSuite() {
+	suite.DBSuite.SetupSuite()
+	suite.repository = gateway.NewTwoFactorRepository(suite.DB)
+}
+
+func (suite *TwoFactorRepositorySuite) TestCredentialLifecycle() {
+	_, err := suite.repository.SaveCredential(&entity.TOTPCredential{UserID: 1, Secret: "OLD"})
+	suite.Assert().Nil(err)
+	// 登録をやり直した場合は古いシークレットを置き換える
+	_, err = suite.repository.SaveCredential(&entity.TOTPCredential{UserID: 1, Secret: "NEW"})
+	suite.Assert().Nil(err)
+
+	credential, err := suite.repository.GetCredential(1)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal("NEW", credential.Secret)
+	suite.Assert().False(credential.Enabled())
+
+	suite.Assert().Nil(suite.repository.EnableCredential(1, time.Now(), []string{"hash-1", "hash-2"}))
+	credential, err = suite.repository.GetCredential(1)
+	suite.Assert().Nil(err)
+	suite.Assert().True(credential.Enabled())
+
+	updated, err := suite.repository.UpdateLastUsedStep(1, 100)
+	suite.Assert().Nil(err)
+	suite.Assert().True(updated)
+	updated, err = suite.repository.UpdateLastUsedStep(1, 100)
+	suite.Assert().Nil(err)
+	suite.Assert().False(updated)
+
+	used, err := suite.repository.UseRecoveryCode(1, "hash-1", time.Now())
+	suite.Assert().Nil(err)
+	suite.Assert().True(used)
+	used, err = suite.repository.UseRecoveryCode(1, "hash-1", time.Now())
+	suite.Assert().Nil(err)
+	suite.Assert().False(used)
+
+	// 再発行すると以前のコードは使えなくなる
+	suite.Assert().Nil(suite.repository.ReplaceRecoveryCodes(1, []string{"hash-3"}))
+	used, err = suite.repository.UseRecoveryCode(1, "hash-2", time.Now())
+	suite.Assert().Nil(err)
+	suite.Assert().False(used)
+
+	suite.Assert().Nil(suite.repository.DeleteCredential(1))
+	credential, err = suite.repository.GetCredential(1)
+	suite.Assert().Nil(err)
+	suite.Assert().Nil(credential)
+	used, err = suite.repository.UseRecoveryCode(1, "hash-3", time.Now())
+	suite.Assert().Nil(err)
+	suite.Assert().False(used)
+}
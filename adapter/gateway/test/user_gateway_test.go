@@ -4,17 +4,19 @@ import (
 	"errors"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/suite"
 
 	"household-account-backend/adapter/gateway"
 	"household-account-backend/entity"
+	"household-account-backend/infrastructure/database"
 	"household-account-backend/pkg/tester"
 )
 
 type UserRepositorySuite struct {
-	tester.DBSQLiteSuite
+	tester.DBSuite
 	repository gateway.UserRepository
 }
 
@@ -22,8 +24,12 @@ func TestUserRepositorySuite(t *testing.T) {
 	suite.Run(t, new(UserRepositorySuite))
 }
 
+func TestUserRepositorySuitePostgreSQL(t *testing.T) {
+	suite.Run(t, &UserRepositorySuite{DBSuite: tester.DBSuite{Driver: database.DriverPostgreSQL}})
+}
+
 func (suite *UserRepositorySuite) SetupSuite() {
-	suite.DBSQLiteSuite.SetupSuite()
+	suite.DBSuite.SetupSuite()
 	suite.repository = gateway.NewUserRepository(suite.DB)
 }
 
@@ -70,11 +76,20 @@ func (suite *UserRepositorySuite) TestUserRepositoryCRUD() {
 	suite.Assert().Equal("record not found", err.Error())
 }
 
+func (suite *UserRepositorySuite) TestUserSignupDuplicateEmail() {
+	_, err := suite.repository.Signup(&entity.User{Email: "duplicate@example.com", Password: "password", Name: "First"})
+	suite.Require().Nil(err)
+
+	user, err := suite.repository.Signup(&entity.User{Email: "duplicate@example.com", Password: "password", Name: "Second"})
+	suite.Assert().Nil(user)
+	suite.Assert().ErrorIs(err, gateway.ErrDuplicateKey)
+}
+
 func (suite *UserRepositorySuite) TestUserCreateFailure() {
 	mockDB := suite.MockDB()
 	mockDB.ExpectBegin()
-	mockDB.ExpectExec(regexp.QuoteMeta("INSERT INTO `users` (`email`,`password`,`name`) VALUES (?,?,?)")).
-		WithArgs("fail@example.com", "password", "Jhon").
+	mockDB.ExpectExec(regexp.QuoteMeta("INSERT INTO `users` (`email`,`password`,`name`,`email_verified_at`,`role`,`disabled_at`,`sessions_revoked_at`,`created_at`) VALUES (?,?,?,?,?,?,?,?)")).
+		WithArgs("fail@example.com", "password", "Jhon", nil, entity.UserRoleUser, nil, nil, sqlmock.AnyArg()).
 		WillReturnError(errors.New("create error"))
 	mockDB.ExpectRollback()
 
@@ -132,3 +147,41 @@ func (suite *UserRepositorySuite) TestUserDeleteFailure() {
 	suite.Assert().NotNil(err)
 	suite.Assert().Equal("delete error", err.Error())
 }
+
+func (suite *UserRepositorySuite) TestUserResetPasswordRevokesTokens() {
+	user, err := suite.repository.Signup(&entity.User{Email: "reset@example.com", Password: "old", Name: "Reset"})
+	suite.Require().Nil(err)
+	suite.Require().Nil(suite.DB.Create(&entity.PersonalAccessToken{UserID: user.ID, Name: "cli", TokenHash: "reset-hash", Scopes: "read:categories"}).Error)
+
+	revokedAt := time.Now()
+	err = suite.repository.ResetPassword(user.ID, "new", revokedAt)
+	suite.Assert().Nil(err)
+
+	updatedUser, err := suite.repository.GetCurrentUser(user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal("new", updatedUser.Password)
+	suite.Assert().True(updatedUser.SessionRevoked(revokedAt.Add(-time.Minute)))
+
+	var count int64
+	suite.Assert().Nil(suite.DB.Model(&entity.PersonalAccessToken{}).Where("user_id = ?", user.ID).Count(&count).Error)
+	suite.Assert().Zero(count)
+}
+
+func (suite *UserRepositorySuite) TestUserUpdateEmailClearsVerification() {
+	user, err := suite.repository.Signup(&entity.User{Email: "before@example.com", Password: "password", Name: "Verified"})
+	suite.Require().Nil(err)
+	suite.Require().Nil(suite.repository.MarkEmailVerified(user.ID, time.Now()))
+
+	_, err = suite.repository.UpdateUser(&entity.User{ID: user.ID, Name: "Renamed"})
+	suite.Assert().Nil(err)
+	unchangedUser, err := suite.repository.GetCurrentUser(user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().True(unchangedUser.EmailVerified())
+
+	_, err = suite.repository.UpdateUser(&entity.User{ID: user.ID, Email: "after@example.com"})
+	suite.Assert().Nil(err)
+	changedUser, err := suite.repository.GetCurrentUser(user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal("after@example.com", changedUser.Email)
+	suite.Assert().False(changedUser.EmailVerified())
+}
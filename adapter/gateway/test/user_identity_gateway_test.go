@@ -0,0 +1,74 @@
+package gateway_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/entity"
+	"household-account-backend/infrastructure/database"
+	"household-account-backend/pkg/tester"
+)
+
+type UserIdentityRepositorySuite struct {
+	tester.DBSuite
+	repository gateway.UserIdentityRepository
+}
+
+func TestUserIdentityRepositorySuite(t *testing.T) {
+	suite.Run(t, new(UserIdentityRepositorySuite))
+}
+
+func TestUserIdentityRepositorySuitePostgreSQL(t *testing.T) {
+	suite.Run(t, &UserIdentityRepositorySuite{DBSuite: tester.DBSuite{Driver: database.DriverPostgreSQL}})
+}
+
+func (suite *UserIdentityRepositorySuite) SetupSuite() {
+	suite.DBSuite.SetupSuite()
+	suite.repository = gateway.NewUserIdentityRepository(suite.DB)
+}
+
+func (suite *UserIdentityRepositorySuite) TestIdentityCRUD() {
+	_, err := suite.repository.CreateIdentity(&entity.UserIdentity{UserID: 1, Provider: "google", Subject: "sub-1", Email: "test@example.com"})
+	suite.Assert().Nil(err)
+
+	identity, err := suite.repository.GetIdentity("google", "sub-1")
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(1, identity.UserID)
+
+	otherProvider, err := suite.repository.GetIdentity("keycloak", "sub-1")
+	suite.Assert().Nil(err)
+	suite.Assert().Nil(otherProvider)
+
+	identities, err := suite.repository.GetIdentitiesByUserID(1)
+	suite.Assert().Nil(err)
+	suite.Assert().Len(identities, 1)
+
+	deleted, err := suite.repository.DeleteIdentity(1, "google")
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(int64(1), deleted)
+	deleted, err = suite.repository.DeleteIdentity(1, "google")
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(int64(0), deleted)
+}
+
+func (suite *UserIdentityRepositorySuite) TestConsumeStateOnlyOnce() {
+	_, err := suite.repository.CreateState(&entity.OIDCLoginState{
+		StateHash:    "state-hash",
+		Provider:     "google",
+		CodeVerifier: "verifier",
+		Nonce:        "nonce",
+		ExpiresAt:    time.Now().Add(time.Minute),
+	})
+	suite.Assert().Nil(err)
+
+	state, err := suite.repository.ConsumeState("state-hash")
+	suite.Assert().Nil(err)
+	suite.Assert().Equal("verifier", state.CodeVerifier)
+
+	state, err = suite.repository.ConsumeState("state-hash")
+	suite.Assert().Nil(err)
+	suite.Assert().Nil(state)
+}
@@ -0,0 +1,91 @@
+package gateway_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/entity"
+	"household-account-backend/infrastructure/database"
+	"household-account-backend/pkg/tester"
+)
+
+type UserTokenRepositorySuite struct {
+	tester.DBSuite
+	repository gateway.UserTokenRepository
+}
+
+func TestUserTokenRepositorySuite(t *testing.T) {
+	suite.Run(t, new(UserTokenRepositorySuite))
+}
+
+func TestUserTokenRepositorySuitePostgreSQL(t *testing.T) {
+	suite.Run(t, &UserTokenRepositorySuite{DBSuite: tester.DBSuite{Driver: database.DriverPostgreSQL}})
+}
+
+func (suite *UserTokenRepositorySuite) SetupSuite() {
+	suite.DBSuite.SetupSuite()
+	suite.repository = gateway.NewUserTokenRepository(suite.DB)
+}
+
+func (suite *UserTokenRepositorySuite) TestMarkTokenUsedOnlyOnce() {
+	token, err := suite.repository.CreateToken(&entity.UserToken{
+		UserID:    1,
+		Purpose:   entity.UserTokenPurposeEmailVerification,
+		TokenHash: "hash-1",
+		ExpiresAt: time.Now().Add(time.Hour),
+	})
+	suite.Assert().Nil(err)
+	suite.Assert().NotZero(token.ID)
+
+	// 用途が異なるトークンとしては取得できない
+	otherPurpose, err := suite.repository.GetTokenByHash(entity.UserTokenPurposePasswordReset, "hash-1")
+	suite.Assert().Nil(err)
+	suite.Assert().Nil(otherPurpose)
+
+	used, err := suite.repository.MarkTokenUsed(token.ID, time.Now())
+	suite.Assert().Nil(err)
+	suite.Assert().True(used)
+
+	used, err = suite.repository.MarkTokenUsed(token.ID, time.Now())
+	suite.Assert().Nil(err)
+	suite.Assert().False(used)
+
+	getToken, err := suite.repository.GetTokenByHash(entity.UserTokenPurposeEmailVerification, "hash-1")
+	suite.Assert().Nil(err)
+	suite.Assert().NotNil(getToken.UsedAt)
+	suite.Assert().False(getToken.Usable(time.Now()))
+}
+
+func (suite *UserTokenRepositorySuite) TestInvalidateTokens() {
+	for _, hash := range []string{"reset-1", "reset-2"} {
+		_, err := suite.repository.CreateToken(&entity.UserToken{
+			UserID:    2,
+			Purpose:   entity.UserTokenPurposePasswordReset,
+			TokenHash: hash,
+			ExpiresAt: time.Now().Add(time.Hour),
+		})
+		suite.Assert().Nil(err)
+	}
+	_, err := suite.repository.CreateToken(&entity.UserToken{
+		UserID:    2,
+		Purpose:   entity.UserTokenPurposeEmailVerification,
+		TokenHash: "verify-2",
+		ExpiresAt: time.Now().Add(time.Hour),
+	})
+	suite.Assert().Nil(err)
+
+	suite.Assert().Nil(suite.repository.InvalidateTokens(2, entity.UserTokenPurposePasswordReset, time.Now()))
+
+	for _, hash := range []string{"reset-1", "reset-2"} {
+		token, err := suite.repository.GetTokenByHash(entity.UserTokenPurposePasswordReset, hash)
+		suite.Assert().Nil(err)
+		suite.Assert().NotNil(token.UsedAt)
+	}
+	// 他の用途のトークンは無効化しない
+	verifyToken, err := suite.repository.GetTokenByHash(entity.UserTokenPurposeEmailVerification, "verify-2")
+	suite.Assert().Nil(err)
+	suite.Assert().Nil(verifyToken.UsedAt)
+}
@@ -0,0 +1,71 @@
+package gateway_test
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"household-account-backend/adapter/gateway"
+)
+
+type WebhookSenderSuite struct {
+	suite.Suite
+	sender gateway.WebhookSender
+}
+
+func TestWebhookSenderSuite(t *testing.T) {
+	suite.Run(t, new(WebhookSenderSuite))
+}
+
+func (suite *WebhookSenderSuite) SetupTest() {
+	suite.sender = gateway.NewHTTPWebhookSender(5 * time.Second)
+}
+
+func (suite *WebhookSenderSuite) TestValidateURLRejectsInternalAddresses() {
+	for _, rawURL := range []string{
+		"http://127.0.0.1/hook",
+		"http://localhost:8080/hook",
+		"http://10.0.0.1/hook",
+		"http://172.16.0.1/hook",
+		"http://192.168.1.1/hook",
+		"http://169.254.169.254/latest/meta-data",
+		"http://100.64.0.1/hook",
+		"http://0.0.0.0/hook",
+		"http://[::1]/hook",
+		"http://[fe80::1]/hook",
+		"http://[fd00::1]/hook",
+		"http://[::ffff:127.0.0.1]/hook",
+	} {
+		suite.Assert().ErrorIs(suite.sender.ValidateURL(rawURL), gateway.ErrWebhookAddressNotAllowed, rawURL)
+	}
+}
+
+func (suite *WebhookSenderSuite) TestValidateURLAllowsPublicAddresses() {
+	suite.Assert().Nil(suite.sender.ValidateURL("https://93.184.216.34/hook"))
+	suite.Assert().Nil(suite.sender.ValidateURL("https://[2606:2800:220:1:248:1893:25c8:1946]/hook"))
+}
+
+func (suite *WebhookSenderSuite) TestSendRejectsInternalAddress() {
+	var called bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	// 保存後に名前解決の結果が内部のアドレスに変わった場合も、接続時に拒否する
+	statusCode, err := suite.sender.Send(server.URL, map[string]string{}, []byte("{}"))
+	suite.Assert().ErrorIs(err, gateway.ErrWebhookAddressNotAllowed)
+	suite.Assert().Zero(statusCode)
+	suite.Assert().False(called)
+}
+
+func (suite *WebhookSenderSuite) TestIsPublicIP() {
+	suite.Assert().True(gateway.IsPublicIP(net.ParseIP("8.8.8.8")))
+	suite.Assert().False(gateway.IsPublicIP(net.ParseIP("169.254.169.254")))
+	suite.Assert().False(gateway.IsPublicIP(net.ParseIP("::1")))
+}
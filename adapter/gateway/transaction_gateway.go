@@ -11,8 +11,12 @@ type TransactionRepository interface {
 	CreateTransaction(transaction *entity.Transaction) (*entity.Transaction, error)
 	GetTransactionByID(userID int, transactionID int) (*entity.Transaction, error)
 	GetTransactionsByUserID(userID int) ([]entity.Transaction, error)
+	// FindTransactions は条件に一致する取引を日付の順に返す
+	FindTransactions(userID int, filter entity.TransactionFilter) ([]entity.Transaction, error)
 	UpdateTransaction(transaction *entity.Transaction) (*entity.Transaction, error)
-	DeleteTransaction(userID int, transactionID int) error
+	DeleteTransaction(userID int, transactionID int, version int) error
+	// RunInTransaction はfnに渡したリポジトリとアウトボックスの操作を1つのDBトランザクションで実行する
+	RunInTransaction(fn func(repository TransactionRepository, outbox OutboxRepository) error) error
 }
 
 type transactionRepository struct {
@@ -24,6 +28,7 @@ func NewTransactionRepository(db *gorm.DB) TransactionRepository {
 }
 
 func (tr *transactionRepository) CreateTransaction(transaction *entity.Transaction) (*entity.Transaction, error) {
+	transaction.Version = 1
 	if err := tr.db.Create(transaction).Error; err != nil {
 		return nil, err
 	}
@@ -40,7 +45,26 @@ func (tr *transactionRepository) GetTransactionByID(userID int, transactionID in
 
 func (tr *transactionRepository) GetTransactionsByUserID(userID int) ([]entity.Transaction, error) {
 	var transactions []entity.Transaction
-	if err := tr.db.Where("user_id = ?", userID).Find(&transactions).Error; err != nil {
+	if err := tr.db.Where("user_id = ?", userID).Order("id").Find(&transactions).Error; err != nil {
+		return nil, err
+	}
+	return transactions, nil
+}
+
+func (tr *transactionRepository) FindTransactions(userID int, filter entity.TransactionFilter) ([]entity.Transaction, error) {
+	query := tr.db.Where("user_id = ?", userID)
+	if filter.From != nil {
+		query = query.Where("date >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("date <= ?", *filter.To)
+	}
+	if filter.CategoryIDs != nil {
+		query = query.Where("category_id IN ?", filter.CategoryIDs)
+	}
+
+	var transactions []entity.Transaction
+	if err := query.Order("date, id").Find(&transactions).Error; err != nil {
 		return nil, err
 	}
 	return transactions, nil
@@ -53,23 +77,56 @@ func (tr *transactionRepository) UpdateTransaction(transaction *entity.Transacti
 		return nil, err
 	}
 
+	// バージョンが指定されていない場合は取得時点のバージョンを条件にする
+	expectedVersion := transaction.Version
+	if expectedVersion == 0 {
+		expectedVersion = selectedTransaction.Version
+	}
+
 	// フィールドをコピー（空の値を無視）
 	if err := copier.CopyWithOption(selectedTransaction, transaction, copier.Option{IgnoreEmpty: true, DeepCopy: true}); err != nil {
 		return nil, err
 	}
+	selectedTransaction.Version = expectedVersion + 1
 
-	// 更新
-	if err := tr.db.Save(selectedTransaction).Error; err != nil {
-		return nil, err
+	// バージョンが一致する場合のみ更新
+	result := tr.db.Model(&entity.Transaction{}).
+		Where("id = ? AND user_id = ? AND version = ?", selectedTransaction.ID, selectedTransaction.UserID, expectedVersion).
+		Select("*").Updates(selectedTransaction)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, ErrVersionConflict
 	}
 
 	return selectedTransaction, nil
 }
 
-
-func (tr *transactionRepository) DeleteTransaction(userID int, transactionID int) error {
-	if err := tr.db.Where("id = ? AND user_id = ?", transactionID, userID).Delete(&entity.Transaction{}).Error; err != nil {
-		return err
+func (tr *transactionRepository) DeleteTransaction(userID int, transactionID int, version int) error {
+	query := tr.db.Where("id = ? AND user_id = ?", transactionID, userID)
+	if version != 0 {
+		query = query.Where("version = ?", version)
+	}
+	result := query.Delete(&entity.Transaction{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		// 存在しない場合は "*" の指定でもGetと同じエラーを返す
+		if _, err := tr.GetTransactionByID(userID, transactionID); err != nil {
+			return err
+		}
+		return ErrVersionConflict
 	}
 	return nil
 }
+
+// RunInTransaction はfnに渡したリポジトリの操作を1つのDBトランザクションで実行する
+// 既にトランザクション内で呼び出された場合はセーブポイントを使ったネストしたトランザクションになる
+// イベントは同じトランザクションのoutboxに保存し、データの変更と一緒にコミット・ロールバックする
+func (tr *transactionRepository) RunInTransaction(fn func(repository TransactionRepository, outbox OutboxRepository) error) error {
+	return tr.db.Transaction(func(tx *gorm.DB) error {
+		return fn(&transactionRepository{tx}, &outboxRepository{tx})
+	})
+}
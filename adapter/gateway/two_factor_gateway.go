@@ -0,0 +1,120 @@
+package gateway
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"household-account-backend/entity"
+)
+
+type TwoFactorRepository interface {
+	// GetCredential は登録されていない場合はnilを返す
+	GetCredential(userID int) (*entity.TOTPCredential, error)
+	// SaveCredential は登録確認待ちのシークレットを保存する。既存の設定は置き換える
+	SaveCredential(credential *entity.TOTPCredential) (*entity.TOTPCredential, error)
+	// EnableCredential は二要素認証を有効にし、リカバリーコードを登録する
+	EnableCredential(userID int, enabledAt time.Time, recoveryCodeHashes []string) error
+	DeleteCredential(userID int) error
+	// UpdateLastUsedStep は最後に使用したステップより新しい場合のみ更新し、更新できなかった場合はfalseを返す
+	UpdateLastUsedStep(userID int, step int64) (bool, error)
+	ReplaceRecoveryCodes(userID int, recoveryCodeHashes []string) error
+	// UseRecoveryCode は未使用のリカバリーコードを使用済みにし、該当するコードがない場合はfalseを返す
+	UseRecoveryCode(userID int, codeHash string, usedAt time.Time) (bool, error)
+}
+
+type twoFactorRepository struct {
+	db *gorm.DB
+}
+
+func NewTwoFactorRepository(db *gorm.DB) TwoFactorRepository {
+	return &twoFactorRepository{db}
+}
+
+func (tr *twoFactorRepository) GetCredential(userID int) (*entity.TOTPCredential, error) {
+	var credentials []entity.TOTPCredential
+	if err := tr.db.Where("user_id = ?", userID).Limit(1).Find(&credentials).Error; err != nil {
+		return nil, err
+	}
+	if len(credentials) == 0 {
+		return nil, nil
+	}
+	return &credentials[0], nil
+}
+
+func (tr *twoFactorRepository) SaveCredential(credential *entity.TOTPCredential) (*entity.TOTPCredential, error) {
+	err := tr.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", credential.UserID).Delete(&entity.TOTPCredential{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(credential).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return credential, nil
+}
+
+func (tr *twoFactorRepository) EnableCredential(userID int, enabledAt time.Time, recoveryCodeHashes []string) error {
+	return tr.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&entity.TOTPCredential{}).
+			Where("user_id = ? AND enabled_at IS NULL", userID).
+			Update("enabled_at", enabledAt)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrRecordNotFound
+		}
+		return replaceRecoveryCodes(tx, userID, recoveryCodeHashes)
+	})
+}
+
+func (tr *twoFactorRepository) DeleteCredential(userID int) error {
+	return tr.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Delete(&entity.TOTPCredential{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("user_id = ?", userID).Delete(&entity.RecoveryCode{}).Error
+	})
+}
+
+func (tr *twoFactorRepository) UpdateLastUsedStep(userID int, step int64) (bool, error) {
+	result := tr.db.Model(&entity.TOTPCredential{}).
+		Where("user_id = ? AND last_used_step < ?", userID, step).
+		Update("last_used_step", step)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+func (tr *twoFactorRepository) ReplaceRecoveryCodes(userID int, recoveryCodeHashes []string) error {
+	return tr.db.Transaction(func(tx *gorm.DB) error {
+		return replaceRecoveryCodes(tx, userID, recoveryCodeHashes)
+	})
+}
+
+func (tr *twoFactorRepository) UseRecoveryCode(userID int, codeHash string, usedAt time.Time) (bool, error) {
+	result := tr.db.Model(&entity.RecoveryCode{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userID, codeHash).
+		Update("used_at", usedAt)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func replaceRecoveryCodes(tx *gorm.DB, userID int, recoveryCodeHashes []string) error {
+	if err := tx.Where("user_id = ?", userID).Delete(&entity.RecoveryCode{}).Error; err != nil {
+		return err
+	}
+	codes := make([]entity.RecoveryCode, 0, len(recoveryCodeHashes))
+	for _, hash := range recoveryCodeHashes {
+		codes = append(codes, entity.RecoveryCode{UserID: userID, CodeHash: hash})
+	}
+	if len(codes) == 0 {
+		return nil
+	}
+	return tx.Create(&codes).Error
+}
@@ -1,6 +1,8 @@
 package gateway
 
 import (
+	"time"
+
 	"github.com/jinzhu/copier"
 	"gorm.io/gorm"
 
@@ -17,6 +19,9 @@ type UserRepository interface {
 	UpdateUser(*entity.User) (*entity.User, error)
 	DeleteUser(userId int) error
 	GetUserByEmail(email string) (*entity.User, error)
+	MarkEmailVerified(userId int, verifiedAt time.Time) error
+	// ResetPassword はパスワードを更新し、revokedAt以前に発行した認証トークンとパーソナルアクセストークンを同じトランザクションで無効にする
+	ResetPassword(userId int, hashedPassword string, revokedAt time.Time) error
 }
 
 type userRepository struct {
@@ -55,9 +60,14 @@ func (ur *userRepository) UpdateUser(user *entity.User) (*entity.User, error) {
 		return nil, err
 	}
 
+	emailChanged := user.Email != "" && user.Email != selectedUser.Email
 	if err := copier.CopyWithOption(selectedUser, user, copier.Option{IgnoreEmpty: true, DeepCopy: true}); err != nil {
 		return nil, err
 	}
+	// 新しいメールアドレスは所有を確認するまで未確認として扱う
+	if emailChanged {
+		selectedUser.EmailVerifiedAt = nil
+	}
 	if err := ur.db.Save(&selectedUser).Error; err != nil {
 		return nil, err
 	}
@@ -72,3 +82,19 @@ func (ur *userRepository) GetUserByEmail(email string) (*entity.User, error) {
 	}
 	return &user, nil
 }
+
+func (ur *userRepository) MarkEmailVerified(userId int, verifiedAt time.Time) error {
+	return ur.db.Model(&entity.User{}).Where("id = ?", userId).Update("email_verified_at", verifiedAt).Error
+}
+
+func (ur *userRepository) ResetPassword(userId int, hashedPassword string, revokedAt time.Time) error {
+	return ur.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&entity.User{}).Where("id = ?", userId).Updates(map[string]interface{}{
+			"password":            hashedPassword,
+			"sessions_revoked_at": revokedAt,
+		}).Error; err != nil {
+			return err
+		}
+		return tx.Where("user_id = ?", userId).Delete(&entity.PersonalAccessToken{}).Error
+	})
+}
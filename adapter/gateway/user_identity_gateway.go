@@ -0,0 +1,92 @@
+package gateway
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"household-account-backend/entity"
+)
+
+type UserIdentityRepository interface {
+	CreateIdentity(identity *entity.UserIdentity) (*entity.UserIdentity, error)
+	// GetIdentity は紐付けが存在しない場合はnilを返す
+	GetIdentity(provider string, subject string) (*entity.UserIdentity, error)
+	GetIdentitiesByUserID(userID int) ([]entity.UserIdentity, error)
+	DeleteIdentity(userID int, provider string) (int64, error)
+
+	CreateState(state *entity.OIDCLoginState) (*entity.OIDCLoginState, error)
+	// ConsumeState はstateを取り出して削除する。存在しない場合や既に使われた場合はnilを返す
+	ConsumeState(stateHash string) (*entity.OIDCLoginState, error)
+	DeleteExpiredStates(now time.Time) (int64, error)
+}
+
+type userIdentityRepository struct {
+	db *gorm.DB
+}
+
+func NewUserIdentityRepository(db *gorm.DB) UserIdentityRepository {
+	return &userIdentityRepository{db}
+}
+
+func (ir *userIdentityRepository) CreateIdentity(identity *entity.UserIdentity) (*entity.UserIdentity, error) {
+	if err := ir.db.Create(identity).Error; err != nil {
+		return nil, err
+	}
+	return identity, nil
+}
+
+func (ir *userIdentityRepository) GetIdentity(provider string, subject string) (*entity.UserIdentity, error) {
+	var identities []entity.UserIdentity
+	if err := ir.db.Where("provider = ? AND subject = ?", provider, subject).Limit(1).Find(&identities).Error; err != nil {
+		return nil, err
+	}
+	if len(identities) == 0 {
+		return nil, nil
+	}
+	return &identities[0], nil
+}
+
+func (ir *userIdentityRepository) GetIdentitiesByUserID(userID int) ([]entity.UserIdentity, error) {
+	var identities []entity.UserIdentity
+	if err := ir.db.Where("user_id = ?", userID).Order("id").Find(&identities).Error; err != nil {
+		return nil, err
+	}
+	return identities, nil
+}
+
+func (ir *userIdentityRepository) DeleteIdentity(userID int, provider string) (int64, error) {
+	result := ir.db.Where("user_id = ? AND provider = ?", userID, provider).Delete(&entity.UserIdentity{})
+	return result.RowsAffected, result.Error
+}
+
+func (ir *userIdentityRepository) CreateState(state *entity.OIDCLoginState) (*entity.OIDCLoginState, error) {
+	if err := ir.db.Create(state).Error; err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+func (ir *userIdentityRepository) ConsumeState(stateHash string) (*entity.OIDCLoginState, error) {
+	var states []entity.OIDCLoginState
+	if err := ir.db.Where("state_hash = ?", stateHash).Limit(1).Find(&states).Error; err != nil {
+		return nil, err
+	}
+	if len(states) == 0 {
+		return nil, nil
+	}
+	// 削除できたリクエストだけがstateを使用できる
+	result := ir.db.Where("id = ?", states[0].ID).Delete(&entity.OIDCLoginState{})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+	return &states[0], nil
+}
+
+func (ir *userIdentityRepository) DeleteExpiredStates(now time.Time) (int64, error) {
+	result := ir.db.Where("expires_at <= ?", now).Delete(&entity.OIDCLoginState{})
+	return result.RowsAffected, result.Error
+}
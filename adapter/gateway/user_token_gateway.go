@@ -0,0 +1,68 @@
+package gateway
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"household-account-backend/entity"
+)
+
+type UserTokenRepository interface {
+	CreateToken(token *entity.UserToken) (*entity.UserToken, error)
+	// GetTokenByHash はトークンが存在しない場合はnilを返す
+	GetTokenByHash(purpose string, tokenHash string) (*entity.UserToken, error)
+	// MarkTokenUsed は未使用のトークンを使用済みにし、既に使用済みだった場合はfalseを返す
+	MarkTokenUsed(tokenID int, usedAt time.Time) (bool, error)
+	// InvalidateTokens はユーザーの未使用トークンを全て使用済みにする
+	InvalidateTokens(userID int, purpose string, usedAt time.Time) error
+	IncrementTokenAttempts(tokenID int) error
+}
+
+type userTokenRepository struct {
+	db *gorm.DB
+}
+
+func NewUserTokenRepository(db *gorm.DB) UserTokenRepository {
+	return &userTokenRepository{db}
+}
+
+func (tr *userTokenRepository) CreateToken(token *entity.UserToken) (*entity.UserToken, error) {
+	if err := tr.db.Create(token).Error; err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+func (tr *userTokenRepository) GetTokenByHash(purpose string, tokenHash string) (*entity.UserToken, error) {
+	var tokens []entity.UserToken
+	if err := tr.db.Where("purpose = ? AND token_hash = ?", purpose, tokenHash).Limit(1).Find(&tokens).Error; err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+	return &tokens[0], nil
+}
+
+func (tr *userTokenRepository) MarkTokenUsed(tokenID int, usedAt time.Time) (bool, error) {
+	// used_at IS NULL を条件にすることで同じトークンの同時使用を防ぐ
+	result := tr.db.Model(&entity.UserToken{}).
+		Where("id = ? AND used_at IS NULL", tokenID).
+		Update("used_at", usedAt)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+func (tr *userTokenRepository) InvalidateTokens(userID int, purpose string, usedAt time.Time) error {
+	return tr.db.Model(&entity.UserToken{}).
+		Where("user_id = ? AND purpose = ? AND used_at IS NULL", userID, purpose).
+		Update("used_at", usedAt).Error
+}
+
+func (tr *userTokenRepository) IncrementTokenAttempts(tokenID int) error {
+	return tr.db.Model(&entity.UserToken{}).Where("id = ?", tokenID).
+		Update("attempts", gorm.Expr("attempts + ?", 1)).Error
+}
@@ -0,0 +1,116 @@
+package gateway
+
+import (
+	"github.com/jinzhu/copier"
+	"gorm.io/gorm"
+
+	"household-account-backend/entity"
+)
+
+type WebhookRepository interface {
+	CreateWebhook(webhook *entity.Webhook) (*entity.Webhook, error)
+	GetWebhookByID(userID int, webhookID int) (*entity.Webhook, error)
+	GetWebhooksByUserID(userID int) ([]entity.Webhook, error)
+	UpdateWebhook(webhook *entity.Webhook) (*entity.Webhook, error)
+	DeleteWebhook(userID int, webhookID int) error
+	CreateDelivery(delivery *entity.WebhookDelivery) (*entity.WebhookDelivery, error)
+	GetDeliveryByID(webhookID int, deliveryID int) (*entity.WebhookDelivery, error)
+	GetDeliveriesByWebhookID(webhookID int) ([]entity.WebhookDelivery, error)
+	HasSuccessfulDelivery(webhookID int, eventID int) (bool, error)
+}
+
+type webhookRepository struct {
+	db *gorm.DB
+}
+
+func NewWebhookRepository(db *gorm.DB) WebhookRepository {
+	return &webhookRepository{db}
+}
+
+func (wr *webhookRepository) CreateWebhook(webhook *entity.Webhook) (*entity.Webhook, error) {
+	if err := wr.db.Create(webhook).Error; err != nil {
+		return nil, err
+	}
+	return webhook, nil
+}
+
+func (wr *webhookRepository) GetWebhookByID(userID int, webhookID int) (*entity.Webhook, error) {
+	webhook := &entity.Webhook{}
+	if err := wr.db.Where("id = ? AND user_id = ?", webhookID, userID).First(webhook).Error; err != nil {
+		return nil, err
+	}
+	return webhook, nil
+}
+
+func (wr *webhookRepository) GetWebhooksByUserID(userID int) ([]entity.Webhook, error) {
+	var webhooks []entity.Webhook
+	if err := wr.db.Where("user_id = ?", userID).Order("id").Find(&webhooks).Error; err != nil {
+		return nil, err
+	}
+	return webhooks, nil
+}
+
+func (wr *webhookRepository) UpdateWebhook(webhook *entity.Webhook) (*entity.Webhook, error) {
+	selectedWebhook, err := wr.GetWebhookByID(webhook.UserID, webhook.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Activeはfalseへの変更も反映するためcopierとは別に扱う
+	active := webhook.Active
+	if err := copier.CopyWithOption(selectedWebhook, webhook, copier.Option{IgnoreEmpty: true, DeepCopy: true}); err != nil {
+		return nil, err
+	}
+	selectedWebhook.Active = active
+
+	if err := wr.db.Save(selectedWebhook).Error; err != nil {
+		return nil, err
+	}
+	return selectedWebhook, nil
+}
+
+func (wr *webhookRepository) DeleteWebhook(userID int, webhookID int) error {
+	return wr.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("id = ? AND user_id = ?", webhookID, userID).Delete(&entity.Webhook{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrRecordNotFound
+		}
+		return tx.Where("webhook_id = ?", webhookID).Delete(&entity.WebhookDelivery{}).Error
+	})
+}
+
+func (wr *webhookRepository) CreateDelivery(delivery *entity.WebhookDelivery) (*entity.WebhookDelivery, error) {
+	if err := wr.db.Create(delivery).Error; err != nil {
+		return nil, err
+	}
+	return delivery, nil
+}
+
+func (wr *webhookRepository) GetDeliveryByID(webhookID int, deliveryID int) (*entity.WebhookDelivery, error) {
+	delivery := &entity.WebhookDelivery{}
+	if err := wr.db.Where("id = ? AND webhook_id = ?", deliveryID, webhookID).First(delivery).Error; err != nil {
+		return nil, err
+	}
+	return delivery, nil
+}
+
+func (wr *webhookRepository) GetDeliveriesByWebhookID(webhookID int) ([]entity.WebhookDelivery, error) {
+	var deliveries []entity.WebhookDelivery
+	if err := wr.db.Where("webhook_id = ?", webhookID).Order("id DESC").Find(&deliveries).Error; err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+func (wr *webhookRepository) HasSuccessfulDelivery(webhookID int, eventID int) (bool, error) {
+	var count int64
+	if err := wr.db.Model(&entity.WebhookDelivery{}).
+		Where("webhook_id = ? AND event_id = ? AND success = ?", webhookID, eventID, true).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
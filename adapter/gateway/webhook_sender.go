@@ -0,0 +1,140 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+// ErrWebhookAddressNotAllowed はWebhookの送信先が内部ネットワークのアドレスの場合に返す
+var ErrWebhookAddressNotAllowed = errors.New("webhook address is not allowed")
+
+// 内部ネットワークとして扱うアドレスの範囲
+// ループバックやプライベートアドレスなどはnet.IPのメソッドで判定する
+var blockedWebhookNetworks = []*net.IPNet{
+	mustParseCIDR("0.0.0.0/8"),
+	mustParseCIDR("100.64.0.0/10"), // キャリアグレードNAT
+	mustParseCIDR("169.254.169.254/32"),
+	mustParseCIDR("192.0.0.0/24"),
+	mustParseCIDR("198.18.0.0/15"),
+	mustParseCIDR("64:ff9b::/96"), // IPv4/IPv6変換
+}
+
+// WebhookSender はWebhookのエンドポイントへペイロードを送信する
+type WebhookSender interface {
+	// ValidateURL は送信先のホストを名前解決し、内部ネットワークのアドレスであればエラーを返す
+	ValidateURL(rawURL string) error
+	Send(url string, headers map[string]string, body []byte) (int, error)
+}
+
+type httpWebhookSender struct {
+	client   *http.Client
+	resolver *net.Resolver
+	timeout  time.Duration
+}
+
+func NewHTTPWebhookSender(timeout time.Duration) WebhookSender {
+	// 名前解決の結果が保存時から変わった場合やリダイレクトされた場合に備え、接続時にもアドレスを検証する
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !IsPublicIP(ip) {
+				return ErrWebhookAddressNotAllowed
+			}
+			return nil
+		},
+	}
+	transport := &http.Transport{
+		// プロキシを経由すると接続先のアドレスを検証できないため使わない
+		Proxy:               nil,
+		DialContext:         dialer.DialContext,
+		TLSHandshakeTimeout: timeout,
+	}
+	return &httpWebhookSender{
+		client:   &http.Client{Timeout: timeout, Transport: transport},
+		resolver: net.DefaultResolver,
+		timeout:  timeout,
+	}
+}
+
+func (s *httpWebhookSender) ValidateURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+
+	host := u.Hostname()
+	if ip := net.ParseIP(host); ip != nil {
+		if !IsPublicIP(ip) {
+			return ErrWebhookAddressNotAllowed
+		}
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	addrs, err := s.resolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return err
+	}
+	// 1つでも内部ネットワークのアドレスに解決される場合は拒否する
+	for _, addr := range addrs {
+		if !IsPublicIP(addr.IP) {
+			return ErrWebhookAddressNotAllowed
+		}
+	}
+	return nil
+}
+
+func (s *httpWebhookSender) Send(url string, headers map[string]string, body []byte) (int, error) {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	return res.StatusCode, nil
+}
+
+// IsPublicIP はipがWebhookの送信先として許可されるグローバルなアドレスかを返す
+func IsPublicIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return false
+	}
+	for _, network := range blockedWebhookNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+func mustParseCIDR(cidr string) *net.IPNet {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		panic(err)
+	}
+	return network
+}
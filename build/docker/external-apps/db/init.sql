@@ -7,8 +7,13 @@ CREATE TABLE IF NOT EXISTS users (
     email VARCHAR(255) NOT NULL,
     password VARCHAR(255) NOT NULL,
     name VARCHAR(20) NOT NULL,
+    email_verified_at TIMESTAMP NULL DEFAULT NULL, -- NULLの場合はメールアドレス未確認
+    role ENUM('user', 'admin') NOT NULL DEFAULT 'user', -- 最初の管理者はSQLで設定する
+    disabled_at TIMESTAMP NULL DEFAULT NULL, -- NULL以外の場合は管理者によって無効にされている
+    sessions_revoked_at TIMESTAMP NULL DEFAULT NULL, -- これ以前に発行した認証トークンは無効(強制ログアウト)
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_users_email (email) -- 登録済みのメールアドレスは409で返す
 );
 
 CREATE TABLE IF NOT EXISTS categories (
@@ -16,6 +21,7 @@ CREATE TABLE IF NOT EXISTS categories (
     user_id INT NOT NULL,
     name VARCHAR(20) NOT NULL,
     type ENUM('income', 'expense') NOT NULL,
+    version INT NOT NULL DEFAULT 1, -- 楽観的排他制御用のバージョン
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
     FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
@@ -28,6 +34,7 @@ CREATE TABLE IF NOT EXISTS transactions (
     date DATE NOT NULL,
     amount DECIMAL(10, 2) NOT NULL,
     content TEXT,
+    version INT NOT NULL DEFAULT 1, -- 楽観的排他制御用のバージョン
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
     FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
@@ -42,7 +49,199 @@ CREATE TABLE IF NOT EXISTS monthly_summaries (
     income DECIMAL(10, 2) NOT NULL,
     expense DECIMAL(10, 2) NOT NULL,
     balance DECIMAL(10, 2) NOT NULL,
+    version INT NOT NULL DEFAULT 1, -- 楽観的排他制御用のバージョン
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
     FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
-);
\ No newline at end of file
+);
+-- ユースケースで発生したイベントのアウトボックス
+CREATE TABLE IF NOT EXISTS outbox_events (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id INT NOT NULL,
+    type VARCHAR(64) NOT NULL,
+    payload TEXT NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    processed_at TIMESTAMP NULL,
+    locked_until TIMESTAMP NULL, -- 配信中のワーカーが取得している期限
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_outbox_events_pending (processed_at, attempts),
+    INDEX idx_outbox_events_user (user_id, id), -- SSEの再接続時の再送用
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS webhooks (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id INT NOT NULL,
+    url VARCHAR(2048) NOT NULL,
+    secret VARCHAR(64) NOT NULL,
+    events TEXT NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    webhook_id INT NOT NULL,
+    event_id INT NOT NULL,
+    event_type VARCHAR(64) NOT NULL,
+    payload TEXT NOT NULL,
+    status_code INT NOT NULL DEFAULT 0,
+    success BOOLEAN NOT NULL DEFAULT FALSE,
+    error TEXT,
+    replay BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_webhook_deliveries_event (webhook_id, event_id),
+    FOREIGN KEY (webhook_id) REFERENCES webhooks(id) ON DELETE CASCADE
+);
+
+-- Idempotency-Key付きリクエストのレスポンス保存用
+CREATE TABLE IF NOT EXISTS idempotency_records (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id INT NOT NULL,
+    idempotency_key VARCHAR(255) NOT NULL,
+    request_hash CHAR(64) NOT NULL,
+    status_code INT NOT NULL DEFAULT 0, -- 0の場合は処理中
+    response_headers TEXT,
+    response_body MEDIUMTEXT,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_idempotency_records_user_key (user_id, idempotency_key),
+    INDEX idx_idempotency_records_expires_at (expires_at)
+);
+
+CREATE TABLE IF NOT EXISTS user_tokens (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id INT NOT NULL,
+    purpose ENUM('email_verification', 'password_reset', 'login_challenge', 'account_unlock', 'account_deletion') NOT NULL,
+    token_hash CHAR(64) NOT NULL, -- トークン本体は保存せずSHA-256ハッシュのみ保存する
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP NULL DEFAULT NULL, -- NULLの場合は未使用
+    attempts INT NOT NULL DEFAULT 0, -- 検証に失敗した回数
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_user_tokens_purpose_hash (purpose, token_hash),
+    INDEX idx_user_tokens_user_purpose (user_id, purpose),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS totp_credentials (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id INT NOT NULL,
+    secret VARCHAR(64) NOT NULL,
+    enabled_at TIMESTAMP NULL DEFAULT NULL, -- NULLの場合は登録確認待ち
+    last_used_step BIGINT NOT NULL DEFAULT 0, -- 同じコードの再利用を防ぐため最後に受け付けたタイムステップ
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_totp_credentials_user_id (user_id),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS recovery_codes (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id INT NOT NULL,
+    code_hash CHAR(64) NOT NULL, -- コード本体は保存せずSHA-256ハッシュのみ保存する
+    used_at TIMESTAMP NULL DEFAULT NULL, -- NULLの場合は未使用
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_recovery_codes_user_hash (user_id, code_hash),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- 外部のOIDCプロバイダのアカウントとの紐付け
+CREATE TABLE IF NOT EXISTS user_identities (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id INT NOT NULL,
+    provider VARCHAR(64) NOT NULL,
+    subject VARCHAR(255) NOT NULL, -- プロバイダ内で一意なユーザーID(subクレーム)
+    email VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_user_identities_provider_subject (provider, subject),
+    UNIQUE KEY uk_user_identities_user_provider (user_id, provider),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- 認可リクエストからコールバックまでの間に保持するstateとPKCEのcode_verifier
+CREATE TABLE IF NOT EXISTS oidc_login_states (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    state_hash CHAR(64) NOT NULL, -- state本体は保存せずSHA-256ハッシュのみ保存する
+    provider VARCHAR(64) NOT NULL,
+    code_verifier VARCHAR(128) NOT NULL,
+    nonce VARCHAR(128) NOT NULL,
+    link_user_id INT NOT NULL DEFAULT 0, -- 0の場合はログイン、それ以外は紐付けを開始したユーザー
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_oidc_login_states_state_hash (state_hash),
+    INDEX idx_oidc_login_states_expires_at (expires_at)
+);
+
+-- アカウントまたはIPアドレスごとのログイン失敗回数とロック
+CREATE TABLE IF NOT EXISTS login_attempts (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    attempt_key VARCHAR(320) NOT NULL, -- "account:<メールアドレス>" または "ip:<IPアドレス>"
+    failures INT NOT NULL DEFAULT 0,
+    last_failed_at TIMESTAMP NULL DEFAULT NULL,
+    locked_until TIMESTAMP NULL DEFAULT NULL, -- NULLの場合はロックされていない
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_login_attempts_attempt_key (attempt_key)
+);
+
+-- スクリプトや外部連携用のパーソナルアクセストークン
+CREATE TABLE IF NOT EXISTS personal_access_tokens (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id INT NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    token_prefix VARCHAR(16) NOT NULL, -- 一覧でトークンを見分けるための先頭部分
+    token_hash CHAR(64) NOT NULL, -- トークン本体は保存せずSHA-256ハッシュのみ保存する
+    scopes TEXT NOT NULL, -- カンマ区切りのスコープ
+    expires_at TIMESTAMP NULL DEFAULT NULL, -- NULLの場合は無期限
+    last_used_at TIMESTAMP NULL DEFAULT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_personal_access_tokens_token_hash (token_hash),
+    INDEX idx_personal_access_tokens_user_id (user_id),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- ユーザーが持ち出すデータのアーカイブ。ワーカーが非同期に作成する
+CREATE TABLE IF NOT EXISTS data_exports (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id INT NOT NULL,
+    status ENUM('pending', 'processing', 'completed', 'failed', 'expired') NOT NULL DEFAULT 'pending',
+    file_key VARCHAR(255) NOT NULL DEFAULT '', -- ファイルストレージ上のキー
+    file_size BIGINT NOT NULL DEFAULT 0,
+    error VARCHAR(255) NOT NULL DEFAULT '',
+    expires_at TIMESTAMP NULL DEFAULT NULL, -- 作成完了後、ダウンロードできる期限
+    started_at TIMESTAMP NULL DEFAULT NULL, -- ワーカーが作成を開始した日時
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    completed_at TIMESTAMP NULL DEFAULT NULL,
+    INDEX idx_data_exports_user_id (user_id),
+    INDEX idx_data_exports_status (status),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- アカウントの削除依頼と削除後の検証結果
+-- ユーザーの削除後も記録として残すため、usersへの外部キーは設定しない
+CREATE TABLE IF NOT EXISTS account_deletions (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id INT NOT NULL,
+    email VARCHAR(255) NOT NULL DEFAULT '', -- 削除結果の通知先。削除完了後に消去する
+    status ENUM('pending', 'scheduled', 'cancelled', 'completed', 'failed') NOT NULL DEFAULT 'pending',
+    scheduled_at TIMESTAMP NULL DEFAULT NULL, -- 猶予期間の終了日時
+    report TEXT, -- 削除した件数と削除後に残った件数のJSON
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    confirmed_at TIMESTAMP NULL DEFAULT NULL,
+    completed_at TIMESTAMP NULL DEFAULT NULL,
+    INDEX idx_account_deletions_user_id (user_id),
+    INDEX idx_account_deletions_status_scheduled_at (status, scheduled_at)
+);
+
+-- 管理者の操作の監査ログ
+-- 対象のユーザーの削除後も記録として残すため、usersへの外部キーは設定しない
+CREATE TABLE IF NOT EXISTS admin_audit_logs (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    admin_id INT NOT NULL,
+    action VARCHAR(50) NOT NULL, -- user.disable, user.impersonate など
+    target_user_id INT NOT NULL,
+    detail VARCHAR(255) NOT NULL DEFAULT '', -- なりすましの理由、変更前後のロールなど
+    ip_address VARCHAR(45) NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_admin_audit_logs_target_user_id (target_user_id)
+);
@@ -0,0 +1,5 @@
+-- init.sqlはデータベースの初回作成時にしか実行されないため、既存のデータベースにはこのディレクトリのSQLを番号順に適用する
+-- 例: mysql -u root -p api_database < 001_outbox_events_locked_until.sql
+
+-- Webhookワーカーが配信中のイベントを取得するための期限
+ALTER TABLE outbox_events ADD COLUMN locked_until TIMESTAMP NULL AFTER processed_at;
@@ -0,0 +1,7 @@
+-- init.sqlはデータベースの初回作成時にしか実行されないため、既存のデータベースにはこのディレクトリのSQLを番号順に適用する
+-- 例: mysql -u root -p api_database < 002_users_email_unique.sql
+
+-- 登録済みのメールアドレスでの登録を409で返すための一意制約
+-- 重複したメールアドレスがあると失敗するため、事前に次のSQLで確認して整理する
+-- SELECT email, COUNT(*) FROM users GROUP BY email HAVING COUNT(*) > 1;
+ALTER TABLE users ADD UNIQUE KEY uk_users_email (email);
@@ -0,0 +1,7 @@
+-- init.sqlはデータベースの初回作成時にしか実行されないため、既存のデータベースにはこのディレクトリのSQLを番号順に適用する
+-- 例: mysql -u root -p api_database < 003_version_columns.sql
+
+-- 楽観的排他制御用のバージョン。既存の行はバージョン1から始める
+ALTER TABLE categories ADD COLUMN version INT NOT NULL DEFAULT 1 AFTER type;
+ALTER TABLE transactions ADD COLUMN version INT NOT NULL DEFAULT 1 AFTER content;
+ALTER TABLE monthly_summaries ADD COLUMN version INT NOT NULL DEFAULT 1 AFTER balance;
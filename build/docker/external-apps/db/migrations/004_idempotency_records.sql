@@ -0,0 +1,18 @@
+-- init.sqlはデータベースの初回作成時にしか実行されないため、既存のデータベースにはこのディレクトリのSQLを番号順に適用する
+-- 例: mysql -u root -p api_database < 004_idempotency_records.sql
+
+-- Idempotency-Key付きリクエストのレスポンス保存用
+-- 同時に同じキーで送られたリクエストは一意制約で片方を失敗させる
+CREATE TABLE IF NOT EXISTS idempotency_records (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id INT NOT NULL,
+    idempotency_key VARCHAR(255) NOT NULL,
+    request_hash CHAR(64) NOT NULL,
+    status_code INT NOT NULL DEFAULT 0, -- 0の場合は処理中
+    response_headers TEXT,
+    response_body MEDIUMTEXT,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_idempotency_records_user_key (user_id, idempotency_key),
+    INDEX idx_idempotency_records_expires_at (expires_at)
+);
@@ -0,0 +1,21 @@
+-- init.sqlはデータベースの初回作成時にしか実行されないため、既存のデータベースにはこのディレクトリのSQLを番号順に適用する
+-- 例: mysql -u root -p api_database < 005_admin.sql
+
+-- 管理者用APIのロールとアカウントの状態。既存のユーザーは有効な一般ユーザーとする
+ALTER TABLE users
+    ADD COLUMN role ENUM('user', 'admin') NOT NULL DEFAULT 'user', -- 最初の管理者はSQLで設定する
+    ADD COLUMN disabled_at TIMESTAMP NULL DEFAULT NULL, -- NULL以外の場合は管理者によって無効にされている
+    ADD COLUMN sessions_revoked_at TIMESTAMP NULL DEFAULT NULL; -- これ以前に発行した認証トークンは無効(強制ログアウト)
+
+-- 管理者の操作の監査ログ
+-- 対象のユーザーの削除後も記録として残すため、usersへの外部キーは設定しない
+CREATE TABLE IF NOT EXISTS admin_audit_logs (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    admin_id INT NOT NULL,
+    action VARCHAR(50) NOT NULL, -- user.disable, user.impersonate など
+    target_user_id INT NOT NULL,
+    detail VARCHAR(255) NOT NULL DEFAULT '', -- なりすましの理由、変更前後のロールなど
+    ip_address VARCHAR(45) NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_admin_audit_logs_target_user_id (target_user_id)
+);
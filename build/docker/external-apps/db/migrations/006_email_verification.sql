@@ -0,0 +1,20 @@
+-- init.sqlはデータベースの初回作成時にしか実行されないため、既存のデータベースにはこのディレクトリのSQLを番号順に適用する
+-- 例: mysql -u root -p api_database < 006_email_verification.sql
+
+-- メールアドレスの確認状態。既存のユーザーは未確認として扱う
+ALTER TABLE users ADD COLUMN email_verified_at TIMESTAMP NULL DEFAULT NULL AFTER name; -- NULLの場合はメールアドレス未確認
+
+-- メールで送る確認・再設定用のトークン
+CREATE TABLE IF NOT EXISTS user_tokens (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id INT NOT NULL,
+    purpose ENUM('email_verification', 'password_reset', 'login_challenge', 'account_unlock', 'account_deletion') NOT NULL,
+    token_hash CHAR(64) NOT NULL, -- トークン本体は保存せずSHA-256ハッシュのみ保存する
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP NULL DEFAULT NULL, -- NULLの場合は未使用
+    attempts INT NOT NULL DEFAULT 0, -- 検証に失敗した回数
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_user_tokens_purpose_hash (purpose, token_hash),
+    INDEX idx_user_tokens_user_purpose (user_id, purpose),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
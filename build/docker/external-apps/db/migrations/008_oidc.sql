@@ -0,0 +1,29 @@
+-- init.sqlはデータベースの初回作成時にしか実行されないため、既存のデータベースにはこのディレクトリのSQLを番号順に適用する
+-- 例: mysql -u root -p api_database < 008_oidc.sql
+
+-- 外部のOIDCプロバイダのアカウントとの紐付け
+CREATE TABLE IF NOT EXISTS user_identities (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id INT NOT NULL,
+    provider VARCHAR(64) NOT NULL,
+    subject VARCHAR(255) NOT NULL, -- プロバイダ内で一意なユーザーID(subクレーム)
+    email VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_user_identities_provider_subject (provider, subject),
+    UNIQUE KEY uk_user_identities_user_provider (user_id, provider),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- 認可リクエストからコールバックまでの間に保持するstateとPKCEのcode_verifier
+CREATE TABLE IF NOT EXISTS oidc_login_states (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    state_hash CHAR(64) NOT NULL, -- state本体は保存せずSHA-256ハッシュのみ保存する
+    provider VARCHAR(64) NOT NULL,
+    code_verifier VARCHAR(128) NOT NULL,
+    nonce VARCHAR(128) NOT NULL,
+    link_user_id INT NOT NULL DEFAULT 0, -- 0の場合はログイン、それ以外は紐付けを開始したユーザー
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_oidc_login_states_state_hash (state_hash),
+    INDEX idx_oidc_login_states_expires_at (expires_at)
+);
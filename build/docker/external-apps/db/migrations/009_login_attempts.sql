@@ -0,0 +1,13 @@
+-- init.sqlはデータベースの初回作成時にしか実行されないため、既存のデータベースにはこのディレクトリのSQLを番号順に適用する
+-- 例: mysql -u root -p api_database < 009_login_attempts.sql
+
+-- アカウントまたはIPアドレスごとのログイン失敗回数とロック
+CREATE TABLE IF NOT EXISTS login_attempts (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    attempt_key VARCHAR(320) NOT NULL, -- "account:<メールアドレス>" または "ip:<IPアドレス>"
+    failures INT NOT NULL DEFAULT 0,
+    last_failed_at TIMESTAMP NULL DEFAULT NULL,
+    locked_until TIMESTAMP NULL DEFAULT NULL, -- NULLの場合はロックされていない
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_login_attempts_attempt_key (attempt_key)
+);
@@ -0,0 +1,18 @@
+-- init.sqlはデータベースの初回作成時にしか実行されないため、既存のデータベースにはこのディレクトリのSQLを番号順に適用する
+-- 例: mysql -u root -p api_database < 010_personal_access_tokens.sql
+
+-- スクリプトや外部連携用のパーソナルアクセストークン
+CREATE TABLE IF NOT EXISTS personal_access_tokens (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id INT NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    token_prefix VARCHAR(16) NOT NULL, -- 一覧でトークンを見分けるための先頭部分
+    token_hash CHAR(64) NOT NULL, -- トークン本体は保存せずSHA-256ハッシュのみ保存する
+    scopes TEXT NOT NULL, -- カンマ区切りのスコープ
+    expires_at TIMESTAMP NULL DEFAULT NULL, -- NULLの場合は無期限
+    last_used_at TIMESTAMP NULL DEFAULT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_personal_access_tokens_token_hash (token_hash),
+    INDEX idx_personal_access_tokens_user_id (user_id),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
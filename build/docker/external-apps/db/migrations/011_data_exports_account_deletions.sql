@@ -0,0 +1,35 @@
+-- init.sqlはデータベースの初回作成時にしか実行されないため、既存のデータベースにはこのディレクトリのSQLを番号順に適用する
+-- 例: mysql -u root -p api_database < 011_data_exports_account_deletions.sql
+
+-- ユーザーが持ち出すデータのアーカイブ。ワーカーが非同期に作成する
+CREATE TABLE IF NOT EXISTS data_exports (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id INT NOT NULL,
+    status ENUM('pending', 'processing', 'completed', 'failed', 'expired') NOT NULL DEFAULT 'pending',
+    file_key VARCHAR(255) NOT NULL DEFAULT '', -- ファイルストレージ上のキー
+    file_size BIGINT NOT NULL DEFAULT 0,
+    error VARCHAR(255) NOT NULL DEFAULT '',
+    expires_at TIMESTAMP NULL DEFAULT NULL, -- 作成完了後、ダウンロードできる期限
+    started_at TIMESTAMP NULL DEFAULT NULL, -- ワーカーが作成を開始した日時
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    completed_at TIMESTAMP NULL DEFAULT NULL,
+    INDEX idx_data_exports_user_id (user_id),
+    INDEX idx_data_exports_status (status),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- アカウントの削除依頼と削除後の検証結果
+-- ユーザーの削除後も記録として残すため、usersへの外部キーは設定しない
+CREATE TABLE IF NOT EXISTS account_deletions (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id INT NOT NULL,
+    email VARCHAR(255) NOT NULL DEFAULT '', -- 削除結果の通知先。削除完了後に消去する
+    status ENUM('pending', 'scheduled', 'cancelled', 'completed', 'failed') NOT NULL DEFAULT 'pending',
+    scheduled_at TIMESTAMP NULL DEFAULT NULL, -- 猶予期間の終了日時
+    report TEXT, -- 削除した件数と削除後に残った件数のJSON
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    confirmed_at TIMESTAMP NULL DEFAULT NULL,
+    completed_at TIMESTAMP NULL DEFAULT NULL,
+    INDEX idx_account_deletions_user_id (user_id),
+    INDEX idx_account_deletions_status_scheduled_at (status, scheduled_at)
+);
@@ -0,0 +1,235 @@
+-- PostgreSQL用のスキーマ。external-apps/db/init.sql(MySQL)と同じテーブルを作成する
+-- DB_DRIVER=postgres で起動する場合に使う
+-- updated_atはアプリケーション(gorm)が更新するため、MySQLのON UPDATEに相当するトリガーは作成しない
+-- ENUMはCHECK制約で表す
+
+CREATE TABLE IF NOT EXISTS users (
+    id SERIAL PRIMARY KEY,
+    email VARCHAR(255) NOT NULL,
+    password VARCHAR(255) NOT NULL,
+    name VARCHAR(20) NOT NULL,
+    email_verified_at TIMESTAMPTZ NULL DEFAULT NULL, -- NULLの場合はメールアドレス未確認
+    role VARCHAR(16) NOT NULL DEFAULT 'user' CHECK (role IN ('user', 'admin')), -- 最初の管理者はSQLで設定する
+    disabled_at TIMESTAMPTZ NULL DEFAULT NULL, -- NULL以外の場合は管理者によって無効にされている
+    sessions_revoked_at TIMESTAMPTZ NULL DEFAULT NULL, -- これ以前に発行した認証トークンは無効(強制ログアウト)
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uk_users_email UNIQUE (email) -- 登録済みのメールアドレスは409で返す
+);
+
+CREATE TABLE IF NOT EXISTS categories (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(20) NOT NULL,
+    type VARCHAR(16) NOT NULL CHECK (type IN ('income', 'expense')),
+    version INT NOT NULL DEFAULT 1, -- 楽観的排他制御用のバージョン
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS transactions (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    category_id INT NOT NULL REFERENCES categories(id) ON DELETE CASCADE,
+    date DATE NOT NULL,
+    amount DECIMAL(10, 2) NOT NULL,
+    content TEXT,
+    version INT NOT NULL DEFAULT 1, -- 楽観的排他制御用のバージョン
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS monthly_summaries (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    year_month VARCHAR(7) NOT NULL,
+    income DECIMAL(10, 2) NOT NULL,
+    expense DECIMAL(10, 2) NOT NULL,
+    balance DECIMAL(10, 2) NOT NULL,
+    version INT NOT NULL DEFAULT 1, -- 楽観的排他制御用のバージョン
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+-- ユースケースで発生したイベントのアウトボックス
+CREATE TABLE IF NOT EXISTS outbox_events (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    type VARCHAR(64) NOT NULL,
+    payload TEXT NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    processed_at TIMESTAMPTZ NULL,
+    locked_until TIMESTAMPTZ NULL, -- 配信中のワーカーが取得している期限
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_outbox_events_pending ON outbox_events (processed_at, attempts);
+CREATE INDEX IF NOT EXISTS idx_outbox_events_user ON outbox_events (user_id, id); -- SSEの再接続時の再送用
+
+CREATE TABLE IF NOT EXISTS webhooks (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    url VARCHAR(2048) NOT NULL,
+    secret VARCHAR(64) NOT NULL,
+    events TEXT NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id SERIAL PRIMARY KEY,
+    webhook_id INT NOT NULL REFERENCES webhooks(id) ON DELETE CASCADE,
+    event_id INT NOT NULL,
+    event_type VARCHAR(64) NOT NULL,
+    payload TEXT NOT NULL,
+    status_code INT NOT NULL DEFAULT 0,
+    success BOOLEAN NOT NULL DEFAULT FALSE,
+    error TEXT,
+    replay BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_event ON webhook_deliveries (webhook_id, event_id);
+
+-- Idempotency-Key付きリクエストのレスポンス保存用
+CREATE TABLE IF NOT EXISTS idempotency_records (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL,
+    idempotency_key VARCHAR(255) NOT NULL,
+    request_hash CHAR(64) NOT NULL,
+    status_code INT NOT NULL DEFAULT 0, -- 0の場合は処理中
+    response_headers TEXT,
+    response_body TEXT,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uk_idempotency_records_user_key UNIQUE (user_id, idempotency_key)
+);
+CREATE INDEX IF NOT EXISTS idx_idempotency_records_expires_at ON idempotency_records (expires_at);
+
+CREATE TABLE IF NOT EXISTS user_tokens (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    purpose VARCHAR(32) NOT NULL CHECK (purpose IN ('email_verification', 'password_reset', 'login_challenge', 'account_unlock', 'account_deletion')),
+    token_hash CHAR(64) NOT NULL, -- トークン本体は保存せずSHA-256ハッシュのみ保存する
+    expires_at TIMESTAMPTZ NOT NULL,
+    used_at TIMESTAMPTZ NULL DEFAULT NULL, -- NULLの場合は未使用
+    attempts INT NOT NULL DEFAULT 0, -- 検証に失敗した回数
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uk_user_tokens_purpose_hash UNIQUE (purpose, token_hash)
+);
+CREATE INDEX IF NOT EXISTS idx_user_tokens_user_purpose ON user_tokens (user_id, purpose);
+
+CREATE TABLE IF NOT EXISTS totp_credentials (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    secret VARCHAR(64) NOT NULL,
+    enabled_at TIMESTAMPTZ NULL DEFAULT NULL, -- NULLの場合は登録確認待ち
+    last_used_step BIGINT NOT NULL DEFAULT 0, -- 同じコードの再利用を防ぐため最後に受け付けたタイムステップ
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uk_totp_credentials_user_id UNIQUE (user_id)
+);
+
+CREATE TABLE IF NOT EXISTS recovery_codes (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    code_hash CHAR(64) NOT NULL, -- コード本体は保存せずSHA-256ハッシュのみ保存する
+    used_at TIMESTAMPTZ NULL DEFAULT NULL, -- NULLの場合は未使用
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_recovery_codes_user_hash ON recovery_codes (user_id, code_hash);
+
+-- 外部のOIDCプロバイダのアカウントとの紐付け
+CREATE TABLE IF NOT EXISTS user_identities (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    provider VARCHAR(64) NOT NULL,
+    subject VARCHAR(255) NOT NULL, -- プロバイダ内で一意なユーザーID(subクレーム)
+    email VARCHAR(255) NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uk_user_identities_provider_subject UNIQUE (provider, subject),
+    CONSTRAINT uk_user_identities_user_provider UNIQUE (user_id, provider)
+);
+
+-- 認可リクエストからコールバックまでの間に保持するstateとPKCEのcode_verifier
+CREATE TABLE IF NOT EXISTS oidc_login_states (
+    id SERIAL PRIMARY KEY,
+    state_hash CHAR(64) NOT NULL, -- state本体は保存せずSHA-256ハッシュのみ保存する
+    provider VARCHAR(64) NOT NULL,
+    code_verifier VARCHAR(128) NOT NULL,
+    nonce VARCHAR(128) NOT NULL,
+    link_user_id INT NOT NULL DEFAULT 0, -- 0の場合はログイン、それ以外は紐付けを開始したユーザー
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uk_oidc_login_states_state_hash UNIQUE (state_hash)
+);
+CREATE INDEX IF NOT EXISTS idx_oidc_login_states_expires_at ON oidc_login_states (expires_at);
+
+-- アカウントまたはIPアドレスごとのログイン失敗回数とロック
+CREATE TABLE IF NOT EXISTS login_attempts (
+    id SERIAL PRIMARY KEY,
+    attempt_key VARCHAR(320) NOT NULL, -- "account:<メールアドレス>" または "ip:<IPアドレス>"
+    failures INT NOT NULL DEFAULT 0,
+    last_failed_at TIMESTAMPTZ NULL DEFAULT NULL,
+    locked_until TIMESTAMPTZ NULL DEFAULT NULL, -- NULLの場合はロックされていない
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uk_login_attempts_attempt_key UNIQUE (attempt_key)
+);
+
+-- スクリプトや外部連携用のパーソナルアクセストークン
+CREATE TABLE IF NOT EXISTS personal_access_tokens (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    token_prefix VARCHAR(16) NOT NULL, -- 一覧でトークンを見分けるための先頭部分
+    token_hash CHAR(64) NOT NULL, -- トークン本体は保存せずSHA-256ハッシュのみ保存する
+    scopes TEXT NOT NULL, -- カンマ区切りのスコープ
+    expires_at TIMESTAMPTZ NULL DEFAULT NULL, -- NULLの場合は無期限
+    last_used_at TIMESTAMPTZ NULL DEFAULT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uk_personal_access_tokens_token_hash UNIQUE (token_hash)
+);
+CREATE INDEX IF NOT EXISTS idx_personal_access_tokens_user_id ON personal_access_tokens (user_id);
+
+-- ユーザーが持ち出すデータのアーカイブ。ワーカーが非同期に作成する
+CREATE TABLE IF NOT EXISTS data_exports (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status VARCHAR(16) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'processing', 'completed', 'failed', 'expired')),
+    file_key VARCHAR(255) NOT NULL DEFAULT '', -- ファイルストレージ上のキー
+    file_size BIGINT NOT NULL DEFAULT 0,
+    error VARCHAR(255) NOT NULL DEFAULT '',
+    expires_at TIMESTAMPTZ NULL DEFAULT NULL, -- 作成完了後、ダウンロードできる期限
+    started_at TIMESTAMPTZ NULL DEFAULT NULL, -- ワーカーが作成を開始した日時
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    completed_at TIMESTAMPTZ NULL DEFAULT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_data_exports_user_id ON data_exports (user_id);
+CREATE INDEX IF NOT EXISTS idx_data_exports_status ON data_exports (status);
+
+-- アカウントの削除依頼と削除後の検証結果
+-- ユーザーの削除後も記録として残すため、usersへの外部キーは設定しない
+CREATE TABLE IF NOT EXISTS account_deletions (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL,
+    email VARCHAR(255) NOT NULL DEFAULT '', -- 削除結果の通知先。削除完了後に消去する
+    status VARCHAR(16) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'scheduled', 'cancelled', 'completed', 'failed')),
+    scheduled_at TIMESTAMPTZ NULL DEFAULT NULL, -- 猶予期間の終了日時
+    report TEXT, -- 削除した件数と削除後に残った件数のJSON
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    confirmed_at TIMESTAMPTZ NULL DEFAULT NULL,
+    completed_at TIMESTAMPTZ NULL DEFAULT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_account_deletions_user_id ON account_deletions (user_id);
+CREATE INDEX IF NOT EXISTS idx_account_deletions_status_scheduled_at ON account_deletions (status, scheduled_at);
+
+-- 管理者の操作の監査ログ
+-- 対象のユーザーの削除後も記録として残すため、usersへの外部キーは設定しない
+CREATE TABLE IF NOT EXISTS admin_audit_logs (
+    id SERIAL PRIMARY KEY,
+    admin_id INT NOT NULL,
+    action VARCHAR(50) NOT NULL, -- user.disable, user.impersonate など
+    target_user_id INT NOT NULL,
+    detail VARCHAR(255) NOT NULL DEFAULT '', -- なりすましの理由、変更前後のロールなど
+    ip_address VARCHAR(45) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_admin_audit_logs_target_user_id ON admin_audit_logs (target_user_id);
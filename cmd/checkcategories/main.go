@@ -0,0 +1,58 @@
+// checkcategories は既存の取引が参照するカテゴリーを確認し、
+// 他のユーザーのカテゴリーや存在しないカテゴリーを参照している取引、金額の符号が規約に合わない取引を報告する
+// データは変更しない。問題が見つかった場合は終了コード1で終了する
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/joho/godotenv"
+
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/infrastructure/database"
+	"household-account-backend/pkg"
+	"household-account-backend/pkg/config"
+	"household-account-backend/pkg/logger"
+	"household-account-backend/usecase"
+)
+
+func main() {
+	appEnv := pkg.GetEnvDefault("APP_ENV", "development")
+	if appEnv == "development" {
+		if err := godotenv.Load(".env.development"); err != nil {
+			logger.Warn("Error loading .env.development file")
+		}
+	}
+	defer logger.Sync()
+
+	// DB_DRIVERでMySQLとPostgreSQLを切り替える
+	instance, err := database.DriverInstance()
+	if err != nil {
+		logger.Fatal(err.Error())
+	}
+	db, err := database.NewDatabaseSQLFactory(instance)
+	if err != nil {
+		logger.Fatal(err.Error())
+	}
+
+	signPolicy := usecase.NewTransactionSignPolicy(config.NewConfig().TransactionSignPolicy)
+	categoryReferenceUseCase := usecase.NewCategoryReferenceUseCase(gateway.NewCategoryReferenceRepository(db), signPolicy)
+	report, err := categoryReferenceUseCase.Check()
+	if err != nil {
+		logger.Fatal(err.Error())
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		logger.Fatal(err.Error())
+	}
+	fmt.Fprintf(os.Stderr, "checked %d transactions (sign policy: %s): %d cross-user, %d missing category, %d sign violations\n",
+		report.Checked, signPolicy, len(report.CrossUser), len(report.MissingCategory), len(report.SignViolations))
+	if report.HasIssues() {
+		logger.Sync()
+		os.Exit(1)
+	}
+}
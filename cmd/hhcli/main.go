@@ -0,0 +1,36 @@
+// hhcli はコマンドラインから取引やカテゴリーを登録・参照する家計簿のクライアント
+// 認証情報は設定ファイル($HHCLI_CONFIG、なければユーザーの設定ディレクトリのhhcli/config.json)に保存する
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"household-account-backend/pkg/cli"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	app := &cli.App{Stdin: os.Stdin, Stdout: os.Stdout, Stderr: os.Stderr}
+	err := app.Run(ctx, os.Args[1:])
+	switch {
+	case err == nil:
+		return
+	case errors.Is(err, flag.ErrHelp):
+		stop()
+		os.Exit(0)
+	case errors.Is(err, cli.ErrUsage):
+		fmt.Fprintln(os.Stderr, "hhcli:", err)
+		stop()
+		os.Exit(2)
+	}
+	fmt.Fprintln(os.Stderr, "hhcli:", err)
+	stop()
+	os.Exit(1)
+}
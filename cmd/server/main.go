@@ -13,9 +13,12 @@ import (
 
 	"github.com/joho/godotenv"
 
+	"household-account-backend/adapter/controller/container"
 	"household-account-backend/infrastructure/database"
 	"household-account-backend/infrastructure/web"
+	"household-account-backend/infrastructure/worker"
 	"household-account-backend/pkg"
+	"household-account-backend/pkg/config"
 	"household-account-backend/pkg/logger"
 )
 
@@ -29,13 +32,39 @@ func main() {
 		logger.Info("Loaded SECRET from .env.development: ", os.Getenv("SECRET"))
 	}
 
-	db, err := database.NewDatabaseSQLFactory(database.InstanceMySQL)
+	// DB_DRIVERでMySQLとPostgreSQLを切り替える
+	databaseInstance, err := database.DriverInstance()
 	if err != nil {
 		logger.Fatal(err.Error())
 	}
+	db, err := database.NewDatabaseSQLFactory(databaseInstance)
+	if err != nil {
+		logger.Fatal(err.Error())
+	}
+
+	// 設定は起動時に1度だけ読み込み、ワーカーとサーバーに渡す
+	configs := config.NewConfig()
+
+	webhookWorker := worker.NewWebhookWorker(db, configs)
+	webhookWorker.Start()
+
+	idempotencyWorker := worker.NewIdempotencyWorker(db, configs)
+	idempotencyWorker.Start()
+
+	accountDataWorker := worker.NewAccountDataWorker(db, configs)
+	accountDataWorker.Start()
 
-	// server, err := web.NewServer(web.InstanceGin, db)
-	server, err := web.NewServer(web.InstanceEcho, db)
+	// WEB_FRAMEWORKでechoとginを切り替える
+	frameworkInstance, err := web.FrameworkInstance(web.NewConfigWeb().Framework)
+	if err != nil {
+		logger.Fatal(err.Error())
+	}
+	// WebとgRPCのサーバーは同じユースケースを使い、イベントの通知なども共有する
+	deps, err := container.New(db, configs)
+	if err != nil {
+		logger.Fatal(err.Error())
+	}
+	server, err := web.NewServer(frameworkInstance, deps)
 	if err != nil {
 		logger.Fatal(err.Error())
 	}
@@ -45,6 +74,17 @@ func main() {
 		}
 	}()
 
+	// 社内のサービス向けのgRPCのサーバーはWebのサーバーと並行して起動する
+	grpcServer, err := web.NewServer(web.InstanceGRPC, deps)
+	if err != nil {
+		logger.Fatal(err.Error())
+	}
+	go func() {
+		if err := grpcServer.Start(); err != nil {
+			logger.Fatal(err.Error())
+		}
+	}()
+
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
@@ -56,5 +96,17 @@ func main() {
 	if err := server.Shutdown(ctx); err != nil {
 		logger.Error(fmt.Sprintf("Server Shutdown: %s", err.Error()))
 	}
+	if err := grpcServer.Shutdown(ctx); err != nil {
+		logger.Error(fmt.Sprintf("gRPC Server Shutdown: %s", err.Error()))
+	}
+	if err := webhookWorker.Shutdown(ctx); err != nil {
+		logger.Error(fmt.Sprintf("Webhook Worker Shutdown: %s", err.Error()))
+	}
+	if err := idempotencyWorker.Shutdown(ctx); err != nil {
+		logger.Error(fmt.Sprintf("Idempotency Worker Shutdown: %s", err.Error()))
+	}
+	if err := accountDataWorker.Shutdown(ctx); err != nil {
+		logger.Error(fmt.Sprintf("Account Data Worker Shutdown: %s", err.Error()))
+	}
 	<-ctx.Done()
 }
\ No newline at end of file
@@ -0,0 +1,60 @@
+package entity
+
+import "time"
+
+const (
+	DataExportStatusPending    = "pending"
+	DataExportStatusProcessing = "processing"
+	DataExportStatusCompleted  = "completed"
+	DataExportStatusFailed     = "failed"
+	// DataExportStatusExpired はダウンロード期限を過ぎてファイルを削除したエクスポート
+	DataExportStatusExpired = "expired"
+)
+
+// DataExport はユーザーが持ち出すデータのアーカイブ。作成はワーカーで非同期に行う
+type DataExport struct {
+	ID          int        `json:"id"`
+	UserID      int        `json:"user_id"`
+	Status      string     `json:"status"`
+	FileKey     string     `json:"-"` // ファイルストレージ上のキー
+	FileSize    int64      `json:"file_size"`
+	Error       string     `json:"error"`
+	ExpiresAt   *time.Time `json:"expires_at"` // 作成完了後、ダウンロードできる期限
+	StartedAt   *time.Time `json:"started_at"` // ワーカーが作成を開始した日時
+	CreatedAt   time.Time  `json:"created_at"`
+	CompletedAt *time.Time `json:"completed_at"`
+}
+
+// Downloadable は指定した時刻にダウンロードできるかを返す
+func (e *DataExport) Downloadable(now time.Time) bool {
+	return e.Status == DataExportStatusCompleted && e.ExpiresAt != nil && now.Before(*e.ExpiresAt)
+}
+
+const (
+	// AccountDeletionStatusPending はメールでの確認待ち
+	AccountDeletionStatusPending = "pending"
+	// AccountDeletionStatusScheduled は確認済みで、猶予期間の経過後に削除される
+	AccountDeletionStatusScheduled = "scheduled"
+	AccountDeletionStatusCancelled = "cancelled"
+	AccountDeletionStatusCompleted = "completed"
+	AccountDeletionStatusFailed    = "failed"
+)
+
+// AccountDeletion はアカウントの削除依頼と、削除後の検証結果
+// ユーザーの削除後も記録として残すため、usersへの外部キーは持たない
+type AccountDeletion struct {
+	ID          int        `json:"id"`
+	UserID      int        `json:"user_id"`
+	Email       string     `json:"-"` // 削除結果の通知先。削除完了後に消去する
+	Status      string     `json:"status"`
+	ScheduledAt *time.Time `json:"scheduled_at"` // 猶予期間の終了日時
+	Report      string     `json:"report"`       // 削除した件数と削除後に残った件数のJSON
+	CreatedAt   time.Time  `json:"created_at"`
+	ConfirmedAt *time.Time `json:"confirmed_at"`
+	CompletedAt *time.Time `json:"completed_at"`
+}
+
+// Active は取り消しや完了をしていない削除依頼かどうかを返す
+func (d *AccountDeletion) Active() bool {
+	return d.Status == AccountDeletionStatusPending || d.Status == AccountDeletionStatusScheduled
+}
@@ -0,0 +1,37 @@
+package entity
+
+import "time"
+
+// 監査ログに記録する管理者の操作
+const (
+	AdminActionDisableUser = "user.disable"
+	AdminActionEnableUser  = "user.enable"
+	AdminActionForceLogout = "user.force_logout"
+	AdminActionUpdateRole  = "user.update_role"
+	AdminActionImpersonate = "user.impersonate"
+)
+
+// AdminAuditLog は管理者の操作の記録
+// 対象のユーザーが削除された後も残すため、usersへの外部キーは設定しない
+type AdminAuditLog struct {
+	ID           int       `json:"id"`
+	AdminID      int       `json:"admin_id"`
+	Action       string    `json:"action"`
+	TargetUserID int       `json:"target_user_id"`
+	Detail       string    `json:"detail"`
+	IPAddress    string    `json:"ip_address"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// SystemStats はシステム全体の利用状況
+type SystemStats struct {
+	UserCount               int64     `json:"user_count"`
+	DisabledUserCount       int64     `json:"disabled_user_count"`
+	AdminCount              int64     `json:"admin_count"`
+	NewUserCount            int64     `json:"new_user_count"` // Since以降に登録したユーザー数
+	TransactionCount        int64     `json:"transaction_count"`
+	TransactionAmount       float64   `json:"transaction_amount"`
+	RecentTransactionCount  int64     `json:"recent_transaction_count"` // 日付がSince以降の取引の件数
+	RecentTransactionAmount float64   `json:"recent_transaction_amount"`
+	Since                   time.Time `json:"since"`
+}
@@ -1,8 +1,15 @@
 package entity
 
+// カテゴリーの種類
+const (
+	CategoryTypeIncome  = "income"
+	CategoryTypeExpense = "expense"
+)
+
 type Category struct {
-	ID        int    `json:"id"`
-	UserID    int    `json:"user_id"`
-	Name      string `json:"name"`
-	Type      string `json:"type"` // "income" or "expense"
+	ID      int    `json:"id"`
+	UserID  int    `json:"user_id"`
+	Name    string `json:"name"`
+	Type    string `json:"type"`                              // "income" or "expense"
+	Version int    `json:"version" gorm:"not null;default:1"` // 楽観的排他制御用のバージョン
 }
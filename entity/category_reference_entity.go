@@ -0,0 +1,25 @@
+package entity
+
+// CategoryReference は取引とその取引が参照するカテゴリー
+// カテゴリーが存在しない場合、CategoryUserIDはnilでCategoryTypeは空になる
+type CategoryReference struct {
+	TransactionID     int     `json:"transaction_id"`
+	TransactionUserID int     `json:"transaction_user_id"`
+	CategoryID        int     `json:"category_id"`
+	CategoryUserID    *int    `json:"category_user_id"`
+	CategoryType      string  `json:"category_type"`
+	Amount            float32 `json:"amount"`
+}
+
+// CategoryReferenceReport は既存の取引のカテゴリーの参照を確認した結果
+type CategoryReferenceReport struct {
+	Checked         int                 `json:"checked"`
+	CrossUser       []CategoryReference `json:"cross_user"`       // 他のユーザーのカテゴリーを参照している取引
+	MissingCategory []CategoryReference `json:"missing_category"` // 存在しないカテゴリーを参照している取引
+	SignViolations  []CategoryReference `json:"sign_violations"`  // 金額の符号が規約に合わない取引
+}
+
+// HasIssues は問題のある参照が見つかったかどうかを返す
+func (r *CategoryReferenceReport) HasIssues() bool {
+	return len(r.CrossUser) > 0 || len(r.MissingCategory) > 0 || len(r.SignViolations) > 0
+}
@@ -0,0 +1,42 @@
+package entity
+
+import "time"
+
+// ユースケースから発行されるイベントの種類
+const (
+	EventTransactionCreated    = "transaction.created"
+	EventTransactionUpdated    = "transaction.updated"
+	EventTransactionDeleted    = "transaction.deleted"
+	EventCategoryCreated       = "category.created"
+	EventCategoryUpdated       = "category.updated"
+	EventCategoryDeleted       = "category.deleted"
+	EventMonthlySummaryCreated = "monthly_summary.created"
+	EventMonthlySummaryUpdated = "monthly_summary.updated"
+	EventMonthlySummaryDeleted = "monthly_summary.deleted"
+)
+
+func EventTypes() []string {
+	return []string{
+		EventTransactionCreated,
+		EventTransactionUpdated,
+		EventTransactionDeleted,
+		EventCategoryCreated,
+		EventCategoryUpdated,
+		EventCategoryDeleted,
+		EventMonthlySummaryCreated,
+		EventMonthlySummaryUpdated,
+		EventMonthlySummaryDeleted,
+	}
+}
+
+// OutboxEvent はアウトボックステーブルに保存される未配信のイベント
+type OutboxEvent struct {
+	ID          int        `json:"id"`
+	UserID      int        `json:"user_id"`
+	Type        string     `json:"type"`
+	Payload     string     `json:"payload"` // JSON encoded
+	Attempts    int        `json:"attempts"`
+	ProcessedAt *time.Time `json:"processed_at"`
+	LockedUntil *time.Time `json:"-"` // 配信中のワーカーが取得している期限
+	CreatedAt   time.Time  `json:"created_at"`
+}
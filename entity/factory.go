@@ -6,5 +6,19 @@ func NewDomains() []interface{} {
 		Category{},
 		Transaction{},
 		MonthlySummary{},
+		OutboxEvent{},
+		Webhook{},
+		WebhookDelivery{},
+		IdempotencyRecord{},
+		UserToken{},
+		TOTPCredential{},
+		RecoveryCode{},
+		UserIdentity{},
+		OIDCLoginState{},
+		LoginAttempt{},
+		PersonalAccessToken{},
+		DataExport{},
+		AccountDeletion{},
+		AdminAuditLog{},
 	}
 }
\ No newline at end of file
@@ -0,0 +1,40 @@
+package entity
+
+// ヘルスチェックの状態
+const (
+	HealthStatusOK          = "ok"
+	HealthStatusUnavailable = "unavailable"
+)
+
+// Readiness はサーバーがリクエストを受け付けられる状態かを表す
+// 依存するサービスのいずれかが利用できない場合はunavailableになる
+type Readiness struct {
+	Status   string         `json:"status"`
+	Database DatabaseHealth `json:"database"`
+}
+
+// Ready はリクエストを受け付けられるかどうかを返す
+func (r *Readiness) Ready() bool {
+	return r.Status == HealthStatusOK
+}
+
+// DatabaseHealth はデータベースへの接続の状態と接続プールの統計
+// 接続できない理由は接続先の情報を含むため、レスポンスには含めずログに記録する
+type DatabaseHealth struct {
+	Status string             `json:"status"`
+	Pool   *DatabasePoolStats `json:"pool,omitempty"`
+}
+
+// DatabasePoolStats は接続プールの統計
+type DatabasePoolStats struct {
+	MaxOpenConnections int   `json:"max_open_connections"`
+	OpenConnections    int   `json:"open_connections"`
+	InUse              int   `json:"in_use"`
+	Idle               int   `json:"idle"`
+	WaitCount          int64 `json:"wait_count"`
+	// WaitDurationMs は接続が空くのを待った時間の合計(ミリ秒)
+	WaitDurationMs    int64 `json:"wait_duration_ms"`
+	MaxIdleClosed     int64 `json:"max_idle_closed"`
+	MaxIdleTimeClosed int64 `json:"max_idle_time_closed"`
+	MaxLifetimeClosed int64 `json:"max_lifetime_closed"`
+}
@@ -0,0 +1,28 @@
+package entity
+
+import "time"
+
+// IdempotencyRecord はIdempotency-Keyヘッダー付きリクエストの処理結果
+// 同じキーで再送されたリクエストには保存したレスポンスをそのまま返す
+// UserIDとIdempotencyKeyの組は一意で、同じ組を作成するとErrDuplicateKeyになる
+type IdempotencyRecord struct {
+	ID              int       `json:"id"`
+	UserID          int       `json:"user_id" gorm:"uniqueIndex:uk_idempotency_records_user_key"`
+	IdempotencyKey  string    `json:"idempotency_key" gorm:"uniqueIndex:uk_idempotency_records_user_key"`
+	RequestHash     string    `json:"request_hash"`     // メソッド・パス・ボディのSHA-256
+	StatusCode      int       `json:"status_code"`      // 0の場合は処理中
+	ResponseHeaders string    `json:"response_headers"` // JSON encoded
+	ResponseBody    string    `json:"response_body"`
+	ExpiresAt       time.Time `json:"expires_at"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// Completed はレスポンスが保存済みかどうかを返す
+func (r *IdempotencyRecord) Completed() bool {
+	return r.StatusCode != 0
+}
+
+// Expired は有効期限が切れているかどうかを返す
+func (r *IdempotencyRecord) Expired(now time.Time) bool {
+	return !now.Before(r.ExpiresAt)
+}
@@ -0,0 +1,32 @@
+package entity
+
+import (
+	"strings"
+	"time"
+)
+
+// LoginAttempt はアカウントまたはIPアドレスごとのログイン失敗の記録
+type LoginAttempt struct {
+	ID           int        `json:"id"`
+	AttemptKey   string     `json:"attempt_key"` // LoginAttemptAccountKey または LoginAttemptIPKey で作成する
+	Failures     int        `json:"failures"`    // 最後の失敗から一定時間経過するとリセットされる
+	LastFailedAt time.Time  `json:"last_failed_at"`
+	LockedUntil  *time.Time `json:"locked_until"` // nilの場合はロックされていない
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+// Locked は指定した時刻にロック中かどうかを返す
+func (a *LoginAttempt) Locked(now time.Time) bool {
+	return a.LockedUntil != nil && now.Before(*a.LockedUntil)
+}
+
+// LoginAttemptAccountKey はアカウント単位の失敗回数を記録するキーを返す
+// 登録の有無を推測されないよう、未登録のメールアドレスも同じように記録する
+func LoginAttemptAccountKey(email string) string {
+	return "account:" + strings.ToLower(strings.TrimSpace(email))
+}
+
+// LoginAttemptIPKey はIPアドレス単位の失敗回数を記録するキーを返す
+func LoginAttemptIPKey(ip string) string {
+	return "ip:" + ip
+}
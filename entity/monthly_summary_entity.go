@@ -7,4 +7,5 @@ type MonthlySummary struct {
 	Income    float64 `json:"income"`
 	Expense   float64 `json:"expense"`
 	Balance   float64 `json:"balance"`
+	Version   int     `json:"version" gorm:"not null;default:1"` // 楽観的排他制御用のバージョン
 }
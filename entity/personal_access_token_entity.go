@@ -0,0 +1,68 @@
+package entity
+
+import (
+	"strings"
+	"time"
+)
+
+// パーソナルアクセストークンに付与できるスコープ
+const (
+	ScopeReadTransactions  = "read:transactions"
+	ScopeWriteTransactions = "write:transactions"
+	ScopeReadCategories    = "read:categories"
+	ScopeWriteCategories   = "write:categories"
+	ScopeReadReports       = "read:reports" // 月次集計
+	ScopeWriteReports      = "write:reports"
+	ScopeReadWebhooks      = "read:webhooks"
+	ScopeWriteWebhooks     = "write:webhooks"
+	ScopeReadEvents        = "read:events"
+)
+
+func TokenScopes() []string {
+	return []string{
+		ScopeReadTransactions,
+		ScopeWriteTransactions,
+		ScopeReadCategories,
+		ScopeWriteCategories,
+		ScopeReadReports,
+		ScopeWriteReports,
+		ScopeReadWebhooks,
+		ScopeWriteWebhooks,
+		ScopeReadEvents,
+	}
+}
+
+// PersonalAccessToken はスクリプトや外部連携からAuthorizationヘッダーで使うトークン
+// トークン本体は保存せず、SHA-256ハッシュのみを保存する
+type PersonalAccessToken struct {
+	ID          int        `json:"id"`
+	UserID      int        `json:"user_id"`
+	Name        string     `json:"name"`
+	TokenPrefix string     `json:"token_prefix"` // 一覧でトークンを見分けるための先頭部分
+	TokenHash   string     `json:"-"`
+	Scopes      string     `json:"scopes"`     // Comma separated scopes
+	ExpiresAt   *time.Time `json:"expires_at"` // nilの場合は無期限
+	LastUsedAt  *time.Time `json:"last_used_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+func (t *PersonalAccessToken) ScopeList() []string {
+	if t.Scopes == "" {
+		return []string{}
+	}
+	return strings.Split(t.Scopes, ",")
+}
+
+func (t *PersonalAccessToken) HasScope(scope string) bool {
+	for _, s := range t.ScopeList() {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// Expired は指定した時刻に有効期限が切れているかどうかを返す
+func (t *PersonalAccessToken) Expired(now time.Time) bool {
+	return t.ExpiresAt != nil && !now.Before(*t.ExpiresAt)
+}
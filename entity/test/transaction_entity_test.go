@@ -13,6 +13,7 @@ func TestTransaction(t *testing.T) {
 	transaction := entity.Transaction{
 		ID:         1,
 		UserID:     2,
+		CategoryID: 3,
 		Date:       time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
 		Amount:     1000.50,
 		Content:    "Grocery shopping",
@@ -20,7 +21,7 @@ func TestTransaction(t *testing.T) {
 	assert.Equal(t, 1, transaction.ID)
 	assert.Equal(t, 2, transaction.UserID)
 	assert.Equal(t, 3, transaction.CategoryID)
-	assert.Equal(t, "2025-01-01", transaction.Date)
-	assert.Equal(t, 1000.50, transaction.Amount)
+	assert.Equal(t, "2025-01-01", transaction.Date.Format("2006-01-02"))
+	assert.Equal(t, float32(1000.50), transaction.Amount)
 	assert.Equal(t, "Grocery shopping", transaction.Content)
 }
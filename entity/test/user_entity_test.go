@@ -2,6 +2,7 @@ package entity_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -20,3 +21,22 @@ func TestUser(t *testing.T) {
 	assert.Equal(t, "password123", user.Password)
 	assert.Equal(t, "jhon", user.Name)
 }
+
+func TestUserSessionRevoked(t *testing.T) {
+	revokedAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	user := entity.User{ID: 1}
+	assert.False(t, user.SessionRevoked(revokedAt))
+
+	user.SessionsRevokedAt = &revokedAt
+	assert.True(t, user.SessionRevoked(revokedAt.Add(-time.Hour)))
+	// 同じ秒に発行したトークンも無効にする
+	assert.True(t, user.SessionRevoked(revokedAt))
+	assert.False(t, user.SessionRevoked(revokedAt.Add(time.Second)))
+}
+
+func TestUserRole(t *testing.T) {
+	assert.False(t, (&entity.User{Role: entity.UserRoleUser}).IsAdmin())
+	assert.True(t, (&entity.User{Role: entity.UserRoleAdmin}).IsAdmin())
+	assert.True(t, entity.ValidUserRole(entity.UserRoleAdmin))
+	assert.False(t, entity.ValidUserRole("owner"))
+}
@@ -0,0 +1,25 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"household-account-backend/entity"
+)
+
+func TestWebhookSubscribes(t *testing.T) {
+	webhook := entity.Webhook{
+		ID:     1,
+		UserID: 2,
+		URL:    "https://example.com/hook",
+		Events: "transaction.created,category.deleted",
+	}
+	assert.Equal(t, []string{"transaction.created", "category.deleted"}, webhook.EventList())
+	assert.True(t, webhook.Subscribes(entity.EventTransactionCreated))
+	assert.True(t, webhook.Subscribes(entity.EventCategoryDeleted))
+	assert.False(t, webhook.Subscribes(entity.EventTransactionUpdated))
+
+	webhook.Events = entity.WebhookEventAll
+	assert.True(t, webhook.Subscribes(entity.EventMonthlySummaryDeleted))
+}
@@ -3,10 +3,18 @@ package entity
 import "time"
 
 type Transaction struct {
-	ID         int     `json:"id"`
-	UserID     int     `json:"user_id"`
-	CategoryID int     `json:"category_id"`
-	Date       time.Time  `json:"date"`       // Format: YYYY-MM-DD
-	Amount     float32 `json:"amount"`     // Decimal value
-	Content    string  `json:"content"`    // Optional description
+	ID         int       `json:"id"`
+	UserID     int       `json:"user_id"`
+	CategoryID int       `json:"category_id"`
+	Date       time.Time `json:"date"`                              // Format: YYYY-MM-DD
+	Amount     float32   `json:"amount"`                            // Decimal value
+	Content    string    `json:"content"`                           // Optional description
+	Version    int       `json:"version" gorm:"not null;default:1"` // 楽観的排他制御用のバージョン
+}
+
+// TransactionFilter は取引を絞り込む条件。値がない項目は条件にしない
+type TransactionFilter struct {
+	From        *time.Time // この日以降の取引
+	To          *time.Time // この日以前の取引
+	CategoryIDs []int
 }
@@ -0,0 +1,25 @@
+package entity
+
+// 一括操作の種類
+const (
+	TransactionOperationCreate = "create"
+	TransactionOperationUpdate = "update"
+	TransactionOperationDelete = "delete"
+)
+
+// TransactionOperation は一括操作で実行する1件分の操作
+type TransactionOperation struct {
+	Op          string
+	Transaction Transaction
+}
+
+// TransactionOperationResult は一括操作の1件分の実行結果
+type TransactionOperationResult struct {
+	Index       int
+	Op          string
+	Success     bool
+	Transaction *Transaction
+	Error       string
+	// Err は失敗した理由のエラー。Errorはそのメッセージ
+	Err error
+}
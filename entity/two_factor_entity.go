@@ -0,0 +1,30 @@
+package entity
+
+import "time"
+
+// TOTPCredential はユーザーの認証アプリ(TOTP)の設定
+// EnabledAtがnilの間は登録確認待ちで、ログインには使わない
+type TOTPCredential struct {
+	ID           int        `json:"id"`
+	UserID       int        `json:"user_id"`
+	Secret       string     `json:"-"`
+	EnabledAt    *time.Time `json:"enabled_at"`
+	LastUsedStep int64      `json:"-"` // 同じコードの再利用を防ぐため最後に受け付けたタイムステップを保存する
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+// Enabled は二要素認証が有効かどうかを返す
+func (c *TOTPCredential) Enabled() bool {
+	return c.EnabledAt != nil
+}
+
+// RecoveryCode は認証アプリを使えない場合に一度だけ使えるコード
+// コード本体は保存せず、SHA-256ハッシュのみを保存する
+type RecoveryCode struct {
+	ID        int        `json:"id"`
+	UserID    int        `json:"user_id"`
+	CodeHash  string     `json:"-"`
+	UsedAt    *time.Time `json:"used_at"`
+	CreatedAt time.Time  `json:"created_at"`
+}
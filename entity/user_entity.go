@@ -1,13 +1,52 @@
 package entity
 
+import "time"
+
+const (
+	UserRoleUser  = "user"
+	UserRoleAdmin = "admin"
+)
+
 type User struct {
-	ID       int    `json:"id"`
-	Email	 string `json:"email"`
-	Password string `json:"password"`
-	Name     string `json:"name"`
+	ID              int        `json:"id"`
+	Email           string     `json:"email" gorm:"uniqueIndex:uk_users_email"` // 登録済みのメールアドレスはErrDuplicateKeyになる
+	Password        string     `json:"password"`
+	Name            string     `json:"name"`
+	EmailVerifiedAt *time.Time `json:"email_verified_at"` // nilの場合は未確認
+	Role            string     `json:"role" gorm:"not null;default:user"`
+	DisabledAt      *time.Time `json:"disabled_at"` // nilの場合は有効
+	// SessionsRevokedAt 以前に発行した認証トークンは無効とする。強制ログアウトで更新する
+	SessionsRevokedAt *time.Time `json:"-"`
+	CreatedAt         time.Time  `json:"created_at"`
+}
+
+// EmailVerified はメールアドレスが確認済みかどうかを返す
+func (u *User) EmailVerified() bool {
+	return u.EmailVerifiedAt != nil
+}
+
+// IsAdmin は管理者かどうかを返す
+func (u *User) IsAdmin() bool {
+	return u.Role == UserRoleAdmin
+}
+
+// Disabled は管理者によって無効にされているかどうかを返す
+func (u *User) Disabled() bool {
+	return u.DisabledAt != nil
+}
+
+// SessionRevoked はissuedAtに発行した認証トークンが強制ログアウトで無効になっているかを返す
+// JWTのiatは秒単位のため、無効にした時刻と同じ秒に発行したトークンも無効として扱う
+func (u *User) SessionRevoked(issuedAt time.Time) bool {
+	return u.SessionsRevokedAt != nil && !issuedAt.After(*u.SessionsRevokedAt)
+}
+
+// ValidUserRole は指定できるロールかどうかを返す
+func ValidUserRole(role string) bool {
+	return role == UserRoleUser || role == UserRoleAdmin
 }
 
 type Credentials struct {
 	Email    string
 	Password string
-}
\ No newline at end of file
+}
@@ -0,0 +1,36 @@
+package entity
+
+import "time"
+
+// UserIdentity は外部のOpenID Connectプロバイダのアカウントとユーザーの紐付け
+type UserIdentity struct {
+	ID        int       `json:"id"`
+	UserID    int       `json:"user_id"`
+	Provider  string    `json:"provider"`
+	Subject   string    `json:"subject"` // プロバイダ内で一意なユーザーID(subクレーム)
+	Email     string    `json:"email"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// OIDCLoginState は認可リクエストからコールバックまでの間に保持する情報
+// stateはハッシュのみを保存し、コールバック時に一度だけ取り出せる
+type OIDCLoginState struct {
+	ID           int       `json:"id"`
+	StateHash    string    `json:"-"`
+	Provider     string    `json:"provider"`
+	CodeVerifier string    `json:"-"` // PKCEのcode_verifier
+	Nonce        string    `json:"-"`
+	LinkUserID   int       `json:"link_user_id"` // 0の場合はログイン、それ以外はこのユーザーへの紐付け
+	ExpiresAt    time.Time `json:"expires_at"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// TableName はテーブル名を返す。GORMの命名規則では o_id_c_login_states になるため指定する
+func (OIDCLoginState) TableName() string {
+	return "oidc_login_states"
+}
+
+// Expired は有効期限が切れているかどうかを返す
+func (s *OIDCLoginState) Expired(now time.Time) bool {
+	return !now.Before(s.ExpiresAt)
+}
@@ -0,0 +1,32 @@
+package entity
+
+import "time"
+
+const (
+	UserTokenPurposeEmailVerification = "email_verification"
+	UserTokenPurposePasswordReset     = "password_reset"
+	// UserTokenPurposeLoginChallenge はパスワード認証後、TOTPコードの入力を待つログイン
+	UserTokenPurposeLoginChallenge = "login_challenge"
+	// UserTokenPurposeAccountUnlock はログイン失敗によるロックを解除するトークン
+	UserTokenPurposeAccountUnlock = "account_unlock"
+	// UserTokenPurposeAccountDeletion はアカウント削除の依頼をメールで確認するトークン
+	UserTokenPurposeAccountDeletion = "account_deletion"
+)
+
+// UserToken はメール確認やパスワード再設定、二段階ログインに使う使い捨てトークン
+// トークン本体は保存せず、SHA-256ハッシュのみを保存する
+type UserToken struct {
+	ID        int        `json:"id"`
+	UserID    int        `json:"user_id"`
+	Purpose   string     `json:"purpose"`
+	TokenHash string     `json:"token_hash"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at"`  // nilの場合は未使用
+	Attempts  int        `json:"attempts"` // 検証に失敗した回数
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// Usable は未使用かつ有効期限内かどうかを返す
+func (t *UserToken) Usable(now time.Time) bool {
+	return t.UsedAt == nil && now.Before(t.ExpiresAt)
+}
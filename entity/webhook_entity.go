@@ -0,0 +1,48 @@
+package entity
+
+import (
+	"strings"
+	"time"
+)
+
+// WebhookEventAll は全てのイベントを購読する場合に指定する
+const WebhookEventAll = "*"
+
+type Webhook struct {
+	ID        int       `json:"id"`
+	UserID    int       `json:"user_id"`
+	URL       string    `json:"url"`
+	Secret    string    `json:"secret"`
+	Events    string    `json:"events"` // Comma separated event types
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (w *Webhook) EventList() []string {
+	if w.Events == "" {
+		return []string{}
+	}
+	return strings.Split(w.Events, ",")
+}
+
+func (w *Webhook) Subscribes(eventType string) bool {
+	for _, event := range w.EventList() {
+		if event == WebhookEventAll || event == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+type WebhookDelivery struct {
+	ID         int       `json:"id"`
+	WebhookID  int       `json:"webhook_id"`
+	EventID    int       `json:"event_id"`
+	EventType  string    `json:"event_type"`
+	Payload    string    `json:"payload"`
+	StatusCode int       `json:"status_code"`
+	Success    bool      `json:"success"`
+	Error      string    `json:"error"`
+	Replay     bool      `json:"replay"`
+	CreatedAt  time.Time `json:"created_at"`
+}
@@ -1,6 +1,9 @@
 package database
 
 import (
+	"strconv"
+	"time"
+
 	"household-account-backend/pkg"
 )
 
@@ -11,6 +14,12 @@ type Config struct {
 	Driver   string
 	User     string
 	Password string
+	// DSN は接続文字列。PostgreSQLで設定した場合は他の接続の設定より優先する
+	DSN string
+	// SSLMode はPostgreSQLのsslmode。disable, require, verify-full など
+	SSLMode string
+	// Schema はPostgreSQLでテーブルを作成するスキーマ
+	Schema string
 }
 
 func NewConfigMySQL() *Config {
@@ -24,8 +33,81 @@ func NewConfigMySQL() *Config {
 	}
 }
 
+func NewConfigPostgreSQL() *Config {
+	return &Config{
+		Host:     pkg.GetEnvDefault("DB_HOST", "localhost"),
+		Database: pkg.GetEnvDefault("DB_NAME", "api_database"),
+		Port:     pkg.GetEnvDefault("DB_PORT", "5432"),
+		Driver:   pkg.GetEnvDefault("DB_DRIVER", "postgres"),
+		User:     pkg.GetEnvDefault("DB_USER", "app"),
+		Password: pkg.GetEnvDefault("DB_PASSWORD", "password"),
+		DSN:      pkg.GetEnvDefault("DB_DSN", ""),
+		SSLMode:  pkg.GetEnvDefault("DB_SSLMODE", "disable"),
+		Schema:   pkg.GetEnvDefault("DB_SCHEMA", "public"),
+	}
+}
+
 func NewConfigSQLite() *Config {
 	return &Config{
 		Database: pkg.GetEnvDefault("DB_NAME", "api_database.sqlite"),
 	}
-}
\ No newline at end of file
+}
+
+// ConnectionConfig はMySQLとPostgreSQLの接続プールと起動時の接続の設定
+type ConnectionConfig struct {
+	// MaxOpenConns は同時に開く接続の上限。0の場合は制限しない
+	MaxOpenConns int
+	// MaxIdleConns は使っていない状態で保持する接続の上限
+	MaxIdleConns int
+	// ConnMaxLifetime は接続を再利用する期間。データベース側のタイムアウトより短くする
+	ConnMaxLifetime time.Duration
+	// ConnMaxIdleTime は使っていない接続を閉じるまでの時間
+	ConnMaxIdleTime time.Duration
+
+	// ConnectMaxAttempts は起動時に接続を試みる回数
+	// コンテナの起動直後などデータベースの準備ができていない場合に待つ
+	ConnectMaxAttempts int
+	// ConnectRetryWait は最初の再試行までの待ち時間。再試行のたびに倍にし、ConnectMaxRetryWaitを上限とする
+	ConnectRetryWait    time.Duration
+	ConnectMaxRetryWait time.Duration
+}
+
+func NewConfigConnection() *ConnectionConfig {
+	maxOpenConns, err := strconv.Atoi(pkg.GetEnvDefault("DB_MAX_OPEN_CONNS", "25"))
+	if err != nil {
+		maxOpenConns = 25
+	}
+	maxIdleConns, err := strconv.Atoi(pkg.GetEnvDefault("DB_MAX_IDLE_CONNS", "10"))
+	if err != nil {
+		maxIdleConns = 10
+	}
+	connMaxLifetime, err := time.ParseDuration(pkg.GetEnvDefault("DB_CONN_MAX_LIFETIME", "30m"))
+	if err != nil {
+		connMaxLifetime = 30 * time.Minute
+	}
+	connMaxIdleTime, err := time.ParseDuration(pkg.GetEnvDefault("DB_CONN_MAX_IDLE_TIME", "5m"))
+	if err != nil {
+		connMaxIdleTime = 5 * time.Minute
+	}
+	connectMaxAttempts, err := strconv.Atoi(pkg.GetEnvDefault("DB_CONNECT_MAX_ATTEMPTS", "10"))
+	if err != nil || connectMaxAttempts < 1 {
+		connectMaxAttempts = 10
+	}
+	connectRetryWait, err := time.ParseDuration(pkg.GetEnvDefault("DB_CONNECT_RETRY_WAIT", "1s"))
+	if err != nil {
+		connectRetryWait = time.Second
+	}
+	connectMaxRetryWait, err := time.ParseDuration(pkg.GetEnvDefault("DB_CONNECT_MAX_RETRY_WAIT", "30s"))
+	if err != nil {
+		connectMaxRetryWait = 30 * time.Second
+	}
+	return &ConnectionConfig{
+		MaxOpenConns:        maxOpenConns,
+		MaxIdleConns:        maxIdleConns,
+		ConnMaxLifetime:     connMaxLifetime,
+		ConnMaxIdleTime:     connMaxIdleTime,
+		ConnectMaxAttempts:  connectMaxAttempts,
+		ConnectRetryWait:    connectRetryWait,
+		ConnectMaxRetryWait: connectMaxRetryWait,
+	}
+}
@@ -3,20 +3,53 @@ package database
 import (
 	"errors"
 	"fmt"
+	"net"
+	"net/url"
+	"time"
+
 	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
+
+	"household-account-backend/pkg"
+	"household-account-backend/pkg/logger"
 )
 
 const (
 	InstanceSQLite = iota
 	InstanceMySQL
+	InstancePostgreSQL
+)
+
+// DB_DRIVERに指定するドライバ名
+const (
+	DriverSQLite     = "sqlite"
+	DriverMySQL      = "mysql"
+	DriverPostgreSQL = "postgres"
 )
 
 var (
 	errInvalidSQLDatabaseInstance = errors.New("invalid sql db instance")
+	errInvalidSQLDatabaseDriver   = errors.New("invalid sql db driver")
 )
 
+// DriverInstance はDB_DRIVERの値からNewDatabaseSQLFactoryに渡すインスタンスを返す
+// 未設定の場合はMySQLを使う
+func DriverInstance() (int, error) {
+	driver := pkg.GetEnvDefault("DB_DRIVER", DriverMySQL)
+	switch driver {
+	case DriverMySQL:
+		return InstanceMySQL, nil
+	case DriverPostgreSQL, "postgresql":
+		return InstancePostgreSQL, nil
+	case DriverSQLite:
+		return InstanceSQLite, nil
+	default:
+		return 0, fmt.Errorf("%w: %q", errInvalidSQLDatabaseDriver, driver)
+	}
+}
+
 func NewDatabaseSQLFactory(instance int) (db *gorm.DB, err error) {
 	switch instance {
 	case InstanceMySQL:
@@ -28,12 +61,86 @@ func NewDatabaseSQLFactory(instance int) (db *gorm.DB, err error) {
 			configs.Host,
 			configs.Port,
 			configs.Database)
-		db, err = gorm.Open(mysql.Open(dsn), &gorm.Config{})
+		db, err = openWithRetry(func() gorm.Dialector { return mysql.Open(dsn) }, NewConfigConnection())
+	case InstancePostgreSQL:
+		dsn := PostgreSQLDSN(NewConfigPostgreSQL())
+		db, err = openWithRetry(func() gorm.Dialector { return postgres.Open(dsn) }, NewConfigConnection())
 	case InstanceSQLite:
 		configs := NewConfigSQLite()
-		db, err = gorm.Open(sqlite.Open(configs.Database), &gorm.Config{})
+		db, err = gorm.Open(sqlite.Open(configs.Database), newGormConfig())
 	default:
 		return nil, errInvalidSQLDatabaseInstance
 	}
 	return db, err
-}
\ No newline at end of file
+}
+
+// openWithRetry は接続できるまで待ち時間を倍にしながら再試行し、接続プールを設定する
+// データベースのサーバーより先にAPIのサーバーが起動した場合も、終了せずに準備ができるのを待つ
+func openWithRetry(dialector func() gorm.Dialector, configs *ConnectionConfig) (*gorm.DB, error) {
+	wait := configs.ConnectRetryWait
+	for attempt := 1; ; attempt++ {
+		db, err := gorm.Open(dialector(), newGormConfig())
+		if err == nil {
+			err = configurePool(db, configs)
+			if err == nil {
+				return db, nil
+			}
+		}
+		// 失敗した接続は再試行の前に閉じる
+		closeDB(db)
+		if attempt >= configs.ConnectMaxAttempts {
+			return nil, fmt.Errorf("failed to connect to database after %d attempts: %w", attempt, err)
+		}
+		logger.Warn("failed to connect to database, retrying", "attempt", attempt, "wait", wait.String(), "error", err.Error())
+		time.Sleep(wait)
+		wait = min(wait*2, configs.ConnectMaxRetryWait)
+	}
+}
+
+// configurePool は接続プールの上限と接続を再利用する期間を設定する
+func configurePool(db *gorm.DB, configs *ConnectionConfig) error {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	sqlDB.SetMaxOpenConns(configs.MaxOpenConns)
+	sqlDB.SetMaxIdleConns(configs.MaxIdleConns)
+	sqlDB.SetConnMaxLifetime(configs.ConnMaxLifetime)
+	sqlDB.SetConnMaxIdleTime(configs.ConnMaxIdleTime)
+	return nil
+}
+
+func closeDB(db *gorm.DB) {
+	if db == nil {
+		return
+	}
+	if sqlDB, err := db.DB(); err == nil {
+		sqlDB.Close()
+	}
+}
+
+// PostgreSQLDSN はPostgreSQLの接続文字列を返す
+// DSNを設定した場合はそのまま使い、それ以外は個別の設定から組み立てる
+// 日時はMySQLと同じくUTCで扱う
+func PostgreSQLDSN(configs *Config) string {
+	if configs.DSN != "" {
+		return configs.DSN
+	}
+	query := url.Values{}
+	query.Set("sslmode", configs.SSLMode)
+	query.Set("search_path", configs.Schema)
+	query.Set("TimeZone", "UTC")
+	dsn := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(configs.User, configs.Password),
+		Host:     net.JoinHostPort(configs.Host, configs.Port),
+		Path:     "/" + configs.Database,
+		RawQuery: query.Encode(),
+	}
+	return dsn.String()
+}
+
+// newGormConfig はドライバ固有のエラーを一意制約違反などのgormのエラーに変換する設定を返す
+func newGormConfig() *gorm.Config {
+	return &gorm.Config{TranslateError: true}
+}
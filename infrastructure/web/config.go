@@ -6,18 +6,32 @@ import (
 	"household-account-backend/pkg"
 )
 
+// Webのサーバーに使うフレームワーク
+const (
+	FrameworkEcho = "echo"
+	FrameworkGin  = "gin"
+)
+
 type Config struct {
+	// Framework はAPIを提供するフレームワーク。echoまたはgin
+	Framework        string
 	Host             string
 	Port             string
 	CorsAllowOrigins []string
+	// GRPCHost, GRPCPort は社内のサービス向けのgRPCのサーバーのアドレス
+	GRPCHost string
+	GRPCPort string
 }
 
 func NewConfigWeb() *Config {
 	return &Config{
-		Host: pkg.GetEnvDefault("WEB_HOST", "0.0.0.0"),
-		Port: pkg.GetEnvDefault("WEB_PORT", "8080"),
+		Framework: pkg.GetEnvDefault("WEB_FRAMEWORK", FrameworkEcho),
+		Host:      pkg.GetEnvDefault("WEB_HOST", "0.0.0.0"),
+		Port:      pkg.GetEnvDefault("WEB_PORT", "8080"),
 		CorsAllowOrigins: strings.Split(pkg.GetEnvDefault(
 			"WEB_CORS_ALLOW_ORIGINS",
 			"http://0.0.0.0:8001"), ","),
+		GRPCHost: pkg.GetEnvDefault("GRPC_HOST", "0.0.0.0"),
+		GRPCPort: pkg.GetEnvDefault("GRPC_PORT", "9090"),
 	}
 }
@@ -5,8 +5,8 @@ import (
 	"fmt"
 
 	"github.com/labstack/echo/v4"
-	"gorm.io/gorm"
 
+	"household-account-backend/adapter/controller/container"
 	"household-account-backend/adapter/controller/echo/router"
 )
 
@@ -15,9 +15,9 @@ type EchoServer struct {
 	host, port string
 }
 
-func NewEchoServer(host, port string, db *gorm.DB) (Server, error) {
+func NewEchoServer(host, port string, deps *container.Container) (Server, error) {
 	return &EchoServer{
-		router: router.NewEchoRouter(db),
+		router: router.NewEchoRouter(deps),
 		host:   host,
 		port:   port,
 	}, nil
@@ -3,17 +3,20 @@ package web
 import (
 	"context"
 	"errors"
+	"fmt"
 
-	"gorm.io/gorm"
+	"household-account-backend/adapter/controller/container"
 )
 
 var (
 	errInvalidWebServerInstance = errors.New("invalid router server instance")
+	errInvalidWebFramework      = errors.New("invalid web framework")
 )
 
 const (
 	InstanceGin int = iota
 	InstanceEcho
+	InstanceGRPC
 )
 
 type Server interface {
@@ -21,14 +24,30 @@ type Server interface {
 	Shutdown(ctx context.Context) error
 }
 
-func NewServer(instance int, db *gorm.DB) (Server, error) {
-	config := NewConfigWeb()
+// NewServer はinstanceのサーバーを作成する。depsのハンドラーとユースケースはサーバー間で共有する
+func NewServer(instance int, deps *container.Container) (Server, error) {
+	webConfig := NewConfigWeb()
 	switch instance {
-	// case InstanceGin:
-	// 	return NewGinServer(config.Host, config.Port, config.CorsAllowOrigins, db)
+	case InstanceGin:
+		return NewGinServer(webConfig.Host, webConfig.Port, deps)
 	case InstanceEcho:
-		return NewEchoServer(config.Host, config.Port, db)
+		return NewEchoServer(webConfig.Host, webConfig.Port, deps)
+	case InstanceGRPC:
+		return NewGRPCServer(webConfig.GRPCHost, webConfig.GRPCPort, deps)
 	default:
 		panic(errInvalidWebServerInstance)
 	}
 }
+
+// FrameworkInstance はWEB_FRAMEWORKの値からNewServerに渡すインスタンスを返す
+// どちらのフレームワークも同じハンドラーとユースケースを使い、同じAPIを提供する
+func FrameworkInstance(framework string) (int, error) {
+	switch framework {
+	case FrameworkEcho:
+		return InstanceEcho, nil
+	case FrameworkGin:
+		return InstanceGin, nil
+	default:
+		return 0, fmt.Errorf("%w: %q", errInvalidWebFramework, framework)
+	}
+}
@@ -0,0 +1,36 @@
+package web
+
+import (
+	"context"
+	"net"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"household-account-backend/adapter/controller/container"
+	"household-account-backend/adapter/controller/gin/router"
+)
+
+type GinServer struct {
+	router *gin.Engine
+	server *http.Server
+}
+
+func NewGinServer(host, port string, deps *container.Container) (Server, error) {
+	r := router.NewGinRouter(deps)
+	return &GinServer{
+		router: r,
+		server: &http.Server{
+			Addr:    net.JoinHostPort(host, port),
+			Handler: r,
+		},
+	}, nil
+}
+
+func (g *GinServer) Start() error {
+	return g.server.ListenAndServe()
+}
+
+func (g *GinServer) Shutdown(ctx context.Context) error {
+	return g.server.Shutdown(ctx)
+}
@@ -0,0 +1,49 @@
+package web
+
+import (
+	"context"
+	"net"
+
+	"google.golang.org/grpc"
+
+	"household-account-backend/adapter/controller/container"
+	grpcserver "household-account-backend/adapter/controller/grpc"
+)
+
+type GRPCServer struct {
+	server     *grpc.Server
+	host, port string
+}
+
+func NewGRPCServer(host, port string, deps *container.Container) (Server, error) {
+	return &GRPCServer{
+		server: grpcserver.NewServer(deps),
+		host:   host,
+		port:   port,
+	}, nil
+}
+
+// Start は停止されるまでリクエストを受け付ける。Shutdownで停止した場合はnilを返す
+func (g *GRPCServer) Start() error {
+	listener, err := net.Listen("tcp", net.JoinHostPort(g.host, g.port))
+	if err != nil {
+		return err
+	}
+	return g.server.Serve(listener)
+}
+
+// Shutdown は処理中のリクエストの完了を待って停止する。ctxの期限を過ぎた場合は接続を切断する
+func (g *GRPCServer) Shutdown(ctx context.Context) error {
+	stopped := make(chan struct{})
+	go func() {
+		g.server.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		g.server.Stop()
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,108 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/pkg/config"
+	"household-account-backend/pkg/logger"
+	"household-account-backend/usecase"
+)
+
+// AccountDataWorker はデータのエクスポートの作成と、猶予期間が終了したアカウントの削除を定期的に行う
+type AccountDataWorker struct {
+	dataExportUseCase      usecase.DataExportUseCase
+	accountDeletionUseCase usecase.AccountDeletionUseCase
+	interval               time.Duration
+	batchSize              int
+	cancel                 context.CancelFunc
+	done                   chan struct{}
+}
+
+func NewAccountDataWorker(db *gorm.DB, configs *config.Config) *AccountDataWorker {
+	workerConfig := NewConfigWorker()
+	userRepository := gateway.NewUserRepository(db)
+	fileStorage := gateway.NewLocalFileStorage(configs.FileStorageDir)
+	dataExportUseCase := usecase.NewDataExportUseCase(
+		gateway.NewDataExportRepository(db),
+		userRepository,
+		gateway.NewCategoryRepository(db),
+		gateway.NewTransactionRepository(db),
+		gateway.NewMonthlySummaryRepository(db),
+		fileStorage,
+		usecase.DataExportConfig{TTL: configs.DataExportTTL, StaleAfter: configs.DataExportStaleAfter},
+	)
+	accountDeletionUseCase := usecase.NewAccountDeletionUseCase(
+		gateway.NewAccountDeletionRepository(db),
+		userRepository,
+		gateway.NewUserTokenRepository(db),
+		fileStorage,
+		gateway.NewLogMailSender(),
+		usecase.AccountDeletionConfig{
+			GracePeriod:     configs.AccountDeletionGracePeriod,
+			ConfirmationTTL: configs.AccountDeletionTokenTTL,
+			FrontendURL:     configs.FrontendURL,
+		},
+	)
+	return &AccountDataWorker{
+		dataExportUseCase:      dataExportUseCase,
+		accountDeletionUseCase: accountDeletionUseCase,
+		interval:               workerConfig.AccountDataInterval,
+		batchSize:              workerConfig.AccountDataBatchSize,
+	}
+}
+
+func (w *AccountDataWorker) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+	w.done = make(chan struct{})
+
+	go func() {
+		defer close(w.done)
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				w.process()
+			}
+		}
+	}()
+}
+
+func (w *AccountDataWorker) process() {
+	if processed, err := w.dataExportUseCase.ProcessPendingExports(w.batchSize); err != nil {
+		logger.Error("account data worker: " + err.Error())
+	} else if processed > 0 {
+		logger.Info("account data worker: built data exports", "count", processed)
+	}
+	if purged, err := w.dataExportUseCase.PurgeExpiredExports(w.batchSize); err != nil {
+		logger.Error("account data worker: " + err.Error())
+	} else if purged > 0 {
+		logger.Info("account data worker: purged expired data exports", "count", purged)
+	}
+	// 失敗した削除は削除予定のまま残り、次のtickで再試行される
+	if deleted, err := w.accountDeletionUseCase.ProcessDueDeletions(w.batchSize); err != nil {
+		logger.Error("account data worker: " + err.Error())
+	} else if deleted > 0 {
+		logger.Info("account data worker: deleted accounts", "count", deleted)
+	}
+}
+
+func (w *AccountDataWorker) Shutdown(ctx context.Context) error {
+	if w.cancel == nil {
+		return nil
+	}
+	w.cancel()
+	select {
+	case <-w.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,54 @@
+package worker
+
+import (
+	"strconv"
+	"time"
+
+	"household-account-backend/pkg"
+)
+
+// Config はワーカーの実行間隔と一度に処理する件数
+// ユースケースに渡す設定はHTTPのサーバーと共通のconfig.Configを使う
+type Config struct {
+	WebhookInterval  time.Duration
+	WebhookBatchSize int
+
+	IdempotencyCleanupInterval time.Duration
+
+	// AccountDataInterval はエクスポートの作成とアカウントの削除を行う間隔
+	AccountDataInterval  time.Duration
+	AccountDataBatchSize int
+}
+
+func NewConfigWorker() *Config {
+	interval, err := time.ParseDuration(pkg.GetEnvDefault("WEBHOOK_DISPATCH_INTERVAL", "5s"))
+	if err != nil {
+		interval = 5 * time.Second
+	}
+	batchSize, err := strconv.Atoi(pkg.GetEnvDefault("WEBHOOK_BATCH_SIZE", "100"))
+	if err != nil {
+		batchSize = 100
+	}
+	idempotencyCleanupInterval, err := time.ParseDuration(pkg.GetEnvDefault("IDEMPOTENCY_CLEANUP_INTERVAL", "1h"))
+	if err != nil {
+		idempotencyCleanupInterval = time.Hour
+	}
+	accountDataInterval, err := time.ParseDuration(pkg.GetEnvDefault("ACCOUNT_DATA_INTERVAL", "30s"))
+	if err != nil {
+		accountDataInterval = 30 * time.Second
+	}
+	accountDataBatchSize, err := strconv.Atoi(pkg.GetEnvDefault("ACCOUNT_DATA_BATCH_SIZE", "10"))
+	if err != nil {
+		accountDataBatchSize = 10
+	}
+
+	return &Config{
+		WebhookInterval:  interval,
+		WebhookBatchSize: batchSize,
+
+		IdempotencyCleanupInterval: idempotencyCleanupInterval,
+
+		AccountDataInterval:  accountDataInterval,
+		AccountDataBatchSize: accountDataBatchSize,
+	}
+}
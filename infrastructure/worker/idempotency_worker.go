@@ -0,0 +1,73 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/pkg/config"
+	"household-account-backend/pkg/logger"
+	"household-account-backend/usecase"
+)
+
+// IdempotencyWorker は有効期限が切れたIdempotency-Keyを定期的に削除する
+type IdempotencyWorker struct {
+	idempotencyUseCase usecase.IdempotencyUseCase
+	interval           time.Duration
+	cancel             context.CancelFunc
+	done               chan struct{}
+}
+
+func NewIdempotencyWorker(db *gorm.DB, configs *config.Config) *IdempotencyWorker {
+	workerConfig := NewConfigWorker()
+	return &IdempotencyWorker{
+		idempotencyUseCase: usecase.NewIdempotencyUseCase(gateway.NewIdempotencyRepository(db), configs.IdempotencyKeyTTL),
+		interval:           workerConfig.IdempotencyCleanupInterval,
+	}
+}
+
+func (w *IdempotencyWorker) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+	w.done = make(chan struct{})
+
+	go func() {
+		defer close(w.done)
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				w.purge()
+			}
+		}
+	}()
+}
+
+func (w *IdempotencyWorker) purge() {
+	deleted, err := w.idempotencyUseCase.PurgeExpired()
+	if err != nil {
+		logger.Error("idempotency worker: " + err.Error())
+		return
+	}
+	if deleted > 0 {
+		logger.Info("idempotency worker: purged expired keys", "count", deleted)
+	}
+}
+
+func (w *IdempotencyWorker) Shutdown(ctx context.Context) error {
+	if w.cancel == nil {
+		return nil
+	}
+	w.cancel()
+	select {
+	case <-w.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
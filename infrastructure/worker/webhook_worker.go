@@ -0,0 +1,78 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/pkg/config"
+	"household-account-backend/pkg/logger"
+	"household-account-backend/usecase"
+)
+
+// WebhookWorker はアウトボックスのイベントを定期的にWebhookへ配信する
+type WebhookWorker struct {
+	webhookUseCase usecase.WebhookUseCase
+	interval       time.Duration
+	batchSize      int
+	cancel         context.CancelFunc
+	done           chan struct{}
+}
+
+func NewWebhookWorker(db *gorm.DB, configs *config.Config) *WebhookWorker {
+	workerConfig := NewConfigWorker()
+	webhookUseCase := usecase.NewWebhookUseCase(
+		gateway.NewWebhookRepository(db),
+		gateway.NewOutboxRepository(db),
+		gateway.NewHTTPWebhookSender(configs.WebhookTimeout),
+		configs.WebhookMaxAttempts,
+		configs.WebhookClaimTTL,
+	)
+	return &WebhookWorker{
+		webhookUseCase: webhookUseCase,
+		interval:       workerConfig.WebhookInterval,
+		batchSize:      workerConfig.WebhookBatchSize,
+	}
+}
+
+func (w *WebhookWorker) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+	w.done = make(chan struct{})
+
+	go func() {
+		defer close(w.done)
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				w.dispatch()
+			}
+		}
+	}()
+}
+
+func (w *WebhookWorker) dispatch() {
+	// 失敗したイベントは次のtickで再試行される
+	if _, err := w.webhookUseCase.DispatchPendingEvents(w.batchSize); err != nil {
+		logger.Error("webhook worker: " + err.Error())
+	}
+}
+
+func (w *WebhookWorker) Shutdown(ctx context.Context) error {
+	if w.cancel == nil {
+		return nil
+	}
+	w.cancel()
+	select {
+	case <-w.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,107 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"household-account-backend/pkg/client"
+)
+
+// login はメールアドレスとパスワードでログインし、受け取ったCookieを設定ファイルに保存する
+// -tokenを指定した場合はパーソナルアクセストークンを保存する。パスワードは標準入力から読み込む
+func (a *App) login(ctx context.Context, args []string) error {
+	config, err := LoadConfig(a.configPath())
+	if err != nil {
+		return err
+	}
+
+	flags := a.newFlagSet("login")
+	server := flags.String("server", config.Server, "API server URL")
+	email := flags.String("email", "", "email address")
+	token := flags.String("token", "", "personal access token to use instead of email and password")
+	totpCode := flags.String("totp", "", "TOTP code or recovery code for two-factor authentication")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	config = &Config{Server: strings.TrimRight(*server, "/")}
+	if *token != "" {
+		config.Token = *token
+		if err := config.Save(a.configPath()); err != nil {
+			return err
+		}
+		fmt.Fprintf(a.Stdout, "Saved access token for %s\n", config.Server)
+		return nil
+	}
+
+	if *email == "" {
+		if *email, err = a.readLine("Email: "); err != nil {
+			return err
+		}
+	}
+	password, err := a.readPassword("Password: ")
+	if err != nil {
+		return err
+	}
+
+	c, jar, err := a.newClient(config)
+	if err != nil {
+		return err
+	}
+	err = c.Login(ctx, *email, password)
+	var totpRequired *client.TOTPRequiredError
+	if errors.As(err, &totpRequired) {
+		code := *totpCode
+		if code == "" {
+			if code, err = a.readLine("Two-factor code: "); err != nil {
+				return err
+			}
+		}
+		err = c.LoginWithTOTP(ctx, totpRequired.ChallengeToken, code)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := config.saveCookies(jar); err != nil {
+		return err
+	}
+	if err := config.Save(a.configPath()); err != nil {
+		return err
+	}
+	fmt.Fprintf(a.Stdout, "Logged in to %s as %s\n", config.Server, *email)
+	return nil
+}
+
+// logout はサーバーからログアウトし、設定ファイルの認証情報を削除する
+// トークンはサーバー側で無効にしないため、不要になった場合はWebの設定画面から削除する
+func (a *App) logout(ctx context.Context, args []string) error {
+	if err := a.newFlagSet("logout").Parse(args); err != nil {
+		return err
+	}
+	config, err := LoadConfig(a.configPath())
+	if err != nil {
+		return err
+	}
+
+	if len(config.Cookies) > 0 {
+		c, _, err := a.newClient(config)
+		if err != nil {
+			return err
+		}
+		// セッションが期限切れでも設定ファイルの認証情報は削除する
+		if err := c.Logout(ctx); err != nil && !errors.Is(err, client.ErrUnauthorized) {
+			return err
+		}
+	}
+
+	config.Token = ""
+	config.Cookies = nil
+	if err := config.Save(a.configPath()); err != nil {
+		return err
+	}
+	fmt.Fprintln(a.Stdout, "Logged out")
+	return nil
+}
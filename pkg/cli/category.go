@@ -0,0 +1,252 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"household-account-backend/adapter/controller/echo/presenter"
+	"household-account-backend/pkg/client"
+)
+
+// category はカテゴリーのサブコマンドを実行する
+func (a *App) category(ctx context.Context, args []string) error {
+	command, rest, err := subcommand(args, "category", "add|list|edit|rm")
+	if err != nil {
+		return err
+	}
+	c, err := a.authorizedClient()
+	if err != nil {
+		return err
+	}
+
+	switch command {
+	case "list", "ls":
+		return a.listCategories(ctx, c, rest)
+	case "add":
+		return a.addCategory(ctx, c, rest)
+	case "edit":
+		return a.editCategory(ctx, c, rest)
+	case "rm", "delete":
+		return a.removeCategory(ctx, c, rest)
+	}
+	return fmt.Errorf("%w: unknown category command %q", ErrUsage, command)
+}
+
+func (a *App) listCategories(ctx context.Context, c *client.Client, args []string) error {
+	if err := a.newFlagSet("category list").Parse(args); err != nil {
+		return err
+	}
+	response, err := c.GetCategoriesWithResponse(ctx)
+	if err != nil {
+		return err
+	}
+	return a.printCategories(*response.JSON200...)
+}
+
+func (a *App) addCategory(ctx context.Context, c *client.Client, args []string) error {
+	flags := a.newFlagSet("category add")
+	name := flags.String("name", "", "category name")
+	categoryType := flags.String("type", "expense", "category type (income or expense)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *name == "" {
+		return fmt.Errorf("%w: -name is required", ErrUsage)
+	}
+
+	// user_idはサーバーがログイン中のユーザーで上書きする
+	response, err := c.CreateCategoryWithResponse(ctx, &presenter.CreateCategoryParams{}, presenter.CreateCategoryJSONRequestBody{
+		Name: *name,
+		Type: presenter.CategoryCreateRequestType(*categoryType),
+	})
+	if err != nil {
+		return err
+	}
+	return a.printCategories(*response.JSON201)
+}
+
+// editCategory は指定した項目だけを変更する。現在の内容を取得し、そのバージョンで更新する
+func (a *App) editCategory(ctx context.Context, c *client.Client, args []string) error {
+	id, args, err := idArgument(args, "category edit")
+	if err != nil {
+		return err
+	}
+	flags := a.newFlagSet("category edit")
+	name := flags.String("name", "", "new category name")
+	categoryType := flags.String("type", "", "new category type (income or expense)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	current, err := c.GetCategoryByIdWithResponse(ctx, id)
+	if err != nil {
+		return err
+	}
+	body := presenter.UpdateCategoryByIdJSONRequestBody{
+		Name: current.JSON200.Name,
+		Type: presenter.CategoryUpdateRequestType(current.JSON200.Type),
+	}
+	if *name != "" {
+		body.Name = *name
+	}
+	if *categoryType != "" {
+		body.Type = presenter.CategoryUpdateRequestType(*categoryType)
+	}
+
+	response, err := c.UpdateCategoryByIdWithResponse(ctx, id, &presenter.UpdateCategoryByIdParams{IfMatch: etag(current.JSON200.Version)}, body)
+	if err != nil {
+		return err
+	}
+	return a.printCategories(*response.JSON200)
+}
+
+func (a *App) removeCategory(ctx context.Context, c *client.Client, args []string) error {
+	id, args, err := idArgument(args, "category rm")
+	if err != nil {
+		return err
+	}
+	if err := a.newFlagSet("category rm").Parse(args); err != nil {
+		return err
+	}
+
+	current, err := c.GetCategoryByIdWithResponse(ctx, id)
+	if err != nil {
+		return err
+	}
+	if _, err := c.DeleteCategoryByIdWithResponse(ctx, id, &presenter.DeleteCategoryByIdParams{IfMatch: etag(current.JSON200.Version)}); err != nil {
+		return err
+	}
+	fmt.Fprintf(a.Stdout, "Deleted category %d\n", id)
+	return nil
+}
+
+func (a *App) printCategories(categories ...presenter.CategoryRequest) error {
+	t := table{header: []string{"ID", "NAME", "TYPE", "VERSION"}}
+	for _, category := range categories {
+		t.rows = append(t.rows, []string{
+			strconv.Itoa(category.Id),
+			category.Name,
+			string(category.Type),
+			strconv.Itoa(category.Version),
+		})
+	}
+	return a.print(categories, t)
+}
+
+// monthlySummary は1か月の取引をカテゴリーごとに集計した結果
+type monthlySummary struct {
+	Month      string            `json:"month"`
+	Income     float64           `json:"income"`
+	Expense    float64           `json:"expense"`
+	Balance    float64           `json:"balance"`
+	Categories []categorySummary `json:"categories"`
+}
+
+type categorySummary struct {
+	ID     int     `json:"id"`
+	Name   string  `json:"name"`
+	Type   string  `json:"type"`
+	Count  int     `json:"count"`
+	Amount float64 `json:"amount"`
+}
+
+// summary は指定した月の取引をカテゴリーごとに集計する
+// 金額の符号はサーバーの設定によって異なるため、カテゴリーの種類で収入と支出に分ける
+func (a *App) summary(ctx context.Context, args []string) error {
+	flags := a.newFlagSet("summary")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	month := time.Now().Format("2006-01")
+	if flags.NArg() > 0 {
+		month = flags.Arg(0)
+	}
+	if _, err := time.Parse("2006-01", month); err != nil {
+		return fmt.Errorf("%w: month must be in YYYY-MM format: %s", ErrUsage, month)
+	}
+
+	c, err := a.authorizedClient()
+	if err != nil {
+		return err
+	}
+	categories, err := c.GetCategoriesWithResponse(ctx)
+	if err != nil {
+		return err
+	}
+	transactions, err := c.GetTransactionsWithResponse(ctx)
+	if err != nil {
+		return err
+	}
+
+	byID := map[int]*categorySummary{}
+	for _, category := range *categories.JSON200 {
+		byID[category.Id] = &categorySummary{ID: category.Id, Name: category.Name, Type: string(category.Type)}
+	}
+	result := monthlySummary{Month: month, Categories: []categorySummary{}}
+	for _, transaction := range *transactions.JSON200 {
+		if transaction.Date.Format("2006-01") != month {
+			continue
+		}
+		category, ok := byID[transaction.CategoryId]
+		if !ok {
+			category = &categorySummary{ID: transaction.CategoryId, Name: "(unknown)"}
+			byID[transaction.CategoryId] = category
+		}
+		amount := math.Abs(float64(transaction.Amount))
+		category.Count++
+		category.Amount += amount
+		if category.Type == string(presenter.CategoryRequestTypeIncome) {
+			result.Income += amount
+		} else {
+			result.Expense += amount
+		}
+	}
+	result.Income = roundAmount(result.Income)
+	result.Expense = roundAmount(result.Expense)
+	result.Balance = roundAmount(result.Income - result.Expense)
+
+	for _, category := range byID {
+		if category.Count > 0 {
+			category.Amount = roundAmount(category.Amount)
+			result.Categories = append(result.Categories, *category)
+		}
+	}
+	sort.Slice(result.Categories, func(i, j int) bool {
+		if result.Categories[i].Type != result.Categories[j].Type {
+			return result.Categories[i].Type > result.Categories[j].Type
+		}
+		return result.Categories[i].Amount > result.Categories[j].Amount
+	})
+
+	t := table{header: []string{"CATEGORY", "TYPE", "COUNT", "AMOUNT"}}
+	for _, category := range result.Categories {
+		t.rows = append(t.rows, []string{category.Name, category.Type, strconv.Itoa(category.Count), formatAmount(category.Amount)})
+	}
+	t.rows = append(t.rows,
+		[]string{"Income", "", "", formatAmount(result.Income)},
+		[]string{"Expense", "", "", formatAmount(result.Expense)},
+		[]string{"Balance", "", "", formatAmount(result.Balance)},
+	)
+	return a.print(result, t)
+}
+
+// idArgument は先頭の引数をIDとして読み込む
+func idArgument(args []string, command string) (int, []string, error) {
+	if len(args) == 0 {
+		return 0, nil, fmt.Errorf("%w: usage: hhcli %s <id>", ErrUsage, command)
+	}
+	id, err := strconv.Atoi(args[0])
+	if err != nil {
+		return 0, nil, fmt.Errorf("%w: invalid id %q", ErrUsage, args[0])
+	}
+	return id, args[1:], nil
+}
+
+// etag はバージョンをIf-Matchの値にする
+func etag(version int) string {
+	return fmt.Sprintf(`"%d"`, version)
+}
@@ -0,0 +1,187 @@
+// Package cli はコマンドラインから家計簿のAPIを操作するhhcliの実装
+// 生成したAPIクライアント(pkg/client)を使い、認証情報は設定ファイルに保存する
+package cli
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"os"
+	"strings"
+	"time"
+
+	"golang.org/x/term"
+
+	"household-account-backend/pkg/client"
+)
+
+const usage = `Usage: hhcli [-config PATH] [-output table|json] <command> [arguments]
+
+Commands:
+  login       Log in with email and password, or save a personal access token
+  logout      Log out and remove the saved credentials
+  tx          Manage transactions (add, list, edit, rm)
+  category    Manage categories (add, list, edit, rm)
+  summary     Show income and expense for a month (YYYY-MM)
+  import      Import transactions from a CSV or JSON file
+  export      Export transactions to a CSV or JSON file
+`
+
+// App はコマンドの入出力と設定ファイルの場所を持つ
+type App struct {
+	Stdin  io.Reader
+	Stdout io.Writer
+	Stderr io.Writer
+	// ConfigPath は設定ファイルのパス。空の場合はDefaultConfigPathを使う
+	ConfigPath string
+	// HTTPClient はAPIとの通信に使うクライアント。Cookie Jarは設定ファイルから復元したものに置き換える
+	HTTPClient *http.Client
+
+	output string
+}
+
+// ErrUsage は引数が正しくない場合のエラー
+var ErrUsage = errors.New("invalid usage")
+
+// Run は引数で指定されたコマンドを実行する。argsにはプログラム名を含めない
+func (a *App) Run(ctx context.Context, args []string) error {
+	flags := a.newFlagSet("hhcli")
+	flags.Usage = func() { fmt.Fprint(a.Stderr, usage) }
+	flags.StringVar(&a.ConfigPath, "config", a.ConfigPath, "path to the config file")
+	flags.StringVar(&a.output, "output", outputTable, "output format (table or json)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if a.output != outputTable && a.output != outputJSON {
+		return fmt.Errorf("%w: unknown output format %q", ErrUsage, a.output)
+	}
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return ErrUsage
+	}
+
+	command, rest := flags.Arg(0), flags.Args()[1:]
+	switch command {
+	case "login":
+		return a.login(ctx, rest)
+	case "logout":
+		return a.logout(ctx, rest)
+	case "tx", "transaction", "transactions":
+		return a.transaction(ctx, rest)
+	case "category", "categories":
+		return a.category(ctx, rest)
+	case "summary":
+		return a.summary(ctx, rest)
+	case "import":
+		return a.importTransactions(ctx, rest)
+	case "export":
+		return a.exportTransactions(ctx, rest)
+	}
+	flags.Usage()
+	return fmt.Errorf("%w: unknown command %q", ErrUsage, command)
+}
+
+// newFlagSet はエラーを呼び出し元に返すフラグのセットを作る
+func (a *App) newFlagSet(name string) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(a.Stderr)
+	return flags
+}
+
+// subcommand はサブコマンドの名前と残りの引数に分ける
+func subcommand(args []string, name string, commands string) (string, []string, error) {
+	if len(args) == 0 {
+		return "", nil, fmt.Errorf("%w: usage: hhcli %s <%s>", ErrUsage, name, commands)
+	}
+	return args[0], args[1:], nil
+}
+
+// newClient は設定ファイルの認証情報を使うクライアントを作る
+func (a *App) newClient(config *Config) (*client.Client, *cookiejar.Jar, error) {
+	jar, err := config.cookieJar()
+	if err != nil {
+		return nil, nil, err
+	}
+	httpClient := &http.Client{Timeout: 30 * time.Second}
+	if a.HTTPClient != nil {
+		copied := *a.HTTPClient
+		httpClient = &copied
+	}
+	httpClient.Jar = jar
+
+	c, err := client.New(client.Config{
+		ServerURL:  config.Server,
+		Token:      config.Token,
+		HTTPClient: httpClient,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return c, jar, nil
+}
+
+// authorizedClient はログイン済みの設定ファイルからクライアントを作る
+func (a *App) authorizedClient() (*client.Client, error) {
+	config, err := LoadConfig(a.configPath())
+	if err != nil {
+		return nil, err
+	}
+	if !config.loggedIn() {
+		return nil, errors.New("not logged in: run `hhcli login` first")
+	}
+	c, _, err := a.newClient(config)
+	return c, err
+}
+
+func (a *App) configPath() string {
+	if a.ConfigPath != "" {
+		return a.ConfigPath
+	}
+	return DefaultConfigPath()
+}
+
+// readPassword はパスワードを読み込む。標準入力が端末の場合は入力した文字を表示しない
+func (a *App) readPassword(prompt string) (string, error) {
+	file, ok := a.Stdin.(*os.File)
+	if !ok || !term.IsTerminal(int(file.Fd())) {
+		return a.readLine(prompt)
+	}
+	fmt.Fprint(a.Stderr, prompt)
+	password, err := term.ReadPassword(int(file.Fd()))
+	// 入力した改行は表示されないため、続く出力が同じ行にならないよう改行する
+	fmt.Fprintln(a.Stderr)
+	if err != nil {
+		return "", err
+	}
+	return string(password), nil
+}
+
+// readLine は標準入力から1行読み込む。パスワードなどをコマンドライン引数に残さないために使う
+func (a *App) readLine(prompt string) (string, error) {
+	fmt.Fprint(a.Stderr, prompt)
+	var line strings.Builder
+	buf := make([]byte, 1)
+	for {
+		n, err := a.Stdin.Read(buf)
+		if n > 0 {
+			if buf[0] == '\n' {
+				break
+			}
+			line.WriteByte(buf[0])
+		}
+		if errors.Is(err, io.EOF) {
+			if line.Len() == 0 {
+				return "", io.ErrUnexpectedEOF
+			}
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+	return strings.TrimRight(line.String(), "\r"), nil
+}
@@ -0,0 +1,117 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"os"
+	"path/filepath"
+)
+
+const (
+	// configEnv は設定ファイルのパスを指定する環境変数
+	configEnv = "HHCLI_CONFIG"
+	// DefaultServerURL はログイン時にサーバーを指定しなかった場合のURL
+	DefaultServerURL = "http://localhost:8080"
+)
+
+// Config は設定ファイルの内容
+// 認証情報を含むため、ファイルは所有者のみ読み書きできる権限で保存する
+type Config struct {
+	Server string `json:"server"`
+	// Token はパーソナルアクセストークン。設定した場合はCookieを使わない
+	Token string `json:"token,omitempty"`
+	// Cookies はメールアドレスとパスワードでログインした場合に受け取ったCookie
+	Cookies []SavedCookie `json:"cookies,omitempty"`
+}
+
+// SavedCookie は保存したCookie
+type SavedCookie struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// DefaultConfigPath は環境変数HHCLI_CONFIG、なければユーザーの設定ディレクトリ内のパスを返す
+func DefaultConfigPath() string {
+	if path := os.Getenv(configEnv); path != "" {
+		return path
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		dir = "."
+	}
+	return filepath.Join(dir, "hhcli", "config.json")
+}
+
+// LoadConfig は設定ファイルを読み込む。ファイルがない場合は空の設定を返す
+func LoadConfig(path string) (*Config, error) {
+	config := &Config{Server: DefaultServerURL}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return config, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+	return config, nil
+}
+
+// Save は設定ファイルを書き込む
+func (c *Config) Save(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o600); err != nil {
+		return err
+	}
+	// 既存のファイルはWriteFileで権限が変わらないため設定し直す
+	return os.Chmod(path, 0o600)
+}
+
+func (c *Config) loggedIn() bool {
+	return c.Token != "" || len(c.Cookies) > 0
+}
+
+// cookieJar は保存したCookieを入れたJarを作る
+func (c *Config) cookieJar() (*cookiejar.Jar, error) {
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(c.Cookies) == 0 {
+		return jar, nil
+	}
+	u, err := url.Parse(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	cookies := make([]*http.Cookie, 0, len(c.Cookies))
+	for _, cookie := range c.Cookies {
+		cookies = append(cookies, &http.Cookie{Name: cookie.Name, Value: cookie.Value, Path: "/"})
+	}
+	jar.SetCookies(u, cookies)
+	return jar, nil
+}
+
+// saveCookies はJarのうちサーバーに送るCookieを保存する
+func (c *Config) saveCookies(jar http.CookieJar) error {
+	u, err := url.Parse(c.Server)
+	if err != nil {
+		return err
+	}
+	c.Cookies = nil
+	for _, cookie := range jar.Cookies(u) {
+		c.Cookies = append(c.Cookies, SavedCookie{Name: cookie.Name, Value: cookie.Value})
+	}
+	return nil
+}
@@ -0,0 +1,227 @@
+package cli_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"household-account-backend/adapter/controller/container"
+	"household-account-backend/adapter/controller/echo/presenter"
+	"household-account-backend/adapter/controller/echo/router"
+	"household-account-backend/pkg/cli"
+	"household-account-backend/pkg/client"
+	"household-account-backend/pkg/config"
+	"household-account-backend/pkg/tester"
+)
+
+// CLISuite はルーターをプロセス内で起動し、hhcliのコマンドを実行する
+type CLISuite struct {
+	tester.DBSQLiteSuite
+	server *httptest.Server
+}
+
+func TestCLISuite(t *testing.T) {
+	suite.Run(t, new(CLISuite))
+}
+
+func (suite *CLISuite) SetupSuite() {
+	suite.DBSQLiteSuite.SetupSuite()
+	configs := config.NewConfig()
+	configs.Secret = "cli-test"
+	// 全てのテストが同じIPアドレスからログインするため、認証の制限を緩める
+	configs.RateLimitAuth = config.RateLimitConfig{Limit: 100, Period: time.Minute}
+	deps, err := container.New(suite.DB, configs)
+	suite.Require().NoError(err)
+	suite.server = httptest.NewServer(router.NewEchoRouter(deps))
+}
+
+func (suite *CLISuite) TearDownSuite() {
+	suite.server.Close()
+	suite.DBSQLiteSuite.TearDownSuite()
+}
+
+// run はコマンドを実行し、標準出力の内容を返す
+func (suite *CLISuite) run(configPath string, stdin string, args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	app := &cli.App{
+		Stdin:      strings.NewReader(stdin),
+		Stdout:     &stdout,
+		Stderr:     &stderr,
+		ConfigPath: configPath,
+	}
+	err := app.Run(context.Background(), args)
+	return stdout.String(), err
+}
+
+// login はユーザーを作成してhhcliでログインし、設定ファイルのパスを返す
+func (suite *CLISuite) login(email string) string {
+	c, err := client.New(client.Config{ServerURL: suite.server.URL})
+	suite.Require().NoError(err)
+	_, err = c.CreateUserWithResponse(context.Background(), presenter.CreateUserJSONRequestBody{
+		Email:    types.Email(email),
+		Name:     "cli",
+		Password: "password123",
+	})
+	suite.Require().NoError(err)
+
+	configPath := filepath.Join(suite.T().TempDir(), "hhcli", "config.json")
+	out, err := suite.run(configPath, "password123\n", "login", "-server", suite.server.URL, "-email", email)
+	suite.Require().NoError(err)
+	suite.Assert().Contains(out, "Logged in")
+	return configPath
+}
+
+// runJSON はJSONで出力するコマンドを実行し、結果を読み込む
+func (suite *CLISuite) runJSON(configPath string, value any, args ...string) {
+	out, err := suite.run(configPath, "", append([]string{"-output", "json"}, args...)...)
+	suite.Require().NoError(err)
+	suite.Require().NoError(json.Unmarshal([]byte(out), value), out)
+}
+
+func (suite *CLISuite) TestTransactionCommands() {
+	configPath := suite.login("transactions@example.com")
+
+	info, err := os.Stat(configPath)
+	suite.Require().NoError(err)
+	suite.Assert().Equal(os.FileMode(0o600), info.Mode().Perm())
+
+	var salary, food []presenter.CategoryRequest
+	suite.runJSON(configPath, &salary, "category", "add", "-name", "Salary", "-type", "income")
+	suite.runJSON(configPath, &food, "category", "add", "-name", "Food")
+	suite.Require().Len(food, 1)
+	suite.Assert().Equal(presenter.CategoryRequestTypeExpense, food[0].Type)
+
+	var created []presenter.TransactionRequest
+	suite.runJSON(configPath, &created, "tx", "add", "-category", strconv.Itoa(salary[0].Id), "-amount", "3000", "-date", "2025-01-25")
+	suite.runJSON(configPath, &created, "tx", "add", "-category", strconv.Itoa(food[0].Id), "-amount", "120.5", "-date", "2025-01-10", "-content", "lunch")
+	suite.runJSON(configPath, &created, "tx", "add", "-category", strconv.Itoa(food[0].Id), "-amount", "80", "-date", "2025-02-01")
+	suite.Require().Len(created, 1)
+
+	var edited []presenter.TransactionRequest
+	suite.runJSON(configPath, &edited, "tx", "edit", strconv.Itoa(created[0].Id), "-amount", "99.5")
+	suite.Require().Len(edited, 1)
+	suite.Assert().Equal(float32(99.5), edited[0].Amount)
+	suite.Assert().Equal("2025-02-01", edited[0].Date.Format("2006-01-02"))
+	suite.Assert().Equal(created[0].Version+1, edited[0].Version)
+
+	var january []presenter.TransactionRequest
+	suite.runJSON(configPath, &january, "tx", "list", "-month", "2025-01")
+	suite.Assert().Len(january, 2)
+
+	var summary struct {
+		Income  float64 `json:"income"`
+		Expense float64 `json:"expense"`
+		Balance float64 `json:"balance"`
+	}
+	suite.runJSON(configPath, &summary, "summary", "2025-01")
+	suite.Assert().Equal(3000.0, summary.Income)
+	suite.Assert().Equal(120.5, summary.Expense)
+	suite.Assert().Equal(2879.5, summary.Balance)
+
+	out, err := suite.run(configPath, "", "tx", "list")
+	suite.Require().NoError(err)
+	suite.Assert().Contains(out, "lunch")
+	suite.Assert().True(strings.HasPrefix(out, "ID"), out)
+
+	_, err = suite.run(configPath, "", "tx", "rm", strconv.Itoa(created[0].Id))
+	suite.Require().NoError(err)
+	_, err = suite.run(configPath, "", "tx", "rm", strconv.Itoa(created[0].Id))
+	suite.Assert().ErrorIs(err, client.ErrNotFound)
+
+	_, err = suite.run(configPath, "", "logout")
+	suite.Require().NoError(err)
+	_, err = suite.run(configPath, "", "tx", "list")
+	suite.Assert().ErrorContains(err, "not logged in")
+}
+
+func (suite *CLISuite) TestImportAndExport() {
+	configPath := suite.login("import@example.com")
+
+	var categories []presenter.CategoryRequest
+	suite.runJSON(configPath, &categories, "category", "add", "-name", "Rent")
+	categoryID := strconv.Itoa(categories[0].Id)
+
+	dir := suite.T().TempDir()
+	csvPath := filepath.Join(dir, "transactions.csv")
+	suite.Require().NoError(os.WriteFile(csvPath, []byte(
+		"date,amount,category_id,content\n"+
+			"2025-03-01,800,"+categoryID+",March\n"+
+			"2025-04-01,800,"+categoryID+",\"April, prepaid\"\n"), 0o600))
+
+	out, err := suite.run(configPath, "", "import", csvPath)
+	suite.Require().NoError(err)
+	suite.Assert().Contains(out, "Imported 2 of 2")
+
+	jsonPath := filepath.Join(dir, "export.json")
+	_, err = suite.run(configPath, "", "export", "-month", "2025-04", jsonPath)
+	suite.Require().NoError(err)
+	data, err := os.ReadFile(jsonPath)
+	suite.Require().NoError(err)
+	var exported []map[string]any
+	suite.Require().NoError(json.Unmarshal(data, &exported))
+	suite.Require().Len(exported, 1)
+	suite.Assert().Equal("April, prepaid", exported[0]["content"])
+
+	// 存在しないカテゴリーを含むファイルはatomicでは何も登録しない
+	suite.Require().NoError(os.WriteFile(jsonPath, []byte(`[
+		{"date": "2025-05-01", "category_id": `+categoryID+`, "amount": 800},
+		{"date": "2025-05-02", "category_id": 999999, "amount": 10}
+	]`), 0o600))
+	_, err = suite.run(configPath, "", "import", jsonPath)
+	suite.Assert().ErrorContains(err, "import failed")
+
+	out, err = suite.run(configPath, "", "export", "-month", "2025-05", "-")
+	suite.Require().NoError(err)
+	suite.Assert().Equal("id,date,category_id,amount,content\n", out)
+}
+
+func (suite *CLISuite) TestAtomicImportLimit() {
+	configPath := suite.login("atomic-import@example.com")
+
+	var categories []presenter.CategoryRequest
+	suite.runJSON(configPath, &categories, "category", "add", "-name", "Daily")
+	categoryID := strconv.Itoa(categories[0].Id)
+
+	var content strings.Builder
+	content.WriteString("date,amount,category_id\n")
+	for i := 0; i < 501; i++ {
+		content.WriteString("2025-06-01,1," + categoryID + "\n")
+	}
+	csvPath := filepath.Join(suite.T().TempDir(), "transactions.csv")
+	suite.Require().NoError(os.WriteFile(csvPath, []byte(content.String()), 0o600))
+
+	// 1回のリクエストに収まらない件数はatomicでは送信しない
+	_, err := suite.run(configPath, "", "import", csvPath)
+	suite.Assert().ErrorIs(err, cli.ErrUsage)
+	suite.Assert().ErrorContains(err, "atomic imports are limited to 500 transactions")
+	out, err := suite.run(configPath, "", "export", "-month", "2025-06", "-")
+	suite.Require().NoError(err)
+	suite.Assert().Equal("id,date,category_id,amount,content\n", out)
+
+	_, err = suite.run(configPath, "", "import", "-mode", "unknown", csvPath)
+	suite.Assert().ErrorIs(err, cli.ErrUsage)
+
+	out, err = suite.run(configPath, "", "import", "-mode", "best_effort", csvPath)
+	suite.Require().NoError(err)
+	suite.Assert().Contains(out, "Imported 501 of 501")
+}
+
+func TestRunUsage(t *testing.T) {
+	app := &cli.App{Stdin: strings.NewReader(""), Stdout: &bytes.Buffer{}, Stderr: &bytes.Buffer{}, ConfigPath: filepath.Join(t.TempDir(), "config.json")}
+
+	assert.ErrorIs(t, app.Run(context.Background(), nil), cli.ErrUsage)
+	assert.ErrorIs(t, app.Run(context.Background(), []string{"unknown"}), cli.ErrUsage)
+	assert.ErrorIs(t, app.Run(context.Background(), []string{"-output", "yaml", "tx", "list"}), cli.ErrUsage)
+	assert.ErrorContains(t, app.Run(context.Background(), []string{"tx", "list"}), "not logged in")
+}
@@ -0,0 +1,224 @@
+package cli
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/oapi-codegen/runtime/types"
+
+	"household-account-backend/adapter/controller/echo/presenter"
+	"household-account-backend/pkg/client"
+)
+
+// transaction は取引のサブコマンドを実行する
+func (a *App) transaction(ctx context.Context, args []string) error {
+	command, rest, err := subcommand(args, "tx", "add|list|edit|rm")
+	if err != nil {
+		return err
+	}
+	c, err := a.authorizedClient()
+	if err != nil {
+		return err
+	}
+
+	switch command {
+	case "list", "ls":
+		return a.listTransactions(ctx, c, rest)
+	case "add":
+		return a.addTransaction(ctx, c, rest)
+	case "edit":
+		return a.editTransaction(ctx, c, rest)
+	case "rm", "delete":
+		return a.removeTransaction(ctx, c, rest)
+	}
+	return fmt.Errorf("%w: unknown tx command %q", ErrUsage, command)
+}
+
+// transactionFilter は一覧と書き出しで取引を絞り込む条件
+type transactionFilter struct {
+	month      string
+	categoryID int
+}
+
+func (f *transactionFilter) register(flags *flag.FlagSet) {
+	flags.StringVar(&f.month, "month", "", "only transactions in the month (YYYY-MM)")
+	flags.IntVar(&f.categoryID, "category", 0, "only transactions in the category")
+}
+
+func (f *transactionFilter) validate() error {
+	if f.month == "" {
+		return nil
+	}
+	if _, err := time.Parse("2006-01", f.month); err != nil {
+		return fmt.Errorf("%w: -month must be in YYYY-MM format: %s", ErrUsage, f.month)
+	}
+	return nil
+}
+
+// fetchTransactions は取引を取得し、日付の順に条件に一致するものを返す
+func (f *transactionFilter) fetchTransactions(ctx context.Context, c *client.Client) ([]presenter.TransactionRequest, error) {
+	response, err := c.GetTransactionsWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	transactions := []presenter.TransactionRequest{}
+	for _, transaction := range *response.JSON200 {
+		if f.month != "" && transaction.Date.Format("2006-01") != f.month {
+			continue
+		}
+		if f.categoryID != 0 && transaction.CategoryId != f.categoryID {
+			continue
+		}
+		transactions = append(transactions, transaction)
+	}
+	return transactions, nil
+}
+
+func (a *App) listTransactions(ctx context.Context, c *client.Client, args []string) error {
+	flags := a.newFlagSet("tx list")
+	var filter transactionFilter
+	filter.register(flags)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if err := filter.validate(); err != nil {
+		return err
+	}
+
+	transactions, err := filter.fetchTransactions(ctx, c)
+	if err != nil {
+		return err
+	}
+	return a.printTransactions(transactions...)
+}
+
+func (a *App) addTransaction(ctx context.Context, c *client.Client, args []string) error {
+	flags := a.newFlagSet("tx add")
+	categoryID := flags.Int("category", 0, "category ID")
+	amount := flags.Float64("amount", 0, "amount")
+	date := flags.String("date", time.Now().Format(time.DateOnly), "date (YYYY-MM-DD)")
+	content := flags.String("content", "", "memo")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *categoryID == 0 || *amount == 0 {
+		return fmt.Errorf("%w: -category and -amount are required", ErrUsage)
+	}
+	parsedDate, err := parseDate(*date)
+	if err != nil {
+		return err
+	}
+
+	body := presenter.CreateTransactionJSONRequestBody{
+		CategoryId: *categoryID,
+		Amount:     float32(*amount),
+		Date:       parsedDate,
+	}
+	if *content != "" {
+		body.Content = content
+	}
+	// 通信の失敗で再試行しても二重に登録しないようIdempotency-Keyを付ける
+	key, err := newIdempotencyKey()
+	if err != nil {
+		return err
+	}
+	response, err := c.CreateTransactionWithResponse(ctx, &presenter.CreateTransactionParams{IdempotencyKey: &key}, body)
+	if err != nil {
+		return err
+	}
+	return a.printTransactions(*response.JSON201)
+}
+
+// editTransaction は指定した項目だけを変更する。現在の内容を取得し、そのバージョンで更新する
+func (a *App) editTransaction(ctx context.Context, c *client.Client, args []string) error {
+	id, args, err := idArgument(args, "tx edit")
+	if err != nil {
+		return err
+	}
+	current, err := c.GetTransactionByIdWithResponse(ctx, id)
+	if err != nil {
+		return err
+	}
+	transaction := current.JSON200
+
+	flags := a.newFlagSet("tx edit")
+	categoryID := flags.Int("category", transaction.CategoryId, "new category ID")
+	amount := flags.Float64("amount", float64(transaction.Amount), "new amount")
+	date := flags.String("date", transaction.Date.Format(time.DateOnly), "new date (YYYY-MM-DD)")
+	content := flags.String("content", stringValue(transaction.Content), "new memo")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	parsedDate, err := parseDate(*date)
+	if err != nil {
+		return err
+	}
+
+	body := presenter.UpdateTransactionByIdJSONRequestBody{
+		CategoryId: *categoryID,
+		Amount:     float32(*amount),
+		Date:       parsedDate,
+		Content:    content,
+	}
+	response, err := c.UpdateTransactionByIdWithResponse(ctx, id, &presenter.UpdateTransactionByIdParams{IfMatch: etag(transaction.Version)}, body)
+	if err != nil {
+		return err
+	}
+	return a.printTransactions(*response.JSON200)
+}
+
+func (a *App) removeTransaction(ctx context.Context, c *client.Client, args []string) error {
+	id, args, err := idArgument(args, "tx rm")
+	if err != nil {
+		return err
+	}
+	if err := a.newFlagSet("tx rm").Parse(args); err != nil {
+		return err
+	}
+
+	current, err := c.GetTransactionByIdWithResponse(ctx, id)
+	if err != nil {
+		return err
+	}
+	if _, err := c.DeleteTransactionByIdWithResponse(ctx, id, &presenter.DeleteTransactionByIdParams{IfMatch: etag(current.JSON200.Version)}); err != nil {
+		return err
+	}
+	fmt.Fprintf(a.Stdout, "Deleted transaction %d\n", id)
+	return nil
+}
+
+func (a *App) printTransactions(transactions ...presenter.TransactionRequest) error {
+	t := table{header: []string{"ID", "DATE", "CATEGORY", "AMOUNT", "CONTENT", "VERSION"}}
+	for _, transaction := range transactions {
+		t.rows = append(t.rows, []string{
+			strconv.Itoa(transaction.Id),
+			transaction.Date.Format(time.DateOnly),
+			strconv.Itoa(transaction.CategoryId),
+			formatAmount(float64(transaction.Amount)),
+			stringValue(transaction.Content),
+			strconv.Itoa(transaction.Version),
+		})
+	}
+	return a.print(transactions, t)
+}
+
+func parseDate(value string) (types.Date, error) {
+	date, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return types.Date{}, fmt.Errorf("%w: date must be in YYYY-MM-DD format: %s", ErrUsage, value)
+	}
+	return types.Date{Time: date}, nil
+}
+
+func newIdempotencyKey() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,312 @@
+package cli
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"household-account-backend/adapter/controller/echo/presenter"
+	"household-account-backend/pkg/client"
+)
+
+const (
+	formatCSV  = "csv"
+	formatJSON = "json"
+	// importBatchSize は一括操作の1回のリクエストで送る件数の上限
+	importBatchSize = 500
+)
+
+// csvHeader は取引のファイルの列。読み込みでは列名で対応付けるため、idの列と列の順序は任意
+var csvHeader = []string{"id", "date", "category_id", "amount", "content"}
+
+// transactionRecord はファイルに書き出す取引
+// 書き出したファイルをそのまま読み込めるよう、読み込みではidを無視して新しい取引として登録する
+type transactionRecord struct {
+	ID         int     `json:"id,omitempty"`
+	Date       string  `json:"date"`
+	CategoryID int     `json:"category_id"`
+	Amount     float64 `json:"amount"`
+	Content    string  `json:"content,omitempty"`
+}
+
+// importTransactions はCSVまたはJSONのファイルの取引を一括操作のAPIで登録する
+// best_effortでは500件ごとにリクエストを分け、途中のリクエストで失敗した場合はそれまでの登録は残る
+// atomicは1回のリクエストで全て登録するか何も登録しないため、500件を超えるファイルは送信せずにエラーにする
+func (a *App) importTransactions(ctx context.Context, args []string) error {
+	flags := a.newFlagSet("import")
+	format := flags.String("format", "", "file format (csv or json). Defaults to the file extension")
+	mode := flags.String("mode", string(presenter.Atomic), "atomic (up to 500 transactions in one request) or best_effort")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		return fmt.Errorf("%w: usage: hhcli import [-format csv|json] [-mode atomic|best_effort] <file>", ErrUsage)
+	}
+	bulkMode := presenter.TransactionBulkRequestMode(*mode)
+	if bulkMode != presenter.Atomic && bulkMode != presenter.BestEffort {
+		return fmt.Errorf("%w: unknown mode %q: use -mode atomic or -mode best_effort", ErrUsage, *mode)
+	}
+	path := flags.Arg(0)
+	fileFormat, err := fileFormat(path, *format)
+	if err != nil {
+		return err
+	}
+
+	records, err := readRecords(a, path, fileFormat)
+	if err != nil {
+		return err
+	}
+	if len(records) == 0 {
+		return errors.New("no transactions to import")
+	}
+	operations := make([]presenter.TransactionBulkOperation, 0, len(records))
+	for i, record := range records {
+		operation, err := record.createOperation()
+		if err != nil {
+			return fmt.Errorf("record %d: %w", i+1, err)
+		}
+		operations = append(operations, operation)
+	}
+	if bulkMode == presenter.Atomic && len(operations) > importBatchSize {
+		return fmt.Errorf("%w: atomic imports are limited to %d transactions: split the file or use -mode best_effort", ErrUsage, importBatchSize)
+	}
+
+	c, err := a.authorizedClient()
+	if err != nil {
+		return err
+	}
+	imported := 0
+	for start := 0; start < len(operations); start += importBatchSize {
+		end := min(start+importBatchSize, len(operations))
+		result, err := bulkTransactions(ctx, c, bulkMode, operations[start:end])
+		if err != nil {
+			return fmt.Errorf("imported %d transactions before the error: %w", imported, err)
+		}
+		for _, r := range result.Results {
+			if r.Success {
+				imported++
+				continue
+			}
+			fmt.Fprintf(a.Stderr, "record %d: %s\n", start+r.Index+1, stringValue(r.Error))
+		}
+		if !result.Committed {
+			return fmt.Errorf("import failed: imported %d transactions before the error", imported)
+		}
+	}
+	fmt.Fprintf(a.Stdout, "Imported %d of %d transactions\n", imported, len(operations))
+	return nil
+}
+
+// bulkTransactions は一括操作を送る。入力エラーの422でもボディの結果を返す
+func bulkTransactions(ctx context.Context, c *client.Client, mode presenter.TransactionBulkRequestMode, operations []presenter.TransactionBulkOperation) (*presenter.TransactionBulkResultList, error) {
+	key, err := newIdempotencyKey()
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.BulkTransactionsWithResponse(ctx, &presenter.BulkTransactionsParams{IdempotencyKey: &key}, presenter.BulkTransactionsJSONRequestBody{
+		Mode:       &mode,
+		Operations: operations,
+	})
+	var apiError *client.APIError
+	if errors.As(err, &apiError) && errors.Is(err, client.ErrValidation) {
+		var result presenter.TransactionBulkResultList
+		if json.Unmarshal(apiError.Body, &result) == nil && result.Results != nil {
+			return &result, nil
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return response.JSON200, nil
+}
+
+func (r transactionRecord) createOperation() (presenter.TransactionBulkOperation, error) {
+	date, err := parseDate(r.Date)
+	if err != nil {
+		return presenter.TransactionBulkOperation{}, err
+	}
+	amount := float32(r.Amount)
+	operation := presenter.TransactionBulkOperation{
+		Op:         presenter.Create,
+		CategoryId: &r.CategoryID,
+		Amount:     &amount,
+		Date:       &date,
+	}
+	if r.Content != "" {
+		operation.Content = &r.Content
+	}
+	return operation, nil
+}
+
+// exportTransactions は取引をCSVまたはJSONのファイルに書き出す。ファイルに-を指定した場合は標準出力に書き出す
+func (a *App) exportTransactions(ctx context.Context, args []string) error {
+	flags := a.newFlagSet("export")
+	format := flags.String("format", "", "file format (csv or json). Defaults to the file extension, or csv for stdout")
+	var filter transactionFilter
+	filter.register(flags)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		return fmt.Errorf("%w: usage: hhcli export [-format csv|json] [-month YYYY-MM] [-category ID] <file>", ErrUsage)
+	}
+	if err := filter.validate(); err != nil {
+		return err
+	}
+	path := flags.Arg(0)
+	fileFormat, err := fileFormat(path, *format)
+	if err != nil {
+		return err
+	}
+
+	c, err := a.authorizedClient()
+	if err != nil {
+		return err
+	}
+	transactions, err := filter.fetchTransactions(ctx, c)
+	if err != nil {
+		return err
+	}
+	records := make([]transactionRecord, 0, len(transactions))
+	for _, transaction := range transactions {
+		records = append(records, transactionRecord{
+			ID:         transaction.Id,
+			Date:       transaction.Date.Format(time.DateOnly),
+			CategoryID: transaction.CategoryId,
+			Amount:     roundAmount(float64(transaction.Amount)),
+			Content:    stringValue(transaction.Content),
+		})
+	}
+
+	if path == "-" {
+		return writeRecords(a.Stdout, fileFormat, records)
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := writeRecords(file, fileFormat, records); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	fmt.Fprintf(a.Stderr, "Exported %d transactions to %s\n", len(records), path)
+	return nil
+}
+
+// fileFormat は指定された形式、なければファイルの拡張子から形式を決める
+func fileFormat(path string, format string) (string, error) {
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")
+		if path == "-" {
+			format = formatCSV
+		}
+	}
+	if format != formatCSV && format != formatJSON {
+		return "", fmt.Errorf("%w: unknown file format %q: use -format csv or -format json", ErrUsage, format)
+	}
+	return format, nil
+}
+
+func readRecords(a *App, path string, format string) ([]transactionRecord, error) {
+	var r io.Reader = a.Stdin
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		r = file
+	}
+
+	if format == formatJSON {
+		var records []transactionRecord
+		if err := json.NewDecoder(r).Decode(&records); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+		return records, nil
+	}
+	return readCSV(r)
+}
+
+// readCSV はヘッダー行の列名で各列を対応付けて読み込む
+func readCSV(r io.Reader) ([]transactionRecord, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read csv header: %w", err)
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.TrimSpace(strings.ToLower(name))] = i
+	}
+	for _, name := range []string{"date", "category_id", "amount"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("csv header must contain %q", name)
+		}
+	}
+
+	var records []transactionRecord
+	for line := 2; ; line++ {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return records, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		field := func(name string) string {
+			i, ok := columns[name]
+			if !ok || i >= len(row) {
+				return ""
+			}
+			return strings.TrimSpace(row[i])
+		}
+
+		record := transactionRecord{Date: field("date"), Content: field("content")}
+		if record.CategoryID, err = strconv.Atoi(field("category_id")); err != nil {
+			return nil, fmt.Errorf("line %d: invalid category_id %q", line, field("category_id"))
+		}
+		if record.Amount, err = strconv.ParseFloat(field("amount"), 64); err != nil {
+			return nil, fmt.Errorf("line %d: invalid amount %q", line, field("amount"))
+		}
+		records = append(records, record)
+	}
+}
+
+func writeRecords(w io.Writer, format string, records []transactionRecord) error {
+	if format == formatJSON {
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(records)
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, record := range records {
+		if err := writer.Write([]string{
+			strconv.Itoa(record.ID),
+			record.Date,
+			strconv.Itoa(record.CategoryID),
+			formatAmount(record.Amount),
+			record.Content,
+		}); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
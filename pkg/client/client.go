@@ -0,0 +1,314 @@
+// Package client はAPIのGoクライアント
+// OpenAPIの定義から生成したクライアントに、Cookieでのログイン、CSRFトークンの付与、再試行とエラーの型付けを加える
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/oapi-codegen/runtime/types"
+
+	"household-account-backend/adapter/controller/echo/presenter"
+)
+
+const (
+	// apiBasePath はサーバーのURLに付けるAPIのパス
+	apiBasePath = "/api/v1"
+	// csrfHeader はCSRFトークンを送るヘッダー
+	csrfHeader = "X-CSRF-Token"
+	// idempotencyKeyHeader が付いたリクエストはPOSTでも再試行できる
+	idempotencyKeyHeader = "Idempotency-Key"
+
+	DefaultMaxRetries = 2
+	DefaultRetryWait  = 500 * time.Millisecond
+)
+
+// Config はクライアントの設定
+type Config struct {
+	// ServerURL はAPIサーバーのURL。例: http://localhost:8080
+	ServerURL string
+	// Token はパーソナルアクセストークン。設定した場合はCookieでのログインとCSRFトークンを使わない
+	Token string
+	// HTTPClient は通信に使うクライアント。Cookie Jarがなければ追加する
+	HTTPClient *http.Client
+	// MaxRetries は一時的なエラーを再試行する回数。0の場合は既定値、負の場合は再試行しない
+	MaxRetries int
+	// RetryWait は最初の再試行までの待ち時間。再試行ごとに2倍にする
+	RetryWait time.Duration
+}
+
+// Client はAPIのクライアント
+// 各操作は生成したクライアントのメソッドをそのまま使い、2xx以外のレスポンスは*APIErrorを返す
+type Client struct {
+	*presenter.ClientWithResponses
+
+	httpClient *http.Client
+	apiURL     string
+	token      string
+	maxRetries int
+	retryWait  time.Duration
+
+	mu        sync.Mutex
+	csrfToken string
+}
+
+// doerFunc は関数を生成したクライアントのHttpRequestDoerにする
+type doerFunc func(req *http.Request) (*http.Response, error)
+
+func (f doerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func New(config Config) (*Client, error) {
+	if config.ServerURL == "" {
+		return nil, errors.New("server url is required")
+	}
+
+	httpClient := &http.Client{Timeout: 30 * time.Second}
+	if config.HTTPClient != nil {
+		copied := *config.HTTPClient
+		httpClient = &copied
+	}
+	if httpClient.Jar == nil {
+		jar, err := cookiejar.New(nil)
+		if err != nil {
+			return nil, err
+		}
+		httpClient.Jar = jar
+	}
+
+	c := &Client{
+		httpClient: httpClient,
+		apiURL:     strings.TrimRight(config.ServerURL, "/") + apiBasePath,
+		token:      config.Token,
+		maxRetries: config.MaxRetries,
+		retryWait:  config.RetryWait,
+	}
+	if c.maxRetries == 0 {
+		c.maxRetries = DefaultMaxRetries
+	}
+	if c.retryWait <= 0 {
+		c.retryWait = DefaultRetryWait
+	}
+
+	generated, err := presenter.NewClientWithResponses(c.apiURL, presenter.WithHTTPClient(doerFunc(c.do)))
+	if err != nil {
+		return nil, err
+	}
+	c.ClientWithResponses = generated
+	return c, nil
+}
+
+// Login はメールアドレスとパスワードでログインし、認証用CookieをJarに保存する
+// 二要素認証が有効なユーザーは*TOTPRequiredErrorを返すため、LoginWithTOTPでログインを完了する
+func (c *Client) Login(ctx context.Context, email string, password string) error {
+	response, err := c.LoginUserWithResponse(ctx, presenter.LoginUserJSONRequestBody{
+		Email:    types.Email(email),
+		Password: password,
+	})
+	if err != nil {
+		return err
+	}
+	if response.JSON202 != nil {
+		return &TOTPRequiredError{ChallengeToken: response.JSON202.ChallengeToken}
+	}
+	return nil
+}
+
+// LoginWithTOTP はLoginで受け取ったチャレンジとTOTPコードまたはリカバリーコードでログインを完了する
+func (c *Client) LoginWithTOTP(ctx context.Context, challengeToken string, code string) error {
+	_, err := c.LoginUserWithTOTPWithResponse(ctx, presenter.LoginUserWithTOTPJSONRequestBody{
+		ChallengeToken: challengeToken,
+		Code:           code,
+	})
+	return err
+}
+
+// Logout は認証用Cookieを削除する
+func (c *Client) Logout(ctx context.Context) error {
+	_, err := c.LogoutUserWithResponse(ctx)
+	return err
+}
+
+// do は生成したクライアントのリクエストを送る
+// 状態を変更するリクエストにはCSRFトークンを付け、一時的なエラーは再試行する
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	csrfRetried := false
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 || csrfRetried {
+			if err := rewindBody(req); err != nil {
+				return nil, err
+			}
+		}
+		if c.needsCSRF(req) {
+			token, err := c.fetchCSRFToken(req.Context())
+			if err != nil {
+				return nil, err
+			}
+			req.Header.Set(csrfHeader, token)
+		}
+
+		response, err := c.httpClient.Do(req)
+		if err == nil && response.StatusCode >= http.StatusBadRequest {
+			err = newAPIError(response)
+			response = nil
+		}
+		if err == nil {
+			return response, nil
+		}
+
+		// CSRFトークンの期限が切れた場合は取得し直して1回だけ送り直す
+		var apiError *APIError
+		if c.needsCSRF(req) && !csrfRetried && errors.As(err, &apiError) && apiError.isCSRFError() {
+			c.resetCSRFToken()
+			csrfRetried = true
+			attempt--
+			continue
+		}
+		if attempt >= c.maxRetries || !retryable(req, err) {
+			return nil, err
+		}
+		if err := sleep(req.Context(), c.retryDelay(attempt, err)); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// needsCSRF はCSRFトークンが必要なリクエストかを返す
+// パーソナルアクセストークンでのリクエストはサーバーがCSRFの検証を行わない
+func (c *Client) needsCSRF(req *http.Request) bool {
+	if c.token != "" {
+		return false
+	}
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace:
+		return false
+	}
+	return true
+}
+
+// fetchCSRFToken はCSRFトークンを返す。まだ取得していない場合はサーバーから取得し、CookieをJarに保存する
+func (c *Client) fetchCSRFToken(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.csrfToken != "" {
+		return c.csrfToken, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.apiURL+"/auth/csrf", nil)
+	if err != nil {
+		return "", err
+	}
+	response, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	if response.StatusCode != http.StatusOK {
+		return "", newAPIError(response)
+	}
+	defer response.Body.Close()
+
+	var body presenter.GetCsrfToken200JSONResponse
+	if err := json.NewDecoder(response.Body).Decode(&body); err != nil {
+		return "", fmt.Errorf("failed to decode csrf token: %w", err)
+	}
+	c.csrfToken = body.CsrfToken
+	return c.csrfToken, nil
+}
+
+func (c *Client) resetCSRFToken() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.csrfToken = ""
+}
+
+// retryDelay は再試行までの待ち時間を返す。サーバーがRetry-Afterを返した場合はそれに従う
+func (c *Client) retryDelay(attempt int, err error) time.Duration {
+	var apiError *APIError
+	if errors.As(err, &apiError) && apiError.RetryAfter > 0 {
+		return apiError.RetryAfter
+	}
+	return c.retryWait << attempt
+}
+
+// retryable は一時的な失敗で、送り直しても結果が変わらないリクエストかを返す
+// POSTとPATCHはIdempotency-Keyを付けた場合のみ再試行する
+func retryable(req *http.Request, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+	default:
+		if req.Header.Get(idempotencyKeyHeader) == "" {
+			return false
+		}
+	}
+
+	var apiError *APIError
+	if !errors.As(err, &apiError) {
+		// 接続の失敗などレスポンスを受け取れなかった場合
+		return true
+	}
+	switch apiError.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// rewindBody は送り直すためにリクエストのボディを最初から読めるようにする
+func rewindBody(req *http.Request) error {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil
+	}
+	if req.GetBody == nil {
+		return errors.New("request body cannot be sent again")
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return err
+	}
+	req.Body = body
+	return nil
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// newAPIError はエラーのレスポンスを読み込んで閉じる
+func newAPIError(response *http.Response) *APIError {
+	defer response.Body.Close()
+	body, _ := io.ReadAll(response.Body)
+
+	apiError := &APIError{StatusCode: response.StatusCode, Body: body}
+	if seconds, err := strconv.Atoi(response.Header.Get("Retry-After")); err == nil && seconds > 0 {
+		apiError.RetryAfter = time.Duration(seconds) * time.Second
+	}
+	var problem presenter.Problem
+	if err := json.NewDecoder(bytes.NewReader(body)).Decode(&problem); err == nil && problem.Status != 0 {
+		apiError.Problem = &problem
+	}
+	return apiError
+}
@@ -0,0 +1,71 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"household-account-backend/adapter/controller/echo/presenter"
+)
+
+// レスポンスのステータスごとのエラー。errors.Isで*APIErrorと比較できる
+var (
+	ErrBadRequest         = &APIError{StatusCode: http.StatusBadRequest}
+	ErrUnauthorized       = &APIError{StatusCode: http.StatusUnauthorized}
+	ErrForbidden          = &APIError{StatusCode: http.StatusForbidden}
+	ErrNotFound           = &APIError{StatusCode: http.StatusNotFound}
+	ErrConflict           = &APIError{StatusCode: http.StatusConflict}
+	ErrPreconditionFailed = &APIError{StatusCode: http.StatusPreconditionFailed}
+	ErrValidation         = &APIError{StatusCode: http.StatusUnprocessableEntity}
+	ErrTooManyRequests    = &APIError{StatusCode: http.StatusTooManyRequests}
+)
+
+// APIError はサーバーが2xx以外のステータスを返した場合のエラー
+type APIError struct {
+	StatusCode int
+	// Problem はRFC 7807のエラーの詳細。problem+jsonでないレスポンスではnil
+	Problem *presenter.Problem
+	// RetryAfter はRetry-Afterヘッダーで指定された待ち時間
+	RetryAfter time.Duration
+	// Body はレスポンスのボディ。一括操作の結果など、エラーでもボディを返す操作で使う
+	Body []byte
+}
+
+func (e *APIError) Error() string {
+	if e.Problem != nil && e.Problem.Detail != nil {
+		return fmt.Sprintf("api error %d: %s", e.StatusCode, *e.Problem.Detail)
+	}
+	return fmt.Sprintf("api error %d: %s", e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+// Is はステータスが同じエラーと一致させる
+func (e *APIError) Is(target error) bool {
+	t, ok := target.(*APIError)
+	return ok && t.StatusCode == e.StatusCode
+}
+
+// FieldErrors は項目ごとの入力エラーを返す
+func (e *APIError) FieldErrors() []presenter.FieldError {
+	if e.Problem == nil || e.Problem.Errors == nil {
+		return nil
+	}
+	return *e.Problem.Errors
+}
+
+// isCSRFError はCSRFトークンの不足や不一致で拒否されたかを返す
+func (e *APIError) isCSRFError() bool {
+	if e.StatusCode != http.StatusForbidden && e.StatusCode != http.StatusBadRequest {
+		return false
+	}
+	return e.Problem != nil && e.Problem.Detail != nil && strings.Contains(strings.ToLower(*e.Problem.Detail), "csrf")
+}
+
+// TOTPRequiredError はログインに二要素認証のコードが必要な場合のエラー
+type TOTPRequiredError struct {
+	ChallengeToken string
+}
+
+func (e *TOTPRequiredError) Error() string {
+	return "totp code is required to complete the login"
+}
@@ -0,0 +1,173 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"household-account-backend/adapter/controller/container"
+	"household-account-backend/adapter/controller/echo/presenter"
+	"household-account-backend/adapter/controller/echo/router"
+	"household-account-backend/entity"
+	"household-account-backend/pkg/client"
+	"household-account-backend/pkg/config"
+	"household-account-backend/pkg/tester"
+)
+
+// ClientSuite はルーターをプロセス内で起動し、クライアントから実際のAPIを呼び出す
+type ClientSuite struct {
+	tester.DBSQLiteSuite
+	server *httptest.Server
+}
+
+func TestClientSuite(t *testing.T) {
+	suite.Run(t, new(ClientSuite))
+}
+
+func (suite *ClientSuite) SetupSuite() {
+	suite.DBSQLiteSuite.SetupSuite()
+	configs := config.NewConfig()
+	configs.Secret = "client-test"
+	// テストごとにログインの失敗の記録を消せるようDBに記録する
+	configs.LoginAttemptStore = "db"
+	deps, err := container.New(suite.DB, configs)
+	suite.Require().NoError(err)
+	suite.server = httptest.NewServer(router.NewEchoRouter(deps))
+}
+
+// SetupTest はログインの失敗の記録を消し、他のテストの失敗でログインが制限されないようにする
+func (suite *ClientSuite) SetupTest() {
+	suite.Require().NoError(suite.DB.Where("1 = 1").Delete(&entity.LoginAttempt{}).Error)
+}
+
+func (suite *ClientSuite) TearDownSuite() {
+	suite.server.Close()
+	suite.DBSQLiteSuite.TearDownSuite()
+}
+
+func (suite *ClientSuite) newClient() *client.Client {
+	c, err := client.New(client.Config{ServerURL: suite.server.URL, RetryWait: time.Millisecond})
+	suite.Require().NoError(err)
+	return c
+}
+
+// signup はユーザーを作成してログインしたクライアントを返す
+func (suite *ClientSuite) signup(email string) *client.Client {
+	ctx := context.Background()
+	c := suite.newClient()
+	_, err := c.CreateUserWithResponse(ctx, presenter.CreateUserJSONRequestBody{
+		Email:    types.Email(email),
+		Name:     "client",
+		Password: "password123",
+	})
+	suite.Require().NoError(err)
+	suite.Require().NoError(c.Login(ctx, email, "password123"))
+	return c
+}
+
+func (suite *ClientSuite) TestLoginAndCRUD() {
+	ctx := context.Background()
+	c := suite.signup("crud@example.com")
+
+	created, err := c.CreateCategoryWithResponse(ctx, &presenter.CreateCategoryParams{}, presenter.CreateCategoryJSONRequestBody{
+		Name: "Groceries",
+		Type: presenter.CategoryCreateRequestTypeExpense,
+	})
+	suite.Require().NoError(err)
+	suite.Require().NotNil(created.JSON201)
+	suite.Assert().Equal("Groceries", created.JSON201.Name)
+	suite.Assert().Equal(`"1"`, created.HTTPResponse.Header.Get("ETag"))
+
+	categories, err := c.GetCategoriesWithResponse(ctx)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(categories.JSON200)
+	suite.Assert().Len(*categories.JSON200, 1)
+
+	_, err = c.GetCategoryByIdWithResponse(ctx, created.JSON201.Id+100)
+	suite.Assert().ErrorIs(err, client.ErrNotFound)
+
+	suite.Require().NoError(c.Logout(ctx))
+	_, err = c.GetCategoriesWithResponse(ctx)
+	suite.Assert().ErrorIs(err, client.ErrUnauthorized)
+}
+
+func (suite *ClientSuite) TestWrongPasswordIsThrottled() {
+	suite.signup("wrong@example.com")
+	c := suite.newClient()
+
+	err := c.Login(context.Background(), "wrong@example.com", "incorrect")
+	var apiError *client.APIError
+	suite.Require().True(errors.As(err, &apiError))
+	suite.Assert().Equal(http.StatusUnauthorized, apiError.StatusCode)
+	suite.Require().NotNil(apiError.Problem)
+	suite.Assert().Equal(http.StatusUnauthorized, apiError.Problem.Status)
+
+	// 続けて失敗した場合は待ち時間が返る。POSTのため自動では再試行しない
+	err = c.Login(context.Background(), "wrong@example.com", "incorrect")
+	suite.Require().ErrorIs(err, client.ErrTooManyRequests)
+	suite.Require().True(errors.As(err, &apiError))
+	suite.Assert().Positive(apiError.RetryAfter)
+}
+
+func (suite *ClientSuite) TestValidationError() {
+	c := suite.signup("validation@example.com")
+
+	_, err := c.CreateCategoryWithResponse(context.Background(), &presenter.CreateCategoryParams{}, presenter.CreateCategoryJSONRequestBody{
+		Name: "Invalid",
+		Type: "unknown",
+	})
+	suite.Assert().ErrorIs(err, client.ErrValidation)
+	var apiError *client.APIError
+	suite.Require().True(errors.As(err, &apiError))
+	suite.Assert().NotEmpty(apiError.FieldErrors())
+}
+
+func TestRetryTemporaryErrors(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	c, err := client.New(client.Config{ServerURL: server.URL, RetryWait: time.Millisecond})
+	assert.NoError(t, err)
+
+	response, err := c.GetCategoriesWithResponse(context.Background())
+	if assert.NoError(t, err) {
+		assert.NotNil(t, response.JSON200)
+	}
+	assert.Equal(t, int32(3), requests.Load())
+}
+
+func TestDoNotRetryNonIdempotentRequests(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	c, err := client.New(client.Config{ServerURL: server.URL, Token: "hha_token", RetryWait: time.Millisecond})
+	assert.NoError(t, err)
+
+	// Idempotency-Keyのない作成は重複する恐れがあるため送り直さない
+	_, err = c.CreateCategoryWithResponse(context.Background(), &presenter.CreateCategoryParams{}, presenter.CreateCategoryJSONRequestBody{Name: "Groceries", Type: "expense"})
+	var apiError *client.APIError
+	if assert.True(t, errors.As(err, &apiError)) {
+		assert.Equal(t, http.StatusServiceUnavailable, apiError.StatusCode)
+	}
+	assert.Equal(t, int32(1), requests.Load())
+}
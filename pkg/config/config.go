@@ -0,0 +1,337 @@
+// Package config はHTTPのサーバーとワーカーが共通で使うアプリケーションの設定
+// 環境変数はcmdやinfrastructureで読み込み、アダプターには組み立てた値を渡す
+package config
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"household-account-backend/pkg"
+)
+
+type Config struct {
+	// Env は実行環境。developmentの場合はSwagger UIなどの開発用の機能を有効にする
+	Env string
+	// FrontendURL はメールのリンクやOIDCのログイン後のリダイレクト先になるフロントエンドのURL
+	FrontendURL string
+	// APIDomain は認証用Cookieのドメイン
+	APIDomain string
+	// Secret はJWTKeysを設定していない場合に認証トークンの署名に使う共通鍵
+	Secret     string
+	TOTPIssuer string
+
+	WebhookMaxAttempts int
+	WebhookTimeout     time.Duration
+	WebhookClaimTTL    time.Duration
+
+	IdempotencyKeyTTL time.Duration
+
+	EventStreamBufferSize        int
+	EventStreamHeartbeatInterval time.Duration
+
+	EmailVerificationTokenTTL time.Duration
+	PasswordResetTokenTTL     time.Duration
+	LoginChallengeTTL         time.Duration
+	AccountUnlockTokenTTL     time.Duration
+
+	// LoginAttemptStore はログイン失敗の記録先。"db" または "memory"
+	LoginAttemptStore       string
+	LoginMaxAccountFailures int
+	LoginMaxIPFailures      int
+	LoginLockoutDuration    time.Duration
+	LoginBaseDelay          time.Duration
+	LoginMaxDelay           time.Duration
+	LoginFailureWindow      time.Duration
+
+	// FileStorageDir はエクスポートのアーカイブなどユーザーのファイルを保存するディレクトリ
+	FileStorageDir       string
+	DataExportTTL        time.Duration
+	DataExportStaleAfter time.Duration
+	// AccountDeletionGracePeriod は削除を確認してから実際に削除するまでの猶予期間
+	AccountDeletionGracePeriod time.Duration
+	AccountDeletionTokenTTL    time.Duration
+
+	// AdminImpersonationTTL は管理者がなりすましで発行する認証トークンの有効期間
+	AdminImpersonationTTL time.Duration
+
+	// JWTKeys は認証トークンの署名・検証に使う鍵。空の場合はSecretを共通鍵としてHS256で署名する
+	JWTKeys         []JWTKeyConfig
+	JWTSigningKeyID string
+	JWTIssuer       string
+	JWTAudience     string
+
+	OIDCProviders       []OIDCProviderConfig
+	OIDCRedirectBaseURL string
+	OIDCStateTTL        time.Duration
+
+	// TransactionSignPolicy は取引の金額の符号の規約。"positive" または "signed"
+	TransactionSignPolicy string
+
+	// TrustedProxies はX-Forwarded-Forを信頼するリバースプロキシのIPアドレスまたはCIDR
+	// 空の場合はヘッダーを参照せず、接続元のアドレスをクライアントのIPアドレスとする
+	TrustedProxies []string
+
+	// RateLimitAuth は認証用エンドポイントのIPアドレスごとの制限
+	RateLimitAuth         RateLimitConfig
+	RateLimitTransactions RateLimitConfig
+	// RateLimitExports はデータのエクスポートなど負荷の高いエンドポイントの制限
+	RateLimitExports RateLimitConfig
+	// RateLimitDefault は個別に設定していないエンドポイントの制限
+	RateLimitDefault RateLimitConfig
+}
+
+// RateLimitConfig はPeriodあたりLimit回までリクエストを受け付けるレート制限の設定
+// RATE_LIMIT_<NAME> に "100/1m" の形式で設定し、Limitが0の場合は制限しない
+type RateLimitConfig struct {
+	Limit  int
+	Period time.Duration
+}
+
+// JWTKeyConfig はPEM形式の鍵ファイル
+// JWT_KEYS に "kid=path" をカンマ区切りで列挙し、ローテーション中は古い鍵の公開鍵も残しておく
+type JWTKeyConfig struct {
+	ID   string
+	Path string
+}
+
+// OIDCProviderConfig はOpenID Connectプロバイダの設定
+// OIDC_PROVIDERS にカンマ区切りで名前を列挙し、OIDC_<NAME>_ISSUER などで各プロバイダを設定する
+type OIDCProviderConfig struct {
+	Name         string
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+}
+
+func NewConfig() *Config {
+	timeout, err := time.ParseDuration(pkg.GetEnvDefault("WEBHOOK_TIMEOUT", "10s"))
+	if err != nil {
+		timeout = 10 * time.Second
+	}
+	maxAttempts, err := strconv.Atoi(pkg.GetEnvDefault("WEBHOOK_MAX_ATTEMPTS", "5"))
+	if err != nil {
+		maxAttempts = 5
+	}
+	// 配信中のイベントを他のワーカーが取得しないようにする期間。送信のタイムアウトより十分長くする
+	claimTTL, err := time.ParseDuration(pkg.GetEnvDefault("WEBHOOK_CLAIM_TTL", "5m"))
+	if err != nil {
+		claimTTL = 5 * time.Minute
+	}
+	idempotencyKeyTTL, err := time.ParseDuration(pkg.GetEnvDefault("IDEMPOTENCY_KEY_TTL", "24h"))
+	if err != nil {
+		idempotencyKeyTTL = 24 * time.Hour
+	}
+	eventStreamBufferSize, err := strconv.Atoi(pkg.GetEnvDefault("EVENT_STREAM_BUFFER_SIZE", "64"))
+	if err != nil {
+		eventStreamBufferSize = 64
+	}
+	eventStreamHeartbeatInterval, err := time.ParseDuration(pkg.GetEnvDefault("EVENT_STREAM_HEARTBEAT_INTERVAL", "15s"))
+	if err != nil {
+		eventStreamHeartbeatInterval = 15 * time.Second
+	}
+	emailVerificationTokenTTL, err := time.ParseDuration(pkg.GetEnvDefault("EMAIL_VERIFICATION_TOKEN_TTL", "24h"))
+	if err != nil {
+		emailVerificationTokenTTL = 24 * time.Hour
+	}
+	passwordResetTokenTTL, err := time.ParseDuration(pkg.GetEnvDefault("PASSWORD_RESET_TOKEN_TTL", "1h"))
+	if err != nil {
+		passwordResetTokenTTL = time.Hour
+	}
+	loginChallengeTTL, err := time.ParseDuration(pkg.GetEnvDefault("LOGIN_CHALLENGE_TTL", "5m"))
+	if err != nil {
+		loginChallengeTTL = 5 * time.Minute
+	}
+	accountUnlockTokenTTL, err := time.ParseDuration(pkg.GetEnvDefault("ACCOUNT_UNLOCK_TOKEN_TTL", "24h"))
+	if err != nil {
+		accountUnlockTokenTTL = 24 * time.Hour
+	}
+	loginMaxAccountFailures, err := strconv.Atoi(pkg.GetEnvDefault("LOGIN_MAX_ACCOUNT_FAILURES", "5"))
+	if err != nil {
+		loginMaxAccountFailures = 5
+	}
+	loginMaxIPFailures, err := strconv.Atoi(pkg.GetEnvDefault("LOGIN_MAX_IP_FAILURES", "20"))
+	if err != nil {
+		loginMaxIPFailures = 20
+	}
+	loginLockoutDuration, err := time.ParseDuration(pkg.GetEnvDefault("LOGIN_LOCKOUT_DURATION", "15m"))
+	if err != nil {
+		loginLockoutDuration = 15 * time.Minute
+	}
+	loginBaseDelay, err := time.ParseDuration(pkg.GetEnvDefault("LOGIN_BASE_DELAY", "1s"))
+	if err != nil {
+		loginBaseDelay = time.Second
+	}
+	loginMaxDelay, err := time.ParseDuration(pkg.GetEnvDefault("LOGIN_MAX_DELAY", "30s"))
+	if err != nil {
+		loginMaxDelay = 30 * time.Second
+	}
+	loginFailureWindow, err := time.ParseDuration(pkg.GetEnvDefault("LOGIN_FAILURE_WINDOW", "1h"))
+	if err != nil {
+		loginFailureWindow = time.Hour
+	}
+	dataExportTTL, err := time.ParseDuration(pkg.GetEnvDefault("DATA_EXPORT_TTL", "168h"))
+	if err != nil {
+		dataExportTTL = 7 * 24 * time.Hour
+	}
+	dataExportStaleAfter, err := time.ParseDuration(pkg.GetEnvDefault("DATA_EXPORT_STALE_AFTER", "30m"))
+	if err != nil {
+		dataExportStaleAfter = 30 * time.Minute
+	}
+	accountDeletionGracePeriod, err := time.ParseDuration(pkg.GetEnvDefault("ACCOUNT_DELETION_GRACE_PERIOD", "336h"))
+	if err != nil {
+		accountDeletionGracePeriod = 14 * 24 * time.Hour
+	}
+	accountDeletionTokenTTL, err := time.ParseDuration(pkg.GetEnvDefault("ACCOUNT_DELETION_TOKEN_TTL", "24h"))
+	if err != nil {
+		accountDeletionTokenTTL = 24 * time.Hour
+	}
+	adminImpersonationTTL, err := time.ParseDuration(pkg.GetEnvDefault("ADMIN_IMPERSONATION_TTL", "1h"))
+	if err != nil {
+		adminImpersonationTTL = time.Hour
+	}
+	oidcStateTTL, err := time.ParseDuration(pkg.GetEnvDefault("OIDC_STATE_TTL", "10m"))
+	if err != nil {
+		oidcStateTTL = 10 * time.Minute
+	}
+
+	return &Config{
+		Env:         pkg.GetEnvDefault("APP_ENV", "development"),
+		FrontendURL: pkg.GetEnvDefault("FE_URL", "http://localhost:3000"),
+		APIDomain:   pkg.GetEnvDefault("API_DOMAIN", ""),
+		Secret:      pkg.GetEnvDefault("SECRET", ""),
+		TOTPIssuer:  pkg.GetEnvDefault("TOTP_ISSUER", "Household Account"),
+
+		WebhookMaxAttempts: maxAttempts,
+		WebhookTimeout:     timeout,
+		WebhookClaimTTL:    claimTTL,
+
+		IdempotencyKeyTTL: idempotencyKeyTTL,
+
+		EventStreamBufferSize:        eventStreamBufferSize,
+		EventStreamHeartbeatInterval: eventStreamHeartbeatInterval,
+
+		EmailVerificationTokenTTL: emailVerificationTokenTTL,
+		PasswordResetTokenTTL:     passwordResetTokenTTL,
+		LoginChallengeTTL:         loginChallengeTTL,
+		AccountUnlockTokenTTL:     accountUnlockTokenTTL,
+
+		LoginAttemptStore:       pkg.GetEnvDefault("LOGIN_ATTEMPT_STORE", "db"),
+		LoginMaxAccountFailures: loginMaxAccountFailures,
+		LoginMaxIPFailures:      loginMaxIPFailures,
+		LoginLockoutDuration:    loginLockoutDuration,
+		LoginBaseDelay:          loginBaseDelay,
+		LoginMaxDelay:           loginMaxDelay,
+		LoginFailureWindow:      loginFailureWindow,
+
+		FileStorageDir:             pkg.GetEnvDefault("FILE_STORAGE_DIR", "./storage"),
+		DataExportTTL:              dataExportTTL,
+		DataExportStaleAfter:       dataExportStaleAfter,
+		AccountDeletionGracePeriod: accountDeletionGracePeriod,
+		AccountDeletionTokenTTL:    accountDeletionTokenTTL,
+
+		AdminImpersonationTTL: adminImpersonationTTL,
+
+		JWTKeys:         newJWTKeyConfigs(),
+		JWTSigningKeyID: pkg.GetEnvDefault("JWT_SIGNING_KEY_ID", ""),
+		JWTIssuer:       pkg.GetEnvDefault("JWT_ISSUER", "household-account-backend"),
+		JWTAudience:     pkg.GetEnvDefault("JWT_AUDIENCE", "household-account"),
+
+		OIDCProviders:       newOIDCProviderConfigs(),
+		OIDCRedirectBaseURL: pkg.GetEnvDefault("OIDC_REDIRECT_BASE_URL", "http://localhost:8080"),
+		OIDCStateTTL:        oidcStateTTL,
+
+		TransactionSignPolicy: pkg.GetEnvDefault("TRANSACTION_SIGN_POLICY", "positive"),
+
+		TrustedProxies: splitList(pkg.GetEnvDefault("TRUSTED_PROXIES", "")),
+
+		RateLimitAuth:         newRateLimitConfig("AUTH", RateLimitConfig{Limit: 20, Period: time.Minute}),
+		RateLimitTransactions: newRateLimitConfig("TRANSACTIONS", RateLimitConfig{Limit: 120, Period: time.Minute}),
+		RateLimitExports:      newRateLimitConfig("EXPORTS", RateLimitConfig{Limit: 5, Period: time.Hour}),
+		RateLimitDefault:      newRateLimitConfig("DEFAULT", RateLimitConfig{Limit: 300, Period: time.Minute}),
+	}
+}
+
+// TrustedProxyNetworks はTrustedProxiesをネットワークの範囲に変換する
+// CIDRではないIPアドレスはそのアドレスだけの範囲として扱う
+func (c *Config) TrustedProxyNetworks() ([]*net.IPNet, error) {
+	networks := make([]*net.IPNet, 0, len(c.TrustedProxies))
+	for _, proxy := range c.TrustedProxies {
+		if _, network, err := net.ParseCIDR(proxy); err == nil {
+			networks = append(networks, network)
+			continue
+		}
+		ip := net.ParseIP(proxy)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid trusted proxy: %q", proxy)
+		}
+		bits := 8 * net.IPv6len
+		if ip4 := ip.To4(); ip4 != nil {
+			ip, bits = ip4, 8*net.IPv4len
+		}
+		networks = append(networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+	}
+	return networks, nil
+}
+
+// splitList はカンマ区切りの値を空の要素を除いて返す
+func splitList(value string) []string {
+	var values []string
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+func newRateLimitConfig(name string, fallback RateLimitConfig) RateLimitConfig {
+	value := pkg.GetEnvDefault("RATE_LIMIT_"+name, "")
+	limit, period, ok := strings.Cut(value, "/")
+	if !ok {
+		return fallback
+	}
+	config := RateLimitConfig{}
+	var err error
+	if config.Limit, err = strconv.Atoi(strings.TrimSpace(limit)); err != nil {
+		return fallback
+	}
+	if config.Period, err = time.ParseDuration(strings.TrimSpace(period)); err != nil || config.Period <= 0 {
+		return fallback
+	}
+	return config
+}
+
+func newJWTKeyConfigs() []JWTKeyConfig {
+	var configs []JWTKeyConfig
+	for _, entry := range strings.Split(pkg.GetEnvDefault("JWT_KEYS", ""), ",") {
+		id, path, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok || id == "" || path == "" {
+			continue
+		}
+		configs = append(configs, JWTKeyConfig{ID: id, Path: path})
+	}
+	return configs
+}
+
+func newOIDCProviderConfigs() []OIDCProviderConfig {
+	var configs []OIDCProviderConfig
+	for _, name := range strings.Split(pkg.GetEnvDefault("OIDC_PROVIDERS", ""), ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		prefix := "OIDC_" + strings.ToUpper(name) + "_"
+		configs = append(configs, OIDCProviderConfig{
+			Name:         strings.ToLower(name),
+			Issuer:       pkg.GetEnvDefault(prefix+"ISSUER", ""),
+			ClientID:     pkg.GetEnvDefault(prefix+"CLIENT_ID", ""),
+			ClientSecret: pkg.GetEnvDefault(prefix+"CLIENT_SECRET", ""),
+			Scopes:       strings.Fields(pkg.GetEnvDefault(prefix+"SCOPES", "email profile")),
+		})
+	}
+	return configs
+}
@@ -0,0 +1,228 @@
+package jwtkeys
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+var (
+	ErrNoSigningKey  = errors.New("no signing key is configured")
+	ErrUnknownKeyID  = errors.New("unknown key id")
+	ErrInvalidClaims = errors.New("invalid token claims")
+)
+
+// Key はJWTの署名・検証に使う鍵
+// 公開鍵だけを読み込んだ場合は検証にのみ使い、ローテーションで署名に使わなくなった鍵の発行済みトークンを受け付ける
+type Key struct {
+	ID         string
+	Method     jwt.SigningMethod
+	signingKey interface{}
+	verifyKey  interface{}
+}
+
+// NewHMACKey は共通鍵の鍵を返す。共通鍵はJWKSで公開しない
+func NewHMACKey(id string, secret []byte) *Key {
+	return &Key{ID: id, Method: jwt.SigningMethodHS256, signingKey: secret, verifyKey: secret}
+}
+
+func NewRSAKey(id string, key *rsa.PrivateKey) *Key {
+	return &Key{ID: id, Method: jwt.SigningMethodRS256, signingKey: key, verifyKey: &key.PublicKey}
+}
+
+func NewEdDSAKey(id string, key ed25519.PrivateKey) *Key {
+	return &Key{ID: id, Method: jwt.SigningMethodEdDSA, signingKey: key, verifyKey: key.Public()}
+}
+
+// LoadKeyFile はPEM形式の鍵ファイルを読み込む
+func LoadKeyFile(id string, path string) (*Key, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	key, err := ParseKeyPEM(id, data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return key, nil
+}
+
+// ParseKeyPEM はRSAまたはEd25519の秘密鍵(PKCS#1/PKCS#8)か公開鍵(PKIX)を読み込む
+func ParseKeyPEM(id string, data []byte) (*Key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	var parsed interface{}
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		parsed, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type: %s", block.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch k := parsed.(type) {
+	case *rsa.PrivateKey:
+		return NewRSAKey(id, k), nil
+	case ed25519.PrivateKey:
+		return NewEdDSAKey(id, k), nil
+	case *rsa.PublicKey:
+		return &Key{ID: id, Method: jwt.SigningMethodRS256, verifyKey: k}, nil
+	case ed25519.PublicKey:
+		return &Key{ID: id, Method: jwt.SigningMethodEdDSA, verifyKey: k}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type: %T", parsed)
+	}
+}
+
+// CanSign は秘密鍵を持っているかを返す
+func (k *Key) CanSign() bool {
+	return k.signingKey != nil
+}
+
+// Config はトークンに設定・検証するクレーム
+type Config struct {
+	// SigningKeyID は署名に使う鍵のID。空の場合は秘密鍵を持つ最初の鍵を使う
+	SigningKeyID string
+	Issuer       string
+	Audience     string
+}
+
+// KeyManager は鍵IDごとにJWTの署名・検証を行う
+// 署名に使う鍵はひとつで、それ以外の鍵は発行済みトークンの検証にだけ使う
+type KeyManager struct {
+	keys       []*Key
+	keysByID   map[string]*Key
+	signingKey *Key
+	issuer     string
+	audience   string
+}
+
+func NewKeyManager(keys []*Key, config Config) (*KeyManager, error) {
+	m := &KeyManager{
+		keysByID: map[string]*Key{},
+		issuer:   config.Issuer,
+		audience: config.Audience,
+	}
+	for _, key := range keys {
+		if key.ID == "" {
+			return nil, errors.New("key id must not be empty")
+		}
+		if _, ok := m.keysByID[key.ID]; ok {
+			return nil, fmt.Errorf("duplicate key id: %s", key.ID)
+		}
+		m.keys = append(m.keys, key)
+		m.keysByID[key.ID] = key
+		if m.signingKey == nil && key.CanSign() && (config.SigningKeyID == "" || config.SigningKeyID == key.ID) {
+			m.signingKey = key
+		}
+	}
+	if m.signingKey == nil {
+		return nil, ErrNoSigningKey
+	}
+	return m, nil
+}
+
+// SigningKeyID は署名に使う鍵のIDを返す
+func (m *KeyManager) SigningKeyID() string {
+	return m.signingKey.ID
+}
+
+// Sign はiss・aud・iatを設定し、kidヘッダーを付けて署名する
+func (m *KeyManager) Sign(claims jwt.MapClaims) (string, error) {
+	if m.issuer != "" {
+		claims["iss"] = m.issuer
+	}
+	if m.audience != "" {
+		claims["aud"] = m.audience
+	}
+	claims["iat"] = time.Now().Unix()
+
+	token := jwt.NewWithClaims(m.signingKey.Method, claims)
+	token.Header["kid"] = m.signingKey.ID
+	return token.SignedString(m.signingKey.signingKey)
+}
+
+// Parse は署名と有効期限、iss・audを検証する
+// kidで選んだ鍵のアルゴリズム以外で署名されたトークンは受け付けない
+func (m *KeyManager) Parse(tokenString string) (*jwt.Token, error) {
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		key, ok := m.keysByID[kid]
+		if !ok {
+			return nil, ErrUnknownKeyID
+		}
+		if token.Method.Alg() != key.Method.Alg() {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return key.verifyKey, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || !token.Valid {
+		return nil, ErrInvalidClaims
+	}
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) ||
+		!claims.VerifyIssuer(m.issuer, m.issuer != "") ||
+		!claims.VerifyAudience(m.audience, m.audience != "") {
+		return nil, ErrInvalidClaims
+	}
+	return token, nil
+}
+
+// JSONWebKey はRFC 7517の公開鍵
+type JSONWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+// JWKS は検証に使うすべての公開鍵を返す。共通鍵は含めない
+func (m *KeyManager) JWKS() []JSONWebKey {
+	jwks := []JSONWebKey{}
+	for _, key := range m.keys {
+		jwk := JSONWebKey{Kid: key.ID, Use: "sig", Alg: key.Method.Alg()}
+		switch k := key.verifyKey.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(k.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(k.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(k)
+		default:
+			continue
+		}
+		jwks = append(jwks, jwk)
+	}
+	return jwks
+}
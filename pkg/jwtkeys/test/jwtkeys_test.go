@@ -0,0 +1,178 @@
+package jwtkeys_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"household-account-backend/pkg/jwtkeys"
+)
+
+var testConfig = jwtkeys.Config{
+	Issuer:   "household-account-backend",
+	Audience: "household-account",
+}
+
+func newRSAKey(t *testing.T, id string) *jwtkeys.Key {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return jwtkeys.NewRSAKey(id, key)
+}
+
+func newEdDSAKey(t *testing.T, id string) *jwtkeys.Key {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	return jwtkeys.NewEdDSAKey(id, key)
+}
+
+func claims() jwt.MapClaims {
+	return jwt.MapClaims{"user_id": 1, "exp": time.Now().Add(time.Hour).Unix()}
+}
+
+func TestSignAndParse(t *testing.T) {
+	for _, key := range []*jwtkeys.Key{newRSAKey(t, "rsa"), newEdDSAKey(t, "ed"), jwtkeys.NewHMACKey("hmac", []byte("secret"))} {
+		manager, err := jwtkeys.NewKeyManager([]*jwtkeys.Key{key}, testConfig)
+		require.NoError(t, err)
+
+		tokenString, err := manager.Sign(claims())
+		require.NoError(t, err)
+		token, err := manager.Parse(tokenString)
+		require.NoError(t, err, key.ID)
+		assert.Equal(t, key.ID, token.Header["kid"])
+		assert.Equal(t, key.Method.Alg(), token.Method.Alg())
+		assert.Equal(t, "household-account-backend", token.Claims.(jwt.MapClaims)["iss"])
+	}
+}
+
+func TestParse_Rotation(t *testing.T) {
+	oldKey := newRSAKey(t, "2026-04")
+	newKey := newEdDSAKey(t, "2026-10")
+	oldManager, err := jwtkeys.NewKeyManager([]*jwtkeys.Key{oldKey}, testConfig)
+	require.NoError(t, err)
+	oldToken, err := oldManager.Sign(claims())
+	require.NoError(t, err)
+
+	manager, err := jwtkeys.NewKeyManager([]*jwtkeys.Key{oldKey, newKey}, jwtkeys.Config{
+		SigningKeyID: "2026-10",
+		Issuer:       testConfig.Issuer,
+		Audience:     testConfig.Audience,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "2026-10", manager.SigningKeyID())
+
+	// 古い鍵で発行したトークンも検証できる
+	_, err = manager.Parse(oldToken)
+	assert.NoError(t, err)
+
+	newToken, err := manager.Sign(claims())
+	require.NoError(t, err)
+	_, err = oldManager.Parse(newToken)
+	assert.ErrorIs(t, err, jwtkeys.ErrUnknownKeyID)
+}
+
+func TestParse_InvalidClaims(t *testing.T) {
+	key := jwtkeys.NewHMACKey("hmac", []byte("secret"))
+	manager, err := jwtkeys.NewKeyManager([]*jwtkeys.Key{key}, testConfig)
+	require.NoError(t, err)
+
+	otherAudience, err := jwtkeys.NewKeyManager([]*jwtkeys.Key{key}, jwtkeys.Config{Issuer: testConfig.Issuer, Audience: "other-service"})
+	require.NoError(t, err)
+	tokenString, err := otherAudience.Sign(claims())
+	require.NoError(t, err)
+	_, err = manager.Parse(tokenString)
+	assert.ErrorIs(t, err, jwtkeys.ErrInvalidClaims)
+
+	// 有効期限のないトークンは受け付けない
+	tokenString, err = manager.Sign(jwt.MapClaims{"user_id": 1})
+	require.NoError(t, err)
+	_, err = manager.Parse(tokenString)
+	assert.ErrorIs(t, err, jwtkeys.ErrInvalidClaims)
+}
+
+func TestParse_RejectsAlgorithmMismatch(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	manager, err := jwtkeys.NewKeyManager([]*jwtkeys.Key{jwtkeys.NewRSAKey("rsa", rsaKey)}, testConfig)
+	require.NoError(t, err)
+
+	// 公開鍵を共通鍵として使ったHS256のトークン
+	publicKey := x509.MarshalPKCS1PublicKey(&rsaKey.PublicKey)
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims())
+	token.Header["kid"] = "rsa"
+	tokenString, err := token.SignedString(publicKey)
+	require.NoError(t, err)
+
+	_, err = manager.Parse(tokenString)
+	assert.Error(t, err)
+}
+
+func TestNewKeyManager_NoSigningKey(t *testing.T) {
+	_, err := jwtkeys.NewKeyManager(nil, testConfig)
+	assert.ErrorIs(t, err, jwtkeys.ErrNoSigningKey)
+
+	_, err = jwtkeys.NewKeyManager([]*jwtkeys.Key{newRSAKey(t, "rsa")}, jwtkeys.Config{SigningKeyID: "unknown"})
+	assert.ErrorIs(t, err, jwtkeys.ErrNoSigningKey)
+}
+
+func TestLoadKeyFile(t *testing.T) {
+	dir := t.TempDir()
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	publicKey, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	write := func(name string, blockType string, der []byte) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0600))
+		return path
+	}
+	edDER, err := x509.MarshalPKCS8PrivateKey(edKey)
+	require.NoError(t, err)
+	publicDER, err := x509.MarshalPKIXPublicKey(publicKey)
+	require.NoError(t, err)
+
+	rsaFile, err := jwtkeys.LoadKeyFile("rsa", write("rsa.pem", "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(rsaKey)))
+	require.NoError(t, err)
+	assert.Equal(t, jwt.SigningMethodRS256, rsaFile.Method)
+	assert.True(t, rsaFile.CanSign())
+
+	edFile, err := jwtkeys.LoadKeyFile("ed", write("ed.pem", "PRIVATE KEY", edDER))
+	require.NoError(t, err)
+	assert.True(t, edFile.CanSign())
+
+	publicFile, err := jwtkeys.LoadKeyFile("ed-old", write("ed.pub", "PUBLIC KEY", publicDER))
+	require.NoError(t, err)
+	assert.False(t, publicFile.CanSign())
+
+	_, err = jwtkeys.LoadKeyFile("invalid", write("invalid.pem", "CERTIFICATE", []byte("invalid")))
+	assert.Error(t, err)
+}
+
+func TestJWKS(t *testing.T) {
+	rsaKey := newRSAKey(t, "rsa")
+	edKey := newEdDSAKey(t, "ed")
+	manager, err := jwtkeys.NewKeyManager([]*jwtkeys.Key{jwtkeys.NewHMACKey("hmac", []byte("secret")), rsaKey, edKey}, testConfig)
+	require.NoError(t, err)
+
+	jwks := manager.JWKS()
+	// 共通鍵は公開しない
+	require.Len(t, jwks, 2)
+	assert.Equal(t, "RSA", jwks[0].Kty)
+	assert.Equal(t, "RS256", jwks[0].Alg)
+	assert.Equal(t, "AQAB", jwks[0].E)
+	assert.NotEmpty(t, jwks[0].N)
+	assert.Equal(t, "OKP", jwks[1].Kty)
+	assert.Equal(t, "Ed25519", jwks[1].Crv)
+	assert.Equal(t, "EdDSA", jwks[1].Alg)
+	assert.NotEmpty(t, jwks[1].X)
+}
@@ -1,7 +1,6 @@
 package pkg
 
 import (
-	"fmt"
 	"net"
 	"net/url"
 	"os"
@@ -9,7 +8,7 @@ import (
 )
 
 func CheckPort(host string, port string) bool {
-	conn, err := net.Dial("tcp", fmt.Sprintf("%s:%s", host, port))
+	conn, err := net.Dial("tcp", net.JoinHostPort(host, port))
 	if conn != nil {
 		conn.Close()
 		return false
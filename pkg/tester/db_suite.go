@@ -0,0 +1,43 @@
+package tester
+
+import (
+	"household-account-backend/infrastructure/database"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+// DBSuite はDriverで指定したDBに接続する。指定しない場合はSQLiteを使う
+// 同じテストをSQLiteとPostgreSQLで実行し、SQLの方言による違いを検出するために使う
+type DBSuite struct {
+	suite.Suite
+	Driver string
+	DB     *gorm.DB
+
+	sqlite     *DBSQLiteSuite
+	postgreSQL *DBPostgreSQLSuite
+}
+
+func (suite *DBSuite) SetupSuite() {
+	switch suite.Driver {
+	case database.DriverPostgreSQL:
+		suite.postgreSQL = &DBPostgreSQLSuite{}
+		suite.postgreSQL.SetT(suite.T())
+		suite.postgreSQL.SetupSuite()
+		suite.DB = suite.postgreSQL.DB
+	default:
+		suite.sqlite = &DBSQLiteSuite{}
+		suite.sqlite.SetT(suite.T())
+		suite.sqlite.SetupSuite()
+		suite.DB = suite.sqlite.DB
+	}
+}
+
+func (suite *DBSuite) TearDownSuite() {
+	switch {
+	case suite.postgreSQL != nil:
+		suite.postgreSQL.TearDownSuite()
+	case suite.sqlite != nil:
+		suite.sqlite.TearDownSuite()
+	}
+}
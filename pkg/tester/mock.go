@@ -22,7 +22,7 @@ func MockDB() (mock sqlmock.Sqlmock, mockGormDB *gorm.DB) {
 		DriverName:                "mysql",
 		Conn:                      mockDB,
 		SkipInitializeWithVersion: true,
-	}), &gorm.Config{})
+	}), &gorm.Config{TranslateError: true})
 	if err != nil {
 		logger.Fatal(err.Error())
 	}
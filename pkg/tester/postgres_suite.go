@@ -0,0 +1,97 @@
+package tester
+
+import (
+	"context"
+	"household-account-backend/entity"
+	"household-account-backend/infrastructure/database"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+	"gorm.io/gorm"
+)
+
+// DBPostgreSQLSuite はtestcontainersで起動したPostgreSQLに接続する
+// Dockerを利用できない環境ではテストをスキップする
+type DBPostgreSQLSuite struct {
+	suite.Suite
+	postgreSQLContainer testcontainers.Container
+	ctx                 context.Context
+	DB                  *gorm.DB
+}
+
+func (suite *DBPostgreSQLSuite) SetupTestContainers() (err error) {
+	skipIfDockerIsUnavailable(suite.T())
+
+	configs := database.NewConfigPostgreSQL()
+	suite.ctx = context.Background()
+	req := testcontainers.ContainerRequest{
+		Image: "postgres:16",
+		Env: map[string]string{
+			"POSTGRES_DB":       configs.Database,
+			"POSTGRES_USER":     configs.User,
+			"POSTGRES_PASSWORD": configs.Password,
+		},
+		ExposedPorts: []string{"5432/tcp"},
+		// 初期化のために1度再起動するため、2回目の起動完了を待つ
+		WaitingFor: wait.ForLog("database system is ready to accept connections").WithOccurrence(2).WithStartupTimeout(time.Minute),
+	}
+
+	suite.postgreSQLContainer, err = testcontainers.GenericContainer(suite.ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	if err != nil {
+		return err
+	}
+
+	// ポートは空いているものが割り当てられるため、接続先を環境変数で渡す
+	host, err := suite.postgreSQLContainer.Host(suite.ctx)
+	if err != nil {
+		return err
+	}
+	port, err := suite.postgreSQLContainer.MappedPort(suite.ctx, "5432/tcp")
+	if err != nil {
+		return err
+	}
+	os.Setenv("DB_HOST", host)
+	os.Setenv("DB_PORT", port.Port())
+	return nil
+}
+
+func (suite *DBPostgreSQLSuite) SetupSuite() {
+	err := suite.SetupTestContainers()
+	suite.Require().Nil(err)
+
+	db, err := database.NewDatabaseSQLFactory(database.InstancePostgreSQL)
+	suite.Require().Nil(err)
+	suite.DB = db
+	for _, model := range entity.NewDomains() {
+		err = suite.DB.AutoMigrate(model)
+		suite.Assert().Nil(err)
+	}
+}
+
+func (suite *DBPostgreSQLSuite) TearDownSuite() {
+	os.Unsetenv("DB_HOST")
+	os.Unsetenv("DB_PORT")
+	if suite.postgreSQLContainer == nil {
+		return
+	}
+	err := suite.postgreSQLContainer.Terminate(suite.ctx)
+	suite.Assert().Nil(err)
+}
+
+// skipIfDockerIsUnavailable はDockerに接続できない場合にテストをスキップする
+// testcontainersはDockerのホストが見つからない最初の呼び出しでpanicするため、スキップに変換する
+func skipIfDockerIsUnavailable(t *testing.T) {
+	defer func() {
+		if r := recover(); r != nil {
+			t.Skipf("docker is not available: %v", r)
+		}
+	}()
+	testcontainers.SkipIfProviderIsNotHealthy(t)
+}
@@ -0,0 +1,56 @@
+package totp_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"household-account-backend/pkg/totp"
+)
+
+// RFC 6238 Appendix B のテストベクタ(SHA1)の下6桁
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestCode(t *testing.T) {
+	cases := map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1234567890: "005924",
+		2000000000: "279037",
+	}
+	for unix, expected := range cases {
+		code, err := totp.Code(rfcSecret, totp.Step(time.Unix(unix, 0)))
+		assert.NoError(t, err)
+		assert.Equal(t, expected, code)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1111111109, 0)
+	code, _ := totp.Code(rfcSecret, totp.Step(now))
+
+	step, ok := totp.Validate(rfcSecret, code, now)
+	assert.True(t, ok)
+	assert.Equal(t, totp.Step(now), step)
+
+	// 1ステップ前後のずれは許容する
+	_, ok = totp.Validate(rfcSecret, code, now.Add(totp.Period*time.Second))
+	assert.True(t, ok)
+	_, ok = totp.Validate(rfcSecret, code, now.Add(2*totp.Period*time.Second))
+	assert.False(t, ok)
+
+	_, ok = totp.Validate(rfcSecret, "12345", now)
+	assert.False(t, ok)
+}
+
+func TestGenerateSecretAndURI(t *testing.T) {
+	secret, err := totp.GenerateSecret()
+	assert.NoError(t, err)
+	assert.Len(t, secret, 32)
+
+	uri := totp.URI("Household Account", "test@example.com", secret)
+	assert.True(t, strings.HasPrefix(uri, "otpauth://totp/Household%20Account:test@example.com?"))
+	assert.Contains(t, uri, "secret="+secret)
+}
@@ -0,0 +1,266 @@
+package usecase
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/entity"
+	"household-account-backend/pkg/logger"
+)
+
+var (
+	ErrDeletionAlreadyScheduled = &ConflictError{Message: "account deletion is already scheduled"}
+	ErrDeletionNotFound         = &NotFoundError{Message: "account deletion request not found"}
+)
+
+// AccountDeletionConfig はアカウント削除の設定
+type AccountDeletionConfig struct {
+	// GracePeriod は確認してから実際に削除するまでの猶予期間。この間は取り消せる
+	GracePeriod time.Duration
+	// ConfirmationTTL は確認メールのトークンの有効期限
+	ConfirmationTTL time.Duration
+	// FrontendURL はメール本文に記載するリンクのベースURL
+	FrontendURL string
+}
+
+// AccountDeletionReport は削除の検証結果
+// Remaining と FilesRemaining が全て0の場合のみ Verified がtrueになる
+type AccountDeletionReport struct {
+	Deleted        map[string]int64 `json:"deleted"`
+	Remaining      map[string]int64 `json:"remaining"`
+	FilesDeleted   int              `json:"files_deleted"`
+	FilesRemaining int              `json:"files_remaining"`
+	Verified       bool             `json:"verified"`
+	CompletedAt    time.Time        `json:"completed_at"`
+}
+
+type AccountDeletionUseCase interface {
+	// RequestDeletion は削除の依頼を作成し、確認用のメールを送る
+	// 確認待ちの依頼がある場合は確認メールを送り直す
+	RequestDeletion(userID int) (*entity.AccountDeletion, error)
+	// ConfirmDeletion はメールのトークンで依頼を確認し、猶予期間の終了後に削除されるようにする
+	ConfirmDeletion(token string) (*entity.AccountDeletion, error)
+	GetDeletion(userID int) (*entity.AccountDeletion, error)
+	CancelDeletion(userID int) error
+	// ProcessDueDeletions は猶予期間が終了したアカウントのデータとファイルを削除し、削除した件数を返す
+	ProcessDueDeletions(limit int) (int, error)
+}
+
+type accountDeletionUseCase struct {
+	accountDeletionRepository gateway.AccountDeletionRepository
+	userRepository            gateway.UserRepository
+	userTokenRepository       gateway.UserTokenRepository
+	fileStorage               gateway.FileStorage
+	mailSender                gateway.MailSender
+	config                    AccountDeletionConfig
+}
+
+func NewAccountDeletionUseCase(accountDeletionRepository gateway.AccountDeletionRepository, userRepository gateway.UserRepository, userTokenRepository gateway.UserTokenRepository, fileStorage gateway.FileStorage, mailSender gateway.MailSender, config AccountDeletionConfig) AccountDeletionUseCase {
+	return &accountDeletionUseCase{
+		accountDeletionRepository: accountDeletionRepository,
+		userRepository:            userRepository,
+		userTokenRepository:       userTokenRepository,
+		fileStorage:               fileStorage,
+		mailSender:                mailSender,
+		config:                    config,
+	}
+}
+
+func (du *accountDeletionUseCase) RequestDeletion(userID int) (*entity.AccountDeletion, error) {
+	user, err := du.userRepository.GetCurrentUser(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	deletion, err := du.accountDeletionRepository.GetActiveDeletion(userID)
+	if err != nil {
+		return nil, err
+	}
+	if deletion != nil && deletion.Status == entity.AccountDeletionStatusScheduled {
+		return nil, ErrDeletionAlreadyScheduled
+	}
+	if deletion == nil {
+		deletion, err = du.accountDeletionRepository.CreateDeletion(&entity.AccountDeletion{
+			UserID: userID,
+			Email:  user.Email,
+			Status: entity.AccountDeletionStatusPending,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 送り直した場合は古いリンクを使えないようにする
+	if err := du.userTokenRepository.InvalidateTokens(userID, entity.UserTokenPurposeAccountDeletion, time.Now()); err != nil {
+		return nil, err
+	}
+	token, err := issueUserToken(du.userTokenRepository, userID, entity.UserTokenPurposeAccountDeletion, du.config.ConfirmationTTL)
+	if err != nil {
+		return nil, err
+	}
+	body := fmt.Sprintf("アカウントの削除が依頼されました。\n以下のリンクから削除を確定すると、%sの猶予期間の後に全てのデータを削除します。\n%s/confirm-account-deletion?token=%s\n\n心当たりがない場合はこのメールを無視してください。\n有効期限: %s",
+		du.config.GracePeriod, du.config.FrontendURL, token, du.config.ConfirmationTTL)
+	if err := du.mailSender.Send(user.Email, "アカウント削除の確認", body); err != nil {
+		return nil, err
+	}
+	return deletion, nil
+}
+
+func (du *accountDeletionUseCase) ConfirmDeletion(token string) (*entity.AccountDeletion, error) {
+	userToken, err := consumeUserToken(du.userTokenRepository, entity.UserTokenPurposeAccountDeletion, token)
+	if err != nil {
+		return nil, err
+	}
+	deletion, err := du.accountDeletionRepository.GetActiveDeletion(userToken.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if deletion == nil || deletion.Status != entity.AccountDeletionStatusPending {
+		return nil, ErrInvalidUserToken
+	}
+
+	now := time.Now()
+	scheduledAt := now.Add(du.config.GracePeriod)
+	deletion.Status = entity.AccountDeletionStatusScheduled
+	deletion.ConfirmedAt = &now
+	deletion.ScheduledAt = &scheduledAt
+	if err := du.accountDeletionRepository.UpdateDeletion(deletion); err != nil {
+		return nil, err
+	}
+
+	body := fmt.Sprintf("アカウントの削除を受け付けました。%sに全てのデータを削除します。\nそれまではログインして削除を取り消せます。",
+		scheduledAt.Format("2006-01-02 15:04 MST"))
+	if err := du.mailSender.Send(deletion.Email, "アカウント削除の受付", body); err != nil {
+		logger.Warn("failed to send account deletion notice", "user_id", deletion.UserID, "error", err.Error())
+	}
+	return deletion, nil
+}
+
+func (du *accountDeletionUseCase) GetDeletion(userID int) (*entity.AccountDeletion, error) {
+	deletion, err := du.accountDeletionRepository.GetLatestDeletion(userID)
+	if err != nil {
+		return nil, err
+	}
+	if deletion == nil {
+		return nil, ErrDeletionNotFound
+	}
+	return deletion, nil
+}
+
+func (du *accountDeletionUseCase) CancelDeletion(userID int) error {
+	deletion, err := du.accountDeletionRepository.GetActiveDeletion(userID)
+	if err != nil {
+		return err
+	}
+	if deletion == nil {
+		return ErrDeletionNotFound
+	}
+
+	deletion.Status = entity.AccountDeletionStatusCancelled
+	if err := du.accountDeletionRepository.UpdateDeletion(deletion); err != nil {
+		return err
+	}
+	return du.userTokenRepository.InvalidateTokens(userID, entity.UserTokenPurposeAccountDeletion, time.Now())
+}
+
+func (du *accountDeletionUseCase) ProcessDueDeletions(limit int) (int, error) {
+	deletions, err := du.accountDeletionRepository.GetDueDeletions(time.Now(), limit)
+	if err != nil {
+		return 0, err
+	}
+	for i := range deletions {
+		// 失敗した場合は削除予定のまま残し、次回に再試行する
+		if err := du.eraseAccount(&deletions[i]); err != nil {
+			return i, err
+		}
+	}
+	return len(deletions), nil
+}
+
+// eraseAccount はユーザーのファイルと全てのテーブルの行を削除し、残っていないことを確かめて結果を記録する
+func (du *accountDeletionUseCase) eraseAccount(deletion *entity.AccountDeletion) error {
+	// 猶予期間中にメールアドレスを変更している場合があるため、削除時点のメールアドレスを使う
+	email := deletion.Email
+	if user, err := du.userRepository.GetCurrentUser(deletion.UserID); err == nil {
+		email = user.Email
+	} else if !errors.Is(err, gateway.ErrRecordNotFound) {
+		return err
+	}
+
+	prefix := gateway.UserFilePrefix(deletion.UserID)
+	filesDeleted, err := du.fileStorage.DeletePrefix(prefix)
+	if err != nil {
+		return err
+	}
+	deleted, err := du.accountDeletionRepository.EraseUserData(deletion.UserID, email)
+	if err != nil {
+		return err
+	}
+
+	remaining, err := du.accountDeletionRepository.CountUserData(deletion.UserID, email)
+	if err != nil {
+		return err
+	}
+	remainingFiles, err := du.fileStorage.List(prefix)
+	if err != nil {
+		return err
+	}
+	report := AccountDeletionReport{
+		Deleted:        deleted,
+		Remaining:      remaining,
+		FilesDeleted:   filesDeleted,
+		FilesRemaining: len(remainingFiles),
+		Verified:       len(remainingFiles) == 0,
+		CompletedAt:    time.Now(),
+	}
+	for _, count := range remaining {
+		if count > 0 {
+			report.Verified = false
+		}
+	}
+	reportJSON, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+
+	deletion.Report = string(reportJSON)
+	deletion.CompletedAt = &report.CompletedAt
+	deletion.Status = entity.AccountDeletionStatusCompleted
+	if !report.Verified {
+		deletion.Status = entity.AccountDeletionStatusFailed
+		logger.Error("account deletion left data behind", "user_id", deletion.UserID, "report", deletion.Report)
+	}
+
+	if err := du.mailSender.Send(email, "アカウント削除の完了", deletionReportBody(&report)); err != nil {
+		logger.Warn("failed to send account deletion report", "user_id", deletion.UserID, "error", err.Error())
+	}
+	// 削除後は連絡先を保持しない
+	deletion.Email = ""
+	return du.accountDeletionRepository.UpdateDeletion(deletion)
+}
+
+func deletionReportBody(report *AccountDeletionReport) string {
+	tables := make([]string, 0, len(report.Deleted))
+	for table := range report.Deleted {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+
+	var b strings.Builder
+	if report.Verified {
+		b.WriteString("アカウントと全てのデータを削除しました。\n\n")
+	} else {
+		b.WriteString("アカウントを削除しましたが、一部のデータの削除を確認できませんでした。担当者が確認して削除します。\n\n")
+	}
+	b.WriteString("削除したデータ:\n")
+	for _, table := range tables {
+		fmt.Fprintf(&b, "- %s: %d件 (残り%d件)\n", table, report.Deleted[table], report.Remaining[table])
+	}
+	fmt.Fprintf(&b, "- ファイル: %d件 (残り%d件)\n", report.FilesDeleted, report.FilesRemaining)
+	return b.String()
+}
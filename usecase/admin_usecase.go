@@ -0,0 +1,238 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/golang-jwt/jwt/v4"
+
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/entity"
+	"household-account-backend/pkg/jwtkeys"
+)
+
+const (
+	// ImpersonatorClaim はなりすましで発行した認証トークンに、操作した管理者のIDを入れるクレーム
+	ImpersonatorClaim = "impersonator_id"
+	// DefaultAdminUserSearchLimit はユーザー検索で件数を指定しない場合に返す件数
+	DefaultAdminUserSearchLimit = 50
+	// MaxAdminUserSearchLimit はユーザー検索で一度に返す最大件数
+	MaxAdminUserSearchLimit = 200
+	// MaxImpersonationReasonLength はなりすましの理由の最大文字数
+	MaxImpersonationReasonLength = 255
+	// adminStatsPeriod は利用状況で直近の件数を数える期間
+	adminStatsPeriod = 30 * 24 * time.Hour
+)
+
+var (
+	ErrUserNotFound               = &NotFoundError{Message: "user not found"}
+	ErrInvalidUserRole            = newFieldValidationError("invalid user role", "role", "the role must be user or admin")
+	ErrInvalidUserStatus          = newFieldValidationError("invalid user status", "status", "the status must be active or disabled")
+	ErrAdminSelfAction            = &ConflictError{Message: "administrators cannot perform this action on their own account"}
+	ErrImpersonationNotAllowed    = &ConflictError{Message: "administrators and disabled users cannot be impersonated"}
+	ErrInvalidImpersonationReason = newFieldValidationError("a reason of up to 255 characters is required to impersonate a user", "reason", "the reason must be 1 to 255 characters")
+)
+
+// UserSearchQuery は管理者がユーザーを検索する条件
+type UserSearchQuery = gateway.UserSearchQuery
+
+// AdminActor は操作した管理者。監査ログに記録する
+type AdminActor struct {
+	UserID    int
+	IPAddress string
+}
+
+// AdminConfig は管理者用APIの設定
+type AdminConfig struct {
+	// ImpersonationTTL はなりすましで発行する認証トークンの有効期間
+	ImpersonationTTL time.Duration
+}
+
+// ImpersonationResult はなりすましで発行した認証トークン
+type ImpersonationResult struct {
+	AuthToken string
+	ExpiresAt time.Time
+	User      *entity.User
+}
+
+type AdminUseCase interface {
+	SearchUsers(query UserSearchQuery) ([]entity.User, int64, error)
+	GetUser(userID int) (*entity.User, error)
+	// DisableUser はユーザーを無効にし、ログイン中のセッションとパーソナルアクセストークンを使えなくする
+	DisableUser(actor AdminActor, userID int) (*entity.User, error)
+	EnableUser(actor AdminActor, userID int) (*entity.User, error)
+	// ForceLogout はユーザーのログイン中のセッションを全て無効にし、パーソナルアクセストークンを削除する
+	ForceLogout(actor AdminActor, userID int) error
+	UpdateRole(actor AdminActor, userID int, role string) (*entity.User, error)
+	GetSystemStats() (*entity.SystemStats, error)
+	// Impersonate はサポートのためにユーザーとしてログインする認証トークンを発行する
+	// 発行したトークンでは参照のみでき、理由とともに監査ログに記録する
+	Impersonate(actor AdminActor, userID int, reason string) (*ImpersonationResult, error)
+	GetAuditLogs(targetUserID int, limit int) ([]entity.AdminAuditLog, error)
+}
+
+type adminUseCase struct {
+	adminRepository gateway.AdminRepository
+	userRepository  gateway.UserRepository
+	keyManager      *jwtkeys.KeyManager
+	config          AdminConfig
+}
+
+func NewAdminUseCase(adminRepository gateway.AdminRepository, userRepository gateway.UserRepository, keyManager *jwtkeys.KeyManager, config AdminConfig) AdminUseCase {
+	return &adminUseCase{
+		adminRepository: adminRepository,
+		userRepository:  userRepository,
+		keyManager:      keyManager,
+		config:          config,
+	}
+}
+
+func (au *adminUseCase) SearchUsers(query UserSearchQuery) ([]entity.User, int64, error) {
+	if query.Role != "" && !entity.ValidUserRole(query.Role) {
+		return nil, 0, ErrInvalidUserRole
+	}
+	if query.Status != "" && query.Status != gateway.UserStatusActive && query.Status != gateway.UserStatusDisabled {
+		return nil, 0, ErrInvalidUserStatus
+	}
+	if query.Limit <= 0 {
+		query.Limit = DefaultAdminUserSearchLimit
+	}
+	if query.Limit > MaxAdminUserSearchLimit {
+		query.Limit = MaxAdminUserSearchLimit
+	}
+	if query.Offset < 0 {
+		query.Offset = 0
+	}
+	query.Query = strings.TrimSpace(query.Query)
+	return au.adminRepository.SearchUsers(query)
+}
+
+func (au *adminUseCase) GetUser(userID int) (*entity.User, error) {
+	user, err := au.userRepository.GetCurrentUser(userID)
+	if errors.Is(err, gateway.ErrRecordNotFound) {
+		return nil, ErrUserNotFound
+	}
+	return user, err
+}
+
+func (au *adminUseCase) DisableUser(actor AdminActor, userID int) (*entity.User, error) {
+	if actor.UserID == userID {
+		return nil, ErrAdminSelfAction
+	}
+	if _, err := au.GetUser(userID); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if err := au.adminRepository.SetUserDisabled(userID, &now); err != nil {
+		return nil, err
+	}
+	if err := au.audit(actor, entity.AdminActionDisableUser, userID, ""); err != nil {
+		return nil, err
+	}
+	return au.GetUser(userID)
+}
+
+func (au *adminUseCase) EnableUser(actor AdminActor, userID int) (*entity.User, error) {
+	if _, err := au.GetUser(userID); err != nil {
+		return nil, err
+	}
+	if err := au.adminRepository.SetUserDisabled(userID, nil); err != nil {
+		return nil, err
+	}
+	if err := au.audit(actor, entity.AdminActionEnableUser, userID, ""); err != nil {
+		return nil, err
+	}
+	return au.GetUser(userID)
+}
+
+func (au *adminUseCase) ForceLogout(actor AdminActor, userID int) error {
+	if _, err := au.GetUser(userID); err != nil {
+		return err
+	}
+	if err := au.adminRepository.RevokeSessions(userID, time.Now()); err != nil {
+		return err
+	}
+	return au.audit(actor, entity.AdminActionForceLogout, userID, "")
+}
+
+func (au *adminUseCase) UpdateRole(actor AdminActor, userID int, role string) (*entity.User, error) {
+	if !entity.ValidUserRole(role) {
+		return nil, ErrInvalidUserRole
+	}
+	// 管理者が一人もいなくなることを防ぐため、自分のロールは変更できない
+	if actor.UserID == userID {
+		return nil, ErrAdminSelfAction
+	}
+	user, err := au.GetUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := au.adminRepository.UpdateRole(userID, role); err != nil {
+		return nil, err
+	}
+	if err := au.audit(actor, entity.AdminActionUpdateRole, userID, fmt.Sprintf("%s -> %s", user.Role, role)); err != nil {
+		return nil, err
+	}
+	user.Role = role
+	return user, nil
+}
+
+func (au *adminUseCase) GetSystemStats() (*entity.SystemStats, error) {
+	return au.adminRepository.GetSystemStats(time.Now().Add(-adminStatsPeriod))
+}
+
+func (au *adminUseCase) Impersonate(actor AdminActor, userID int, reason string) (*ImpersonationResult, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" || utf8.RuneCountInString(reason) > MaxImpersonationReasonLength {
+		return nil, ErrInvalidImpersonationReason
+	}
+	if actor.UserID == userID {
+		return nil, ErrAdminSelfAction
+	}
+	user, err := au.GetUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	// 管理者になりすますと権限を昇格できるため許可しない
+	if user.IsAdmin() || user.Disabled() {
+		return nil, ErrImpersonationNotAllowed
+	}
+
+	// トークンを発行する前に記録し、記録できない場合はなりすましを許可しない
+	if err := au.audit(actor, entity.AdminActionImpersonate, userID, reason); err != nil {
+		return nil, err
+	}
+	expiresAt := time.Now().Add(au.config.ImpersonationTTL)
+	token, err := au.keyManager.Sign(jwt.MapClaims{
+		"user_id":         userID,
+		ImpersonatorClaim: actor.UserID,
+		"exp":             expiresAt.Unix(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ImpersonationResult{AuthToken: token, ExpiresAt: expiresAt, User: user}, nil
+}
+
+func (au *adminUseCase) GetAuditLogs(targetUserID int, limit int) ([]entity.AdminAuditLog, error) {
+	if limit <= 0 {
+		limit = DefaultAdminUserSearchLimit
+	}
+	if limit > MaxAdminUserSearchLimit {
+		limit = MaxAdminUserSearchLimit
+	}
+	return au.adminRepository.GetAuditLogs(targetUserID, limit)
+}
+
+func (au *adminUseCase) audit(actor AdminActor, action string, targetUserID int, detail string) error {
+	return au.adminRepository.CreateAuditLog(&entity.AdminAuditLog{
+		AdminID:      actor.UserID,
+		Action:       action,
+		TargetUserID: targetUserID,
+		Detail:       detail,
+		IPAddress:    actor.IPAddress,
+	})
+}
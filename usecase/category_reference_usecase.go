@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/entity"
+)
+
+// categoryReferenceBatchSize は一度に読み込む取引の件数
+const categoryReferenceBatchSize = 500
+
+type CategoryReferenceUseCase interface {
+	// Check は全ての取引について、参照しているカテゴリーの所有者と金額の符号を確認する
+	// データは変更せず、問題のある取引を報告する
+	Check() (*entity.CategoryReferenceReport, error)
+}
+
+type categoryReferenceUseCase struct {
+	categoryReferenceRepository gateway.CategoryReferenceRepository
+	signPolicy                  TransactionSignPolicy
+}
+
+func NewCategoryReferenceUseCase(categoryReferenceRepository gateway.CategoryReferenceRepository, signPolicy TransactionSignPolicy) CategoryReferenceUseCase {
+	return &categoryReferenceUseCase{
+		categoryReferenceRepository: categoryReferenceRepository,
+		signPolicy:                  signPolicy,
+	}
+}
+
+func (cu *categoryReferenceUseCase) Check() (*entity.CategoryReferenceReport, error) {
+	report := &entity.CategoryReferenceReport{}
+	afterID := 0
+	for {
+		references, err := cu.categoryReferenceRepository.GetCategoryReferences(afterID, categoryReferenceBatchSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, reference := range references {
+			cu.classify(report, reference)
+			afterID = reference.TransactionID
+		}
+		if len(references) < categoryReferenceBatchSize {
+			return report, nil
+		}
+	}
+}
+
+// classify は参照をレポートの該当する項目に追加する
+// 他のユーザーのカテゴリーは種類を信頼できないため、符号は確認しない
+func (cu *categoryReferenceUseCase) classify(report *entity.CategoryReferenceReport, reference entity.CategoryReference) {
+	report.Checked++
+	switch {
+	case reference.CategoryUserID == nil:
+		report.MissingCategory = append(report.MissingCategory, reference)
+	case *reference.CategoryUserID != reference.TransactionUserID:
+		report.CrossUser = append(report.CrossUser, reference)
+	default:
+		category := &entity.Category{ID: reference.CategoryID, UserID: *reference.CategoryUserID, Type: reference.CategoryType}
+		if cu.signPolicy.AmountError(reference.Amount, category) != "" {
+			report.SignViolations = append(report.SignViolations, reference)
+		}
+	}
+}
@@ -5,40 +5,96 @@ import (
 	"household-account-backend/entity"
 )
 
+var ErrCategoryNotFound = &NotFoundError{Message: "category not found"}
+
 type CategoryUseCase interface {
 	CreateCategory(category *entity.Category) (*entity.Category, error)
 	GetCategoryByID(userID int, categoryID int) (*entity.Category, error)
 	GetCategoriesByUserID(userID int) ([]entity.Category, error)
+	GetCategoriesByIDs(userID int, categoryIDs []int) ([]entity.Category, error)
 	UpdateCategory(category *entity.Category) (*entity.Category, error)
-	DeleteCategory(userID int, categoryID int) error
+	DeleteCategory(userID int, categoryID int, version int) error
 }
 
 type categoryUseCase struct {
 	categoryRepository gateway.CategoryRepository
+	eventPublisher     EventPublisher
 }
 
-func NewCategoryUseCase(categoryRepository gateway.CategoryRepository) CategoryUseCase {
+func NewCategoryUseCase(categoryRepository gateway.CategoryRepository, eventPublisher EventPublisher) CategoryUseCase {
 	return &categoryUseCase{
 		categoryRepository: categoryRepository,
+		eventPublisher:     eventPublisher,
 	}
 }
 
 func (cu *categoryUseCase) CreateCategory(category *entity.Category) (*entity.Category, error) {
-	return cu.categoryRepository.CreateCategory(category)
+	if err := validateCategory(category); err != nil {
+		return nil, err
+	}
+	events := newEventRecorder(cu.eventPublisher)
+	var createdCategory *entity.Category
+	err := cu.categoryRepository.RunInTransaction(func(repository gateway.CategoryRepository, outbox gateway.OutboxRepository) error {
+		var err error
+		if createdCategory, err = repository.CreateCategory(category); err != nil {
+			return err
+		}
+		return events.record(outbox, createdCategory.UserID, entity.EventCategoryCreated, createdCategory)
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.notify()
+	return createdCategory, nil
 }
 
 func (cu *categoryUseCase) GetCategoryByID(userID int, categoryID int) (*entity.Category, error) {
-	return cu.categoryRepository.GetCategoryByID(userID, categoryID)
+	category, err := cu.categoryRepository.GetCategoryByID(userID, categoryID)
+	if err != nil {
+		return nil, notFoundOr(err, ErrCategoryNotFound)
+	}
+	return category, nil
 }
 
 func (cu *categoryUseCase) GetCategoriesByUserID(userID int) ([]entity.Category, error) {
 	return cu.categoryRepository.GetCategoriesByUserID(userID)
 }
 
+func (cu *categoryUseCase) GetCategoriesByIDs(userID int, categoryIDs []int) ([]entity.Category, error) {
+	return cu.categoryRepository.GetCategoriesByIDs(userID, categoryIDs)
+}
+
 func (cu *categoryUseCase) UpdateCategory(category *entity.Category) (*entity.Category, error) {
-	return cu.categoryRepository.UpdateCategory(category)
+	if err := validateCategory(category); err != nil {
+		return nil, err
+	}
+	events := newEventRecorder(cu.eventPublisher)
+	var updatedCategory *entity.Category
+	err := cu.categoryRepository.RunInTransaction(func(repository gateway.CategoryRepository, outbox gateway.OutboxRepository) error {
+		var err error
+		if updatedCategory, err = repository.UpdateCategory(category); err != nil {
+			return err
+		}
+		return events.record(outbox, updatedCategory.UserID, entity.EventCategoryUpdated, updatedCategory)
+	})
+	if err != nil {
+		return nil, notFoundOr(err, ErrCategoryNotFound)
+	}
+	events.notify()
+	return updatedCategory, nil
 }
 
-func (cu *categoryUseCase) DeleteCategory(userID int, categoryID int) error {
-	return cu.categoryRepository.DeleteCategory(userID, categoryID)
+func (cu *categoryUseCase) DeleteCategory(userID int, categoryID int, version int) error {
+	events := newEventRecorder(cu.eventPublisher)
+	err := cu.categoryRepository.RunInTransaction(func(repository gateway.CategoryRepository, outbox gateway.OutboxRepository) error {
+		if err := repository.DeleteCategory(userID, categoryID, version); err != nil {
+			return err
+		}
+		return events.record(outbox, userID, entity.EventCategoryDeleted, map[string]int{"id": categoryID})
+	})
+	if err != nil {
+		return notFoundOr(err, ErrCategoryNotFound)
+	}
+	events.notify()
+	return nil
 }
@@ -0,0 +1,359 @@
+package usecase
+
+import (
+	"archive/zip"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/entity"
+	"household-account-backend/pkg/logger"
+)
+
+var (
+	ErrExportInProgress = &ConflictError{Message: "an export is already in progress"}
+	ErrExportNotFound   = &NotFoundError{Message: "export not found"}
+	ErrExportNotReady   = &ConflictError{Message: "export is not ready yet"}
+	ErrExportExpired    = errors.New("export has expired")
+)
+
+// DataExportConfig はエクスポートの設定
+type DataExportConfig struct {
+	// TTL は作成したアーカイブをダウンロードできる期間
+	TTL time.Duration
+	// StaleAfter は作成中のまま終わらないエクスポートを作り直すまでの時間
+	StaleAfter time.Duration
+}
+
+// ExportProfile はアーカイブに含めるプロフィール。パスワードのハッシュは含めない
+type ExportProfile struct {
+	ID              int        `json:"id"`
+	Email           string     `json:"email"`
+	Name            string     `json:"name"`
+	EmailVerifiedAt *time.Time `json:"email_verified_at"`
+}
+
+type DataExportUseCase interface {
+	// RequestExport はエクスポートの作成を依頼する。アーカイブはワーカーが非同期に作成する
+	RequestExport(userID int) (*entity.DataExport, error)
+	GetExports(userID int) ([]entity.DataExport, error)
+	GetExport(userID int, exportID int) (*entity.DataExport, error)
+	// OpenExport はダウンロードするアーカイブを開く。呼び出し側でCloseする
+	OpenExport(userID int, exportID int) (*entity.DataExport, io.ReadCloser, error)
+	// ProcessPendingExports は作成待ちのエクスポートを最大limit件作成し、処理した件数を返す
+	ProcessPendingExports(limit int) (int, error)
+	// PurgeExpiredExports はダウンロード期限を過ぎたアーカイブを削除する
+	PurgeExpiredExports(limit int) (int, error)
+}
+
+type dataExportUseCase struct {
+	dataExportRepository     gateway.DataExportRepository
+	userRepository           gateway.UserRepository
+	categoryRepository       gateway.CategoryRepository
+	transactionRepository    gateway.TransactionRepository
+	monthlySummaryRepository gateway.MonthlySummaryRepository
+	fileStorage              gateway.FileStorage
+	config                   DataExportConfig
+}
+
+func NewDataExportUseCase(dataExportRepository gateway.DataExportRepository, userRepository gateway.UserRepository, categoryRepository gateway.CategoryRepository, transactionRepository gateway.TransactionRepository, monthlySummaryRepository gateway.MonthlySummaryRepository, fileStorage gateway.FileStorage, config DataExportConfig) DataExportUseCase {
+	return &dataExportUseCase{
+		dataExportRepository:     dataExportRepository,
+		userRepository:           userRepository,
+		categoryRepository:       categoryRepository,
+		transactionRepository:    transactionRepository,
+		monthlySummaryRepository: monthlySummaryRepository,
+		fileStorage:              fileStorage,
+		config:                   config,
+	}
+}
+
+// exportFileKey はアーカイブを保存するキーを返す
+func exportFileKey(userID int, exportID int) string {
+	return fmt.Sprintf("%sexports/%d.zip", gateway.UserFilePrefix(userID), exportID)
+}
+
+func (eu *dataExportUseCase) RequestExport(userID int) (*entity.DataExport, error) {
+	active, err := eu.dataExportRepository.HasActiveExport(userID)
+	if err != nil {
+		return nil, err
+	}
+	if active {
+		return nil, ErrExportInProgress
+	}
+	return eu.dataExportRepository.CreateExport(&entity.DataExport{
+		UserID: userID,
+		Status: entity.DataExportStatusPending,
+	})
+}
+
+func (eu *dataExportUseCase) GetExports(userID int) ([]entity.DataExport, error) {
+	return eu.dataExportRepository.GetExportsByUserID(userID)
+}
+
+func (eu *dataExportUseCase) GetExport(userID int, exportID int) (*entity.DataExport, error) {
+	export, err := eu.dataExportRepository.GetExportByID(userID, exportID)
+	if err != nil {
+		return nil, err
+	}
+	if export == nil {
+		return nil, ErrExportNotFound
+	}
+	return export, nil
+}
+
+func (eu *dataExportUseCase) OpenExport(userID int, exportID int) (*entity.DataExport, io.ReadCloser, error) {
+	export, err := eu.GetExport(userID, exportID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if export.Status == entity.DataExportStatusExpired {
+		return nil, nil, ErrExportExpired
+	}
+	if export.Status != entity.DataExportStatusCompleted {
+		return nil, nil, ErrExportNotReady
+	}
+	if !export.Downloadable(time.Now()) {
+		return nil, nil, ErrExportExpired
+	}
+
+	file, err := eu.fileStorage.Open(export.FileKey)
+	if errors.Is(err, gateway.ErrFileNotFound) {
+		return nil, nil, ErrExportExpired
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return export, file, nil
+}
+
+func (eu *dataExportUseCase) ProcessPendingExports(limit int) (int, error) {
+	processed := 0
+	for processed < limit {
+		now := time.Now()
+		export, err := eu.dataExportRepository.ClaimExport(now, now.Add(-eu.config.StaleAfter))
+		if err != nil {
+			return processed, err
+		}
+		if export == nil {
+			break
+		}
+
+		if err := eu.buildExport(export); err != nil {
+			logger.Error("failed to build data export", "export_id", export.ID, "user_id", export.UserID, "error", err.Error())
+			export.Status = entity.DataExportStatusFailed
+			export.Error = "failed to build the archive"
+		}
+		if err := eu.dataExportRepository.UpdateExport(export); err != nil {
+			return processed, err
+		}
+		processed++
+	}
+	return processed, nil
+}
+
+func (eu *dataExportUseCase) PurgeExpiredExports(limit int) (int, error) {
+	exports, err := eu.dataExportRepository.GetExpiredExports(time.Now(), limit)
+	if err != nil {
+		return 0, err
+	}
+	for i := range exports {
+		export := &exports[i]
+		if err := eu.fileStorage.Delete(export.FileKey); err != nil {
+			return i, err
+		}
+		export.Status = entity.DataExportStatusExpired
+		export.FileKey = ""
+		if err := eu.dataExportRepository.UpdateExport(export); err != nil {
+			return i, err
+		}
+	}
+	return len(exports), nil
+}
+
+// buildExport はアーカイブを作成してファイルストレージに保存し、exportを完了状態にする
+func (eu *dataExportUseCase) buildExport(export *entity.DataExport) error {
+	key := exportFileKey(export.UserID, export.ID)
+
+	// アーカイブ全体をメモリに載せないよう、作成しながらファイルストレージに書き込む
+	reader, writer := io.Pipe()
+	go func() {
+		writer.CloseWithError(eu.writeArchive(writer, export.UserID))
+	}()
+	size, err := eu.fileStorage.Put(key, reader)
+	reader.Close()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(eu.config.TTL)
+	export.Status = entity.DataExportStatusCompleted
+	export.FileKey = key
+	export.FileSize = size
+	export.Error = ""
+	export.CompletedAt = &now
+	export.ExpiresAt = &expiresAt
+	return nil
+}
+
+// writeArchive はユーザーのデータをJSONとCSVで、保存しているファイルをattachments以下に書き込む
+func (eu *dataExportUseCase) writeArchive(w io.Writer, userID int) error {
+	user, err := eu.userRepository.GetCurrentUser(userID)
+	if err != nil {
+		return err
+	}
+	categories, err := eu.categoryRepository.GetCategoriesByUserID(userID)
+	if err != nil {
+		return err
+	}
+	transactions, err := eu.transactionRepository.GetTransactionsByUserID(userID)
+	if err != nil {
+		return err
+	}
+	summaries, err := eu.monthlySummaryRepository.GetMonthlySummariesByUserID(userID)
+	if err != nil {
+		return err
+	}
+
+	archive := zip.NewWriter(w)
+	profile := ExportProfile{ID: user.ID, Email: user.Email, Name: user.Name, EmailVerifiedAt: user.EmailVerifiedAt}
+	if err := writeJSONFile(archive, "profile.json", profile); err != nil {
+		return err
+	}
+
+	if err := writeJSONFile(archive, "categories.json", nonNil(categories)); err != nil {
+		return err
+	}
+	categoryRows := [][]string{{"id", "name", "type"}}
+	categoryNames := map[int]string{}
+	for _, category := range categories {
+		categoryNames[category.ID] = category.Name
+		categoryRows = append(categoryRows, []string{strconv.Itoa(category.ID), csvText(category.Name), category.Type})
+	}
+	if err := writeCSVFile(archive, "categories.csv", categoryRows); err != nil {
+		return err
+	}
+
+	if err := writeJSONFile(archive, "transactions.json", nonNil(transactions)); err != nil {
+		return err
+	}
+	transactionRows := [][]string{{"id", "date", "category_id", "category_name", "amount", "content"}}
+	for _, transaction := range transactions {
+		transactionRows = append(transactionRows, []string{
+			strconv.Itoa(transaction.ID),
+			transaction.Date.Format("2006-01-02"),
+			strconv.Itoa(transaction.CategoryID),
+			csvText(categoryNames[transaction.CategoryID]),
+			strconv.FormatFloat(float64(transaction.Amount), 'f', -1, 32),
+			csvText(transaction.Content),
+		})
+	}
+	if err := writeCSVFile(archive, "transactions.csv", transactionRows); err != nil {
+		return err
+	}
+
+	if err := writeJSONFile(archive, "monthly_summaries.json", nonNil(summaries)); err != nil {
+		return err
+	}
+	summaryRows := [][]string{{"id", "year_month", "income", "expense", "balance"}}
+	for _, summary := range summaries {
+		summaryRows = append(summaryRows, []string{
+			strconv.Itoa(summary.ID),
+			summary.YearMonth,
+			strconv.FormatFloat(summary.Income, 'f', -1, 64),
+			strconv.FormatFloat(summary.Expense, 'f', -1, 64),
+			strconv.FormatFloat(summary.Balance, 'f', -1, 64),
+		})
+	}
+	if err := writeCSVFile(archive, "monthly_summaries.csv", summaryRows); err != nil {
+		return err
+	}
+
+	if err := eu.writeAttachments(archive, userID); err != nil {
+		return err
+	}
+	return archive.Close()
+}
+
+// writeAttachments はユーザーが保存したファイルを、過去のエクスポートを除いてアーカイブに含める
+func (eu *dataExportUseCase) writeAttachments(archive *zip.Writer, userID int) error {
+	prefix := gateway.UserFilePrefix(userID)
+	keys, err := eu.fileStorage.List(prefix)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		name := strings.TrimPrefix(key, prefix)
+		if strings.HasPrefix(name, "exports/") {
+			continue
+		}
+		if err := eu.copyAttachment(archive, key, "attachments/"+name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (eu *dataExportUseCase) copyAttachment(archive *zip.Writer, key string, name string) error {
+	file, err := eu.fileStorage.Open(key)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	w, err := archive.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(w, file)
+	return err
+}
+
+func writeJSONFile(archive *zip.Writer, name string, v interface{}) error {
+	w, err := archive.Create(name)
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+func writeCSVFile(archive *zip.Writer, name string, rows [][]string) error {
+	w, err := archive.Create(name)
+	if err != nil {
+		return err
+	}
+	// Excelで文字化けしないようBOMを付ける
+	if _, err := w.Write([]byte("\xEF\xBB\xBF")); err != nil {
+		return err
+	}
+	writer := csv.NewWriter(w)
+	if err := writer.WriteAll(rows); err != nil {
+		return err
+	}
+	return writer.Error()
+}
+
+// csvText はユーザーが入力した文字列を表計算ソフトが数式として実行しないよう、先頭に'を付けてエスケープする
+// 金額などのアプリケーションが出力する値は数値として扱えるよう対象にしない
+func csvText(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+// nonNil はデータがない場合もJSONで空の配列として出力する
+func nonNil[T any](items []T) []T {
+	if items == nil {
+		return []T{}
+	}
+	return items
+}
@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"errors"
+
+	"household-account-backend/adapter/gateway"
+)
+
+// ErrVersionConflict は更新・削除時に指定されたバージョンが最新でない場合のエラー
+var ErrVersionConflict = gateway.ErrVersionConflict
+
+// ドメインエラー
+// ユースケースはエラーの種類をこれらの型で表し、ハンドラーは型からHTTPステータスを決める
+// 個別のエラーはerrors.Isで、種類はerrors.Asで判定する
+
+// NotFoundError は対象が存在しない、または他のユーザーのものである場合のエラー
+type NotFoundError struct {
+	Message string
+}
+
+func (e *NotFoundError) Error() string {
+	return e.Message
+}
+
+// ConflictError はリソースの現在の状態と両立しない操作のエラー
+type ConflictError struct {
+	Message string
+}
+
+func (e *ConflictError) Error() string {
+	return e.Message
+}
+
+// FieldError は入力項目ごとのエラーの理由
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+// ValidationError は入力値が不正な場合のエラー
+type ValidationError struct {
+	Message string
+	Fields  []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	return e.Message
+}
+
+// UnauthorizedError は認証情報が不正な場合のエラー
+type UnauthorizedError struct {
+	Message string
+}
+
+func (e *UnauthorizedError) Error() string {
+	return e.Message
+}
+
+// ForbiddenError は認証済みでも操作が許可されていない場合のエラー
+type ForbiddenError struct {
+	Message string
+}
+
+func (e *ForbiddenError) Error() string {
+	return e.Message
+}
+
+// newFieldValidationError は1つの項目が不正な場合のエラーを返す
+func newFieldValidationError(message string, field string, reason string) *ValidationError {
+	return &ValidationError{
+		Message: message,
+		Fields:  []FieldError{{Field: field, Message: reason}},
+	}
+}
+
+// notFoundOr はリポジトリがレコードなしを返した場合にnotFoundに置き換える
+// それ以外のエラーはそのまま返す
+func notFoundOr(err error, notFound error) error {
+	if errors.Is(err, gateway.ErrRecordNotFound) {
+		return notFound
+	}
+	return err
+}
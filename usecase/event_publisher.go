@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"encoding/json"
+
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/entity"
+)
+
+// EventPublisher はユースケースで発生したデータ変更イベントを外部へ通知する
+type EventPublisher interface {
+	// Publish はイベントをoutboxに保存する
+	// outboxにはデータの変更と同じDBトランザクションのリポジトリを渡し、保存に失敗した場合は変更もロールバックする
+	Publish(outbox gateway.OutboxRepository, userID int, eventType string, data interface{}) (*entity.OutboxEvent, error)
+	// Notify はコミットしたイベントをEventHubの購読者へ通知する
+	Notify(events ...*entity.OutboxEvent)
+}
+
+type outboxEventPublisher struct {
+	eventHub gateway.EventHub
+}
+
+// NewOutboxEventPublisher はイベントをアウトボックステーブルへ保存するEventPublisherを返す
+// 保存されたイベントはWebhookワーカーによって配信され、コミット後にEventHubの購読者へ通知される
+func NewOutboxEventPublisher(eventHub gateway.EventHub) EventPublisher {
+	return &outboxEventPublisher{
+		eventHub: eventHub,
+	}
+}
+
+func (p *outboxEventPublisher) Publish(outbox gateway.OutboxRepository, userID int, eventType string, data interface{}) (*entity.OutboxEvent, error) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return outbox.CreateEvent(&entity.OutboxEvent{
+		UserID:  userID,
+		Type:    eventType,
+		Payload: string(payload),
+	})
+}
+
+func (p *outboxEventPublisher) Notify(events ...*entity.OutboxEvent) {
+	// アウトボックスのIDをイベントIDとして使い、再接続時の再送に利用する
+	for _, event := range events {
+		p.eventHub.Publish(event)
+	}
+}
+
+// eventRecorder はトランザクション内で保存したイベントを集め、コミット後にまとめて通知する
+// ロールバックした場合は通知しないため、購読者がコミットされていない変更を受け取ることはない
+type eventRecorder struct {
+	publisher EventPublisher
+	events    []*entity.OutboxEvent
+}
+
+func newEventRecorder(publisher EventPublisher) *eventRecorder {
+	return &eventRecorder{publisher: publisher}
+}
+
+func (r *eventRecorder) record(outbox gateway.OutboxRepository, userID int, eventType string, data interface{}) error {
+	event, err := r.publisher.Publish(outbox, userID, eventType, data)
+	if err != nil {
+		return err
+	}
+	r.events = append(r.events, event)
+	return nil
+}
+
+// notify はDBトランザクションのコミット後に呼び出す
+func (r *eventRecorder) notify() {
+	r.publisher.Notify(r.events...)
+}
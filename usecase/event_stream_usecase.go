@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"time"
+
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/entity"
+)
+
+// MaxEventStreamReplay はLast-Event-IDからの再開時に再送するイベント数の上限
+const MaxEventStreamReplay = 500
+
+// EventStreamCommitGrace はイベントのIDの採番からコミットまでにかかる時間の上限の目安
+// Last-Event-IDより小さいIDでもこの時間内に作成されたイベントは後からコミットされた可能性があるため再送する
+const EventStreamCommitGrace = time.Minute
+
+// EventSubscription はユーザーのイベントの購読
+type EventSubscription struct {
+	// Replay はLast-Event-ID以降に発生したイベント。コミットが遅れたイベントを含めるため、送信済みのイベントが含まれる場合がある
+	Replay []entity.OutboxEvent
+	// Reset は再送するイベントが上限を超えたため再送しないことを示す。クライアントは状態を取得し直す
+	Reset bool
+	// ResetEventID はResetの場合に次の再開位置とするユーザーの最新のイベントID
+	ResetEventID int
+	Events       <-chan entity.OutboxEvent
+	Unsubscribe  func()
+}
+
+type EventStreamUseCase interface {
+	// Subscribe はユーザーのイベントを購読する
+	// lastEventIDが指定された場合はそれ以降に発生したイベントをreplayとして返す
+	Subscribe(userID int, lastEventID int) (*EventSubscription, error)
+}
+
+type eventStreamUseCase struct {
+	outboxRepository gateway.OutboxRepository
+	eventHub         gateway.EventHub
+}
+
+func NewEventStreamUseCase(outboxRepository gateway.OutboxRepository, eventHub gateway.EventHub) EventStreamUseCase {
+	return &eventStreamUseCase{
+		outboxRepository: outboxRepository,
+		eventHub:         eventHub,
+	}
+}
+
+func (eu *eventStreamUseCase) Subscribe(userID int, lastEventID int) (*EventSubscription, error) {
+	// 再送イベントの取得中に発生したイベントを取りこぼさないよう先に購読する
+	events, unsubscribe := eu.eventHub.Subscribe(userID)
+	subscription := &EventSubscription{Events: events, Unsubscribe: unsubscribe}
+	if lastEventID <= 0 {
+		return subscription, nil
+	}
+
+	// 上限を超えたかどうかを判定するため1件多く取得する
+	replay, err := eu.outboxRepository.GetEventsAfter(userID, lastEventID, EventStreamCommitGrace, MaxEventStreamReplay+1)
+	if err != nil {
+		unsubscribe()
+		return nil, err
+	}
+	if len(replay) <= MaxEventStreamReplay {
+		subscription.Replay = replay
+		return subscription, nil
+	}
+
+	// 途中までの再送では状態が復元できないため、再送せずにクライアントに取得し直してもらう
+	latestEventID, err := eu.outboxRepository.GetLatestEventID(userID)
+	if err != nil {
+		unsubscribe()
+		return nil, err
+	}
+	subscription.Reset = true
+	subscription.ResetEventID = latestEventID
+	return subscription, nil
+}
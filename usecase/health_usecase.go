@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/entity"
+	"household-account-backend/pkg/logger"
+)
+
+// readinessTimeout はデータベースの確認を待つ時間。ロードバランサーのヘルスチェックより短くする
+const readinessTimeout = 2 * time.Second
+
+type HealthUseCase interface {
+	// Readiness はデータベースに接続できるかを確認し、接続プールの統計とあわせて返す
+	Readiness(ctx context.Context) *entity.Readiness
+}
+
+type healthUseCase struct {
+	healthRepository gateway.HealthRepository
+}
+
+func NewHealthUseCase(healthRepository gateway.HealthRepository) HealthUseCase {
+	return &healthUseCase{healthRepository: healthRepository}
+}
+
+func (hu *healthUseCase) Readiness(ctx context.Context) *entity.Readiness {
+	readiness := &entity.Readiness{
+		Status:   entity.HealthStatusOK,
+		Database: entity.DatabaseHealth{Status: entity.HealthStatusOK},
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readinessTimeout)
+	defer cancel()
+	if err := hu.healthRepository.Ping(ctx); err != nil {
+		logger.Warn("database is not ready", "error", err.Error())
+		readiness.Status = entity.HealthStatusUnavailable
+		readiness.Database.Status = entity.HealthStatusUnavailable
+	}
+
+	// 接続できない場合も、接続が枯渇していないかを確認できるよう統計は返す
+	stats, err := hu.healthRepository.PoolStats()
+	if err != nil {
+		logger.Warn("failed to get database pool stats", "error", err.Error())
+		return readiness
+	}
+	readiness.Database.Pool = stats
+	return readiness
+}
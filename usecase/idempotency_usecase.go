@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/entity"
+)
+
+// MaxIdempotencyKeyLength はIdempotency-Keyとして受け付ける最大文字数
+const MaxIdempotencyKeyLength = 255
+
+var (
+	ErrInvalidIdempotencyKey    = newFieldValidationError("invalid idempotency key", "Idempotency-Key", fmt.Sprintf("the key must be 1 to %d characters", MaxIdempotencyKeyLength))
+	ErrIdempotencyKeyReused     = &ValidationError{Message: "idempotency key was already used with a different request"}
+	ErrIdempotencyKeyInProgress = &ConflictError{Message: "a request with the same idempotency key is still in progress"}
+)
+
+type IdempotencyUseCase interface {
+	// Begin はキーを予約する。既に完了したリクエストがある場合はそのレコードとtrueを返す
+	Begin(userID int, idempotencyKey string, requestHash string) (*entity.IdempotencyRecord, bool, error)
+	Complete(record *entity.IdempotencyRecord, statusCode int, headers map[string]string, body []byte) error
+	Release(record *entity.IdempotencyRecord) error
+	PurgeExpired() (int64, error)
+}
+
+type idempotencyUseCase struct {
+	idempotencyRepository gateway.IdempotencyRepository
+	ttl                   time.Duration
+}
+
+func NewIdempotencyUseCase(idempotencyRepository gateway.IdempotencyRepository, ttl time.Duration) IdempotencyUseCase {
+	return &idempotencyUseCase{
+		idempotencyRepository: idempotencyRepository,
+		ttl:                   ttl,
+	}
+}
+
+func (iu *idempotencyUseCase) Begin(userID int, idempotencyKey string, requestHash string) (*entity.IdempotencyRecord, bool, error) {
+	if idempotencyKey == "" || len(idempotencyKey) > MaxIdempotencyKeyLength {
+		return nil, false, ErrInvalidIdempotencyKey
+	}
+
+	existing, err := iu.idempotencyRepository.GetRecord(userID, idempotencyKey)
+	if err != nil {
+		return nil, false, err
+	}
+	if existing != nil {
+		if !existing.Expired(time.Now()) {
+			return checkIdempotencyRecord(existing, requestHash)
+		}
+		// 期限切れのキーは新しいリクエストとして扱う
+		if err := iu.idempotencyRepository.DeleteRecord(existing.ID); err != nil {
+			return nil, false, err
+		}
+	}
+
+	record, err := iu.idempotencyRepository.CreateRecord(&entity.IdempotencyRecord{
+		UserID:         userID,
+		IdempotencyKey: idempotencyKey,
+		RequestHash:    requestHash,
+		ExpiresAt:      time.Now().Add(iu.ttl),
+	})
+	if errors.Is(err, gateway.ErrDuplicateKey) {
+		// 同時に同じキーで予約された場合は一意制約違反となる
+		if concurrent, getErr := iu.idempotencyRepository.GetRecord(userID, idempotencyKey); getErr == nil && concurrent != nil {
+			return checkIdempotencyRecord(concurrent, requestHash)
+		}
+		return nil, false, err
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return record, false, nil
+}
+
+func (iu *idempotencyUseCase) Complete(record *entity.IdempotencyRecord, statusCode int, headers map[string]string, body []byte) error {
+	encodedHeaders, err := json.Marshal(headers)
+	if err != nil {
+		return err
+	}
+	record.StatusCode = statusCode
+	record.ResponseHeaders = string(encodedHeaders)
+	record.ResponseBody = string(body)
+	return iu.idempotencyRepository.SaveResponse(record)
+}
+
+func (iu *idempotencyUseCase) Release(record *entity.IdempotencyRecord) error {
+	return iu.idempotencyRepository.DeleteRecord(record.ID)
+}
+
+func (iu *idempotencyUseCase) PurgeExpired() (int64, error) {
+	return iu.idempotencyRepository.DeleteExpiredRecords(time.Now())
+}
+
+// HashIdempotentRequest はキーの再利用を検出するためにリクエストの内容をハッシュ化する
+func HashIdempotentRequest(method string, path string, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(method))
+	hash.Write([]byte{0})
+	hash.Write([]byte(path))
+	hash.Write([]byte{0})
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func checkIdempotencyRecord(record *entity.IdempotencyRecord, requestHash string) (*entity.IdempotencyRecord, bool, error) {
+	if record.RequestHash != requestHash {
+		return nil, false, ErrIdempotencyKeyReused
+	}
+	if !record.Completed() {
+		return nil, false, ErrIdempotencyKeyInProgress
+	}
+	return record, true, nil
+}
@@ -0,0 +1,163 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/entity"
+)
+
+var ErrLoginThrottled = errors.New("too many failed login attempts")
+
+const (
+	// defaultLoginMaxDelay はMaxDelayが設定されていない場合の待ち時間の上限
+	defaultLoginMaxDelay = 30 * time.Second
+	// maxLoginDelayShift は待ち時間を倍にする回数の上限
+	maxLoginDelayShift = 30
+)
+
+// LoginThrottledError はログイン失敗が続いたため一時的にログインを受け付けない場合のエラー
+// errors.Is(err, ErrLoginThrottled) で判定できる
+type LoginThrottledError struct {
+	RetryAfter time.Duration
+	// Locked はアカウントまたはIPアドレスがロックされているかどうか。falseの場合は失敗後の待ち時間
+	Locked bool
+}
+
+func (e *LoginThrottledError) Error() string {
+	return fmt.Sprintf("%s: retry after %s", ErrLoginThrottled, e.RetryAfter)
+}
+
+func (e *LoginThrottledError) Is(target error) bool {
+	return target == ErrLoginThrottled
+}
+
+// LoginAttemptConfig はログイン失敗時の待ち時間とロックの設定
+type LoginAttemptConfig struct {
+	// MaxAccountFailures はアカウントをロックするまでに許容する失敗回数
+	MaxAccountFailures int
+	// MaxIPFailures はIPアドレスをロックするまでに許容する失敗回数
+	MaxIPFailures   int
+	LockoutDuration time.Duration
+	// BaseDelay は1回目の失敗後の待ち時間。以降は失敗するごとに倍になる
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	// FailureWindow は最後の失敗からこの時間が経過すると失敗回数をリセットする
+	FailureWindow time.Duration
+}
+
+type LoginAttemptUseCase interface {
+	// Check はログインを試行できるかを確認し、できない場合は *LoginThrottledError を返す
+	Check(email string, clientIP string) error
+	// RecordFailure は失敗を記録し、この失敗でアカウントがロックされた場合はtrueを返す
+	RecordFailure(email string, clientIP string) (bool, error)
+	// RecordSuccess はアカウントの失敗回数をリセットする
+	// IPアドレスの失敗回数は自分のアカウントへのログインでリセットできないようそのままにする
+	RecordSuccess(email string) error
+	Unlock(email string) error
+}
+
+type loginAttemptUseCase struct {
+	loginAttemptStore gateway.LoginAttemptStore
+	config            LoginAttemptConfig
+}
+
+func NewLoginAttemptUseCase(loginAttemptStore gateway.LoginAttemptStore, config LoginAttemptConfig) LoginAttemptUseCase {
+	return &loginAttemptUseCase{
+		loginAttemptStore: loginAttemptStore,
+		config:            config,
+	}
+}
+
+func (lu *loginAttemptUseCase) Check(email string, clientIP string) error {
+	now := time.Now()
+	for _, key := range lu.keys(email, clientIP) {
+		attempt, err := lu.loginAttemptStore.GetAttempt(key)
+		if err != nil {
+			return err
+		}
+		if attempt == nil {
+			continue
+		}
+		if attempt.Locked(now) {
+			return &LoginThrottledError{RetryAfter: attempt.LockedUntil.Sub(now), Locked: true}
+		}
+		if attempt.Failures == 0 || attempt.LastFailedAt.Before(now.Add(-lu.config.FailureWindow)) {
+			continue
+		}
+		if retryAt := attempt.LastFailedAt.Add(lu.delay(attempt.Failures)); now.Before(retryAt) {
+			return &LoginThrottledError{RetryAfter: retryAt.Sub(now)}
+		}
+	}
+	return nil
+}
+
+func (lu *loginAttemptUseCase) RecordFailure(email string, clientIP string) (bool, error) {
+	now := time.Now()
+	resetBefore := now.Add(-lu.config.FailureWindow)
+
+	accountLocked, err := lu.recordFailure(entity.LoginAttemptAccountKey(email), now, resetBefore, lu.config.MaxAccountFailures)
+	if err != nil {
+		return false, err
+	}
+	if clientIP != "" {
+		if _, err := lu.recordFailure(entity.LoginAttemptIPKey(clientIP), now, resetBefore, lu.config.MaxIPFailures); err != nil {
+			return false, err
+		}
+	}
+	return accountLocked, nil
+}
+
+// recordFailure は失敗を記録し、上限に達した場合はロックしてtrueを返す
+func (lu *loginAttemptUseCase) recordFailure(key string, now time.Time, resetBefore time.Time, maxFailures int) (bool, error) {
+	attempt, err := lu.loginAttemptStore.RecordFailure(key, now, resetBefore)
+	if err != nil {
+		return false, err
+	}
+	if maxFailures <= 0 || attempt.Failures < maxFailures || attempt.Locked(now) {
+		return false, nil
+	}
+	if err := lu.loginAttemptStore.Lock(key, now.Add(lu.config.LockoutDuration)); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (lu *loginAttemptUseCase) RecordSuccess(email string) error {
+	return lu.loginAttemptStore.Reset(entity.LoginAttemptAccountKey(email))
+}
+
+func (lu *loginAttemptUseCase) Unlock(email string) error {
+	return lu.loginAttemptStore.Reset(entity.LoginAttemptAccountKey(email))
+}
+
+func (lu *loginAttemptUseCase) keys(email string, clientIP string) []string {
+	keys := []string{entity.LoginAttemptAccountKey(email)}
+	if clientIP != "" {
+		keys = append(keys, entity.LoginAttemptIPKey(clientIP))
+	}
+	return keys
+}
+
+// delay は失敗回数に応じた次の試行までの待ち時間を返す
+// 失敗回数が多くてもオーバーフローしないよう、倍にする回数と待ち時間の上限を設ける
+func (lu *loginAttemptUseCase) delay(failures int) time.Duration {
+	maxDelay := lu.config.MaxDelay
+	if maxDelay <= 0 {
+		maxDelay = defaultLoginMaxDelay
+	}
+	shift := failures - 1
+	if shift < 0 {
+		shift = 0
+	}
+	if shift > maxLoginDelayShift {
+		shift = maxLoginDelayShift
+	}
+	delay := lu.config.BaseDelay << shift
+	if delay < 0 || delay > maxDelay || delay>>shift != lu.config.BaseDelay {
+		return maxDelay
+	}
+	return delay
+}
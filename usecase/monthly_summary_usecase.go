@@ -5,30 +5,54 @@ import (
 	"household-account-backend/entity"
 )
 
+var ErrMonthlySummaryNotFound = &NotFoundError{Message: "monthly summary not found"}
+
 type MonthlySummaryUseCase interface {
 	CreateMonthlySummary(summary *entity.MonthlySummary) (*entity.MonthlySummary, error)
 	GetMonthlySummaryByID(userID int, summaryID int) (*entity.MonthlySummary, error)
 	GetMonthlySummariesByUserID(userID int) ([]entity.MonthlySummary, error)
 	UpdateMonthlySummary(summary *entity.MonthlySummary) (*entity.MonthlySummary, error)
-	DeleteMonthlySummary(userID int, summaryID int) error
+	DeleteMonthlySummary(userID int, summaryID int, version int) error
 }
 
 type monthlySummaryUseCase struct {
 	monthlySummaryRepository gateway.MonthlySummaryRepository
+	eventPublisher           EventPublisher
 }
 
-func NewMonthlySummaryUseCase(monthlySummaryRepository gateway.MonthlySummaryRepository) MonthlySummaryUseCase {
+func NewMonthlySummaryUseCase(monthlySummaryRepository gateway.MonthlySummaryRepository, eventPublisher EventPublisher) MonthlySummaryUseCase {
 	return &monthlySummaryUseCase{
 		monthlySummaryRepository: monthlySummaryRepository,
+		eventPublisher:           eventPublisher,
 	}
 }
 
 func (msu *monthlySummaryUseCase) CreateMonthlySummary(summary *entity.MonthlySummary) (*entity.MonthlySummary, error) {
-	return msu.monthlySummaryRepository.CreateMonthlySummary(summary)
+	if err := validateMonthlySummary(summary); err != nil {
+		return nil, err
+	}
+	events := newEventRecorder(msu.eventPublisher)
+	var createdSummary *entity.MonthlySummary
+	err := msu.monthlySummaryRepository.RunInTransaction(func(repository gateway.MonthlySummaryRepository, outbox gateway.OutboxRepository) error {
+		var err error
+		if createdSummary, err = repository.CreateMonthlySummary(summary); err != nil {
+			return err
+		}
+		return events.record(outbox, createdSummary.UserID, entity.EventMonthlySummaryCreated, createdSummary)
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.notify()
+	return createdSummary, nil
 }
 
 func (msu *monthlySummaryUseCase) GetMonthlySummaryByID(userID int, summaryID int) (*entity.MonthlySummary, error) {
-	return msu.monthlySummaryRepository.GetMonthlySummaryByID(userID, summaryID)
+	summary, err := msu.monthlySummaryRepository.GetMonthlySummaryByID(userID, summaryID)
+	if err != nil {
+		return nil, notFoundOr(err, ErrMonthlySummaryNotFound)
+	}
+	return summary, nil
 }
 
 func (msu *monthlySummaryUseCase) GetMonthlySummariesByUserID(userID int) ([]entity.MonthlySummary, error) {
@@ -36,9 +60,36 @@ func (msu *monthlySummaryUseCase) GetMonthlySummariesByUserID(userID int) ([]ent
 }
 
 func (msu *monthlySummaryUseCase) UpdateMonthlySummary(summary *entity.MonthlySummary) (*entity.MonthlySummary, error) {
-	return msu.monthlySummaryRepository.UpdateMonthlySummary(summary)
+	if err := validateMonthlySummary(summary); err != nil {
+		return nil, err
+	}
+	events := newEventRecorder(msu.eventPublisher)
+	var updatedSummary *entity.MonthlySummary
+	err := msu.monthlySummaryRepository.RunInTransaction(func(repository gateway.MonthlySummaryRepository, outbox gateway.OutboxRepository) error {
+		var err error
+		if updatedSummary, err = repository.UpdateMonthlySummary(summary); err != nil {
+			return err
+		}
+		return events.record(outbox, updatedSummary.UserID, entity.EventMonthlySummaryUpdated, updatedSummary)
+	})
+	if err != nil {
+		return nil, notFoundOr(err, ErrMonthlySummaryNotFound)
+	}
+	events.notify()
+	return updatedSummary, nil
 }
 
-func (msu *monthlySummaryUseCase) DeleteMonthlySummary(userID int, summaryID int) error {
-	return msu.monthlySummaryRepository.DeleteMonthlySummary(userID, summaryID)
+func (msu *monthlySummaryUseCase) DeleteMonthlySummary(userID int, summaryID int, version int) error {
+	events := newEventRecorder(msu.eventPublisher)
+	err := msu.monthlySummaryRepository.RunInTransaction(func(repository gateway.MonthlySummaryRepository, outbox gateway.OutboxRepository) error {
+		if err := repository.DeleteMonthlySummary(userID, summaryID, version); err != nil {
+			return err
+		}
+		return events.record(outbox, userID, entity.EventMonthlySummaryDeleted, map[string]int{"id": summaryID})
+	})
+	if err != nil {
+		return notFoundOr(err, ErrMonthlySummaryNotFound)
+	}
+	events.notify()
+	return nil
 }
@@ -0,0 +1,257 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/entity"
+	"household-account-backend/pkg/logger"
+)
+
+// maxUserNameLength はusers.nameカラムの長さ
+const maxUserNameLength = 20
+
+var (
+	ErrUnknownOIDCProvider   = &NotFoundError{Message: "unknown oidc provider"}
+	ErrInvalidOIDCState      = &UnauthorizedError{Message: "invalid or expired oidc state"}
+	ErrOIDCEmailNotVerified  = &ForbiddenError{Message: "email address is not verified by the provider"}
+	ErrOIDCLinkRequired      = &ConflictError{Message: "an account with this email already exists; log in and link the provider from your profile"}
+	ErrIdentityAlreadyLinked = &ConflictError{Message: "this provider account is already linked to another user"}
+	ErrIdentityNotFound      = &NotFoundError{Message: "identity not found"}
+)
+
+// OIDCAuthorization は認可リクエストのURLと、ブラウザに紐付けるstate
+type OIDCAuthorization struct {
+	URL   string
+	State string
+}
+
+// OIDCCallbackResult はコールバックの処理結果
+// ログインの場合はLoginに結果が入り、プロフィールからの紐付けの場合はLinkedがtrueになる
+type OIDCCallbackResult struct {
+	Login  *LoginResult
+	Linked bool
+}
+
+type OIDCUseCase interface {
+	Providers() []string
+	// BeginAuthorization は認可リクエストを開始する。linkUserIDが0以外の場合はそのユーザーへの紐付けとして扱う
+	BeginAuthorization(ctx context.Context, provider string, linkUserID int) (*OIDCAuthorization, error)
+	HandleCallback(ctx context.Context, provider string, state string, code string) (*OIDCCallbackResult, error)
+	GetIdentities(userID int) ([]entity.UserIdentity, error)
+	UnlinkIdentity(userID int, provider string) error
+}
+
+type oidcUseCase struct {
+	providers              map[string]gateway.OIDCProvider
+	providerNames          []string
+	userIdentityRepository gateway.UserIdentityRepository
+	userRepository         gateway.UserRepository
+	userUseCase            UserUseCase
+	stateTTL               time.Duration
+}
+
+func NewOIDCUseCase(providers []gateway.OIDCProvider, userIdentityRepository gateway.UserIdentityRepository, userRepository gateway.UserRepository, userUseCase UserUseCase, stateTTL time.Duration) OIDCUseCase {
+	providerMap := map[string]gateway.OIDCProvider{}
+	providerNames := make([]string, 0, len(providers))
+	for _, provider := range providers {
+		providerMap[provider.Name()] = provider
+		providerNames = append(providerNames, provider.Name())
+	}
+	return &oidcUseCase{
+		providers:              providerMap,
+		providerNames:          providerNames,
+		userIdentityRepository: userIdentityRepository,
+		userRepository:         userRepository,
+		userUseCase:            userUseCase,
+		stateTTL:               stateTTL,
+	}
+}
+
+func (ou *oidcUseCase) Providers() []string {
+	return ou.providerNames
+}
+
+func (ou *oidcUseCase) BeginAuthorization(ctx context.Context, providerName string, linkUserID int) (*OIDCAuthorization, error) {
+	provider, ok := ou.providers[providerName]
+	if !ok {
+		return nil, ErrUnknownOIDCProvider
+	}
+
+	if _, err := ou.userIdentityRepository.DeleteExpiredStates(time.Now()); err != nil {
+		logger.Warn("failed to delete expired oidc states", "error", err.Error())
+	}
+
+	state, err := generateUserToken()
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := generateUserToken()
+	if err != nil {
+		return nil, err
+	}
+	codeVerifier, err := generateUserToken()
+	if err != nil {
+		return nil, err
+	}
+
+	authURL, err := provider.AuthCodeURL(ctx, state, nonce, codeVerifier)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := ou.userIdentityRepository.CreateState(&entity.OIDCLoginState{
+		StateHash:    HashUserToken(state),
+		Provider:     providerName,
+		CodeVerifier: codeVerifier,
+		Nonce:        nonce,
+		LinkUserID:   linkUserID,
+		ExpiresAt:    time.Now().Add(ou.stateTTL),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &OIDCAuthorization{URL: authURL, State: state}, nil
+}
+
+func (ou *oidcUseCase) HandleCallback(ctx context.Context, providerName string, state string, code string) (*OIDCCallbackResult, error) {
+	provider, ok := ou.providers[providerName]
+	if !ok {
+		return nil, ErrUnknownOIDCProvider
+	}
+	if state == "" || code == "" {
+		return nil, ErrInvalidOIDCState
+	}
+
+	loginState, err := ou.userIdentityRepository.ConsumeState(HashUserToken(state))
+	if err != nil {
+		return nil, err
+	}
+	if loginState == nil || loginState.Expired(time.Now()) || loginState.Provider != providerName {
+		return nil, ErrInvalidOIDCState
+	}
+
+	userInfo, err := provider.Exchange(ctx, code, loginState.CodeVerifier, loginState.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	identity, err := ou.userIdentityRepository.GetIdentity(providerName, userInfo.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	if loginState.LinkUserID != 0 {
+		if err := ou.linkIdentity(loginState.LinkUserID, providerName, identity, userInfo); err != nil {
+			return nil, err
+		}
+		return &OIDCCallbackResult{Linked: true}, nil
+	}
+
+	userID, err := ou.resolveUser(providerName, identity, userInfo)
+	if err != nil {
+		return nil, err
+	}
+	user, err := ou.userRepository.GetCurrentUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.Disabled() {
+		return nil, ErrAccountDisabled
+	}
+	loginResult, err := ou.userUseCase.CompleteLogin(userID)
+	if err != nil {
+		return nil, err
+	}
+	return &OIDCCallbackResult{Login: loginResult}, nil
+}
+
+func (ou *oidcUseCase) GetIdentities(userID int) ([]entity.UserIdentity, error) {
+	return ou.userIdentityRepository.GetIdentitiesByUserID(userID)
+}
+
+func (ou *oidcUseCase) UnlinkIdentity(userID int, providerName string) error {
+	deleted, err := ou.userIdentityRepository.DeleteIdentity(userID, providerName)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return ErrIdentityNotFound
+	}
+	return nil
+}
+
+func (ou *oidcUseCase) linkIdentity(userID int, providerName string, identity *entity.UserIdentity, userInfo *gateway.OIDCUserInfo) error {
+	if identity != nil {
+		if identity.UserID != userID {
+			return ErrIdentityAlreadyLinked
+		}
+		return nil
+	}
+	_, err := ou.userIdentityRepository.CreateIdentity(&entity.UserIdentity{
+		UserID:   userID,
+		Provider: providerName,
+		Subject:  userInfo.Subject,
+		Email:    userInfo.Email,
+	})
+	return err
+}
+
+// resolveUser はプロバイダのアカウントに対応するユーザーを返す
+// 紐付けがない場合は新しくユーザーを作成する。同じメールアドレスのユーザーが既にいる場合は紐付けを求める
+func (ou *oidcUseCase) resolveUser(providerName string, identity *entity.UserIdentity, userInfo *gateway.OIDCUserInfo) (int, error) {
+	if identity != nil {
+		return identity.UserID, nil
+	}
+	if userInfo.Email == "" || !userInfo.EmailVerified {
+		return 0, ErrOIDCEmailNotVerified
+	}
+
+	user, err := ou.userRepository.GetUserByEmail(userInfo.Email)
+	if err != nil && !errors.Is(err, gateway.ErrRecordNotFound) {
+		return 0, err
+	}
+	// 既存のアカウントはプロバイダ側のメールアドレスを乗っ取られた場合にログインされないよう自動で紐付けず、
+	// ログインした状態でプロフィールから紐付けてもらう
+	if user != nil {
+		return 0, ErrOIDCLinkRequired
+	}
+	user, err = ou.createUser(userInfo)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := ou.linkIdentity(user.ID, providerName, nil, userInfo); err != nil {
+		return 0, err
+	}
+	return user.ID, nil
+}
+
+func (ou *oidcUseCase) createUser(userInfo *gateway.OIDCUserInfo) (*entity.User, error) {
+	// パスワードでのログインが必要になった場合はパスワード再設定を使う
+	password, err := generateUserToken()
+	if err != nil {
+		return nil, err
+	}
+	hashedPassword, err := HashPassword(password)
+	if err != nil {
+		return nil, err
+	}
+
+	name := userInfo.Name
+	if name == "" {
+		name = strings.SplitN(userInfo.Email, "@", 2)[0]
+	}
+	if runes := []rune(name); len(runes) > maxUserNameLength {
+		name = string(runes[:maxUserNameLength])
+	}
+
+	verifiedAt := time.Now()
+	return ou.userRepository.Signup(&entity.User{
+		Email:           userInfo.Email,
+		Password:        hashedPassword,
+		Name:            name,
+		EmailVerifiedAt: &verifiedAt,
+	})
+}
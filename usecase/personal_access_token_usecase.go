@@ -0,0 +1,135 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/entity"
+	"household-account-backend/pkg/logger"
+)
+
+const (
+	// PersonalAccessTokenPrefix は発行するトークンの接頭辞。漏洩時にシークレットスキャンで検出しやすくする
+	PersonalAccessTokenPrefix = "hha_"
+	// MaxPersonalAccessTokenNameLength はトークン名の最大文字数
+	MaxPersonalAccessTokenNameLength = 100
+	// tokenPrefixLength は一覧で表示するトークン先頭部分の長さ
+	tokenPrefixLength = len(PersonalAccessTokenPrefix) + 8
+	// lastUsedUpdateInterval は最終利用日時を更新する間隔。リクエストごとにDBへ書き込まないようにする
+	lastUsedUpdateInterval = time.Minute
+)
+
+var (
+	ErrInvalidTokenName    = newFieldValidationError("invalid token name", "name", fmt.Sprintf("the name must be 1 to %d characters", MaxPersonalAccessTokenNameLength))
+	ErrInvalidTokenScope   = newFieldValidationError("invalid token scope", "scopes", "at least one known scope is required")
+	ErrInvalidTokenExpiry  = newFieldValidationError("token expiry must be in the future", "expires_at", "the expiry must be in the future")
+	ErrInvalidAccessToken  = &UnauthorizedError{Message: "invalid or expired access token"}
+	ErrAccessTokenNotFound = &NotFoundError{Message: "access token not found"}
+)
+
+type PersonalAccessTokenUseCase interface {
+	// CreateToken はトークンを発行し、保存した内容とトークン本体を返す。トークン本体は再表示できない
+	CreateToken(token *entity.PersonalAccessToken) (*entity.PersonalAccessToken, string, error)
+	GetTokensByUserID(userID int) ([]entity.PersonalAccessToken, error)
+	DeleteToken(userID int, tokenID int) error
+	// Authenticate はAuthorizationヘッダーのトークンを検証して最終利用日時を記録する
+	Authenticate(token string) (*entity.PersonalAccessToken, error)
+}
+
+type personalAccessTokenUseCase struct {
+	personalAccessTokenRepository gateway.PersonalAccessTokenRepository
+}
+
+func NewPersonalAccessTokenUseCase(personalAccessTokenRepository gateway.PersonalAccessTokenRepository) PersonalAccessTokenUseCase {
+	return &personalAccessTokenUseCase{
+		personalAccessTokenRepository: personalAccessTokenRepository,
+	}
+}
+
+func (pu *personalAccessTokenUseCase) CreateToken(token *entity.PersonalAccessToken) (*entity.PersonalAccessToken, string, error) {
+	token.Name = strings.TrimSpace(token.Name)
+	if token.Name == "" || len([]rune(token.Name)) > MaxPersonalAccessTokenNameLength {
+		return nil, "", ErrInvalidTokenName
+	}
+	if err := validateTokenScopes(token.ScopeList()); err != nil {
+		return nil, "", err
+	}
+	if token.ExpiresAt != nil && !token.ExpiresAt.After(time.Now()) {
+		return nil, "", ErrInvalidTokenExpiry
+	}
+
+	secret, err := generateUserToken()
+	if err != nil {
+		return nil, "", err
+	}
+	plainToken := PersonalAccessTokenPrefix + secret
+	token.TokenPrefix = plainToken[:tokenPrefixLength]
+	token.TokenHash = HashUserToken(plainToken)
+
+	createdToken, err := pu.personalAccessTokenRepository.CreateToken(token)
+	if err != nil {
+		return nil, "", err
+	}
+	return createdToken, plainToken, nil
+}
+
+func (pu *personalAccessTokenUseCase) GetTokensByUserID(userID int) ([]entity.PersonalAccessToken, error) {
+	return pu.personalAccessTokenRepository.GetTokensByUserID(userID)
+}
+
+func (pu *personalAccessTokenUseCase) DeleteToken(userID int, tokenID int) error {
+	err := pu.personalAccessTokenRepository.DeleteToken(userID, tokenID)
+	if errors.Is(err, gateway.ErrRecordNotFound) {
+		return ErrAccessTokenNotFound
+	}
+	return err
+}
+
+func (pu *personalAccessTokenUseCase) Authenticate(token string) (*entity.PersonalAccessToken, error) {
+	if !strings.HasPrefix(token, PersonalAccessTokenPrefix) {
+		return nil, ErrInvalidAccessToken
+	}
+
+	accessToken, err := pu.personalAccessTokenRepository.GetTokenByHash(HashUserToken(token))
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if accessToken == nil || accessToken.Expired(now) {
+		return nil, ErrInvalidAccessToken
+	}
+
+	if accessToken.LastUsedAt == nil || now.Sub(*accessToken.LastUsedAt) >= lastUsedUpdateInterval {
+		// 最終利用日時の記録に失敗してもリクエストは処理する
+		if err := pu.personalAccessTokenRepository.UpdateLastUsedAt(accessToken.ID, now); err != nil {
+			logger.Warn("failed to update token last used at", "token_id", accessToken.ID, "error", err.Error())
+		} else {
+			accessToken.LastUsedAt = &now
+		}
+	}
+	return accessToken, nil
+}
+
+func validateTokenScopes(scopes []string) error {
+	if len(scopes) == 0 {
+		return ErrInvalidTokenScope
+	}
+	for _, scope := range scopes {
+		if !isTokenScope(scope) {
+			return ErrInvalidTokenScope
+		}
+	}
+	return nil
+}
+
+func isTokenScope(scope string) bool {
+	for _, s := range entity.TokenScopes() {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
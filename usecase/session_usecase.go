@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"errors"
+	"time"
+
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/entity"
+)
+
+var ErrSessionRevoked = &UnauthorizedError{Message: "session has been revoked"}
+
+type SessionUseCase interface {
+	// Authorize は認証したユーザーがAPIを利用できる状態かを確かめて返す
+	// issuedAt はCookieの認証トークンの発行日時。パーソナルアクセストークンは強制ログアウトの対象外のためnilを渡す
+	Authorize(userID int, issuedAt *time.Time) (*entity.User, error)
+}
+
+type sessionUseCase struct {
+	userRepository gateway.UserRepository
+}
+
+func NewSessionUseCase(userRepository gateway.UserRepository) SessionUseCase {
+	return &sessionUseCase{userRepository: userRepository}
+}
+
+func (su *sessionUseCase) Authorize(userID int, issuedAt *time.Time) (*entity.User, error) {
+	user, err := su.userRepository.GetCurrentUser(userID)
+	if errors.Is(err, gateway.ErrRecordNotFound) {
+		// 削除されたユーザーのトークン
+		return nil, ErrSessionRevoked
+	}
+	if err != nil {
+		return nil, err
+	}
+	if user.Disabled() {
+		return nil, ErrAccountDisabled
+	}
+	if issuedAt != nil && user.SessionRevoked(*issuedAt) {
+		return nil, ErrSessionRevoked
+	}
+	return user, nil
+}
@@ -0,0 +1,215 @@
+package usecase_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/entity"
+	"household-account-backend/usecase"
+)
+
+type mockAccountDeletionRepository struct {
+	mock.Mock
+}
+
+func NewMockAccountDeletionRepository() *mockAccountDeletionRepository {
+	return new(mockAccountDeletionRepository)
+}
+
+func (m *mockAccountDeletionRepository) CreateDeletion(deletion *entity.AccountDeletion) (*entity.AccountDeletion, error) {
+	args := m.Called(deletion)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.AccountDeletion), args.Error(1)
+}
+
+func (m *mockAccountDeletionRepository) GetActiveDeletion(userID int) (*entity.AccountDeletion, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.AccountDeletion), args.Error(1)
+}
+
+func (m *mockAccountDeletionRepository) GetLatestDeletion(userID int) (*entity.AccountDeletion, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.AccountDeletion), args.Error(1)
+}
+
+func (m *mockAccountDeletionRepository) UpdateDeletion(deletion *entity.AccountDeletion) error {
+	args := m.Called(deletion)
+	return args.Error(0)
+}
+
+func (m *mockAccountDeletionRepository) GetDueDeletions(now time.Time, limit int) ([]entity.AccountDeletion, error) {
+	args := m.Called(now, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.AccountDeletion), args.Error(1)
+}
+
+func (m *mockAccountDeletionRepository) EraseUserData(userID int, email string) (map[string]int64, error) {
+	args := m.Called(userID, email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]int64), args.Error(1)
+}
+
+func (m *mockAccountDeletionRepository) CountUserData(userID int, email string) (map[string]int64, error) {
+	args := m.Called(userID, email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]int64), args.Error(1)
+}
+
+type AccountDeletionUseCaseSuite struct {
+	suite.Suite
+	deletionRepository  *mockAccountDeletionRepository
+	userRepository      *mockUserRepository
+	userTokenRepository *mockUserTokenRepository
+	mailSender          *mockMailSender
+	fileStorage         gateway.FileStorage
+	useCase             usecase.AccountDeletionUseCase
+}
+
+func TestAccountDeletionUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(AccountDeletionUseCaseSuite))
+}
+
+func (suite *AccountDeletionUseCaseSuite) SetupTest() {
+	suite.deletionRepository = NewMockAccountDeletionRepository()
+	suite.userRepository = NewMockUserRepository()
+	suite.userTokenRepository = NewMockUserTokenRepository()
+	suite.mailSender = NewMockMailSender()
+	suite.fileStorage = gateway.NewLocalFileStorage(suite.T().TempDir())
+	suite.useCase = usecase.NewAccountDeletionUseCase(suite.deletionRepository, suite.userRepository, suite.userTokenRepository,
+		suite.fileStorage, suite.mailSender, usecase.AccountDeletionConfig{
+			GracePeriod:     14 * 24 * time.Hour,
+			ConfirmationTTL: 24 * time.Hour,
+			FrontendURL:     "http://localhost:3000",
+		})
+}
+
+func (suite *AccountDeletionUseCaseSuite) TestRequestDeletion() {
+	deletion := &entity.AccountDeletion{ID: 1, UserID: 1, Email: "test@example.com", Status: entity.AccountDeletionStatusPending}
+	suite.userRepository.On("GetCurrentUser", 1).Return(&entity.User{ID: 1, Email: "test@example.com"}, nil)
+	suite.deletionRepository.On("GetActiveDeletion", 1).Return(nil, nil)
+	suite.deletionRepository.On("CreateDeletion", mock.Anything).Return(deletion, nil)
+	suite.userTokenRepository.On("InvalidateTokens", 1, entity.UserTokenPurposeAccountDeletion, mock.Anything).Return(nil)
+	suite.userTokenRepository.On("CreateToken", mock.MatchedBy(func(token *entity.UserToken) bool {
+		return token.Purpose == entity.UserTokenPurposeAccountDeletion
+	})).Return(&entity.UserToken{}, nil)
+	suite.mailSender.On("Send", "test@example.com", "アカウント削除の確認", mock.MatchedBy(func(body string) bool {
+		return strings.Contains(body, "http://localhost:3000/confirm-account-deletion?token=")
+	})).Return(nil)
+
+	result, err := suite.useCase.RequestDeletion(1)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(deletion, result)
+	suite.mailSender.AssertExpectations(suite.T())
+}
+
+func (suite *AccountDeletionUseCaseSuite) TestRequestDeletionAlreadyScheduled() {
+	suite.userRepository.On("GetCurrentUser", 1).Return(&entity.User{ID: 1, Email: "test@example.com"}, nil)
+	suite.deletionRepository.On("GetActiveDeletion", 1).Return(&entity.AccountDeletion{ID: 1, UserID: 1, Status: entity.AccountDeletionStatusScheduled}, nil)
+
+	result, err := suite.useCase.RequestDeletion(1)
+	suite.Assert().Nil(result)
+	suite.Assert().ErrorIs(err, usecase.ErrDeletionAlreadyScheduled)
+	suite.mailSender.AssertNotCalled(suite.T(), "Send", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *AccountDeletionUseCaseSuite) TestConfirmDeletion() {
+	token := "confirm-token"
+	suite.userTokenRepository.On("GetTokenByHash", entity.UserTokenPurposeAccountDeletion, usecase.HashUserToken(token)).
+		Return(&entity.UserToken{ID: 2, UserID: 1, Purpose: entity.UserTokenPurposeAccountDeletion, ExpiresAt: time.Now().Add(time.Hour)}, nil)
+	suite.userTokenRepository.On("MarkTokenUsed", 2, mock.Anything).Return(true, nil)
+	deletion := &entity.AccountDeletion{ID: 1, UserID: 1, Email: "test@example.com", Status: entity.AccountDeletionStatusPending}
+	suite.deletionRepository.On("GetActiveDeletion", 1).Return(deletion, nil)
+	suite.deletionRepository.On("UpdateDeletion", deletion).Return(nil)
+	suite.mailSender.On("Send", "test@example.com", "アカウント削除の受付", mock.Anything).Return(nil)
+
+	result, err := suite.useCase.ConfirmDeletion(token)
+	suite.Require().Nil(err)
+	suite.Assert().Equal(entity.AccountDeletionStatusScheduled, result.Status)
+	suite.Assert().WithinDuration(time.Now().Add(14*24*time.Hour), *result.ScheduledAt, time.Minute)
+}
+
+func (suite *AccountDeletionUseCaseSuite) TestCancelDeletionNotFound() {
+	suite.deletionRepository.On("GetActiveDeletion", 1).Return(nil, nil)
+
+	err := suite.useCase.CancelDeletion(1)
+	suite.Assert().ErrorIs(err, usecase.ErrDeletionNotFound)
+}
+
+func (suite *AccountDeletionUseCaseSuite) TestProcessDueDeletions() {
+	_, err := suite.fileStorage.Put("users/1/exports/1.zip", strings.NewReader("zip"))
+	suite.Require().Nil(err)
+	_, err = suite.fileStorage.Put("users/2/exports/2.zip", strings.NewReader("zip"))
+	suite.Require().Nil(err)
+
+	scheduledAt := time.Now().Add(-time.Minute)
+	suite.deletionRepository.On("GetDueDeletions", mock.Anything, 10).Return([]entity.AccountDeletion{
+		{ID: 1, UserID: 1, Email: "old@example.com", Status: entity.AccountDeletionStatusScheduled, ScheduledAt: &scheduledAt},
+	}, nil)
+	// 猶予期間中に変更したメールアドレスを使う
+	suite.userRepository.On("GetCurrentUser", 1).Return(&entity.User{ID: 1, Email: "new@example.com"}, nil)
+	suite.deletionRepository.On("EraseUserData", 1, "new@example.com").Return(map[string]int64{"users": 1, "transactions": 3}, nil)
+	suite.deletionRepository.On("CountUserData", 1, "new@example.com").Return(map[string]int64{"users": 0, "transactions": 0}, nil)
+	suite.mailSender.On("Send", "new@example.com", "アカウント削除の完了", mock.MatchedBy(func(body string) bool {
+		return strings.Contains(body, "transactions: 3件")
+	})).Return(nil)
+	var updated *entity.AccountDeletion
+	suite.deletionRepository.On("UpdateDeletion", mock.Anything).Run(func(args mock.Arguments) {
+		updated = args.Get(0).(*entity.AccountDeletion)
+	}).Return(nil)
+
+	processed, err := suite.useCase.ProcessDueDeletions(10)
+	suite.Require().Nil(err)
+	suite.Assert().Equal(1, processed)
+	suite.Require().NotNil(updated)
+	suite.Assert().Equal(entity.AccountDeletionStatusCompleted, updated.Status)
+	suite.Assert().Empty(updated.Email)
+
+	var report usecase.AccountDeletionReport
+	suite.Require().Nil(json.Unmarshal([]byte(updated.Report), &report))
+	suite.Assert().True(report.Verified)
+	suite.Assert().Equal(1, report.FilesDeleted)
+
+	// 他のユーザーのファイルは削除しない
+	keys, err := suite.fileStorage.List("users/2/")
+	suite.Assert().Nil(err)
+	suite.Assert().Len(keys, 1)
+}
+
+func (suite *AccountDeletionUseCaseSuite) TestProcessDueDeletionsNotVerified() {
+	scheduledAt := time.Now().Add(-time.Minute)
+	suite.deletionRepository.On("GetDueDeletions", mock.Anything, 10).Return([]entity.AccountDeletion{
+		{ID: 1, UserID: 1, Email: "test@example.com", Status: entity.AccountDeletionStatusScheduled, ScheduledAt: &scheduledAt},
+	}, nil)
+	suite.userRepository.On("GetCurrentUser", 1).Return(nil, gateway.ErrRecordNotFound)
+	suite.deletionRepository.On("EraseUserData", 1, "test@example.com").Return(map[string]int64{"users": 1}, nil)
+	suite.deletionRepository.On("CountUserData", 1, "test@example.com").Return(map[string]int64{"users": 1}, nil)
+	suite.mailSender.On("Send", "test@example.com", "アカウント削除の完了", mock.Anything).Return(nil)
+	suite.deletionRepository.On("UpdateDeletion", mock.MatchedBy(func(deletion *entity.AccountDeletion) bool {
+		return deletion.Status == entity.AccountDeletionStatusFailed
+	})).Return(nil)
+
+	processed, err := suite.useCase.ProcessDueDeletions(10)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(1, processed)
+	suite.deletionRepository.AssertExpectations(suite.T())
+}
@@ -0,0 +1,171 @@
+package usecase_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/entity"
+	"household-account-backend/usecase"
+)
+
+type mockAdminRepository struct {
+	mock.Mock
+}
+
+func NewMockAdminRepository() *mockAdminRepository {
+	return new(mockAdminRepository)
+}
+
+func (m *mockAdminRepository) SearchUsers(query gateway.UserSearchQuery) ([]entity.User, int64, error) {
+	args := m.Called(query)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]entity.User), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *mockAdminRepository) SetUserDisabled(userID int, disabledAt *time.Time) error {
+	args := m.Called(userID, disabledAt)
+	return args.Error(0)
+}
+
+func (m *mockAdminRepository) RevokeSessions(userID int, revokedAt time.Time) error {
+	args := m.Called(userID, revokedAt)
+	return args.Error(0)
+}
+
+func (m *mockAdminRepository) UpdateRole(userID int, role string) error {
+	args := m.Called(userID, role)
+	return args.Error(0)
+}
+
+func (m *mockAdminRepository) GetSystemStats(since time.Time) (*entity.SystemStats, error) {
+	args := m.Called(since)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.SystemStats), args.Error(1)
+}
+
+func (m *mockAdminRepository) CreateAuditLog(log *entity.AdminAuditLog) error {
+	args := m.Called(log)
+	return args.Error(0)
+}
+
+func (m *mockAdminRepository) GetAuditLogs(targetUserID int, limit int) ([]entity.AdminAuditLog, error) {
+	args := m.Called(targetUserID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.AdminAuditLog), args.Error(1)
+}
+
+type AdminUseCaseSuite struct {
+	suite.Suite
+	adminRepository *mockAdminRepository
+	userRepository  *mockUserRepository
+	useCase         usecase.AdminUseCase
+	actor           usecase.AdminActor
+}
+
+func TestAdminUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(AdminUseCaseSuite))
+}
+
+func (suite *AdminUseCaseSuite) SetupTest() {
+	suite.adminRepository = NewMockAdminRepository()
+	suite.userRepository = NewMockUserRepository()
+	suite.useCase = usecase.NewAdminUseCase(suite.adminRepository, suite.userRepository, testKeyManager, usecase.AdminConfig{ImpersonationTTL: time.Hour})
+	suite.actor = usecase.AdminActor{UserID: 1, IPAddress: "192.0.2.1"}
+}
+
+// auditLog は指定した操作の監査ログが記録されることを期待する
+func (suite *AdminUseCaseSuite) auditLog(action string, targetUserID int) {
+	suite.adminRepository.On("CreateAuditLog", mock.MatchedBy(func(log *entity.AdminAuditLog) bool {
+		return log.AdminID == 1 && log.IPAddress == "192.0.2.1" && log.Action == action && log.TargetUserID == targetUserID
+	})).Return(nil).Once()
+}
+
+func (suite *AdminUseCaseSuite) TestSearchUsersNormalizesQuery() {
+	suite.adminRepository.On("SearchUsers", gateway.UserSearchQuery{Query: "alice", Limit: usecase.MaxAdminUserSearchLimit}).
+		Return([]entity.User{{ID: 2}}, int64(1), nil)
+
+	users, total, err := suite.useCase.SearchUsers(usecase.UserSearchQuery{Query: " alice ", Limit: 1000, Offset: -1})
+	suite.Assert().Nil(err)
+	suite.Assert().Len(users, 1)
+	suite.Assert().Equal(int64(1), total)
+
+	_, _, err = suite.useCase.SearchUsers(usecase.UserSearchQuery{Status: "deleted"})
+	suite.Assert().ErrorIs(err, usecase.ErrInvalidUserStatus)
+}
+
+func (suite *AdminUseCaseSuite) TestDisableUser() {
+	suite.userRepository.On("GetCurrentUser", 2).Return(&entity.User{ID: 2}, nil)
+	suite.adminRepository.On("SetUserDisabled", 2, mock.MatchedBy(func(disabledAt *time.Time) bool { return disabledAt != nil })).Return(nil)
+	suite.auditLog(entity.AdminActionDisableUser, 2)
+
+	_, err := suite.useCase.DisableUser(suite.actor, 2)
+	suite.Assert().Nil(err)
+	suite.adminRepository.AssertExpectations(suite.T())
+}
+
+func (suite *AdminUseCaseSuite) TestDisableSelf() {
+	_, err := suite.useCase.DisableUser(suite.actor, 1)
+	suite.Assert().ErrorIs(err, usecase.ErrAdminSelfAction)
+	suite.adminRepository.AssertNotCalled(suite.T(), "SetUserDisabled", mock.Anything, mock.Anything)
+}
+
+func (suite *AdminUseCaseSuite) TestForceLogoutUserNotFound() {
+	suite.userRepository.On("GetCurrentUser", 9).Return(nil, gateway.ErrRecordNotFound)
+
+	err := suite.useCase.ForceLogout(suite.actor, 9)
+	suite.Assert().ErrorIs(err, usecase.ErrUserNotFound)
+	suite.adminRepository.AssertNotCalled(suite.T(), "CreateAuditLog", mock.Anything)
+}
+
+func (suite *AdminUseCaseSuite) TestUpdateRole() {
+	suite.userRepository.On("GetCurrentUser", 2).Return(&entity.User{ID: 2, Role: entity.UserRoleUser}, nil)
+	suite.adminRepository.On("UpdateRole", 2, entity.UserRoleAdmin).Return(nil)
+	suite.adminRepository.On("CreateAuditLog", mock.MatchedBy(func(log *entity.AdminAuditLog) bool {
+		return log.Action == entity.AdminActionUpdateRole && log.Detail == "user -> admin"
+	})).Return(nil)
+
+	user, err := suite.useCase.UpdateRole(suite.actor, 2, entity.UserRoleAdmin)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(entity.UserRoleAdmin, user.Role)
+
+	_, err = suite.useCase.UpdateRole(suite.actor, 2, "owner")
+	suite.Assert().ErrorIs(err, usecase.ErrInvalidUserRole)
+}
+
+func (suite *AdminUseCaseSuite) TestImpersonate() {
+	suite.userRepository.On("GetCurrentUser", 2).Return(&entity.User{ID: 2, Role: entity.UserRoleUser}, nil)
+	suite.adminRepository.On("CreateAuditLog", mock.MatchedBy(func(log *entity.AdminAuditLog) bool {
+		return log.Action == entity.AdminActionImpersonate && log.TargetUserID == 2 && log.Detail == "ticket-123"
+	})).Return(nil)
+
+	result, err := suite.useCase.Impersonate(suite.actor, 2, " ticket-123 ")
+	suite.Require().Nil(err)
+	suite.Assert().WithinDuration(time.Now().Add(time.Hour), result.ExpiresAt, time.Minute)
+
+	token, err := testKeyManager.Parse(result.AuthToken)
+	suite.Require().Nil(err)
+	claims := token.Claims.(jwt.MapClaims)
+	suite.Assert().Equal(float64(2), claims["user_id"])
+	suite.Assert().Equal(float64(1), claims[usecase.ImpersonatorClaim])
+}
+
+func (suite *AdminUseCaseSuite) TestImpersonateNotAllowed() {
+	suite.userRepository.On("GetCurrentUser", 3).Return(&entity.User{ID: 3, Role: entity.UserRoleAdmin}, nil)
+
+	_, err := suite.useCase.Impersonate(suite.actor, 3, "ticket-123")
+	suite.Assert().ErrorIs(err, usecase.ErrImpersonationNotAllowed)
+	_, err = suite.useCase.Impersonate(suite.actor, 3, "")
+	suite.Assert().ErrorIs(err, usecase.ErrInvalidImpersonationReason)
+	suite.adminRepository.AssertNotCalled(suite.T(), "CreateAuditLog", mock.Anything)
+}
@@ -0,0 +1,78 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"household-account-backend/entity"
+	"household-account-backend/usecase"
+)
+
+type mockCategoryReferenceRepository struct {
+	mock.Mock
+}
+
+func NewMockCategoryReferenceRepository() *mockCategoryReferenceRepository {
+	return new(mockCategoryReferenceRepository)
+}
+
+func (m *mockCategoryReferenceRepository) GetCategoryReferences(afterID int, limit int) ([]entity.CategoryReference, error) {
+	args := m.Called(afterID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.CategoryReference), args.Error(1)
+}
+
+type CategoryReferenceUseCaseSuite struct {
+	suite.Suite
+	repository *mockCategoryReferenceRepository
+}
+
+func TestCategoryReferenceUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(CategoryReferenceUseCaseSuite))
+}
+
+func (suite *CategoryReferenceUseCaseSuite) SetupTest() {
+	suite.repository = NewMockCategoryReferenceRepository()
+}
+
+func categoryOwner(userID int) *int {
+	return &userID
+}
+
+func (suite *CategoryReferenceUseCaseSuite) TestCheck() {
+	references := []entity.CategoryReference{
+		{TransactionID: 1, TransactionUserID: 1, CategoryID: 1, CategoryUserID: categoryOwner(1), CategoryType: entity.CategoryTypeExpense, Amount: 100},
+		{TransactionID: 2, TransactionUserID: 1, CategoryID: 2, CategoryUserID: categoryOwner(2), CategoryType: entity.CategoryTypeExpense, Amount: 100},
+		{TransactionID: 3, TransactionUserID: 1, CategoryID: 9, Amount: 100},
+		{TransactionID: 4, TransactionUserID: 1, CategoryID: 1, CategoryUserID: categoryOwner(1), CategoryType: entity.CategoryTypeExpense, Amount: -100},
+	}
+	suite.repository.On("GetCategoryReferences", 0, mock.Anything).Return(references, nil)
+
+	report, err := usecase.NewCategoryReferenceUseCase(suite.repository, usecase.SignPolicyPositive).Check()
+	suite.Require().Nil(err)
+	suite.Assert().Equal(4, report.Checked)
+	suite.Assert().True(report.HasIssues())
+	suite.Require().Len(report.CrossUser, 1)
+	suite.Assert().Equal(2, report.CrossUser[0].TransactionID)
+	suite.Require().Len(report.MissingCategory, 1)
+	suite.Assert().Equal(3, report.MissingCategory[0].TransactionID)
+	suite.Require().Len(report.SignViolations, 1)
+	suite.Assert().Equal(4, report.SignViolations[0].TransactionID)
+}
+
+func (suite *CategoryReferenceUseCaseSuite) TestCheckSignedPolicy() {
+	references := []entity.CategoryReference{
+		{TransactionID: 1, TransactionUserID: 1, CategoryID: 1, CategoryUserID: categoryOwner(1), CategoryType: entity.CategoryTypeExpense, Amount: -100},
+		{TransactionID: 2, TransactionUserID: 1, CategoryID: 2, CategoryUserID: categoryOwner(1), CategoryType: entity.CategoryTypeIncome, Amount: 100},
+	}
+	suite.repository.On("GetCategoryReferences", 0, mock.Anything).Return(references, nil)
+
+	report, err := usecase.NewCategoryReferenceUseCase(suite.repository, usecase.SignPolicySigned).Check()
+	suite.Require().Nil(err)
+	suite.Assert().Equal(2, report.Checked)
+	suite.Assert().False(report.HasIssues())
+}
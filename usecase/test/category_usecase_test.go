@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"household-account-backend/adapter/gateway"
 	"household-account-backend/entity"
 	"household-account-backend/usecase"
 )
@@ -15,7 +16,14 @@ type mockCategoryRepository struct {
 }
 
 func NewMockCategoryRepository() *mockCategoryRepository {
-	return new(mockCategoryRepository)
+	m := new(mockCategoryRepository)
+	m.On("RunInTransaction").Return().Maybe()
+	return m
+}
+
+func (m *mockCategoryRepository) RunInTransaction(fn func(repository gateway.CategoryRepository, outbox gateway.OutboxRepository) error) error {
+	m.Called()
+	return fn(m, NewMockOutboxRepository())
 }
 
 func (m *mockCategoryRepository) CreateCategory(category *entity.Category) (*entity.Category, error) {
@@ -26,8 +34,8 @@ func (m *mockCategoryRepository) CreateCategory(category *entity.Category) (*ent
 	return args.Get(0).(*entity.Category), args.Error(1)
 }
 
-func (m *mockCategoryRepository) GetCategoryByID(categoryID int) (*entity.Category, error) {
-	args := m.Called(categoryID)
+func (m *mockCategoryRepository) GetCategoryByID(userID int, categoryID int) (*entity.Category, error) {
+	args := m.Called(userID, categoryID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -42,6 +50,14 @@ func (m *mockCategoryRepository) GetCategoriesByUserID(userID int) ([]entity.Cat
 	return args.Get(0).([]entity.Category), args.Error(1)
 }
 
+func (m *mockCategoryRepository) GetCategoriesByIDs(userID int, categoryIDs []int) ([]entity.Category, error) {
+	args := m.Called(userID, categoryIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.Category), args.Error(1)
+}
+
 func (m *mockCategoryRepository) UpdateCategory(category *entity.Category) (*entity.Category, error) {
 	args := m.Called(category)
 	if args.Get(0) == nil {
@@ -50,8 +66,8 @@ func (m *mockCategoryRepository) UpdateCategory(category *entity.Category) (*ent
 	return args.Get(0).(*entity.Category), args.Error(1)
 }
 
-func (m *mockCategoryRepository) DeleteCategory(categoryID int) error {
-	args := m.Called(categoryID)
+func (m *mockCategoryRepository) DeleteCategory(userID int, categoryID int, version int) error {
+	args := m.Called(userID, categoryID, version)
 	return args.Error(0)
 }
 
@@ -66,7 +82,7 @@ func TestCategoryUseCaseSuite(t *testing.T) {
 
 func (suite *CategoryUseCaseSuite) SetupTest() {
 	mockRepo := NewMockCategoryRepository()
-	suite.categoryUseCase = usecase.NewCategoryUseCase(mockRepo)
+	suite.categoryUseCase = usecase.NewCategoryUseCase(mockRepo, NewMockEventPublisher())
 }
 
 func (suite *CategoryUseCaseSuite) TestCreateCategory() {
@@ -77,7 +93,7 @@ func (suite *CategoryUseCaseSuite) TestCreateCategory() {
     }
 
     mockRepo := NewMockCategoryRepository()
-    suite.categoryUseCase = usecase.NewCategoryUseCase(mockRepo)
+    suite.categoryUseCase = usecase.NewCategoryUseCase(mockRepo, NewMockEventPublisher())
     mockRepo.On("CreateCategory", category).Return(category, nil)
 
     createdCategory, err := suite.categoryUseCase.CreateCategory(category)
@@ -86,6 +102,19 @@ func (suite *CategoryUseCaseSuite) TestCreateCategory() {
     suite.Assert().Equal("expense", createdCategory.Type)
 }
 
+func (suite *CategoryUseCaseSuite) TestCreateCategoryInvalid() {
+	mockRepo := NewMockCategoryRepository()
+	suite.categoryUseCase = usecase.NewCategoryUseCase(mockRepo, NewMockEventPublisher())
+
+	_, err := suite.categoryUseCase.CreateCategory(&entity.Category{UserID: 1, Name: " ", Type: "saving"})
+	var validation *usecase.ValidationError
+	suite.Require().ErrorAs(err, &validation)
+	suite.Assert().Len(validation.Fields, 2)
+	suite.Assert().Equal("name", validation.Fields[0].Field)
+	suite.Assert().Equal("type", validation.Fields[1].Field)
+	mockRepo.AssertNotCalled(suite.T(), "CreateCategory", mock.Anything)
+}
+
 func (suite *CategoryUseCaseSuite) TestGetCategoryByID() {
     category := &entity.Category{
         ID:     1,
@@ -95,10 +124,10 @@ func (suite *CategoryUseCaseSuite) TestGetCategoryByID() {
     }
 
     mockRepo := NewMockCategoryRepository()
-    suite.categoryUseCase = usecase.NewCategoryUseCase(mockRepo)
-    mockRepo.On("GetCategoryByID", category.ID).Return(category, nil)
+    suite.categoryUseCase = usecase.NewCategoryUseCase(mockRepo, NewMockEventPublisher())
+    mockRepo.On("GetCategoryByID", category.UserID, category.ID).Return(category, nil)
 
-    retrievedCategory, err := suite.categoryUseCase.GetCategoryByID(category.ID)
+    retrievedCategory, err := suite.categoryUseCase.GetCategoryByID(category.UserID, category.ID)
     suite.Assert().Nil(err)
     suite.Assert().Equal("Groceries", retrievedCategory.Name)
     suite.Assert().Equal("expense", retrievedCategory.Type)
@@ -121,7 +150,7 @@ func (suite *CategoryUseCaseSuite) TestGetCategoriesByUserID() {
     }
 
     mockRepo := NewMockCategoryRepository()
-    suite.categoryUseCase = usecase.NewCategoryUseCase(mockRepo)
+    suite.categoryUseCase = usecase.NewCategoryUseCase(mockRepo, NewMockEventPublisher())
     mockRepo.On("GetCategoriesByUserID", 1).Return(categories, nil)
 
     retrievedCategories, err := suite.categoryUseCase.GetCategoriesByUserID(1)
@@ -140,7 +169,7 @@ func (suite *CategoryUseCaseSuite) TestUpdateCategory() {
     }
 
     mockRepo := NewMockCategoryRepository()
-    suite.categoryUseCase = usecase.NewCategoryUseCase(mockRepo)
+    suite.categoryUseCase = usecase.NewCategoryUseCase(mockRepo, NewMockEventPublisher())
     mockRepo.On("UpdateCategory", category).Return(category, nil)
 
     updatedCategory, err := suite.categoryUseCase.UpdateCategory(category)
@@ -151,9 +180,9 @@ func (suite *CategoryUseCaseSuite) TestUpdateCategory() {
 
 func (suite *CategoryUseCaseSuite) TestDeleteCategory() {
     mockRepo := NewMockCategoryRepository()
-    suite.categoryUseCase = usecase.NewCategoryUseCase(mockRepo)
-    mockRepo.On("DeleteCategory", 1).Return(nil)
+    suite.categoryUseCase = usecase.NewCategoryUseCase(mockRepo, NewMockEventPublisher())
+    mockRepo.On("DeleteCategory", 1, 1, 1).Return(nil)
 
-    err := suite.categoryUseCase.DeleteCategory(1)
+    err := suite.categoryUseCase.DeleteCategory(1, 1, 1)
     suite.Assert().Nil(err)
 }
@@ -0,0 +1,237 @@
+package usecase_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/entity"
+	"household-account-backend/usecase"
+)
+
+type mockDataExportRepository struct {
+	mock.Mock
+}
+
+func NewMockDataExportRepository() *mockDataExportRepository {
+	return new(mockDataExportRepository)
+}
+
+func (m *mockDataExportRepository) CreateExport(export *entity.DataExport) (*entity.DataExport, error) {
+	args := m.Called(export)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.DataExport), args.Error(1)
+}
+
+func (m *mockDataExportRepository) GetExportByID(userID int, exportID int) (*entity.DataExport, error) {
+	args := m.Called(userID, exportID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.DataExport), args.Error(1)
+}
+
+func (m *mockDataExportRepository) GetExportsByUserID(userID int) ([]entity.DataExport, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.DataExport), args.Error(1)
+}
+
+func (m *mockDataExportRepository) HasActiveExport(userID int) (bool, error) {
+	args := m.Called(userID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockDataExportRepository) ClaimExport(now time.Time, staleBefore time.Time) (*entity.DataExport, error) {
+	args := m.Called(now, staleBefore)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.DataExport), args.Error(1)
+}
+
+func (m *mockDataExportRepository) UpdateExport(export *entity.DataExport) error {
+	args := m.Called(export)
+	return args.Error(0)
+}
+
+func (m *mockDataExportRepository) GetExpiredExports(now time.Time, limit int) ([]entity.DataExport, error) {
+	args := m.Called(now, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.DataExport), args.Error(1)
+}
+
+type DataExportUseCaseSuite struct {
+	suite.Suite
+	dataExportRepository     *mockDataExportRepository
+	userRepository           *mockUserRepository
+	categoryRepository       *mockCategoryRepository
+	transactionRepository    *mockTransactionRepository
+	monthlySummaryRepository *mockMonthlySummaryRepository
+	fileStorage              gateway.FileStorage
+	useCase                  usecase.DataExportUseCase
+}
+
+func TestDataExportUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(DataExportUseCaseSuite))
+}
+
+func (suite *DataExportUseCaseSuite) SetupTest() {
+	suite.dataExportRepository = NewMockDataExportRepository()
+	suite.userRepository = NewMockUserRepository()
+	suite.categoryRepository = NewMockCategoryRepository()
+	suite.transactionRepository = NewMockTransactionRepository()
+	suite.monthlySummaryRepository = NewMockMonthlySummaryRepository()
+	suite.fileStorage = gateway.NewLocalFileStorage(suite.T().TempDir())
+	suite.useCase = usecase.NewDataExportUseCase(suite.dataExportRepository, suite.userRepository, suite.categoryRepository,
+		suite.transactionRepository, suite.monthlySummaryRepository, suite.fileStorage,
+		usecase.DataExportConfig{TTL: time.Hour, StaleAfter: time.Minute})
+}
+
+func (suite *DataExportUseCaseSuite) TestRequestExportInProgress() {
+	suite.dataExportRepository.On("HasActiveExport", 1).Return(true, nil)
+
+	export, err := suite.useCase.RequestExport(1)
+	suite.Assert().Nil(export)
+	suite.Assert().ErrorIs(err, usecase.ErrExportInProgress)
+	suite.dataExportRepository.AssertNotCalled(suite.T(), "CreateExport", mock.Anything)
+}
+
+func (suite *DataExportUseCaseSuite) TestProcessPendingExports() {
+	export := &entity.DataExport{ID: 5, UserID: 1, Status: entity.DataExportStatusProcessing}
+	suite.dataExportRepository.On("ClaimExport", mock.Anything, mock.Anything).Return(export, nil).Once()
+	suite.dataExportRepository.On("ClaimExport", mock.Anything, mock.Anything).Return(nil, nil)
+	suite.dataExportRepository.On("UpdateExport", export).Return(nil)
+	suite.userRepository.On("GetCurrentUser", 1).Return(&entity.User{ID: 1, Email: "test@example.com", Name: "test", Password: "hashed"}, nil)
+	suite.categoryRepository.On("GetCategoriesByUserID", 1).Return([]entity.Category{{ID: 2, UserID: 1, Name: "食費", Type: "expense"}}, nil)
+	suite.transactionRepository.On("GetTransactionsByUserID", 1).Return([]entity.Transaction{
+		{ID: 3, UserID: 1, CategoryID: 2, Date: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), Amount: 1200, Content: "昼食, コンビニ"},
+	}, nil)
+	suite.monthlySummaryRepository.On("GetMonthlySummariesByUserID", 1).Return(nil, nil)
+	_, err := suite.fileStorage.Put("users/1/receipts/1.jpg", strings.NewReader("receipt"))
+	suite.Require().Nil(err)
+	_, err = suite.fileStorage.Put("users/1/exports/4.zip", strings.NewReader("old export"))
+	suite.Require().Nil(err)
+
+	processed, err := suite.useCase.ProcessPendingExports(10)
+	suite.Require().Nil(err)
+	suite.Assert().Equal(1, processed)
+	suite.Assert().Equal(entity.DataExportStatusCompleted, export.Status)
+	suite.Assert().Equal("users/1/exports/5.zip", export.FileKey)
+	suite.Assert().NotNil(export.ExpiresAt)
+
+	files := suite.readExport(export)
+
+	// パスワードのハッシュは含めない
+	var profile map[string]interface{}
+	suite.Require().Nil(json.Unmarshal([]byte(files["profile.json"]), &profile))
+	suite.Assert().Equal("test@example.com", profile["email"])
+	suite.Assert().NotContains(files["profile.json"], "hashed")
+
+	suite.Assert().Contains(files["transactions.csv"], "3,2026-10-01,2,食費,1200,\"昼食, コンビニ\"")
+	suite.Assert().True(strings.HasPrefix(files["categories.csv"], "\xEF\xBB\xBF"))
+	suite.Assert().Equal("[]\n", files["monthly_summaries.json"])
+	suite.Assert().Equal("receipt", files["attachments/receipts/1.jpg"])
+	suite.Assert().NotContains(files, "attachments/exports/4.zip")
+}
+
+func (suite *DataExportUseCaseSuite) TestProcessPendingExportsEscapesFormulas() {
+	export := &entity.DataExport{ID: 6, UserID: 1, Status: entity.DataExportStatusProcessing}
+	suite.dataExportRepository.On("ClaimExport", mock.Anything, mock.Anything).Return(export, nil).Once()
+	suite.dataExportRepository.On("ClaimExport", mock.Anything, mock.Anything).Return(nil, nil)
+	suite.dataExportRepository.On("UpdateExport", export).Return(nil)
+	suite.userRepository.On("GetCurrentUser", 1).Return(&entity.User{ID: 1, Email: "test@example.com", Name: "test"}, nil)
+	suite.categoryRepository.On("GetCategoriesByUserID", 1).Return([]entity.Category{{ID: 2, UserID: 1, Name: "@SUM(A1)", Type: "expense"}}, nil)
+	suite.transactionRepository.On("GetTransactionsByUserID", 1).Return([]entity.Transaction{
+		{ID: 3, UserID: 1, CategoryID: 2, Date: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), Amount: -1200, Content: "=HYPERLINK(\"http://example.com\")"},
+		{ID: 4, UserID: 1, CategoryID: 2, Date: time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC), Amount: 300, Content: "+1"},
+		{ID: 5, UserID: 1, CategoryID: 2, Date: time.Date(2026, 10, 3, 0, 0, 0, 0, time.UTC), Amount: 300, Content: "-1"},
+		{ID: 6, UserID: 1, CategoryID: 2, Date: time.Date(2026, 10, 4, 0, 0, 0, 0, time.UTC), Amount: 300, Content: "\tcmd"},
+		{ID: 7, UserID: 1, CategoryID: 2, Date: time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC), Amount: 300, Content: "\rcmd"},
+	}, nil)
+	suite.monthlySummaryRepository.On("GetMonthlySummariesByUserID", 1).Return(nil, nil)
+
+	_, err := suite.useCase.ProcessPendingExports(10)
+	suite.Require().Nil(err)
+	files := suite.readExport(export)
+
+	suite.Assert().Contains(files["categories.csv"], "2,'@SUM(A1),expense")
+	// 金額の符号はエスケープせず、カテゴリ名と内容だけをエスケープする
+	suite.Assert().Contains(files["transactions.csv"], "3,2026-10-01,2,'@SUM(A1),-1200,\"'=HYPERLINK(\"\"http://example.com\"\")\"")
+	suite.Assert().Contains(files["transactions.csv"], "4,2026-10-02,2,'@SUM(A1),300,'+1")
+	suite.Assert().Contains(files["transactions.csv"], "5,2026-10-03,2,'@SUM(A1),300,'-1")
+	suite.Assert().Contains(files["transactions.csv"], "6,2026-10-04,2,'@SUM(A1),300,'\tcmd")
+	suite.Assert().Contains(files["transactions.csv"], "7,2026-10-05,2,'@SUM(A1),300,\"'\rcmd\"")
+	// JSONは元の値のまま出力する
+	suite.Assert().Contains(files["transactions.json"], `"content": "+1"`)
+}
+
+// readExport は作成したエクスポートのアーカイブを開き、ファイル名ごとの内容を返す
+func (suite *DataExportUseCaseSuite) readExport(export *entity.DataExport) map[string]string {
+	suite.dataExportRepository.On("GetExportByID", export.UserID, export.ID).Return(export, nil)
+	_, file, err := suite.useCase.OpenExport(export.UserID, export.ID)
+	suite.Require().Nil(err)
+	data, err := io.ReadAll(file)
+	file.Close()
+	suite.Require().Nil(err)
+	suite.Assert().Equal(export.FileSize, int64(len(data)))
+
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	suite.Require().Nil(err)
+	files := map[string]string{}
+	for _, f := range archive.File {
+		r, err := f.Open()
+		suite.Require().Nil(err)
+		content, err := io.ReadAll(r)
+		r.Close()
+		suite.Require().Nil(err)
+		files[f.Name] = string(content)
+	}
+	return files
+}
+
+func (suite *DataExportUseCaseSuite) TestOpenExport() {
+	past := time.Now().Add(-time.Minute)
+	suite.dataExportRepository.On("GetExportByID", 1, 1).Return(&entity.DataExport{ID: 1, UserID: 1, Status: entity.DataExportStatusProcessing}, nil)
+	suite.dataExportRepository.On("GetExportByID", 1, 2).Return(&entity.DataExport{ID: 2, UserID: 1, Status: entity.DataExportStatusCompleted, ExpiresAt: &past}, nil)
+	suite.dataExportRepository.On("GetExportByID", 1, 3).Return(nil, nil)
+
+	_, _, err := suite.useCase.OpenExport(1, 1)
+	suite.Assert().ErrorIs(err, usecase.ErrExportNotReady)
+	_, _, err = suite.useCase.OpenExport(1, 2)
+	suite.Assert().ErrorIs(err, usecase.ErrExportExpired)
+	_, _, err = suite.useCase.OpenExport(1, 3)
+	suite.Assert().ErrorIs(err, usecase.ErrExportNotFound)
+}
+
+func (suite *DataExportUseCaseSuite) TestPurgeExpiredExports() {
+	_, err := suite.fileStorage.Put("users/1/exports/1.zip", strings.NewReader("zip"))
+	suite.Require().Nil(err)
+	suite.dataExportRepository.On("GetExpiredExports", mock.Anything, 10).Return([]entity.DataExport{
+		{ID: 1, UserID: 1, Status: entity.DataExportStatusCompleted, FileKey: "users/1/exports/1.zip"},
+	}, nil)
+	suite.dataExportRepository.On("UpdateExport", mock.MatchedBy(func(export *entity.DataExport) bool {
+		return export.Status == entity.DataExportStatusExpired && export.FileKey == ""
+	})).Return(nil)
+
+	purged, err := suite.useCase.PurgeExpiredExports(10)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(1, purged)
+	keys, err := suite.fileStorage.List("users/1/")
+	suite.Assert().Nil(err)
+	suite.Assert().Empty(keys)
+}
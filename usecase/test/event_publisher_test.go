@@ -0,0 +1,181 @@
+package usecase_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/entity"
+	"household-account-backend/usecase"
+)
+
+type mockEventPublisher struct {
+	mock.Mock
+}
+
+// NewMockEventPublisher はイベント発行を検証しないテスト向けに全ての呼び出しを受け付けるモックを返す
+func NewMockEventPublisher() *mockEventPublisher {
+	m := new(mockEventPublisher)
+	m.On("Publish", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&entity.OutboxEvent{}, nil).Maybe()
+	m.On("Notify", mock.Anything).Return().Maybe()
+	return m
+}
+
+func (m *mockEventPublisher) Publish(outbox gateway.OutboxRepository, userID int, eventType string, data interface{}) (*entity.OutboxEvent, error) {
+	args := m.Called(outbox, userID, eventType, data)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.OutboxEvent), args.Error(1)
+}
+
+func (m *mockEventPublisher) Notify(events ...*entity.OutboxEvent) {
+	m.Called(events)
+}
+
+type mockOutboxRepository struct {
+	mock.Mock
+}
+
+func NewMockOutboxRepository() *mockOutboxRepository {
+	return new(mockOutboxRepository)
+}
+
+func (m *mockOutboxRepository) CreateEvent(event *entity.OutboxEvent) (*entity.OutboxEvent, error) {
+	args := m.Called(event)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.OutboxEvent), args.Error(1)
+}
+
+func (m *mockOutboxRepository) ClaimPendingEvents(limit int, maxAttempts int, claimTTL time.Duration) ([]entity.OutboxEvent, error) {
+	args := m.Called(limit, maxAttempts, claimTTL)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.OutboxEvent), args.Error(1)
+}
+
+func (m *mockOutboxRepository) GetEventsAfter(userID int, afterEventID int, commitGrace time.Duration, limit int) ([]entity.OutboxEvent, error) {
+	args := m.Called(userID, afterEventID, commitGrace, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.OutboxEvent), args.Error(1)
+}
+
+func (m *mockOutboxRepository) GetLatestEventID(userID int) (int, error) {
+	args := m.Called(userID)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *mockOutboxRepository) MarkEventProcessed(eventID int) error {
+	args := m.Called(eventID)
+	return args.Error(0)
+}
+
+func (m *mockOutboxRepository) IncrementEventAttempts(eventID int) error {
+	args := m.Called(eventID)
+	return args.Error(0)
+}
+
+type mockEventHub struct {
+	mock.Mock
+}
+
+func NewMockEventHub() *mockEventHub {
+	return new(mockEventHub)
+}
+
+func (m *mockEventHub) Publish(event *entity.OutboxEvent) {
+	m.Called(event)
+}
+
+func (m *mockEventHub) Subscribe(userID int) (<-chan entity.OutboxEvent, func()) {
+	args := m.Called(userID)
+	return args.Get(0).(<-chan entity.OutboxEvent), args.Get(1).(func())
+}
+
+type EventPublisherSuite struct {
+	suite.Suite
+}
+
+func TestEventPublisherSuite(t *testing.T) {
+	suite.Run(t, new(EventPublisherSuite))
+}
+
+func (suite *EventPublisherSuite) TestPublishStoresOutboxEvent() {
+	mockRepo := NewMockOutboxRepository()
+	mockHub := NewMockEventHub()
+	publisher := usecase.NewOutboxEventPublisher(mockHub)
+	stored := &entity.OutboxEvent{ID: 1, UserID: 1, Type: entity.EventTransactionDeleted, Payload: `{"id":3}`}
+	mockRepo.On("CreateEvent", mock.MatchedBy(func(event *entity.OutboxEvent) bool {
+		return event.UserID == 1 &&
+			event.Type == entity.EventTransactionDeleted &&
+			event.Payload == `{"id":3}`
+	})).Return(stored, nil)
+
+	event, err := publisher.Publish(mockRepo, 1, entity.EventTransactionDeleted, map[string]int{"id": 3})
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(stored, event)
+	mockRepo.AssertExpectations(suite.T())
+	// コミット前のイベントは通知しない
+	mockHub.AssertNotCalled(suite.T(), "Publish", mock.Anything)
+}
+
+func (suite *EventPublisherSuite) TestPublishReturnsOutboxError() {
+	mockRepo := NewMockOutboxRepository()
+	publisher := usecase.NewOutboxEventPublisher(NewMockEventHub())
+	mockRepo.On("CreateEvent", mock.Anything).Return(nil, errors.New("outbox error"))
+
+	event, err := publisher.Publish(mockRepo, 1, entity.EventTransactionDeleted, map[string]int{"id": 3})
+	suite.Assert().Nil(event)
+	suite.Assert().EqualError(err, "outbox error")
+}
+
+func (suite *EventPublisherSuite) TestNotifyPublishesToEventHub() {
+	mockHub := NewMockEventHub()
+	publisher := usecase.NewOutboxEventPublisher(mockHub)
+	first := &entity.OutboxEvent{ID: 1, UserID: 1}
+	second := &entity.OutboxEvent{ID: 2, UserID: 1}
+	mockHub.On("Publish", first).Return().Once()
+	mockHub.On("Publish", second).Return().Once()
+
+	publisher.Notify(first, second)
+	mockHub.AssertExpectations(suite.T())
+}
+
+func (suite *EventPublisherSuite) TestPublishFailureFailsUseCase() {
+	mockRepo := NewMockTransactionRepository()
+	publisher := new(mockEventPublisher)
+	transactionUseCase := usecase.NewTransactionUseCase(mockRepo, NewMockCategoryRepository(), usecase.SignPolicyPositive, publisher)
+
+	mockRepo.On("DeleteTransaction", 1, 2, 0).Return(nil)
+	publisher.On("Publish", mock.Anything, 1, entity.EventTransactionDeleted, map[string]int{"id": 2}).Return(nil, errors.New("outbox error"))
+
+	// outboxへの保存に失敗した場合は削除もロールバックされ、エラーを返す
+	err := transactionUseCase.DeleteTransaction(1, 2, 0)
+	suite.Assert().EqualError(err, "outbox error")
+	mockRepo.AssertCalled(suite.T(), "RunInTransaction")
+	publisher.AssertNotCalled(suite.T(), "Notify", mock.Anything)
+}
+
+func (suite *EventPublisherSuite) TestPublishNotifiesAfterCommit() {
+	mockRepo := NewMockTransactionRepository()
+	publisher := new(mockEventPublisher)
+	transactionUseCase := usecase.NewTransactionUseCase(mockRepo, NewMockCategoryRepository(), usecase.SignPolicyPositive, publisher)
+	event := &entity.OutboxEvent{ID: 5, UserID: 1}
+
+	mockRepo.On("DeleteTransaction", 1, 2, 0).Return(nil)
+	publisher.On("Publish", mock.Anything, 1, entity.EventTransactionDeleted, map[string]int{"id": 2}).Return(event, nil)
+	publisher.On("Notify", []*entity.OutboxEvent{event}).Return()
+
+	err := transactionUseCase.DeleteTransaction(1, 2, 0)
+	suite.Assert().Nil(err)
+	publisher.AssertExpectations(suite.T())
+}
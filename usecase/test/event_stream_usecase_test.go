@@ -0,0 +1,84 @@
+package usecase_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"household-account-backend/entity"
+	"household-account-backend/usecase"
+)
+
+type EventStreamUseCaseSuite struct {
+	suite.Suite
+	outboxRepository   *mockOutboxRepository
+	eventHub           *mockEventHub
+	eventStreamUseCase usecase.EventStreamUseCase
+	events             <-chan entity.OutboxEvent
+	unsubscribed       bool
+}
+
+func TestEventStreamUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(EventStreamUseCaseSuite))
+}
+
+func (suite *EventStreamUseCaseSuite) SetupTest() {
+	suite.outboxRepository = NewMockOutboxRepository()
+	suite.eventHub = NewMockEventHub()
+	suite.eventStreamUseCase = usecase.NewEventStreamUseCase(suite.outboxRepository, suite.eventHub)
+	suite.events = make(chan entity.OutboxEvent)
+	suite.unsubscribed = false
+	suite.eventHub.On("Subscribe", 1).Return(suite.events, func() { suite.unsubscribed = true })
+}
+
+func (suite *EventStreamUseCaseSuite) TestSubscribeWithoutLastEventID() {
+	subscription, err := suite.eventStreamUseCase.Subscribe(1, 0)
+	suite.Assert().Nil(err)
+	suite.Assert().Nil(subscription.Replay)
+	suite.Assert().False(subscription.Reset)
+	suite.Assert().Equal(suite.events, subscription.Events)
+	suite.outboxRepository.AssertNotCalled(suite.T(), "GetEventsAfter", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *EventStreamUseCaseSuite) TestSubscribeReplaysMissedEvents() {
+	missed := []entity.OutboxEvent{
+		{ID: 11, UserID: 1, Type: entity.EventTransactionCreated, Payload: `{"id":1}`},
+		{ID: 12, UserID: 1, Type: entity.EventCategoryDeleted, Payload: `{"id":2}`},
+	}
+	suite.outboxRepository.On("GetEventsAfter", 1, 10, usecase.EventStreamCommitGrace, usecase.MaxEventStreamReplay+1).Return(missed, nil)
+
+	subscription, err := suite.eventStreamUseCase.Subscribe(1, 10)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(missed, subscription.Replay)
+	suite.Assert().False(subscription.Reset)
+
+	subscription.Unsubscribe()
+	suite.Assert().True(suite.unsubscribed)
+}
+
+func (suite *EventStreamUseCaseSuite) TestSubscribeResetsWhenReplayIsTruncated() {
+	missed := make([]entity.OutboxEvent, usecase.MaxEventStreamReplay+1)
+	for i := range missed {
+		missed[i] = entity.OutboxEvent{ID: 11 + i, UserID: 1}
+	}
+	suite.outboxRepository.On("GetEventsAfter", 1, 10, usecase.EventStreamCommitGrace, usecase.MaxEventStreamReplay+1).Return(missed, nil)
+	suite.outboxRepository.On("GetLatestEventID", 1).Return(900, nil)
+
+	subscription, err := suite.eventStreamUseCase.Subscribe(1, 10)
+	suite.Assert().Nil(err)
+	suite.Assert().True(subscription.Reset)
+	suite.Assert().Equal(900, subscription.ResetEventID)
+	suite.Assert().Empty(subscription.Replay)
+	suite.Assert().False(suite.unsubscribed)
+}
+
+func (suite *EventStreamUseCaseSuite) TestSubscribeReplayFailureUnsubscribes() {
+	suite.outboxRepository.On("GetEventsAfter", 1, 10, usecase.EventStreamCommitGrace, usecase.MaxEventStreamReplay+1).Return(nil, errors.New("replay error"))
+
+	subscription, err := suite.eventStreamUseCase.Subscribe(1, 10)
+	suite.Assert().Nil(subscription)
+	suite.Assert().Equal("replay error", err.Error())
+	suite.Assert().True(suite.unsubscribed)
+}
@@ -0,0 +1,78 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"household-account-backend/entity"
+	"household-account-backend/usecase"
+)
+
+type mockHealthRepository struct {
+	mock.Mock
+}
+
+func (m *mockHealthRepository) Ping(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+func (m *mockHealthRepository) PoolStats() (*entity.DatabasePoolStats, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.DatabasePoolStats), args.Error(1)
+}
+
+type HealthUseCaseSuite struct {
+	suite.Suite
+	repository *mockHealthRepository
+	useCase    usecase.HealthUseCase
+}
+
+func TestHealthUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(HealthUseCaseSuite))
+}
+
+func (suite *HealthUseCaseSuite) SetupTest() {
+	suite.repository = new(mockHealthRepository)
+	suite.useCase = usecase.NewHealthUseCase(suite.repository)
+}
+
+func (suite *HealthUseCaseSuite) TestReady() {
+	stats := &entity.DatabasePoolStats{MaxOpenConnections: 25, OpenConnections: 2, InUse: 1, Idle: 1}
+	suite.repository.On("Ping", mock.Anything).Return(nil)
+	suite.repository.On("PoolStats").Return(stats, nil)
+
+	readiness := suite.useCase.Readiness(context.Background())
+	suite.Assert().True(readiness.Ready())
+	suite.Assert().Equal(entity.HealthStatusOK, readiness.Database.Status)
+	suite.Assert().Equal(stats, readiness.Database.Pool)
+}
+
+func (suite *HealthUseCaseSuite) TestDatabaseUnavailable() {
+	stats := &entity.DatabasePoolStats{MaxOpenConnections: 25}
+	suite.repository.On("Ping", mock.Anything).Return(errors.New("connection refused"))
+	suite.repository.On("PoolStats").Return(stats, nil)
+
+	// 接続できない場合も接続プールの統計は返す
+	readiness := suite.useCase.Readiness(context.Background())
+	suite.Assert().False(readiness.Ready())
+	suite.Assert().Equal(entity.HealthStatusUnavailable, readiness.Status)
+	suite.Assert().Equal(entity.HealthStatusUnavailable, readiness.Database.Status)
+	suite.Assert().Equal(stats, readiness.Database.Pool)
+}
+
+func (suite *HealthUseCaseSuite) TestPoolStatsUnavailable() {
+	suite.repository.On("Ping", mock.Anything).Return(nil)
+	suite.repository.On("PoolStats").Return(nil, errors.New("not a sql database"))
+
+	readiness := suite.useCase.Readiness(context.Background())
+	suite.Assert().True(readiness.Ready())
+	suite.Assert().Nil(readiness.Database.Pool)
+}
@@ -0,0 +1,158 @@
+package usecase_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"household-account-backend/entity"
+	"household-account-backend/usecase"
+)
+
+type mockIdempotencyRepository struct {
+	mock.Mock
+}
+
+func NewMockIdempotencyRepository() *mockIdempotencyRepository {
+	return new(mockIdempotencyRepository)
+}
+
+func (m *mockIdempotencyRepository) CreateRecord(record *entity.IdempotencyRecord) (*entity.IdempotencyRecord, error) {
+	args := m.Called(record)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.IdempotencyRecord), args.Error(1)
+}
+
+func (m *mockIdempotencyRepository) GetRecord(userID int, idempotencyKey string) (*entity.IdempotencyRecord, error) {
+	args := m.Called(userID, idempotencyKey)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.IdempotencyRecord), args.Error(1)
+}
+
+func (m *mockIdempotencyRepository) SaveResponse(record *entity.IdempotencyRecord) error {
+	args := m.Called(record)
+	return args.Error(0)
+}
+
+func (m *mockIdempotencyRepository) DeleteRecord(recordID int) error {
+	args := m.Called(recordID)
+	return args.Error(0)
+}
+
+func (m *mockIdempotencyRepository) DeleteExpiredRecords(now time.Time) (int64, error) {
+	args := m.Called(now)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+type IdempotencyUseCaseSuite struct {
+	suite.Suite
+	idempotencyRepository *mockIdempotencyRepository
+	idempotencyUseCase    usecase.IdempotencyUseCase
+}
+
+func TestIdempotencyUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(IdempotencyUseCaseSuite))
+}
+
+func (suite *IdempotencyUseCaseSuite) SetupTest() {
+	suite.idempotencyRepository = NewMockIdempotencyRepository()
+	suite.idempotencyUseCase = usecase.NewIdempotencyUseCase(suite.idempotencyRepository, time.Hour)
+}
+
+func (suite *IdempotencyUseCaseSuite) TestBeginNewKey() {
+	suite.idempotencyRepository.On("GetRecord", 1, "key-1").Return(nil, nil)
+	suite.idempotencyRepository.On("CreateRecord", mock.MatchedBy(func(record *entity.IdempotencyRecord) bool {
+		return record.UserID == 1 && record.IdempotencyKey == "key-1" && record.RequestHash == "hash" &&
+			record.ExpiresAt.After(time.Now().Add(59*time.Minute))
+	})).Return(&entity.IdempotencyRecord{ID: 1, UserID: 1, IdempotencyKey: "key-1", RequestHash: "hash"}, nil)
+
+	record, replay, err := suite.idempotencyUseCase.Begin(1, "key-1", "hash")
+	suite.Assert().Nil(err)
+	suite.Assert().False(replay)
+	suite.Assert().Equal(1, record.ID)
+}
+
+func (suite *IdempotencyUseCaseSuite) TestBeginReplaysCompletedRequest() {
+	stored := &entity.IdempotencyRecord{
+		ID: 1, UserID: 1, IdempotencyKey: "key-1", RequestHash: "hash",
+		StatusCode: 201, ResponseBody: `{"id":10}`, ExpiresAt: time.Now().Add(time.Hour),
+	}
+	suite.idempotencyRepository.On("GetRecord", 1, "key-1").Return(stored, nil)
+
+	record, replay, err := suite.idempotencyUseCase.Begin(1, "key-1", "hash")
+	suite.Assert().Nil(err)
+	suite.Assert().True(replay)
+	suite.Assert().Equal(`{"id":10}`, record.ResponseBody)
+	suite.idempotencyRepository.AssertNotCalled(suite.T(), "CreateRecord", mock.Anything)
+}
+
+func (suite *IdempotencyUseCaseSuite) TestBeginRejectsDifferentRequest() {
+	stored := &entity.IdempotencyRecord{
+		ID: 1, UserID: 1, IdempotencyKey: "key-1", RequestHash: "hash",
+		StatusCode: 201, ExpiresAt: time.Now().Add(time.Hour),
+	}
+	suite.idempotencyRepository.On("GetRecord", 1, "key-1").Return(stored, nil)
+
+	record, _, err := suite.idempotencyUseCase.Begin(1, "key-1", "other-hash")
+	suite.Assert().Nil(record)
+	suite.Assert().ErrorIs(err, usecase.ErrIdempotencyKeyReused)
+}
+
+func (suite *IdempotencyUseCaseSuite) TestBeginInProgress() {
+	stored := &entity.IdempotencyRecord{
+		ID: 1, UserID: 1, IdempotencyKey: "key-1", RequestHash: "hash",
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+	suite.idempotencyRepository.On("GetRecord", 1, "key-1").Return(stored, nil)
+
+	_, _, err := suite.idempotencyUseCase.Begin(1, "key-1", "hash")
+	suite.Assert().ErrorIs(err, usecase.ErrIdempotencyKeyInProgress)
+}
+
+func (suite *IdempotencyUseCaseSuite) TestBeginExpiredKeyIsReused() {
+	stored := &entity.IdempotencyRecord{
+		ID: 1, UserID: 1, IdempotencyKey: "key-1", RequestHash: "old-hash",
+		StatusCode: 201, ExpiresAt: time.Now().Add(-time.Minute),
+	}
+	suite.idempotencyRepository.On("GetRecord", 1, "key-1").Return(stored, nil)
+	suite.idempotencyRepository.On("DeleteRecord", 1).Return(nil)
+	suite.idempotencyRepository.On("CreateRecord", mock.AnythingOfType("*entity.IdempotencyRecord")).
+		Return(&entity.IdempotencyRecord{ID: 2, UserID: 1, IdempotencyKey: "key-1", RequestHash: "hash"}, nil)
+
+	record, replay, err := suite.idempotencyUseCase.Begin(1, "key-1", "hash")
+	suite.Assert().Nil(err)
+	suite.Assert().False(replay)
+	suite.Assert().Equal(2, record.ID)
+}
+
+func (suite *IdempotencyUseCaseSuite) TestBeginInvalidKey() {
+	_, _, err := suite.idempotencyUseCase.Begin(1, string(make([]byte, usecase.MaxIdempotencyKeyLength+1)), "hash")
+	suite.Assert().ErrorIs(err, usecase.ErrInvalidIdempotencyKey)
+	suite.idempotencyRepository.AssertNotCalled(suite.T(), "GetRecord", mock.Anything, mock.Anything)
+}
+
+func (suite *IdempotencyUseCaseSuite) TestComplete() {
+	record := &entity.IdempotencyRecord{ID: 1}
+	suite.idempotencyRepository.On("SaveResponse", mock.MatchedBy(func(r *entity.IdempotencyRecord) bool {
+		return r.StatusCode == 201 && r.ResponseBody == `{"id":10}` && r.ResponseHeaders == `{"ETag":"\"1\""}`
+	})).Return(nil)
+
+	err := suite.idempotencyUseCase.Complete(record, 201, map[string]string{"ETag": `"1"`}, []byte(`{"id":10}`))
+	suite.Assert().Nil(err)
+	suite.Assert().True(record.Completed())
+}
+
+func (suite *IdempotencyUseCaseSuite) TestPurgeExpired() {
+	suite.idempotencyRepository.On("DeleteExpiredRecords", mock.AnythingOfType("time.Time")).Return(int64(3), errors.New("purge error"))
+
+	deleted, err := suite.idempotencyUseCase.PurgeExpired()
+	suite.Assert().Equal(int64(3), deleted)
+	suite.Assert().Equal("purge error", err.Error())
+}
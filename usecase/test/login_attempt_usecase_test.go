@@ -0,0 +1,132 @@
+package usecase_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/usecase"
+)
+
+// loginAttemptConfig は待ち時間なしで5回失敗するとロックする設定
+var loginAttemptConfig = usecase.LoginAttemptConfig{
+	MaxAccountFailures: 5,
+	MaxIPFailures:      20,
+	LockoutDuration:    15 * time.Minute,
+	FailureWindow:      time.Hour,
+}
+
+func newLoginAttemptUseCase() usecase.LoginAttemptUseCase {
+	return usecase.NewLoginAttemptUseCase(gateway.NewInMemoryLoginAttemptStore(), loginAttemptConfig)
+}
+
+type LoginAttemptUseCaseSuite struct {
+	suite.Suite
+	loginAttemptUseCase usecase.LoginAttemptUseCase
+}
+
+func TestLoginAttemptUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(LoginAttemptUseCaseSuite))
+}
+
+func (suite *LoginAttemptUseCaseSuite) SetupTest() {
+	suite.loginAttemptUseCase = newLoginAttemptUseCase()
+}
+
+func (suite *LoginAttemptUseCaseSuite) TestProgressiveDelay() {
+	config := loginAttemptConfig
+	config.BaseDelay = time.Minute
+	config.MaxDelay = 3 * time.Minute
+	suite.loginAttemptUseCase = usecase.NewLoginAttemptUseCase(gateway.NewInMemoryLoginAttemptStore(), config)
+
+	suite.Assert().Nil(suite.loginAttemptUseCase.Check("test@example.com", "192.0.2.1"))
+
+	for i, want := range []time.Duration{time.Minute, 2 * time.Minute, 3 * time.Minute, 3 * time.Minute} {
+		_, err := suite.loginAttemptUseCase.RecordFailure("test@example.com", "192.0.2.1")
+		suite.Require().Nil(err)
+
+		err = suite.loginAttemptUseCase.Check("test@example.com", "192.0.2.1")
+		var throttled *usecase.LoginThrottledError
+		suite.Require().True(errors.As(err, &throttled), "failure %d", i+1)
+		suite.Assert().False(throttled.Locked)
+		suite.Assert().InDelta(want.Seconds(), throttled.RetryAfter.Seconds(), 1)
+	}
+}
+
+func (suite *LoginAttemptUseCaseSuite) TestAccountLockout() {
+	for i := 1; i < loginAttemptConfig.MaxAccountFailures; i++ {
+		locked, err := suite.loginAttemptUseCase.RecordFailure("test@example.com", "192.0.2.1")
+		suite.Require().Nil(err)
+		suite.Assert().False(locked)
+	}
+	suite.Assert().Nil(suite.loginAttemptUseCase.Check("test@example.com", "192.0.2.1"))
+
+	locked, err := suite.loginAttemptUseCase.RecordFailure("TEST@example.com", "192.0.2.1")
+	suite.Assert().Nil(err)
+	suite.Assert().True(locked)
+
+	// 別のIPアドレスからでもアカウントはロックされている
+	err = suite.loginAttemptUseCase.Check("test@example.com", "198.51.100.1")
+	suite.Assert().ErrorIs(err, usecase.ErrLoginThrottled)
+	var throttled *usecase.LoginThrottledError
+	suite.Require().True(errors.As(err, &throttled))
+	suite.Assert().True(throttled.Locked)
+	suite.Assert().InDelta(loginAttemptConfig.LockoutDuration.Seconds(), throttled.RetryAfter.Seconds(), 1)
+
+	suite.Assert().Nil(suite.loginAttemptUseCase.Unlock("test@example.com"))
+	suite.Assert().Nil(suite.loginAttemptUseCase.Check("test@example.com", "198.51.100.1"))
+}
+
+func (suite *LoginAttemptUseCaseSuite) TestIPLockout() {
+	// 異なるアカウントを順に試してもIPアドレス単位でロックされる
+	for i := 0; i < loginAttemptConfig.MaxIPFailures; i++ {
+		_, err := suite.loginAttemptUseCase.RecordFailure(string(rune('a'+i))+"@example.com", "192.0.2.1")
+		suite.Require().Nil(err)
+	}
+
+	suite.Assert().ErrorIs(suite.loginAttemptUseCase.Check("new@example.com", "192.0.2.1"), usecase.ErrLoginThrottled)
+	suite.Assert().Nil(suite.loginAttemptUseCase.Check("new@example.com", "198.51.100.1"))
+}
+
+func (suite *LoginAttemptUseCaseSuite) TestRecordSuccessKeepsIPFailures() {
+	for i := 0; i < loginAttemptConfig.MaxIPFailures-1; i++ {
+		_, err := suite.loginAttemptUseCase.RecordFailure(string(rune('a'+i))+"@example.com", "192.0.2.1")
+		suite.Require().Nil(err)
+	}
+	// 自分のアカウントにログインしてもIPアドレスの失敗回数はリセットされない
+	suite.Assert().Nil(suite.loginAttemptUseCase.RecordSuccess("attacker@example.com"))
+	_, err := suite.loginAttemptUseCase.RecordFailure("victim@example.com", "192.0.2.1")
+	suite.Assert().Nil(err)
+
+	suite.Assert().ErrorIs(suite.loginAttemptUseCase.Check("attacker@example.com", "192.0.2.1"), usecase.ErrLoginThrottled)
+}
+
+func (suite *LoginAttemptUseCaseSuite) TestDelayDoesNotOverflowAfterManyFailures() {
+	for _, maxDelay := range []time.Duration{0, time.Hour} {
+		config := loginAttemptConfig
+		config.MaxAccountFailures = 0
+		config.MaxIPFailures = 0
+		config.BaseDelay = time.Second
+		config.MaxDelay = maxDelay
+		suite.loginAttemptUseCase = usecase.NewLoginAttemptUseCase(gateway.NewInMemoryLoginAttemptStore(), config)
+
+		for i := 0; i < 100; i++ {
+			_, err := suite.loginAttemptUseCase.RecordFailure("test@example.com", "192.0.2.1")
+			suite.Require().Nil(err)
+		}
+
+		err := suite.loginAttemptUseCase.Check("test@example.com", "192.0.2.1")
+		var throttled *usecase.LoginThrottledError
+		suite.Require().True(errors.As(err, &throttled), "max delay %s", maxDelay)
+		suite.Assert().False(throttled.Locked)
+		suite.Assert().Greater(throttled.RetryAfter, time.Duration(0))
+		if maxDelay > 0 {
+			suite.Assert().LessOrEqual(throttled.RetryAfter, maxDelay)
+		} else {
+			suite.Assert().LessOrEqual(throttled.RetryAfter, 30*time.Second)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"household-account-backend/adapter/gateway"
 	"household-account-backend/entity"
 	"household-account-backend/usecase"
 )
@@ -15,7 +16,14 @@ type mockMonthlySummaryRepository struct {
 }
 
 func NewMockMonthlySummaryRepository() *mockMonthlySummaryRepository {
-	return new(mockMonthlySummaryRepository)
+	m := new(mockMonthlySummaryRepository)
+	m.On("RunInTransaction").Return().Maybe()
+	return m
+}
+
+func (m *mockMonthlySummaryRepository) RunInTransaction(fn func(repository gateway.MonthlySummaryRepository, outbox gateway.OutboxRepository) error) error {
+	m.Called()
+	return fn(m, NewMockOutboxRepository())
 }
 
 func (m *mockMonthlySummaryRepository) CreateMonthlySummary(summary *entity.MonthlySummary) (*entity.MonthlySummary, error) {
@@ -26,8 +34,8 @@ func (m *mockMonthlySummaryRepository) CreateMonthlySummary(summary *entity.Mont
 	return args.Get(0).(*entity.MonthlySummary), args.Error(1)
 }
 
-func (m *mockMonthlySummaryRepository) GetMonthlySummaryByID(summaryID int) (*entity.MonthlySummary, error) {
-	args := m.Called(summaryID)
+func (m *mockMonthlySummaryRepository) GetMonthlySummaryByID(userID int, summaryID int) (*entity.MonthlySummary, error) {
+	args := m.Called(userID, summaryID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -50,8 +58,8 @@ func (m *mockMonthlySummaryRepository) UpdateMonthlySummary(summary *entity.Mont
 	return args.Get(0).(*entity.MonthlySummary), args.Error(1)
 }
 
-func (m *mockMonthlySummaryRepository) DeleteMonthlySummary(summaryID int) error {
-	args := m.Called(summaryID)
+func (m *mockMonthlySummaryRepository) DeleteMonthlySummary(userID int, summaryID int, version int) error {
+	args := m.Called(userID, summaryID, version)
 	return args.Error(0)
 }
 
@@ -66,7 +74,7 @@ func TestMonthlySummaryUseCaseSuite(t *testing.T) {
 
 func (suite *MonthlySummaryUseCaseSuite) SetupTest() {
 	mockRepo := NewMockMonthlySummaryRepository()
-	suite.monthlySummaryUseCase = usecase.NewMonthlySummaryUseCase(mockRepo)
+	suite.monthlySummaryUseCase = usecase.NewMonthlySummaryUseCase(mockRepo, NewMockEventPublisher())
 }
 
 func (suite *MonthlySummaryUseCaseSuite) TestCreateMonthlySummary() {
@@ -79,7 +87,7 @@ func (suite *MonthlySummaryUseCaseSuite) TestCreateMonthlySummary() {
 	}
 
 	mockRepo := NewMockMonthlySummaryRepository()
-	suite.monthlySummaryUseCase = usecase.NewMonthlySummaryUseCase(mockRepo)
+	suite.monthlySummaryUseCase = usecase.NewMonthlySummaryUseCase(mockRepo, NewMockEventPublisher())
 	mockRepo.On("CreateMonthlySummary", summary).Return(summary, nil)
 
 	createdSummary, err := suite.monthlySummaryUseCase.CreateMonthlySummary(summary)
@@ -88,6 +96,25 @@ func (suite *MonthlySummaryUseCaseSuite) TestCreateMonthlySummary() {
 	suite.Assert().Equal(2000.00, createdSummary.Balance)
 }
 
+func (suite *MonthlySummaryUseCaseSuite) TestCreateMonthlySummaryInvalid() {
+	mockRepo := NewMockMonthlySummaryRepository()
+	suite.monthlySummaryUseCase = usecase.NewMonthlySummaryUseCase(mockRepo, NewMockEventPublisher())
+
+	_, err := suite.monthlySummaryUseCase.CreateMonthlySummary(&entity.MonthlySummary{
+		UserID:    1,
+		YearMonth: "2025-13",
+		Income:    5000.00,
+		Expense:   -1,
+	})
+	var validation *usecase.ValidationError
+	suite.Require().ErrorAs(err, &validation)
+	suite.Assert().Equal([]usecase.FieldError{
+		{Field: "year_month", Message: "must be in YYYY-MM format"},
+		{Field: "expense", Message: "must be 0 or greater"},
+	}, validation.Fields)
+	mockRepo.AssertNotCalled(suite.T(), "CreateMonthlySummary", mock.Anything)
+}
+
 func (suite *MonthlySummaryUseCaseSuite) TestGetMonthlySummaryByID() {
 	summary := &entity.MonthlySummary{
 		ID:        1,
@@ -99,10 +126,10 @@ func (suite *MonthlySummaryUseCaseSuite) TestGetMonthlySummaryByID() {
 	}
 
 	mockRepo := NewMockMonthlySummaryRepository()
-	suite.monthlySummaryUseCase = usecase.NewMonthlySummaryUseCase(mockRepo)
-	mockRepo.On("GetMonthlySummaryByID", summary.ID).Return(summary, nil)
+	suite.monthlySummaryUseCase = usecase.NewMonthlySummaryUseCase(mockRepo, NewMockEventPublisher())
+	mockRepo.On("GetMonthlySummaryByID", summary.UserID, summary.ID).Return(summary, nil)
 
-	retrievedSummary, err := suite.monthlySummaryUseCase.GetMonthlySummaryByID(summary.ID)
+	retrievedSummary, err := suite.monthlySummaryUseCase.GetMonthlySummaryByID(summary.UserID, summary.ID)
 	suite.Assert().Nil(err)
 	suite.Assert().Equal("2025-01", retrievedSummary.YearMonth)
 	suite.Assert().Equal(2000.00, retrievedSummary.Balance)
@@ -129,7 +156,7 @@ func (suite *MonthlySummaryUseCaseSuite) TestGetMonthlySummariesByUserID() {
 	}
 
 	mockRepo := NewMockMonthlySummaryRepository()
-	suite.monthlySummaryUseCase = usecase.NewMonthlySummaryUseCase(mockRepo)
+	suite.monthlySummaryUseCase = usecase.NewMonthlySummaryUseCase(mockRepo, NewMockEventPublisher())
 	mockRepo.On("GetMonthlySummariesByUserID", 1).Return(summaries, nil)
 
 	retrievedSummaries, err := suite.monthlySummaryUseCase.GetMonthlySummariesByUserID(1)
@@ -150,7 +177,7 @@ func (suite *MonthlySummaryUseCaseSuite) TestUpdateMonthlySummary() {
 	}
 
 	mockRepo := NewMockMonthlySummaryRepository()
-	suite.monthlySummaryUseCase = usecase.NewMonthlySummaryUseCase(mockRepo)
+	suite.monthlySummaryUseCase = usecase.NewMonthlySummaryUseCase(mockRepo, NewMockEventPublisher())
 	mockRepo.On("UpdateMonthlySummary", summary).Return(summary, nil)
 
 	updatedSummary, err := suite.monthlySummaryUseCase.UpdateMonthlySummary(summary)
@@ -162,9 +189,9 @@ func (suite *MonthlySummaryUseCaseSuite) TestUpdateMonthlySummary() {
 
 func (suite *MonthlySummaryUseCaseSuite) TestDeleteMonthlySummary() {
 	mockRepo := NewMockMonthlySummaryRepository()
-	suite.monthlySummaryUseCase = usecase.NewMonthlySummaryUseCase(mockRepo)
-	mockRepo.On("DeleteMonthlySummary", 1).Return(nil)
+	suite.monthlySummaryUseCase = usecase.NewMonthlySummaryUseCase(mockRepo, NewMockEventPublisher())
+	mockRepo.On("DeleteMonthlySummary", 1, 1, 1).Return(nil)
 
-	err := suite.monthlySummaryUseCase.DeleteMonthlySummary(1)
+	err := suite.monthlySummaryUseCase.DeleteMonthlySummary(1, 1, 1)
 	suite.Assert().Nil(err)
 }
@@ -0,0 +1,295 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/entity"
+	"household-account-backend/usecase"
+)
+
+type mockOIDCProvider struct {
+	mock.Mock
+}
+
+func NewMockOIDCProvider() *mockOIDCProvider {
+	m := new(mockOIDCProvider)
+	m.On("Name").Return("mock").Maybe()
+	return m
+}
+
+func (m *mockOIDCProvider) Name() string {
+	args := m.Called()
+	return args.String(0)
+}
+
+func (m *mockOIDCProvider) AuthCodeURL(ctx context.Context, state string, nonce string, codeVerifier string) (string, error) {
+	args := m.Called(ctx, state, nonce, codeVerifier)
+	return args.String(0), args.Error(1)
+}
+
+func (m *mockOIDCProvider) Exchange(ctx context.Context, code string, codeVerifier string, nonce string) (*gateway.OIDCUserInfo, error) {
+	args := m.Called(ctx, code, codeVerifier, nonce)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*gateway.OIDCUserInfo), args.Error(1)
+}
+
+type mockUserIdentityRepository struct {
+	mock.Mock
+}
+
+func NewMockUserIdentityRepository() *mockUserIdentityRepository {
+	return new(mockUserIdentityRepository)
+}
+
+func (m *mockUserIdentityRepository) CreateIdentity(identity *entity.UserIdentity) (*entity.UserIdentity, error) {
+	args := m.Called(identity)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.UserIdentity), args.Error(1)
+}
+
+func (m *mockUserIdentityRepository) GetIdentity(provider string, subject string) (*entity.UserIdentity, error) {
+	args := m.Called(provider, subject)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.UserIdentity), args.Error(1)
+}
+
+func (m *mockUserIdentityRepository) GetIdentitiesByUserID(userID int) ([]entity.UserIdentity, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.UserIdentity), args.Error(1)
+}
+
+func (m *mockUserIdentityRepository) DeleteIdentity(userID int, provider string) (int64, error) {
+	args := m.Called(userID, provider)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *mockUserIdentityRepository) CreateState(state *entity.OIDCLoginState) (*entity.OIDCLoginState, error) {
+	args := m.Called(state)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.OIDCLoginState), args.Error(1)
+}
+
+func (m *mockUserIdentityRepository) ConsumeState(stateHash string) (*entity.OIDCLoginState, error) {
+	args := m.Called(stateHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.OIDCLoginState), args.Error(1)
+}
+
+func (m *mockUserIdentityRepository) DeleteExpiredStates(now time.Time) (int64, error) {
+	args := m.Called(now)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+type mockUserUseCase struct {
+	usecase.UserUseCase
+	mock.Mock
+}
+
+func (m *mockUserUseCase) CompleteLogin(userId int) (*usecase.LoginResult, error) {
+	args := m.Called(userId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecase.LoginResult), args.Error(1)
+}
+
+type OIDCUseCaseSuite struct {
+	suite.Suite
+	provider     *mockOIDCProvider
+	identityRepo *mockUserIdentityRepository
+	userRepo     *mockUserRepository
+	userUseCase  *mockUserUseCase
+	oidcUseCase  usecase.OIDCUseCase
+}
+
+func TestOIDCUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(OIDCUseCaseSuite))
+}
+
+func (suite *OIDCUseCaseSuite) SetupTest() {
+	suite.provider = NewMockOIDCProvider()
+	suite.identityRepo = NewMockUserIdentityRepository()
+	suite.userRepo = NewMockUserRepository()
+	suite.userUseCase = new(mockUserUseCase)
+	suite.oidcUseCase = usecase.NewOIDCUseCase([]gateway.OIDCProvider{suite.provider}, suite.identityRepo, suite.userRepo, suite.userUseCase, 10*time.Minute)
+}
+
+// expectCallback はstateの取り出しとプロバイダでの認可コード交換を設定する
+func (suite *OIDCUseCaseSuite) expectCallback(linkUserID int, userInfo *gateway.OIDCUserInfo) {
+	suite.identityRepo.On("ConsumeState", usecase.HashUserToken("state")).Return(&entity.OIDCLoginState{
+		Provider:     "mock",
+		CodeVerifier: "verifier",
+		Nonce:        "nonce",
+		LinkUserID:   linkUserID,
+		ExpiresAt:    time.Now().Add(time.Minute),
+	}, nil)
+	suite.provider.On("Exchange", mock.Anything, "code", "verifier", "nonce").Return(userInfo, nil)
+}
+
+func (suite *OIDCUseCaseSuite) TestBeginAuthorization() {
+	suite.identityRepo.On("DeleteExpiredStates", mock.AnythingOfType("time.Time")).Return(int64(0), nil)
+	suite.provider.On("AuthCodeURL", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return("https://idp.example.com/authorize", nil)
+	suite.identityRepo.On("CreateState", mock.Anything).Return(&entity.OIDCLoginState{ID: 1}, nil)
+
+	authorization, err := suite.oidcUseCase.BeginAuthorization(context.Background(), "mock", 0)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal("https://idp.example.com/authorize", authorization.URL)
+
+	// stateはハッシュのみを保存し、プロバイダに渡したPKCEとnonceを保持する
+	authArgs := suite.provider.Calls[len(suite.provider.Calls)-1].Arguments
+	state := suite.identityRepo.Calls[1].Arguments.Get(0).(*entity.OIDCLoginState)
+	suite.Assert().Equal(authorization.State, authArgs.String(1))
+	suite.Assert().Equal(usecase.HashUserToken(authorization.State), state.StateHash)
+	suite.Assert().Equal(authArgs.String(2), state.Nonce)
+	suite.Assert().Equal(authArgs.String(3), state.CodeVerifier)
+	suite.Assert().Equal("mock", state.Provider)
+}
+
+func (suite *OIDCUseCaseSuite) TestBeginAuthorization_UnknownProvider() {
+	_, err := suite.oidcUseCase.BeginAuthorization(context.Background(), "unknown", 0)
+	suite.Assert().ErrorIs(err, usecase.ErrUnknownOIDCProvider)
+}
+
+func (suite *OIDCUseCaseSuite) TestHandleCallback_InvalidState() {
+	suite.identityRepo.On("ConsumeState", mock.Anything).Return(nil, nil)
+
+	_, err := suite.oidcUseCase.HandleCallback(context.Background(), "mock", "state", "code")
+	suite.Assert().ErrorIs(err, usecase.ErrInvalidOIDCState)
+	suite.provider.AssertNotCalled(suite.T(), "Exchange", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *OIDCUseCaseSuite) TestHandleCallback_LinkedIdentity() {
+	suite.expectCallback(0, &gateway.OIDCUserInfo{Subject: "sub", Email: "test@example.com", EmailVerified: true})
+	suite.identityRepo.On("GetIdentity", "mock", "sub").Return(&entity.UserIdentity{UserID: 3}, nil)
+	suite.userRepo.On("GetCurrentUser", 3).Return(&entity.User{ID: 3}, nil)
+	suite.userUseCase.On("CompleteLogin", 3).Return(&usecase.LoginResult{AuthToken: "token"}, nil)
+
+	result, err := suite.oidcUseCase.HandleCallback(context.Background(), "mock", "state", "code")
+	suite.Assert().Nil(err)
+	suite.Assert().Equal("token", result.Login.AuthToken)
+}
+
+func (suite *OIDCUseCaseSuite) TestHandleCallback_DisabledUser() {
+	disabledAt := time.Now()
+	suite.expectCallback(0, &gateway.OIDCUserInfo{Subject: "sub", Email: "test@example.com", EmailVerified: true})
+	suite.identityRepo.On("GetIdentity", "mock", "sub").Return(&entity.UserIdentity{UserID: 3}, nil)
+	suite.userRepo.On("GetCurrentUser", 3).Return(&entity.User{ID: 3, DisabledAt: &disabledAt}, nil)
+
+	_, err := suite.oidcUseCase.HandleCallback(context.Background(), "mock", "state", "code")
+	suite.Assert().ErrorIs(err, usecase.ErrAccountDisabled)
+	suite.userUseCase.AssertNotCalled(suite.T(), "CompleteLogin", mock.Anything)
+}
+
+func (suite *OIDCUseCaseSuite) TestHandleCallback_CreatesUser() {
+	suite.expectCallback(0, &gateway.OIDCUserInfo{Subject: "sub", Email: "new@example.com", EmailVerified: true, Name: "A very long display name"})
+	suite.identityRepo.On("GetIdentity", "mock", "sub").Return(nil, nil)
+	suite.userRepo.On("GetUserByEmail", "new@example.com").Return(nil, gateway.ErrRecordNotFound)
+	suite.userRepo.On("Signup", mock.MatchedBy(func(user *entity.User) bool {
+		return user.Email == "new@example.com" &&
+			user.EmailVerified() &&
+			len([]rune(user.Name)) == 20 &&
+			user.Password != ""
+	})).Return(&entity.User{ID: 4, Email: "new@example.com"}, nil)
+	suite.identityRepo.On("CreateIdentity", mock.MatchedBy(func(identity *entity.UserIdentity) bool {
+		return identity.UserID == 4 && identity.Provider == "mock" && identity.Subject == "sub"
+	})).Return(&entity.UserIdentity{ID: 1}, nil)
+	suite.userRepo.On("GetCurrentUser", 4).Return(&entity.User{ID: 4}, nil)
+	suite.userUseCase.On("CompleteLogin", 4).Return(&usecase.LoginResult{AuthToken: "token"}, nil)
+
+	result, err := suite.oidcUseCase.HandleCallback(context.Background(), "mock", "state", "code")
+	suite.Assert().Nil(err)
+	suite.Assert().Equal("token", result.Login.AuthToken)
+}
+
+func (suite *OIDCUseCaseSuite) TestHandleCallback_DoesNotLinkVerifiedLocalUser() {
+	verifiedAt := time.Now()
+	suite.expectCallback(0, &gateway.OIDCUserInfo{Subject: "sub", Email: "test@example.com", EmailVerified: true})
+	suite.identityRepo.On("GetIdentity", "mock", "sub").Return(nil, nil)
+	suite.userRepo.On("GetUserByEmail", "test@example.com").Return(&entity.User{ID: 5, Password: "hashed", EmailVerifiedAt: &verifiedAt}, nil)
+
+	_, err := suite.oidcUseCase.HandleCallback(context.Background(), "mock", "state", "code")
+	suite.Assert().ErrorIs(err, usecase.ErrOIDCLinkRequired)
+	suite.identityRepo.AssertNotCalled(suite.T(), "CreateIdentity", mock.Anything)
+	suite.userUseCase.AssertNotCalled(suite.T(), "CompleteLogin", mock.Anything)
+}
+
+func (suite *OIDCUseCaseSuite) TestHandleCallback_DoesNotLinkUnverifiedLocalUser() {
+	suite.expectCallback(0, &gateway.OIDCUserInfo{Subject: "sub", Email: "test@example.com", EmailVerified: true})
+	suite.identityRepo.On("GetIdentity", "mock", "sub").Return(nil, nil)
+	suite.userRepo.On("GetUserByEmail", "test@example.com").Return(&entity.User{ID: 5}, nil)
+
+	_, err := suite.oidcUseCase.HandleCallback(context.Background(), "mock", "state", "code")
+	suite.Assert().ErrorIs(err, usecase.ErrOIDCLinkRequired)
+	suite.identityRepo.AssertNotCalled(suite.T(), "CreateIdentity", mock.Anything)
+}
+
+func (suite *OIDCUseCaseSuite) TestHandleCallback_ProviderEmailNotVerified() {
+	suite.expectCallback(0, &gateway.OIDCUserInfo{Subject: "sub", Email: "test@example.com", EmailVerified: false})
+	suite.identityRepo.On("GetIdentity", "mock", "sub").Return(nil, nil)
+
+	_, err := suite.oidcUseCase.HandleCallback(context.Background(), "mock", "state", "code")
+	suite.Assert().ErrorIs(err, usecase.ErrOIDCEmailNotVerified)
+	suite.userRepo.AssertNotCalled(suite.T(), "GetUserByEmail", mock.Anything)
+}
+
+func (suite *OIDCUseCaseSuite) TestHandleCallback_LinkToCurrentUser() {
+	// プロフィールからの紐付けではメールアドレスが異なっていても紐付ける
+	suite.expectCallback(7, &gateway.OIDCUserInfo{Subject: "sub", Email: "other@example.com"})
+	suite.identityRepo.On("GetIdentity", "mock", "sub").Return(nil, nil)
+	suite.identityRepo.On("CreateIdentity", mock.MatchedBy(func(identity *entity.UserIdentity) bool {
+		return identity.UserID == 7 && identity.Email == "other@example.com"
+	})).Return(&entity.UserIdentity{ID: 1}, nil)
+
+	result, err := suite.oidcUseCase.HandleCallback(context.Background(), "mock", "state", "code")
+	suite.Assert().Nil(err)
+	suite.Assert().True(result.Linked)
+	suite.userUseCase.AssertNotCalled(suite.T(), "CompleteLogin", mock.Anything)
+}
+
+func (suite *OIDCUseCaseSuite) TestHandleCallback_LinkAlreadyLinkedToAnotherUser() {
+	suite.expectCallback(7, &gateway.OIDCUserInfo{Subject: "sub"})
+	suite.identityRepo.On("GetIdentity", "mock", "sub").Return(&entity.UserIdentity{UserID: 8}, nil)
+
+	_, err := suite.oidcUseCase.HandleCallback(context.Background(), "mock", "state", "code")
+	suite.Assert().ErrorIs(err, usecase.ErrIdentityAlreadyLinked)
+}
+
+func (suite *OIDCUseCaseSuite) TestUnlinkIdentity() {
+	suite.identityRepo.On("DeleteIdentity", 1, "mock").Return(int64(1), nil).Once()
+	suite.identityRepo.On("DeleteIdentity", 1, "mock").Return(int64(0), nil)
+
+	suite.Assert().Nil(suite.oidcUseCase.UnlinkIdentity(1, "mock"))
+	suite.Assert().ErrorIs(suite.oidcUseCase.UnlinkIdentity(1, "mock"), usecase.ErrIdentityNotFound)
+}
+
+func (suite *OIDCUseCaseSuite) TestHandleCallback_ExchangeFailure() {
+	suite.identityRepo.On("ConsumeState", mock.Anything).Return(&entity.OIDCLoginState{
+		Provider:  "mock",
+		ExpiresAt: time.Now().Add(time.Minute),
+	}, nil)
+	suite.provider.On("Exchange", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("invalid_grant"))
+
+	_, err := suite.oidcUseCase.HandleCallback(context.Background(), "mock", "state", "code")
+	suite.Assert().NotNil(err)
+	suite.identityRepo.AssertNotCalled(suite.T(), "GetIdentity", mock.Anything, mock.Anything)
+}
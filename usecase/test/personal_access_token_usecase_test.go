@@ -0,0 +1,149 @@
+package usecase_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/entity"
+	"household-account-backend/usecase"
+)
+
+type mockPersonalAccessTokenRepository struct {
+	mock.Mock
+}
+
+func NewMockPersonalAccessTokenRepository() *mockPersonalAccessTokenRepository {
+	return new(mockPersonalAccessTokenRepository)
+}
+
+func (m *mockPersonalAccessTokenRepository) CreateToken(token *entity.PersonalAccessToken) (*entity.PersonalAccessToken, error) {
+	args := m.Called(token)
+	if fn, ok := args.Get(0).(func(*entity.PersonalAccessToken) *entity.PersonalAccessToken); ok {
+		return fn(token), args.Error(1)
+	}
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.PersonalAccessToken), args.Error(1)
+}
+
+func (m *mockPersonalAccessTokenRepository) GetTokenByHash(tokenHash string) (*entity.PersonalAccessToken, error) {
+	args := m.Called(tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.PersonalAccessToken), args.Error(1)
+}
+
+func (m *mockPersonalAccessTokenRepository) GetTokensByUserID(userID int) ([]entity.PersonalAccessToken, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.PersonalAccessToken), args.Error(1)
+}
+
+func (m *mockPersonalAccessTokenRepository) DeleteToken(userID int, tokenID int) error {
+	args := m.Called(userID, tokenID)
+	return args.Error(0)
+}
+
+func (m *mockPersonalAccessTokenRepository) UpdateLastUsedAt(tokenID int, usedAt time.Time) error {
+	args := m.Called(tokenID, usedAt)
+	return args.Error(0)
+}
+
+type PersonalAccessTokenUseCaseSuite struct {
+	suite.Suite
+	repository *mockPersonalAccessTokenRepository
+	useCase    usecase.PersonalAccessTokenUseCase
+}
+
+func TestPersonalAccessTokenUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(PersonalAccessTokenUseCaseSuite))
+}
+
+func (suite *PersonalAccessTokenUseCaseSuite) SetupTest() {
+	suite.repository = NewMockPersonalAccessTokenRepository()
+	suite.useCase = usecase.NewPersonalAccessTokenUseCase(suite.repository)
+}
+
+func (suite *PersonalAccessTokenUseCaseSuite) TestCreateToken() {
+	suite.repository.On("CreateToken", mock.Anything).Return(func(token *entity.PersonalAccessToken) *entity.PersonalAccessToken {
+		token.ID = 1
+		return token
+	}, nil)
+
+	createdToken, plainToken, err := suite.useCase.CreateToken(&entity.PersonalAccessToken{
+		UserID: 1,
+		Name:   " import script ",
+		Scopes: entity.ScopeWriteTransactions,
+	})
+	suite.Require().Nil(err)
+	suite.Assert().True(strings.HasPrefix(plainToken, usecase.PersonalAccessTokenPrefix))
+	suite.Assert().Equal("import script", createdToken.Name)
+	// トークン本体は保存しない
+	suite.Assert().Equal(usecase.HashUserToken(plainToken), createdToken.TokenHash)
+	suite.Assert().True(strings.HasPrefix(plainToken, createdToken.TokenPrefix))
+	suite.Assert().Less(len(createdToken.TokenPrefix), len(plainToken))
+}
+
+func (suite *PersonalAccessTokenUseCaseSuite) TestCreateToken_Validation() {
+	past := time.Now().Add(-time.Hour)
+	cases := []struct {
+		token *entity.PersonalAccessToken
+		err   error
+	}{
+		{&entity.PersonalAccessToken{Name: "", Scopes: entity.ScopeReadTransactions}, usecase.ErrInvalidTokenName},
+		{&entity.PersonalAccessToken{Name: strings.Repeat("a", 101), Scopes: entity.ScopeReadTransactions}, usecase.ErrInvalidTokenName},
+		{&entity.PersonalAccessToken{Name: "script", Scopes: ""}, usecase.ErrInvalidTokenScope},
+		{&entity.PersonalAccessToken{Name: "script", Scopes: "admin"}, usecase.ErrInvalidTokenScope},
+		{&entity.PersonalAccessToken{Name: "script", Scopes: entity.ScopeReadTransactions, ExpiresAt: &past}, usecase.ErrInvalidTokenExpiry},
+	}
+	for _, c := range cases {
+		_, _, err := suite.useCase.CreateToken(c.token)
+		suite.Assert().ErrorIs(err, c.err)
+	}
+	suite.repository.AssertNotCalled(suite.T(), "CreateToken", mock.Anything)
+}
+
+func (suite *PersonalAccessTokenUseCaseSuite) TestAuthenticate() {
+	suite.repository.On("GetTokenByHash", usecase.HashUserToken("hha_token")).Return(&entity.PersonalAccessToken{ID: 1, UserID: 2}, nil)
+	suite.repository.On("UpdateLastUsedAt", 1, mock.AnythingOfType("time.Time")).Return(nil)
+
+	token, err := suite.useCase.Authenticate("hha_token")
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(2, token.UserID)
+	suite.Assert().NotNil(token.LastUsedAt)
+}
+
+func (suite *PersonalAccessTokenUseCaseSuite) TestAuthenticate_SkipsRecentLastUsedUpdate() {
+	recent := time.Now().Add(-10 * time.Second)
+	suite.repository.On("GetTokenByHash", mock.Anything).Return(&entity.PersonalAccessToken{ID: 1, UserID: 2, LastUsedAt: &recent}, nil)
+
+	_, err := suite.useCase.Authenticate("hha_token")
+	suite.Assert().Nil(err)
+	suite.repository.AssertNotCalled(suite.T(), "UpdateLastUsedAt", mock.Anything, mock.Anything)
+}
+
+func (suite *PersonalAccessTokenUseCaseSuite) TestAuthenticate_Invalid() {
+	expired := time.Now().Add(-time.Minute)
+	suite.repository.On("GetTokenByHash", usecase.HashUserToken("hha_unknown")).Return(nil, nil)
+	suite.repository.On("GetTokenByHash", usecase.HashUserToken("hha_expired")).Return(&entity.PersonalAccessToken{ID: 1, ExpiresAt: &expired}, nil)
+
+	for _, token := range []string{"hha_unknown", "hha_expired", "jwt.looking.token"} {
+		_, err := suite.useCase.Authenticate(token)
+		suite.Assert().ErrorIs(err, usecase.ErrInvalidAccessToken, token)
+	}
+}
+
+func (suite *PersonalAccessTokenUseCaseSuite) TestDeleteToken_NotFound() {
+	suite.repository.On("DeleteToken", 1, 99).Return(gateway.ErrRecordNotFound)
+
+	suite.Assert().ErrorIs(suite.useCase.DeleteToken(1, 99), usecase.ErrAccessTokenNotFound)
+}
@@ -0,0 +1,60 @@
+package usecase_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/entity"
+	"household-account-backend/usecase"
+)
+
+type SessionUseCaseSuite struct {
+	suite.Suite
+	userRepository *mockUserRepository
+	useCase        usecase.SessionUseCase
+}
+
+func TestSessionUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(SessionUseCaseSuite))
+}
+
+func (suite *SessionUseCaseSuite) SetupTest() {
+	suite.userRepository = NewMockUserRepository()
+	suite.useCase = usecase.NewSessionUseCase(suite.userRepository)
+}
+
+func (suite *SessionUseCaseSuite) TestAuthorize() {
+	revokedAt := time.Now().Add(-time.Hour)
+	suite.userRepository.On("GetCurrentUser", 1).Return(&entity.User{ID: 1, SessionsRevokedAt: &revokedAt}, nil)
+
+	issuedAt := time.Now()
+	user, err := suite.useCase.Authorize(1, &issuedAt)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(1, user.ID)
+
+	issuedAt = revokedAt.Add(-time.Minute)
+	_, err = suite.useCase.Authorize(1, &issuedAt)
+	suite.Assert().ErrorIs(err, usecase.ErrSessionRevoked)
+
+	// パーソナルアクセストークンは強制ログアウトの対象外
+	_, err = suite.useCase.Authorize(1, nil)
+	suite.Assert().Nil(err)
+}
+
+func (suite *SessionUseCaseSuite) TestAuthorizeDisabledUser() {
+	disabledAt := time.Now()
+	suite.userRepository.On("GetCurrentUser", 1).Return(&entity.User{ID: 1, DisabledAt: &disabledAt}, nil)
+
+	_, err := suite.useCase.Authorize(1, nil)
+	suite.Assert().ErrorIs(err, usecase.ErrAccountDisabled)
+}
+
+func (suite *SessionUseCaseSuite) TestAuthorizeDeletedUser() {
+	suite.userRepository.On("GetCurrentUser", 1).Return(nil, gateway.ErrRecordNotFound)
+
+	_, err := suite.useCase.Authorize(1, nil)
+	suite.Assert().ErrorIs(err, usecase.ErrSessionRevoked)
+}
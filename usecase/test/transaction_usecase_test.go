@@ -1,12 +1,14 @@
 package usecase_test
 
 import (
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"household-account-backend/adapter/gateway"
 	"household-account-backend/entity"
 	"household-account-backend/usecase"
 )
@@ -16,7 +18,9 @@ type mockTransactionRepository struct {
 }
 
 func NewMockTransactionRepository() *mockTransactionRepository {
-	return new(mockTransactionRepository)
+	m := new(mockTransactionRepository)
+	m.On("RunInTransaction").Return().Maybe()
+	return m
 }
 
 func (m *mockTransactionRepository) CreateTransaction(transaction *entity.Transaction) (*entity.Transaction, error) {
@@ -27,8 +31,8 @@ func (m *mockTransactionRepository) CreateTransaction(transaction *entity.Transa
 	return args.Get(0).(*entity.Transaction), args.Error(1)
 }
 
-func (m *mockTransactionRepository) GetTransactionByID(transactionID int) (*entity.Transaction, error) {
-	args := m.Called(transactionID)
+func (m *mockTransactionRepository) GetTransactionByID(userID int, transactionID int) (*entity.Transaction, error) {
+	args := m.Called(userID, transactionID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -43,6 +47,14 @@ func (m *mockTransactionRepository) GetTransactionsByUserID(userID int) ([]entit
 	return args.Get(0).([]entity.Transaction), args.Error(1)
 }
 
+func (m *mockTransactionRepository) FindTransactions(userID int, filter entity.TransactionFilter) ([]entity.Transaction, error) {
+	args := m.Called(userID, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.Transaction), args.Error(1)
+}
+
 func (m *mockTransactionRepository) UpdateTransaction(transaction *entity.Transaction) (*entity.Transaction, error) {
 	args := m.Called(transaction)
 	if args.Get(0) == nil {
@@ -51,23 +63,37 @@ func (m *mockTransactionRepository) UpdateTransaction(transaction *entity.Transa
 	return args.Get(0).(*entity.Transaction), args.Error(1)
 }
 
-func (m *mockTransactionRepository) DeleteTransaction(transactionID int) error {
-	args := m.Called(transactionID)
+func (m *mockTransactionRepository) DeleteTransaction(userID int, transactionID int, version int) error {
+	args := m.Called(userID, transactionID, version)
 	return args.Error(0)
 }
 
+func (m *mockTransactionRepository) RunInTransaction(fn func(repository gateway.TransactionRepository, outbox gateway.OutboxRepository) error) error {
+	m.Called()
+	return fn(m, NewMockOutboxRepository())
+}
+
 type TransactionUseCaseSuite struct {
 	suite.Suite
 	transactionUseCase usecase.TransactionUseCase
 }
 
+var transactionDate = time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// newOwnedCategoryRepository はユーザー1のカテゴリーとして全てのIDを返すリポジトリを作成する
+func newOwnedCategoryRepository() *mockCategoryRepository {
+	categoryRepo := NewMockCategoryRepository()
+	categoryRepo.On("GetCategoryByID", 1, mock.Anything).Return(&entity.Category{ID: 1, UserID: 1, Type: entity.CategoryTypeExpense}, nil)
+	return categoryRepo
+}
+
 func TestTransactionUseCaseSuite(t *testing.T) {
 	suite.Run(t, new(TransactionUseCaseSuite))
 }
 
 func (suite *TransactionUseCaseSuite) SetupTest() {
 	mockRepo := NewMockTransactionRepository()
-	suite.transactionUseCase = usecase.NewTransactionUseCase(mockRepo)
+	suite.transactionUseCase = usecase.NewTransactionUseCase(mockRepo, newOwnedCategoryRepository(), usecase.SignPolicyPositive, NewMockEventPublisher())
 }
 
 func (suite *TransactionUseCaseSuite) TestCreateTransaction() {
@@ -80,15 +106,57 @@ func (suite *TransactionUseCaseSuite) TestCreateTransaction() {
 	}
 
 	mockRepo := NewMockTransactionRepository()
-	suite.transactionUseCase = usecase.NewTransactionUseCase(mockRepo)
+	suite.transactionUseCase = usecase.NewTransactionUseCase(mockRepo, newOwnedCategoryRepository(), usecase.SignPolicyPositive, NewMockEventPublisher())
 	mockRepo.On("CreateTransaction", transaction).Return(transaction, nil)
 
 	createdTransaction, err := suite.transactionUseCase.CreateTransaction(transaction)
 	suite.Assert().Nil(err)
-	suite.Assert().Equal(100.00, createdTransaction.Amount)
+	suite.Assert().Equal(float32(100.00), createdTransaction.Amount)
 	suite.Assert().Equal("Groceries", createdTransaction.Content)
 }
 
+func (suite *TransactionUseCaseSuite) TestCreateTransactionInvalidAmount() {
+	mockRepo := NewMockTransactionRepository()
+	suite.transactionUseCase = usecase.NewTransactionUseCase(mockRepo, newOwnedCategoryRepository(), usecase.SignPolicyPositive, NewMockEventPublisher())
+
+	_, err := suite.transactionUseCase.CreateTransaction(&entity.Transaction{UserID: 1, CategoryID: 1, Date: transactionDate, Amount: 0})
+	var validation *usecase.ValidationError
+	suite.Require().ErrorAs(err, &validation)
+	suite.Assert().Equal([]usecase.FieldError{{Field: "amount", Message: "must be greater than 0"}}, validation.Fields)
+	mockRepo.AssertNotCalled(suite.T(), "CreateTransaction", mock.Anything)
+}
+
+func (suite *TransactionUseCaseSuite) TestCreateTransactionSignedPolicy() {
+	mockRepo := NewMockTransactionRepository()
+	suite.transactionUseCase = usecase.NewTransactionUseCase(mockRepo, newOwnedCategoryRepository(), usecase.SignPolicySigned, NewMockEventPublisher())
+
+	// 支出のカテゴリーでは負の金額のみ受け付ける
+	_, err := suite.transactionUseCase.CreateTransaction(&entity.Transaction{UserID: 1, CategoryID: 1, Date: transactionDate, Amount: 100.00})
+	var validation *usecase.ValidationError
+	suite.Require().ErrorAs(err, &validation)
+	suite.Assert().Equal([]usecase.FieldError{{Field: "amount", Message: "must be less than 0 for expense categories"}}, validation.Fields)
+	mockRepo.AssertNotCalled(suite.T(), "CreateTransaction", mock.Anything)
+
+	transaction := &entity.Transaction{UserID: 1, CategoryID: 1, Date: transactionDate, Amount: -100.00}
+	mockRepo.On("CreateTransaction", transaction).Return(transaction, nil)
+	_, err = suite.transactionUseCase.CreateTransaction(transaction)
+	suite.Assert().Nil(err)
+}
+
+func (suite *TransactionUseCaseSuite) TestCreateTransactionOtherUsersCategory() {
+	mockRepo := NewMockTransactionRepository()
+	categoryRepo := NewMockCategoryRepository()
+	suite.transactionUseCase = usecase.NewTransactionUseCase(mockRepo, categoryRepo, usecase.SignPolicyPositive, NewMockEventPublisher())
+	// 他のユーザーのカテゴリーはユーザーIDで絞り込むと見つからない
+	categoryRepo.On("GetCategoryByID", 1, 5).Return(nil, gateway.ErrRecordNotFound)
+
+	_, err := suite.transactionUseCase.CreateTransaction(&entity.Transaction{UserID: 1, CategoryID: 5, Date: transactionDate, Amount: 100.00})
+	var validation *usecase.ValidationError
+	suite.Require().ErrorAs(err, &validation)
+	suite.Assert().Equal("category_id", validation.Fields[0].Field)
+	mockRepo.AssertNotCalled(suite.T(), "CreateTransaction", mock.Anything)
+}
+
 func (suite *TransactionUseCaseSuite) TestGetTransactionByID() {
 	transaction := &entity.Transaction{
 		ID:         1,
@@ -100,15 +168,26 @@ func (suite *TransactionUseCaseSuite) TestGetTransactionByID() {
 	}
 
 	mockRepo := NewMockTransactionRepository()
-	suite.transactionUseCase = usecase.NewTransactionUseCase(mockRepo)
-	mockRepo.On("GetTransactionByID", transaction.ID).Return(transaction, nil)
+	suite.transactionUseCase = usecase.NewTransactionUseCase(mockRepo, newOwnedCategoryRepository(), usecase.SignPolicyPositive, NewMockEventPublisher())
+	mockRepo.On("GetTransactionByID", transaction.UserID, transaction.ID).Return(transaction, nil)
 
-	retrievedTransaction, err := suite.transactionUseCase.GetTransactionByID(transaction.ID)
+	retrievedTransaction, err := suite.transactionUseCase.GetTransactionByID(transaction.UserID, transaction.ID)
 	suite.Assert().Nil(err)
-	suite.Assert().Equal(100.00, retrievedTransaction.Amount)
+	suite.Assert().Equal(float32(100.00), retrievedTransaction.Amount)
 	suite.Assert().Equal("Groceries", retrievedTransaction.Content)
 }
 
+func (suite *TransactionUseCaseSuite) TestGetTransactionByIDNotFound() {
+	mockRepo := NewMockTransactionRepository()
+	suite.transactionUseCase = usecase.NewTransactionUseCase(mockRepo, newOwnedCategoryRepository(), usecase.SignPolicyPositive, NewMockEventPublisher())
+	mockRepo.On("GetTransactionByID", 1, 99).Return(nil, gateway.ErrRecordNotFound)
+
+	_, err := suite.transactionUseCase.GetTransactionByID(1, 99)
+	suite.Assert().ErrorIs(err, usecase.ErrTransactionNotFound)
+	var notFound *usecase.NotFoundError
+	suite.Assert().ErrorAs(err, &notFound)
+}
+
 func (suite *TransactionUseCaseSuite) TestGetTransactionsByUserID() {
 	transactions := []entity.Transaction{
 		{
@@ -130,7 +209,7 @@ func (suite *TransactionUseCaseSuite) TestGetTransactionsByUserID() {
 	}
 
 	mockRepo := NewMockTransactionRepository()
-	suite.transactionUseCase = usecase.NewTransactionUseCase(mockRepo)
+	suite.transactionUseCase = usecase.NewTransactionUseCase(mockRepo, newOwnedCategoryRepository(), usecase.SignPolicyPositive, NewMockEventPublisher())
 	mockRepo.On("GetTransactionsByUserID", 1).Return(transactions, nil)
 
 	retrievedTransactions, err := suite.transactionUseCase.GetTransactionsByUserID(1)
@@ -151,20 +230,169 @@ func (suite *TransactionUseCaseSuite) TestUpdateTransaction() {
 	}
 
 	mockRepo := NewMockTransactionRepository()
-	suite.transactionUseCase = usecase.NewTransactionUseCase(mockRepo)
+	suite.transactionUseCase = usecase.NewTransactionUseCase(mockRepo, newOwnedCategoryRepository(), usecase.SignPolicyPositive, NewMockEventPublisher())
 	mockRepo.On("UpdateTransaction", transaction).Return(transaction, nil)
 
 	updatedTransaction, err := suite.transactionUseCase.UpdateTransaction(transaction)
 	suite.Assert().Nil(err)
-	suite.Assert().Equal(150.00, updatedTransaction.Amount)
+	suite.Assert().Equal(float32(150.00), updatedTransaction.Amount)
 	suite.Assert().Equal("Updated Groceries", updatedTransaction.Content)
 }
 
 func (suite *TransactionUseCaseSuite) TestDeleteTransaction() {
 	mockRepo := NewMockTransactionRepository()
-	suite.transactionUseCase = usecase.NewTransactionUseCase(mockRepo)
-	mockRepo.On("DeleteTransaction", 1).Return(nil)
+	suite.transactionUseCase = usecase.NewTransactionUseCase(mockRepo, newOwnedCategoryRepository(), usecase.SignPolicyPositive, NewMockEventPublisher())
+	mockRepo.On("DeleteTransaction", 1, 1, 1).Return(nil)
+
+	err := suite.transactionUseCase.DeleteTransaction(1, 1, 1)
+	suite.Assert().Nil(err)
+}
+
+func (suite *TransactionUseCaseSuite) TestUpdateTransactionVersionConflict() {
+	transaction := &entity.Transaction{ID: 1, UserID: 1, CategoryID: 1, Date: transactionDate, Amount: 150.00, Version: 1}
+
+	mockRepo := NewMockTransactionRepository()
+	publisher := new(mockEventPublisher)
+	suite.transactionUseCase = usecase.NewTransactionUseCase(mockRepo, newOwnedCategoryRepository(), usecase.SignPolicyPositive, publisher)
+	mockRepo.On("UpdateTransaction", transaction).Return(nil, gateway.ErrVersionConflict)
+
+	updatedTransaction, err := suite.transactionUseCase.UpdateTransaction(transaction)
+	suite.Assert().Nil(updatedTransaction)
+	suite.Assert().ErrorIs(err, usecase.ErrVersionConflict)
+	publisher.AssertNotCalled(suite.T(), "Publish", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TransactionUseCaseSuite) TestBulkTransactionsAtomic() {
+	mockRepo := NewMockTransactionRepository()
+	publisher := new(mockEventPublisher)
+	suite.transactionUseCase = usecase.NewTransactionUseCase(mockRepo, newOwnedCategoryRepository(), usecase.SignPolicyPositive, publisher)
+
+	created := &entity.Transaction{ID: 10, UserID: 1, CategoryID: 1, Amount: 100.00}
+	updated := &entity.Transaction{ID: 2, UserID: 1, CategoryID: 3, Amount: 50.00}
+	mockRepo.On("RunInTransaction").Return()
+	mockRepo.On("CreateTransaction", mock.AnythingOfType("*entity.Transaction")).Return(created, nil)
+	mockRepo.On("UpdateTransaction", mock.AnythingOfType("*entity.Transaction")).Return(updated, nil)
+	mockRepo.On("GetTransactionByID", 1, 3).Return(&entity.Transaction{ID: 3, UserID: 1}, nil)
+	mockRepo.On("DeleteTransaction", 1, 3, 1).Return(nil)
+	publisher.On("Publish", mock.Anything, 1, entity.EventTransactionCreated, created).Return(&entity.OutboxEvent{ID: 1}, nil)
+	publisher.On("Publish", mock.Anything, 1, entity.EventTransactionUpdated, updated).Return(&entity.OutboxEvent{ID: 2}, nil)
+	publisher.On("Publish", mock.Anything, 1, entity.EventTransactionDeleted, map[string]int{"id": 3}).Return(&entity.OutboxEvent{ID: 3}, nil)
+	publisher.On("Notify", []*entity.OutboxEvent{{ID: 1}, {ID: 2}, {ID: 3}}).Return()
+
+	results, err := suite.transactionUseCase.BulkTransactions(1, []entity.TransactionOperation{
+		{Op: entity.TransactionOperationCreate, Transaction: entity.Transaction{CategoryID: 1, Date: transactionDate, Amount: 100.00}},
+		{Op: entity.TransactionOperationUpdate, Transaction: entity.Transaction{ID: 2, CategoryID: 3, Date: transactionDate, Amount: 50.00, Version: 1}},
+		{Op: entity.TransactionOperationDelete, Transaction: entity.Transaction{ID: 3, Version: 1}},
+	}, true)
+	suite.Assert().Nil(err)
+	suite.Assert().Len(results, 3)
+	for _, result := range results {
+		suite.Assert().True(result.Success)
+	}
+	suite.Assert().Equal(10, results[0].Transaction.ID)
+	publisher.AssertExpectations(suite.T())
+}
+
+func (suite *TransactionUseCaseSuite) TestBulkTransactionsAtomicFailure() {
+	mockRepo := NewMockTransactionRepository()
+	publisher := NewMockEventPublisher()
+	suite.transactionUseCase = usecase.NewTransactionUseCase(mockRepo, newOwnedCategoryRepository(), usecase.SignPolicyPositive, publisher)
+
+	mockRepo.On("RunInTransaction").Return()
+	mockRepo.On("CreateTransaction", mock.AnythingOfType("*entity.Transaction")).Return(&entity.Transaction{ID: 10, UserID: 1}, nil)
+	mockRepo.On("UpdateTransaction", mock.AnythingOfType("*entity.Transaction")).Return(nil, errors.New("record not found"))
+
+	results, err := suite.transactionUseCase.BulkTransactions(1, []entity.TransactionOperation{
+		{Op: entity.TransactionOperationCreate, Transaction: entity.Transaction{CategoryID: 1, Date: transactionDate, Amount: 100.00}},
+		{Op: entity.TransactionOperationUpdate, Transaction: entity.Transaction{ID: 99, CategoryID: 3, Date: transactionDate, Amount: 50.00, Version: 1}},
+	}, true)
+	suite.Assert().ErrorIs(err, usecase.ErrBulkOperationFailed)
+	suite.Assert().False(results[0].Success)
+	suite.Assert().Nil(results[0].Transaction)
+	suite.Assert().Equal("record not found", results[1].Error)
+	// ロールバックしたトランザクションのイベントは通知しない
+	publisher.AssertNotCalled(suite.T(), "Notify", mock.Anything)
+}
+
+func (suite *TransactionUseCaseSuite) TestBulkTransactionsBestEffort() {
+	mockRepo := NewMockTransactionRepository()
+	suite.transactionUseCase = usecase.NewTransactionUseCase(mockRepo, newOwnedCategoryRepository(), usecase.SignPolicyPositive, NewMockEventPublisher())
+
+	mockRepo.On("RunInTransaction").Return()
+	mockRepo.On("GetTransactionByID", 1, 5).Return(nil, errors.New("record not found"))
+	mockRepo.On("CreateTransaction", mock.AnythingOfType("*entity.Transaction")).Return(&entity.Transaction{ID: 10, UserID: 1}, nil)
+
+	results, err := suite.transactionUseCase.BulkTransactions(1, []entity.TransactionOperation{
+		{Op: entity.TransactionOperationDelete, Transaction: entity.Transaction{ID: 5, Version: 1}},
+		{Op: entity.TransactionOperationCreate, Transaction: entity.Transaction{CategoryID: 1, Date: transactionDate, Amount: 100.00}},
+	}, false)
+	suite.Assert().Nil(err)
+	suite.Assert().False(results[0].Success)
+	suite.Assert().Equal("record not found", results[0].Error)
+	suite.Assert().True(results[1].Success)
+	// 各操作はセーブポイント内で実行される
+	mockRepo.AssertNumberOfCalls(suite.T(), "RunInTransaction", 3)
+}
+
+func (suite *TransactionUseCaseSuite) TestBulkTransactionsVersionConflict() {
+	mockRepo := NewMockTransactionRepository()
+	suite.transactionUseCase = usecase.NewTransactionUseCase(mockRepo, newOwnedCategoryRepository(), usecase.SignPolicyPositive, NewMockEventPublisher())
+
+	mockRepo.On("RunInTransaction").Return()
+	mockRepo.On("UpdateTransaction", mock.AnythingOfType("*entity.Transaction")).Return(nil, gateway.ErrVersionConflict)
+	mockRepo.On("CreateTransaction", mock.AnythingOfType("*entity.Transaction")).Return(&entity.Transaction{ID: 10, UserID: 1}, nil)
 
-	err := suite.transactionUseCase.DeleteTransaction(1)
+	results, err := suite.transactionUseCase.BulkTransactions(1, []entity.TransactionOperation{
+		{Op: entity.TransactionOperationUpdate, Transaction: entity.Transaction{ID: 2, CategoryID: 1, Date: transactionDate, Amount: 50.00, Version: 1}},
+		{Op: entity.TransactionOperationCreate, Transaction: entity.Transaction{CategoryID: 1, Date: transactionDate, Amount: 100.00}},
+	}, false)
 	suite.Assert().Nil(err)
+	suite.Assert().False(results[0].Success)
+	suite.Assert().ErrorIs(results[0].Err, usecase.ErrVersionConflict)
+	suite.Assert().True(results[1].Success)
+}
+
+func (suite *TransactionUseCaseSuite) TestBulkTransactionsRequiresVersion() {
+	mockRepo := NewMockTransactionRepository()
+	suite.transactionUseCase = usecase.NewTransactionUseCase(mockRepo, newOwnedCategoryRepository(), usecase.SignPolicyPositive, NewMockEventPublisher())
+
+	for _, op := range []string{entity.TransactionOperationUpdate, entity.TransactionOperationDelete} {
+		_, err := suite.transactionUseCase.BulkTransactions(1, []entity.TransactionOperation{
+			{Op: op, Transaction: entity.Transaction{ID: 2, CategoryID: 1, Date: transactionDate, Amount: 50.00}},
+		}, true)
+		suite.Assert().ErrorIs(err, usecase.ErrBulkVersionRequired, op)
+	}
+	mockRepo.AssertNotCalled(suite.T(), "RunInTransaction")
+}
+
+func (suite *TransactionUseCaseSuite) TestBulkTransactionsValidatesOperations() {
+	mockRepo := NewMockTransactionRepository()
+	suite.transactionUseCase = usecase.NewTransactionUseCase(mockRepo, newOwnedCategoryRepository(), usecase.SignPolicyPositive, NewMockEventPublisher())
+
+	mockRepo.On("RunInTransaction").Return()
+	mockRepo.On("CreateTransaction", mock.AnythingOfType("*entity.Transaction")).Return(&entity.Transaction{ID: 10, UserID: 1}, nil)
+
+	results, err := suite.transactionUseCase.BulkTransactions(1, []entity.TransactionOperation{
+		{Op: entity.TransactionOperationCreate, Transaction: entity.Transaction{CategoryID: 1, Date: transactionDate, Amount: -100.00}},
+		{Op: entity.TransactionOperationCreate, Transaction: entity.Transaction{CategoryID: 1, Date: transactionDate, Amount: 100.00}},
+	}, false)
+	suite.Assert().Nil(err)
+	suite.Assert().False(results[0].Success)
+	suite.Assert().Equal("invalid transaction: amount must be greater than 0", results[0].Error)
+	suite.Assert().True(results[1].Success)
+	mockRepo.AssertNumberOfCalls(suite.T(), "CreateTransaction", 1)
+}
+
+func (suite *TransactionUseCaseSuite) TestBulkTransactionsInvalidOperation() {
+	mockRepo := NewMockTransactionRepository()
+	suite.transactionUseCase = usecase.NewTransactionUseCase(mockRepo, newOwnedCategoryRepository(), usecase.SignPolicyPositive, NewMockEventPublisher())
+
+	_, err := suite.transactionUseCase.BulkTransactions(1, []entity.TransactionOperation{
+		{Op: "upsert"},
+	}, true)
+	suite.Assert().ErrorIs(err, usecase.ErrInvalidBulkOperation)
+
+	_, err = suite.transactionUseCase.BulkTransactions(1, nil, true)
+	suite.Assert().ErrorIs(err, usecase.ErrInvalidBulkOperation)
+	mockRepo.AssertNotCalled(suite.T(), "RunInTransaction")
 }
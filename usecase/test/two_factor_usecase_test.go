@@ -0,0 +1,285 @@
+package usecase_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"household-account-backend/entity"
+	"household-account-backend/pkg/totp"
+	"household-account-backend/usecase"
+)
+
+type mockTwoFactorRepository struct {
+	mock.Mock
+}
+
+func NewMockTwoFactorRepository() *mockTwoFactorRepository {
+	return new(mockTwoFactorRepository)
+}
+
+func (m *mockTwoFactorRepository) GetCredential(userID int) (*entity.TOTPCredential, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.TOTPCredential), args.Error(1)
+}
+
+func (m *mockTwoFactorRepository) SaveCredential(credential *entity.TOTPCredential) (*entity.TOTPCredential, error) {
+	args := m.Called(credential)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.TOTPCredential), args.Error(1)
+}
+
+func (m *mockTwoFactorRepository) EnableCredential(userID int, enabledAt time.Time, recoveryCodeHashes []string) error {
+	args := m.Called(userID, enabledAt, recoveryCodeHashes)
+	return args.Error(0)
+}
+
+func (m *mockTwoFactorRepository) DeleteCredential(userID int) error {
+	args := m.Called(userID)
+	return args.Error(0)
+}
+
+func (m *mockTwoFactorRepository) UpdateLastUsedStep(userID int, step int64) (bool, error) {
+	args := m.Called(userID, step)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockTwoFactorRepository) ReplaceRecoveryCodes(userID int, recoveryCodeHashes []string) error {
+	args := m.Called(userID, recoveryCodeHashes)
+	return args.Error(0)
+}
+
+func (m *mockTwoFactorRepository) UseRecoveryCode(userID int, codeHash string, usedAt time.Time) (bool, error) {
+	args := m.Called(userID, codeHash, usedAt)
+	return args.Bool(0), args.Error(1)
+}
+
+type mockTwoFactorUseCase struct {
+	mock.Mock
+}
+
+func NewMockTwoFactorUseCase() *mockTwoFactorUseCase {
+	return new(mockTwoFactorUseCase)
+}
+
+func (m *mockTwoFactorUseCase) Enabled(userID int) (bool, error) {
+	args := m.Called(userID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockTwoFactorUseCase) BeginEnrollment(userID int) (*usecase.TOTPEnrollment, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecase.TOTPEnrollment), args.Error(1)
+}
+
+func (m *mockTwoFactorUseCase) ConfirmEnrollment(userID int, code string) ([]string, error) {
+	args := m.Called(userID, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *mockTwoFactorUseCase) Disable(userID int, code string, clientIP string) error {
+	args := m.Called(userID, code, clientIP)
+	return args.Error(0)
+}
+
+func (m *mockTwoFactorUseCase) RegenerateRecoveryCodes(userID int, code string, clientIP string) ([]string, error) {
+	args := m.Called(userID, code, clientIP)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *mockTwoFactorUseCase) VerifyCode(userID int, code string) error {
+	args := m.Called(userID, code)
+	return args.Error(0)
+}
+
+type TwoFactorUseCaseSuite struct {
+	suite.Suite
+}
+
+func TestTwoFactorUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(TwoFactorUseCaseSuite))
+}
+
+const testTOTPSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func currentTOTPCode() (string, int64) {
+	step := totp.Step(time.Now())
+	code, _ := totp.Code(testTOTPSecret, step)
+	return code, step
+}
+
+func (suite *TwoFactorUseCaseSuite) TestBeginEnrollment() {
+	mockUserRepo := NewMockUserRepository()
+	mockRepo := NewMockTwoFactorRepository()
+	twoFactorUseCase := usecase.NewTwoFactorUseCase(mockUserRepo, mockRepo, newLoginAttemptUseCase(), "Household Account")
+
+	mockRepo.On("GetCredential", 1).Return(nil, nil)
+	mockUserRepo.On("GetCurrentUser", 1).Return(&entity.User{ID: 1, Email: "test@example.com"}, nil)
+	mockRepo.On("SaveCredential", mock.MatchedBy(func(credential *entity.TOTPCredential) bool {
+		return credential.UserID == 1 && credential.Secret != "" && !credential.Enabled()
+	})).Return(&entity.TOTPCredential{ID: 1}, nil)
+
+	enrollment, err := twoFactorUseCase.BeginEnrollment(1)
+	suite.Assert().Nil(err)
+	suite.Assert().NotEmpty(enrollment.Secret)
+	suite.Assert().True(strings.HasPrefix(enrollment.URI, "otpauth://totp/"))
+	suite.Assert().Contains(enrollment.URI, "secret="+enrollment.Secret)
+}
+
+func (suite *TwoFactorUseCaseSuite) TestBeginEnrollment_AlreadyEnabled() {
+	mockRepo := NewMockTwoFactorRepository()
+	twoFactorUseCase := usecase.NewTwoFactorUseCase(NewMockUserRepository(), mockRepo, newLoginAttemptUseCase(), "Household Account")
+	enabledAt := time.Now()
+	mockRepo.On("GetCredential", 1).Return(&entity.TOTPCredential{UserID: 1, Secret: testTOTPSecret, EnabledAt: &enabledAt}, nil)
+
+	_, err := twoFactorUseCase.BeginEnrollment(1)
+	suite.Assert().ErrorIs(err, usecase.ErrTOTPAlreadyEnabled)
+	mockRepo.AssertNotCalled(suite.T(), "SaveCredential", mock.Anything)
+}
+
+func (suite *TwoFactorUseCaseSuite) TestConfirmEnrollment() {
+	mockRepo := NewMockTwoFactorRepository()
+	twoFactorUseCase := usecase.NewTwoFactorUseCase(NewMockUserRepository(), mockRepo, newLoginAttemptUseCase(), "Household Account")
+	code, step := currentTOTPCode()
+
+	mockRepo.On("GetCredential", 1).Return(&entity.TOTPCredential{UserID: 1, Secret: testTOTPSecret}, nil)
+	mockRepo.On("UpdateLastUsedStep", 1, step).Return(true, nil)
+	mockRepo.On("EnableCredential", 1, mock.AnythingOfType("time.Time"), mock.MatchedBy(func(hashes []string) bool {
+		return len(hashes) == usecase.RecoveryCodeCount
+	})).Return(nil)
+
+	codes, err := twoFactorUseCase.ConfirmEnrollment(1, code)
+	suite.Assert().Nil(err)
+	suite.Assert().Len(codes, usecase.RecoveryCodeCount)
+
+	// 保存するのはリカバリーコードのハッシュのみ
+	hashes := mockRepo.Calls[len(mockRepo.Calls)-1].Arguments.Get(2).([]string)
+	suite.Assert().Equal(usecase.HashRecoveryCode(codes[0]), hashes[0])
+	suite.Assert().NotEqual(codes[0], hashes[0])
+}
+
+func (suite *TwoFactorUseCaseSuite) TestConfirmEnrollment_InvalidCode() {
+	mockRepo := NewMockTwoFactorRepository()
+	twoFactorUseCase := usecase.NewTwoFactorUseCase(NewMockUserRepository(), mockRepo, newLoginAttemptUseCase(), "Household Account")
+	mockRepo.On("GetCredential", 1).Return(&entity.TOTPCredential{UserID: 1, Secret: testTOTPSecret}, nil)
+
+	_, err := twoFactorUseCase.ConfirmEnrollment(1, "abcdef")
+	suite.Assert().ErrorIs(err, usecase.ErrInvalidTOTPCode)
+	mockRepo.AssertNotCalled(suite.T(), "EnableCredential", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TwoFactorUseCaseSuite) TestVerifyCode_RejectsReusedCode() {
+	mockRepo := NewMockTwoFactorRepository()
+	twoFactorUseCase := usecase.NewTwoFactorUseCase(NewMockUserRepository(), mockRepo, newLoginAttemptUseCase(), "Household Account")
+	enabledAt := time.Now()
+	code, step := currentTOTPCode()
+
+	mockRepo.On("GetCredential", 1).Return(&entity.TOTPCredential{UserID: 1, Secret: testTOTPSecret, EnabledAt: &enabledAt, LastUsedStep: step}, nil)
+	mockRepo.On("UpdateLastUsedStep", 1, step).Return(false, nil)
+
+	err := twoFactorUseCase.VerifyCode(1, code)
+	suite.Assert().ErrorIs(err, usecase.ErrInvalidTOTPCode)
+}
+
+func (suite *TwoFactorUseCaseSuite) TestVerifyCode_RecoveryCode() {
+	mockRepo := NewMockTwoFactorRepository()
+	twoFactorUseCase := usecase.NewTwoFactorUseCase(NewMockUserRepository(), mockRepo, newLoginAttemptUseCase(), "Household Account")
+	enabledAt := time.Now()
+
+	mockRepo.On("GetCredential", 1).Return(&entity.TOTPCredential{UserID: 1, Secret: testTOTPSecret, EnabledAt: &enabledAt}, nil)
+	mockRepo.On("UseRecoveryCode", 1, usecase.HashRecoveryCode("abcde-12345"), mock.AnythingOfType("time.Time")).Return(true, nil).Once()
+	mockRepo.On("UseRecoveryCode", 1, usecase.HashRecoveryCode("abcde-12345"), mock.AnythingOfType("time.Time")).Return(false, nil)
+
+	// ハイフンや大文字小文字の違いは無視する
+	suite.Assert().Nil(twoFactorUseCase.VerifyCode(1, "ABCDE12345"))
+	suite.Assert().ErrorIs(twoFactorUseCase.VerifyCode(1, "abcde-12345"), usecase.ErrInvalidTOTPCode)
+}
+
+func (suite *TwoFactorUseCaseSuite) TestDisable() {
+	mockUserRepo := NewMockUserRepository()
+	mockRepo := NewMockTwoFactorRepository()
+	twoFactorUseCase := usecase.NewTwoFactorUseCase(mockUserRepo, mockRepo, newLoginAttemptUseCase(), "Household Account")
+	enabledAt := time.Now()
+	code, step := currentTOTPCode()
+
+	mockUserRepo.On("GetCurrentUser", 1).Return(&entity.User{ID: 1, Email: "test@example.com"}, nil)
+	mockRepo.On("GetCredential", 1).Return(&entity.TOTPCredential{UserID: 1, Secret: testTOTPSecret, EnabledAt: &enabledAt}, nil)
+	mockRepo.On("UpdateLastUsedStep", 1, step).Return(true, nil)
+	mockRepo.On("DeleteCredential", 1).Return(nil)
+
+	suite.Assert().Nil(twoFactorUseCase.Disable(1, code, "192.0.2.1"))
+	mockRepo.AssertExpectations(suite.T())
+}
+
+func (suite *TwoFactorUseCaseSuite) TestDisable_NotEnabled() {
+	mockUserRepo := NewMockUserRepository()
+	mockRepo := NewMockTwoFactorRepository()
+	twoFactorUseCase := usecase.NewTwoFactorUseCase(mockUserRepo, mockRepo, newLoginAttemptUseCase(), "Household Account")
+	mockUserRepo.On("GetCurrentUser", 1).Return(&entity.User{ID: 1, Email: "test@example.com"}, nil)
+	mockRepo.On("GetCredential", 1).Return(nil, nil)
+
+	err := twoFactorUseCase.Disable(1, "123456", "192.0.2.1")
+	suite.Assert().ErrorIs(err, usecase.ErrTOTPNotEnabled)
+	mockRepo.AssertNotCalled(suite.T(), "DeleteCredential", mock.Anything)
+}
+
+func (suite *TwoFactorUseCaseSuite) TestDisable_InvalidCodesLockAccount() {
+	mockUserRepo := NewMockUserRepository()
+	mockRepo := NewMockTwoFactorRepository()
+	twoFactorUseCase := usecase.NewTwoFactorUseCase(mockUserRepo, mockRepo, newLoginAttemptUseCase(), "Household Account")
+	enabledAt := time.Now()
+
+	mockUserRepo.On("GetCurrentUser", 1).Return(&entity.User{ID: 1, Email: "test@example.com"}, nil)
+	mockRepo.On("GetCredential", 1).Return(&entity.TOTPCredential{UserID: 1, Secret: testTOTPSecret, EnabledAt: &enabledAt}, nil)
+	mockRepo.On("UseRecoveryCode", 1, mock.Anything, mock.Anything).Return(false, nil)
+
+	for i := 0; i < loginAttemptConfig.MaxAccountFailures; i++ {
+		err := twoFactorUseCase.Disable(1, "wrong-code", "192.0.2.1")
+		suite.Require().ErrorIs(err, usecase.ErrInvalidTOTPCode)
+	}
+
+	// ロック中は正しいコードでも検証せずに拒否する
+	code, _ := currentTOTPCode()
+	err := twoFactorUseCase.Disable(1, code, "198.51.100.1")
+	suite.Assert().ErrorIs(err, usecase.ErrLoginThrottled)
+	_, err = twoFactorUseCase.RegenerateRecoveryCodes(1, code, "198.51.100.1")
+	suite.Assert().ErrorIs(err, usecase.ErrLoginThrottled)
+	mockRepo.AssertNotCalled(suite.T(), "UpdateLastUsedStep", mock.Anything, mock.Anything)
+	mockRepo.AssertNotCalled(suite.T(), "DeleteCredential", mock.Anything)
+}
+
+func (suite *TwoFactorUseCaseSuite) TestRegenerateRecoveryCodes() {
+	mockUserRepo := NewMockUserRepository()
+	mockRepo := NewMockTwoFactorRepository()
+	twoFactorUseCase := usecase.NewTwoFactorUseCase(mockUserRepo, mockRepo, newLoginAttemptUseCase(), "Household Account")
+	enabledAt := time.Now()
+	code, step := currentTOTPCode()
+
+	mockUserRepo.On("GetCurrentUser", 1).Return(&entity.User{ID: 1, Email: "test@example.com"}, nil)
+	mockRepo.On("GetCredential", 1).Return(&entity.TOTPCredential{UserID: 1, Secret: testTOTPSecret, EnabledAt: &enabledAt}, nil)
+	mockRepo.On("UpdateLastUsedStep", 1, step).Return(true, nil)
+	mockRepo.On("ReplaceRecoveryCodes", 1, mock.MatchedBy(func(hashes []string) bool {
+		return len(hashes) == usecase.RecoveryCodeCount
+	})).Return(nil)
+
+	codes, err := twoFactorUseCase.RegenerateRecoveryCodes(1, code, "192.0.2.1")
+	suite.Assert().Nil(err)
+	suite.Assert().Len(codes, usecase.RecoveryCodeCount)
+}
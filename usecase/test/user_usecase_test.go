@@ -1,12 +1,18 @@
 package usecase_test
 
 import (
+	"errors"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/golang-jwt/jwt/v4"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"household-account-backend/adapter/gateway"
 	"household-account-backend/entity"
+	"household-account-backend/pkg/jwtkeys"
 	"household-account-backend/usecase"
 )
 
@@ -55,6 +61,104 @@ func (m *mockUserRepository) GetUserByEmail(email string) (*entity.User, error)
 	return args.Get(0).(*entity.User), args.Error(1)
 }
 
+func (m *mockUserRepository) MarkEmailVerified(ID int, verifiedAt time.Time) error {
+	args := m.Called(ID, verifiedAt)
+	return args.Error(0)
+}
+
+func (m *mockUserRepository) ResetPassword(ID int, hashedPassword string, revokedAt time.Time) error {
+	args := m.Called(ID, hashedPassword, revokedAt)
+	return args.Error(0)
+}
+
+type mockUserTokenRepository struct {
+	mock.Mock
+}
+
+func NewMockUserTokenRepository() *mockUserTokenRepository {
+	return new(mockUserTokenRepository)
+}
+
+func (m *mockUserTokenRepository) CreateToken(token *entity.UserToken) (*entity.UserToken, error) {
+	args := m.Called(token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.UserToken), args.Error(1)
+}
+
+func (m *mockUserTokenRepository) GetTokenByHash(purpose string, tokenHash string) (*entity.UserToken, error) {
+	args := m.Called(purpose, tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.UserToken), args.Error(1)
+}
+
+func (m *mockUserTokenRepository) MarkTokenUsed(tokenID int, usedAt time.Time) (bool, error) {
+	args := m.Called(tokenID, usedAt)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockUserTokenRepository) InvalidateTokens(userID int, purpose string, usedAt time.Time) error {
+	args := m.Called(userID, purpose, usedAt)
+	return args.Error(0)
+}
+
+func (m *mockUserTokenRepository) IncrementTokenAttempts(tokenID int) error {
+	args := m.Called(tokenID)
+	return args.Error(0)
+}
+
+type mockLoginAttemptUseCase struct {
+	mock.Mock
+}
+
+func (m *mockLoginAttemptUseCase) Check(email string, clientIP string) error {
+	args := m.Called(email, clientIP)
+	return args.Error(0)
+}
+
+func (m *mockLoginAttemptUseCase) RecordFailure(email string, clientIP string) (bool, error) {
+	args := m.Called(email, clientIP)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockLoginAttemptUseCase) RecordSuccess(email string) error {
+	args := m.Called(email)
+	return args.Error(0)
+}
+
+func (m *mockLoginAttemptUseCase) Unlock(email string) error {
+	args := m.Called(email)
+	return args.Error(0)
+}
+
+type mockMailSender struct {
+	mock.Mock
+}
+
+func NewMockMailSender() *mockMailSender {
+	return new(mockMailSender)
+}
+
+func (m *mockMailSender) Send(to string, subject string, body string) error {
+	args := m.Called(to, subject, body)
+	return args.Error(0)
+}
+
+var userTokenConfig = usecase.UserTokenConfig{
+	EmailVerificationTTL: 24 * time.Hour,
+	PasswordResetTTL:     time.Hour,
+	LoginChallengeTTL:    5 * time.Minute,
+	FrontendURL:          "http://localhost:3000",
+}
+
+var testKeyManager, _ = jwtkeys.NewKeyManager([]*jwtkeys.Key{jwtkeys.NewHMACKey("test", []byte("secret"))}, jwtkeys.Config{
+	Issuer:   "household-account-backend",
+	Audience: "household-account",
+})
+
 type UserUseCaseSuite struct {
 	suite.Suite
 	userUseCase usecase.UserUseCase
@@ -69,7 +173,9 @@ func (suite *UserUseCaseSuite) TestSignup() {
 	password := "password123"
 	hashedPassword, _ := usecase.HashPassword(password)
 	mockRepo := NewMockUserRepository()
-	suite.userUseCase = usecase.NewUserUseCase(mockRepo)
+	mockTokenRepo := NewMockUserTokenRepository()
+	mockMailSender := NewMockMailSender()
+	suite.userUseCase = usecase.NewUserUseCase(mockRepo, mockTokenRepo, mockMailSender, NewMockTwoFactorUseCase(), newLoginAttemptUseCase(), testKeyManager, userTokenConfig)
 
 	user := &entity.User{
 		Email:    email,
@@ -77,6 +183,18 @@ func (suite *UserUseCaseSuite) TestSignup() {
 		Name:     "John",
 	}
 
+	var sentToken string
+	mockTokenRepo.On("CreateToken", mock.MatchedBy(func(token *entity.UserToken) bool {
+		return token.UserID == 1 &&
+			token.Purpose == entity.UserTokenPurposeEmailVerification &&
+			token.ExpiresAt.After(time.Now().Add(23*time.Hour))
+	})).Return(&entity.UserToken{ID: 1}, nil)
+	mockMailSender.On("Send", email, mock.Anything, mock.MatchedBy(func(body string) bool {
+		sentToken = body[strings.Index(body, "token=")+len("token="):]
+		sentToken = sentToken[:strings.Index(sentToken, "\n")]
+		return strings.Contains(body, "http://localhost:3000/verify-email?token=")
+	})).Return(nil)
+
 	mockRepo.On("Signup", mock.AnythingOfType("*entity.User")).Return(&entity.User{
 		ID:       1,
 		Email:    email,
@@ -88,6 +206,40 @@ func (suite *UserUseCaseSuite) TestSignup() {
 	suite.Assert().Nil(err)
 	suite.Assert().Equal(email, createdUser.Email)
 	suite.Assert().True(usecase.CheckPasswordHash(password, createdUser.Password))
+
+	// メールで送るトークン本体ではなくハッシュを保存する
+	createdToken := mockTokenRepo.Calls[0].Arguments.Get(0).(*entity.UserToken)
+	suite.Assert().NotEmpty(sentToken)
+	suite.Assert().NotEqual(sentToken, createdToken.TokenHash)
+	suite.Assert().Equal(usecase.HashUserToken(sentToken), createdToken.TokenHash)
+	mockMailSender.AssertExpectations(suite.T())
+}
+
+func (suite *UserUseCaseSuite) TestSignup_MailFailureDoesNotFailSignup() {
+	mockRepo := NewMockUserRepository()
+	mockTokenRepo := NewMockUserTokenRepository()
+	mockMailSender := NewMockMailSender()
+	suite.userUseCase = usecase.NewUserUseCase(mockRepo, mockTokenRepo, mockMailSender, NewMockTwoFactorUseCase(), newLoginAttemptUseCase(), testKeyManager, userTokenConfig)
+
+	mockRepo.On("Signup", mock.AnythingOfType("*entity.User")).Return(&entity.User{ID: 1, Email: "test@example.com"}, nil)
+	mockTokenRepo.On("CreateToken", mock.Anything).Return(&entity.UserToken{ID: 1}, nil)
+	mockMailSender.On("Send", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("mail error"))
+
+	createdUser, err := suite.userUseCase.Signup(&entity.User{Email: "test@example.com", Password: "password123"})
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(1, createdUser.ID)
+}
+
+func (suite *UserUseCaseSuite) TestSignup_EmailAlreadyRegistered() {
+	mockRepo := NewMockUserRepository()
+	mockTokenRepo := NewMockUserTokenRepository()
+	suite.userUseCase = usecase.NewUserUseCase(mockRepo, mockTokenRepo, NewMockMailSender(), NewMockTwoFactorUseCase(), newLoginAttemptUseCase(), testKeyManager, userTokenConfig)
+
+	mockRepo.On("Signup", mock.AnythingOfType("*entity.User")).Return(nil, gateway.ErrDuplicateKey)
+
+	_, err := suite.userUseCase.Signup(&entity.User{Email: "test@example.com", Password: "password123"})
+	suite.Assert().ErrorIs(err, usecase.ErrEmailAlreadyRegistered)
+	mockTokenRepo.AssertNotCalled(suite.T(), "CreateToken", mock.Anything)
 }
 
 func (suite *UserUseCaseSuite) TestLogin_Success() {
@@ -95,24 +247,58 @@ func (suite *UserUseCaseSuite) TestLogin_Success() {
 	password := "password123"
 	hashedPassword, _ := usecase.HashPassword(password)
 	mockRepo := NewMockUserRepository()
-	suite.userUseCase = usecase.NewUserUseCase(mockRepo)
+	mockTwoFactor := NewMockTwoFactorUseCase()
+	suite.userUseCase = usecase.NewUserUseCase(mockRepo, NewMockUserTokenRepository(), NewMockMailSender(), mockTwoFactor, newLoginAttemptUseCase(), testKeyManager, userTokenConfig)
 
 	mockRepo.On("GetUserByEmail", email).Return(&entity.User{
 		ID:       1,
 		Email:    email,
 		Password: hashedPassword,
 	}, nil)
+	mockTwoFactor.On("Enabled", 1).Return(false, nil)
 
-	token, err := suite.userUseCase.Login(&entity.Credentials{Email: email, Password: password})
+	result, err := suite.userUseCase.Login(&entity.Credentials{Email: email, Password: password}, "192.0.2.1")
 	suite.Assert().Nil(err)
-	suite.Assert().NotEmpty(token)
+	suite.Assert().NotEmpty(result.AuthToken)
+	suite.Assert().Empty(result.TOTPChallenge)
+
+	token, err := testKeyManager.Parse(result.AuthToken)
+	suite.Require().Nil(err)
+	suite.Assert().Equal(float64(1), token.Claims.(jwt.MapClaims)["user_id"])
+}
+
+func (suite *UserUseCaseSuite) TestLogin_TOTPRequired() {
+	email := "test@example.com"
+	password := "password123"
+	hashedPassword, _ := usecase.HashPassword(password)
+	mockRepo := NewMockUserRepository()
+	mockTokenRepo := NewMockUserTokenRepository()
+	mockTwoFactor := NewMockTwoFactorUseCase()
+	suite.userUseCase = usecase.NewUserUseCase(mockRepo, mockTokenRepo, NewMockMailSender(), mockTwoFactor, newLoginAttemptUseCase(), testKeyManager, userTokenConfig)
+
+	mockRepo.On("GetUserByEmail", email).Return(&entity.User{
+		ID:       1,
+		Email:    email,
+		Password: hashedPassword,
+	}, nil)
+	mockTwoFactor.On("Enabled", 1).Return(true, nil)
+	mockTokenRepo.On("CreateToken", mock.MatchedBy(func(token *entity.UserToken) bool {
+		return token.UserID == 1 &&
+			token.Purpose == entity.UserTokenPurposeLoginChallenge &&
+			token.ExpiresAt.Before(time.Now().Add(6*time.Minute))
+	})).Return(&entity.UserToken{ID: 1}, nil)
+
+	result, err := suite.userUseCase.Login(&entity.Credentials{Email: email, Password: password}, "192.0.2.1")
+	suite.Assert().Nil(err)
+	suite.Assert().Empty(result.AuthToken)
+	suite.Assert().NotEmpty(result.TOTPChallenge)
 }
 
 func (suite *UserUseCaseSuite) TestLogin_InvalidCredentials() {
 	email := "test@example.com"
 	password := "wrongpassword"
 	mockRepo := NewMockUserRepository()
-	suite.userUseCase = usecase.NewUserUseCase(mockRepo)
+	suite.userUseCase = usecase.NewUserUseCase(mockRepo, NewMockUserTokenRepository(), NewMockMailSender(), NewMockTwoFactorUseCase(), newLoginAttemptUseCase(), testKeyManager, userTokenConfig)
 
 	mockRepo.On("GetUserByEmail", email).Return(&entity.User{
 		ID:       1,
@@ -120,18 +306,200 @@ func (suite *UserUseCaseSuite) TestLogin_InvalidCredentials() {
 		Password: "hashedpassword",
 	}, nil)
 
-	token, err := suite.userUseCase.Login(&entity.Credentials{Email: email, Password: password})
+	result, err := suite.userUseCase.Login(&entity.Credentials{Email: email, Password: password}, "192.0.2.1")
 	suite.Assert().NotNil(err)
-	suite.Assert().Empty(token)
+	suite.Assert().Nil(result)
 	suite.Assert().EqualError(err, "invalid credentials")
 }
 
+func (suite *UserUseCaseSuite) TestLogin_LockoutSendsUnlockEmail() {
+	email := "test@example.com"
+	hashedPassword, _ := usecase.HashPassword("password123")
+	config := loginAttemptConfig
+	config.MaxAccountFailures = 2
+	mockRepo := NewMockUserRepository()
+	mockTokenRepo := NewMockUserTokenRepository()
+	mockMail := NewMockMailSender()
+	suite.userUseCase = usecase.NewUserUseCase(mockRepo, mockTokenRepo, mockMail, NewMockTwoFactorUseCase(), usecase.NewLoginAttemptUseCase(gateway.NewInMemoryLoginAttemptStore(), config), testKeyManager, userTokenConfig)
+
+	mockRepo.On("GetUserByEmail", email).Return(&entity.User{ID: 1, Email: email, Password: hashedPassword}, nil)
+	mockTokenRepo.On("InvalidateTokens", 1, entity.UserTokenPurposeAccountUnlock, mock.AnythingOfType("time.Time")).Return(nil)
+	mockTokenRepo.On("CreateToken", mock.MatchedBy(func(token *entity.UserToken) bool {
+		return token.UserID == 1 && token.Purpose == entity.UserTokenPurposeAccountUnlock
+	})).Return(&entity.UserToken{ID: 1}, nil)
+	mockMail.On("Send", email, "アカウントのロックについて", mock.MatchedBy(func(body string) bool {
+		return strings.Contains(body, "http://localhost:3000/unlock-account?token=")
+	})).Return(nil).Once()
+
+	for i := 0; i < config.MaxAccountFailures; i++ {
+		_, err := suite.userUseCase.Login(&entity.Credentials{Email: email, Password: "wrong"}, "192.0.2.1")
+		suite.Assert().ErrorIs(err, usecase.ErrInvalidCredentials)
+	}
+	mockMail.AssertExpectations(suite.T())
+
+	// ロック中は正しいパスワードでもログインできない
+	result, err := suite.userUseCase.Login(&entity.Credentials{Email: email, Password: "password123"}, "198.51.100.1")
+	suite.Assert().Nil(result)
+	suite.Assert().ErrorIs(err, usecase.ErrLoginThrottled)
+}
+
+func (suite *UserUseCaseSuite) TestLogin_UnknownEmailCountsAsFailure() {
+	config := loginAttemptConfig
+	config.MaxAccountFailures = 1
+	mockRepo := NewMockUserRepository()
+	suite.userUseCase = usecase.NewUserUseCase(mockRepo, NewMockUserTokenRepository(), NewMockMailSender(), NewMockTwoFactorUseCase(), usecase.NewLoginAttemptUseCase(gateway.NewInMemoryLoginAttemptStore(), config), testKeyManager, userTokenConfig)
+
+	mockRepo.On("GetUserByEmail", "unknown@example.com").Return(nil, gateway.ErrRecordNotFound)
+
+	_, err := suite.userUseCase.Login(&entity.Credentials{Email: "unknown@example.com", Password: "password"}, "192.0.2.1")
+	suite.Assert().ErrorIs(err, usecase.ErrInvalidCredentials)
+	// 登録済みのアカウントと同じようにロックされる
+	_, err = suite.userUseCase.Login(&entity.Credentials{Email: "unknown@example.com", Password: "password"}, "192.0.2.1")
+	suite.Assert().ErrorIs(err, usecase.ErrLoginThrottled)
+}
+
+func (suite *UserUseCaseSuite) TestUnlockAccount() {
+	mockRepo := NewMockUserRepository()
+	mockTokenRepo := NewMockUserTokenRepository()
+	mockLoginAttempt := new(mockLoginAttemptUseCase)
+	suite.userUseCase = usecase.NewUserUseCase(mockRepo, mockTokenRepo, NewMockMailSender(), NewMockTwoFactorUseCase(), mockLoginAttempt, testKeyManager, userTokenConfig)
+
+	mockTokenRepo.On("GetTokenByHash", entity.UserTokenPurposeAccountUnlock, usecase.HashUserToken("token")).Return(&entity.UserToken{
+		ID:        1,
+		UserID:    1,
+		Purpose:   entity.UserTokenPurposeAccountUnlock,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}, nil)
+	mockTokenRepo.On("MarkTokenUsed", 1, mock.AnythingOfType("time.Time")).Return(true, nil)
+	mockRepo.On("GetCurrentUser", 1).Return(&entity.User{ID: 1, Email: "test@example.com"}, nil)
+	mockLoginAttempt.On("Unlock", "test@example.com").Return(nil)
+
+	suite.Assert().Nil(suite.userUseCase.UnlockAccount("token"))
+	mockLoginAttempt.AssertExpectations(suite.T())
+}
+
+func (suite *UserUseCaseSuite) TestLoginWithTOTP() {
+	mockRepo := NewMockUserRepository()
+	mockTokenRepo := NewMockUserTokenRepository()
+	mockTwoFactor := NewMockTwoFactorUseCase()
+	mockLoginAttempt := new(mockLoginAttemptUseCase)
+	suite.userUseCase = usecase.NewUserUseCase(mockRepo, mockTokenRepo, NewMockMailSender(), mockTwoFactor, mockLoginAttempt, testKeyManager, userTokenConfig)
+
+	mockTokenRepo.On("GetTokenByHash", entity.UserTokenPurposeLoginChallenge, usecase.HashUserToken("challenge")).Return(&entity.UserToken{
+		ID:        1,
+		UserID:    2,
+		Purpose:   entity.UserTokenPurposeLoginChallenge,
+		ExpiresAt: time.Now().Add(time.Minute),
+	}, nil)
+	mockRepo.On("GetCurrentUser", 2).Return(&entity.User{ID: 2, Email: "test@example.com"}, nil)
+	mockLoginAttempt.On("Check", "test@example.com", "192.0.2.1").Return(nil)
+	mockTwoFactor.On("VerifyCode", 2, "123456").Return(nil)
+	mockTokenRepo.On("MarkTokenUsed", 1, mock.AnythingOfType("time.Time")).Return(true, nil)
+	mockLoginAttempt.On("RecordSuccess", "test@example.com").Return(nil)
+
+	token, err := suite.userUseCase.LoginWithTOTP("challenge", "123456", "192.0.2.1")
+	suite.Assert().Nil(err)
+	suite.Assert().NotEmpty(token)
+	// 二要素認証に成功して初めて失敗回数をリセットする
+	mockLoginAttempt.AssertExpectations(suite.T())
+}
+
+func (suite *UserUseCaseSuite) TestLoginWithTOTP_InvalidCode() {
+	mockRepo := NewMockUserRepository()
+	mockTokenRepo := NewMockUserTokenRepository()
+	mockTwoFactor := NewMockTwoFactorUseCase()
+	mockLoginAttempt := new(mockLoginAttemptUseCase)
+	suite.userUseCase = usecase.NewUserUseCase(mockRepo, mockTokenRepo, NewMockMailSender(), mockTwoFactor, mockLoginAttempt, testKeyManager, userTokenConfig)
+
+	mockTokenRepo.On("GetTokenByHash", entity.UserTokenPurposeLoginChallenge, mock.Anything).Return(&entity.UserToken{
+		ID:        1,
+		UserID:    2,
+		ExpiresAt: time.Now().Add(time.Minute),
+	}, nil)
+	mockRepo.On("GetCurrentUser", 2).Return(&entity.User{ID: 2, Email: "test@example.com"}, nil)
+	mockLoginAttempt.On("Check", "test@example.com", "192.0.2.1").Return(nil)
+	mockTwoFactor.On("VerifyCode", 2, "000000").Return(usecase.ErrInvalidTOTPCode)
+	mockTokenRepo.On("IncrementTokenAttempts", 1).Return(nil)
+	mockLoginAttempt.On("RecordFailure", "test@example.com", "192.0.2.1").Return(false, nil)
+
+	token, err := suite.userUseCase.LoginWithTOTP("challenge", "000000", "192.0.2.1")
+	suite.Assert().ErrorIs(err, usecase.ErrInvalidTOTPCode)
+	suite.Assert().Empty(token)
+	mockTokenRepo.AssertExpectations(suite.T())
+	mockLoginAttempt.AssertExpectations(suite.T())
+	mockTokenRepo.AssertNotCalled(suite.T(), "MarkTokenUsed", mock.Anything, mock.Anything)
+	mockLoginAttempt.AssertNotCalled(suite.T(), "RecordSuccess", mock.Anything)
+}
+
+func (suite *UserUseCaseSuite) TestLoginWithTOTP_LockoutAcrossChallenges() {
+	email := "test@example.com"
+	hashedPassword, _ := usecase.HashPassword("password123")
+	config := loginAttemptConfig
+	config.MaxAccountFailures = 3
+	mockRepo := NewMockUserRepository()
+	mockTokenRepo := NewMockUserTokenRepository()
+	mockTwoFactor := NewMockTwoFactorUseCase()
+	mockMail := NewMockMailSender()
+	suite.userUseCase = usecase.NewUserUseCase(mockRepo, mockTokenRepo, mockMail, mockTwoFactor, usecase.NewLoginAttemptUseCase(gateway.NewInMemoryLoginAttemptStore(), config), testKeyManager, userTokenConfig)
+
+	user := &entity.User{ID: 1, Email: email, Password: hashedPassword}
+	mockRepo.On("GetUserByEmail", email).Return(user, nil)
+	mockRepo.On("GetCurrentUser", 1).Return(user, nil)
+	mockTwoFactor.On("Enabled", 1).Return(true, nil)
+	mockTwoFactor.On("VerifyCode", 1, "000000").Return(usecase.ErrInvalidTOTPCode)
+	mockTokenRepo.On("CreateToken", mock.AnythingOfType("*entity.UserToken")).Return(&entity.UserToken{ID: 1}, nil)
+	// チャレンジごとの試行回数の上限には達しない
+	mockTokenRepo.On("GetTokenByHash", entity.UserTokenPurposeLoginChallenge, mock.Anything).Return(&entity.UserToken{ID: 1, UserID: 1, ExpiresAt: time.Now().Add(time.Minute)}, nil)
+	mockTokenRepo.On("IncrementTokenAttempts", 1).Return(nil)
+	mockTokenRepo.On("InvalidateTokens", 1, entity.UserTokenPurposeAccountUnlock, mock.AnythingOfType("time.Time")).Return(nil)
+	mockMail.On("Send", email, "アカウントのロックについて", mock.Anything).Return(nil).Once()
+
+	// パスワードの誤りが1回あっても、二要素認証の前のパスワードの成功ではリセットしない
+	_, err := suite.userUseCase.Login(&entity.Credentials{Email: email, Password: "wrong"}, "192.0.2.1")
+	suite.Assert().ErrorIs(err, usecase.ErrInvalidCredentials)
+
+	// チャレンジを取り直しながらコードを誤ると、合計の失敗回数でロックされる
+	for i := 0; i < config.MaxAccountFailures-1; i++ {
+		result, err := suite.userUseCase.Login(&entity.Credentials{Email: email, Password: "password123"}, "192.0.2.1")
+		suite.Require().Nil(err)
+		suite.Require().NotEmpty(result.TOTPChallenge)
+
+		_, err = suite.userUseCase.LoginWithTOTP(result.TOTPChallenge, "000000", "192.0.2.1")
+		suite.Assert().ErrorIs(err, usecase.ErrInvalidTOTPCode)
+	}
+	mockMail.AssertExpectations(suite.T())
+
+	// ロック中はパスワードでもコードでもログインできない
+	_, err = suite.userUseCase.Login(&entity.Credentials{Email: email, Password: "password123"}, "198.51.100.1")
+	suite.Assert().ErrorIs(err, usecase.ErrLoginThrottled)
+	_, err = suite.userUseCase.LoginWithTOTP("challenge", "123456", "198.51.100.1")
+	suite.Assert().ErrorIs(err, usecase.ErrLoginThrottled)
+	mockTwoFactor.AssertNotCalled(suite.T(), "VerifyCode", 1, "123456")
+}
+
+func (suite *UserUseCaseSuite) TestLoginWithTOTP_TooManyAttempts() {
+	mockTokenRepo := NewMockUserTokenRepository()
+	mockTwoFactor := NewMockTwoFactorUseCase()
+	suite.userUseCase = usecase.NewUserUseCase(NewMockUserRepository(), mockTokenRepo, NewMockMailSender(), mockTwoFactor, newLoginAttemptUseCase(), testKeyManager, userTokenConfig)
+
+	mockTokenRepo.On("GetTokenByHash", entity.UserTokenPurposeLoginChallenge, mock.Anything).Return(&entity.UserToken{
+		ID:        1,
+		UserID:    2,
+		ExpiresAt: time.Now().Add(time.Minute),
+		Attempts:  usecase.MaxLoginChallengeAttempts,
+	}, nil)
+
+	_, err := suite.userUseCase.LoginWithTOTP("challenge", "123456", "192.0.2.1")
+	suite.Assert().ErrorIs(err, usecase.ErrInvalidUserToken)
+	mockTwoFactor.AssertNotCalled(suite.T(), "VerifyCode", mock.Anything, mock.Anything)
+}
+
 func (suite *UserUseCaseSuite) TestGetCurrentUser() {
 	userID := 1
 	email := "test@example.com"
 	name := "John"
 	mockRepo := NewMockUserRepository()
-	suite.userUseCase = usecase.NewUserUseCase(mockRepo)
+	suite.userUseCase = usecase.NewUserUseCase(mockRepo, NewMockUserTokenRepository(), NewMockMailSender(), NewMockTwoFactorUseCase(), newLoginAttemptUseCase(), testKeyManager, userTokenConfig)
 
 	mockRepo.On("GetCurrentUser", userID).Return(&entity.User{
 		ID:    userID,
@@ -151,8 +519,13 @@ func (suite *UserUseCaseSuite) TestUpdateUser() {
 	email := "test@example.com"
 	name := "John"
 	mockRepo := NewMockUserRepository()
-	suite.userUseCase = usecase.NewUserUseCase(mockRepo)
+	suite.userUseCase = usecase.NewUserUseCase(mockRepo, NewMockUserTokenRepository(), NewMockMailSender(), NewMockTwoFactorUseCase(), newLoginAttemptUseCase(), testKeyManager, userTokenConfig)
 
+	mockRepo.On("GetCurrentUser", userID).Return(&entity.User{
+		ID:    userID,
+		Email: email,
+		Name:  "Old",
+	}, nil)
 	mockRepo.On("UpdateUser", mock.AnythingOfType("*entity.User")).Return(&entity.User{
 		ID:    userID,
 		Email: email,
@@ -172,13 +545,165 @@ func (suite *UserUseCaseSuite) TestUpdateUser() {
 	suite.Assert().Equal(name, updatedUser.Name)
 }
 
-func (suite *UserUseCaseSuite) TestDeleteUser() {
+func (suite *UserUseCaseSuite) TestUpdateUserEmailResetsVerification() {
 	userID := 1
+	newEmail := "new@example.com"
+	verifiedAt := time.Now().Add(-time.Hour)
 	mockRepo := NewMockUserRepository()
-	suite.userUseCase = usecase.NewUserUseCase(mockRepo)
+	mockTokenRepo := NewMockUserTokenRepository()
+	mockMailSender := NewMockMailSender()
+	suite.userUseCase = usecase.NewUserUseCase(mockRepo, mockTokenRepo, mockMailSender, NewMockTwoFactorUseCase(), newLoginAttemptUseCase(), testKeyManager, userTokenConfig)
 
-	mockRepo.On("DeleteUser", userID).Return(nil)
+	mockRepo.On("GetCurrentUser", userID).Return(&entity.User{
+		ID:              userID,
+		Email:           "old@example.com",
+		EmailVerifiedAt: &verifiedAt,
+	}, nil)
+	mockRepo.On("UpdateUser", mock.AnythingOfType("*entity.User")).Return(&entity.User{
+		ID:    userID,
+		Email: newEmail,
+	}, nil)
+	mockTokenRepo.On("InvalidateTokens", userID, entity.UserTokenPurposeEmailVerification, mock.AnythingOfType("time.Time")).Return(nil)
+	mockTokenRepo.On("CreateToken", mock.MatchedBy(func(token *entity.UserToken) bool {
+		return token.UserID == userID && token.Purpose == entity.UserTokenPurposeEmailVerification
+	})).Return(&entity.UserToken{ID: 1}, nil)
+	mockMailSender.On("Send", newEmail, mock.Anything, mock.Anything).Return(nil)
 
-	err := suite.userUseCase.DeleteUser(userID)
+	updatedUser, err := suite.userUseCase.UpdateUser(&entity.User{ID: userID, Email: newEmail})
 	suite.Assert().Nil(err)
+	suite.Assert().Equal(newEmail, updatedUser.Email)
+	suite.Assert().False(updatedUser.EmailVerified())
+	mockTokenRepo.AssertExpectations(suite.T())
+	mockMailSender.AssertExpectations(suite.T())
+}
+
+func (suite *UserUseCaseSuite) TestVerifyEmail() {
+	mockRepo := NewMockUserRepository()
+	mockTokenRepo := NewMockUserTokenRepository()
+	suite.userUseCase = usecase.NewUserUseCase(mockRepo, mockTokenRepo, NewMockMailSender(), NewMockTwoFactorUseCase(), newLoginAttemptUseCase(), testKeyManager, userTokenConfig)
+
+	mockTokenRepo.On("GetTokenByHash", entity.UserTokenPurposeEmailVerification, usecase.HashUserToken("token")).Return(&entity.UserToken{
+		ID:        1,
+		UserID:    2,
+		Purpose:   entity.UserTokenPurposeEmailVerification,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}, nil)
+	mockTokenRepo.On("MarkTokenUsed", 1, mock.AnythingOfType("time.Time")).Return(true, nil)
+	mockRepo.On("MarkEmailVerified", 2, mock.AnythingOfType("time.Time")).Return(nil)
+
+	err := suite.userUseCase.VerifyEmail("token")
+	suite.Assert().Nil(err)
+	mockRepo.AssertExpectations(suite.T())
+}
+
+func (suite *UserUseCaseSuite) TestVerifyEmail_InvalidToken() {
+	usedAt := time.Now().Add(-time.Minute)
+	cases := map[string]*entity.UserToken{
+		"not found": nil,
+		"expired":   {ID: 1, UserID: 2, ExpiresAt: time.Now().Add(-time.Minute)},
+		"used":      {ID: 1, UserID: 2, ExpiresAt: time.Now().Add(time.Hour), UsedAt: &usedAt},
+	}
+	for name, token := range cases {
+		suite.Run(name, func() {
+			mockRepo := NewMockUserRepository()
+			mockTokenRepo := NewMockUserTokenRepository()
+			suite.userUseCase = usecase.NewUserUseCase(mockRepo, mockTokenRepo, NewMockMailSender(), NewMockTwoFactorUseCase(), newLoginAttemptUseCase(), testKeyManager, userTokenConfig)
+			if token == nil {
+				mockTokenRepo.On("GetTokenByHash", mock.Anything, mock.Anything).Return(nil, nil)
+			} else {
+				mockTokenRepo.On("GetTokenByHash", mock.Anything, mock.Anything).Return(token, nil)
+			}
+
+			err := suite.userUseCase.VerifyEmail("token")
+			suite.Assert().ErrorIs(err, usecase.ErrInvalidUserToken)
+			mockRepo.AssertNotCalled(suite.T(), "MarkEmailVerified", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func (suite *UserUseCaseSuite) TestVerifyEmail_ConcurrentUse() {
+	mockRepo := NewMockUserRepository()
+	mockTokenRepo := NewMockUserTokenRepository()
+	suite.userUseCase = usecase.NewUserUseCase(mockRepo, mockTokenRepo, NewMockMailSender(), NewMockTwoFactorUseCase(), newLoginAttemptUseCase(), testKeyManager, userTokenConfig)
+
+	mockTokenRepo.On("GetTokenByHash", mock.Anything, mock.Anything).Return(&entity.UserToken{
+		ID:        1,
+		UserID:    2,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}, nil)
+	// 他のリクエストが先に使用済みにした場合
+	mockTokenRepo.On("MarkTokenUsed", 1, mock.AnythingOfType("time.Time")).Return(false, nil)
+
+	err := suite.userUseCase.VerifyEmail("token")
+	suite.Assert().ErrorIs(err, usecase.ErrInvalidUserToken)
+	mockRepo.AssertNotCalled(suite.T(), "MarkEmailVerified", mock.Anything, mock.Anything)
+}
+
+func (suite *UserUseCaseSuite) TestRequestPasswordReset() {
+	mockRepo := NewMockUserRepository()
+	mockTokenRepo := NewMockUserTokenRepository()
+	mockMailSender := NewMockMailSender()
+	suite.userUseCase = usecase.NewUserUseCase(mockRepo, mockTokenRepo, mockMailSender, NewMockTwoFactorUseCase(), newLoginAttemptUseCase(), testKeyManager, userTokenConfig)
+
+	mockRepo.On("GetUserByEmail", "test@example.com").Return(&entity.User{ID: 1, Email: "test@example.com"}, nil)
+	mockTokenRepo.On("InvalidateTokens", 1, entity.UserTokenPurposePasswordReset, mock.AnythingOfType("time.Time")).Return(nil)
+	mockTokenRepo.On("CreateToken", mock.MatchedBy(func(token *entity.UserToken) bool {
+		return token.UserID == 1 &&
+			token.Purpose == entity.UserTokenPurposePasswordReset &&
+			token.ExpiresAt.Before(time.Now().Add(time.Hour+time.Minute))
+	})).Return(&entity.UserToken{ID: 1}, nil)
+	mockMailSender.On("Send", "test@example.com", mock.Anything, mock.MatchedBy(func(body string) bool {
+		return strings.Contains(body, "http://localhost:3000/password-reset?token=")
+	})).Return(nil)
+
+	err := suite.userUseCase.RequestPasswordReset("test@example.com")
+	suite.Assert().Nil(err)
+	mockTokenRepo.AssertExpectations(suite.T())
+	mockMailSender.AssertExpectations(suite.T())
+}
+
+func (suite *UserUseCaseSuite) TestRequestPasswordReset_UnknownEmail() {
+	mockRepo := NewMockUserRepository()
+	mockTokenRepo := NewMockUserTokenRepository()
+	mockMailSender := NewMockMailSender()
+	suite.userUseCase = usecase.NewUserUseCase(mockRepo, mockTokenRepo, mockMailSender, NewMockTwoFactorUseCase(), newLoginAttemptUseCase(), testKeyManager, userTokenConfig)
+
+	mockRepo.On("GetUserByEmail", "unknown@example.com").Return(nil, errors.New("record not found"))
+
+	err := suite.userUseCase.RequestPasswordReset("unknown@example.com")
+	suite.Assert().Nil(err)
+	mockTokenRepo.AssertNotCalled(suite.T(), "CreateToken", mock.Anything)
+	mockMailSender.AssertNotCalled(suite.T(), "Send", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *UserUseCaseSuite) TestResetPassword() {
+	mockRepo := NewMockUserRepository()
+	mockTokenRepo := NewMockUserTokenRepository()
+	suite.userUseCase = usecase.NewUserUseCase(mockRepo, mockTokenRepo, NewMockMailSender(), NewMockTwoFactorUseCase(), newLoginAttemptUseCase(), testKeyManager, userTokenConfig)
+
+	mockTokenRepo.On("GetTokenByHash", entity.UserTokenPurposePasswordReset, usecase.HashUserToken("token")).Return(&entity.UserToken{
+		ID:        1,
+		UserID:    2,
+		Purpose:   entity.UserTokenPurposePasswordReset,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}, nil)
+	mockTokenRepo.On("MarkTokenUsed", 1, mock.AnythingOfType("time.Time")).Return(true, nil)
+	mockRepo.On("ResetPassword", 2, mock.MatchedBy(func(hashedPassword string) bool {
+		return usecase.CheckPasswordHash("newpassword", hashedPassword)
+	}), mock.AnythingOfType("time.Time")).Return(nil)
+	mockTokenRepo.On("InvalidateTokens", 2, entity.UserTokenPurposePasswordReset, mock.AnythingOfType("time.Time")).Return(nil)
+
+	err := suite.userUseCase.ResetPassword("token", "newpassword")
+	suite.Assert().Nil(err)
+	mockRepo.AssertExpectations(suite.T())
+	mockTokenRepo.AssertExpectations(suite.T())
+}
+
+func (suite *UserUseCaseSuite) TestResetPassword_EmptyPassword() {
+	mockTokenRepo := NewMockUserTokenRepository()
+	suite.userUseCase = usecase.NewUserUseCase(NewMockUserRepository(), mockTokenRepo, NewMockMailSender(), NewMockTwoFactorUseCase(), newLoginAttemptUseCase(), testKeyManager, userTokenConfig)
+
+	err := suite.userUseCase.ResetPassword("token", "")
+	suite.Assert().ErrorIs(err, usecase.ErrInvalidPassword)
+	mockTokenRepo.AssertNotCalled(suite.T(), "MarkTokenUsed", mock.Anything, mock.Anything)
 }
@@ -0,0 +1,240 @@
+package usecase_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/entity"
+	"household-account-backend/usecase"
+)
+
+type mockWebhookRepository struct {
+	mock.Mock
+}
+
+func NewMockWebhookRepository() *mockWebhookRepository {
+	return new(mockWebhookRepository)
+}
+
+func (m *mockWebhookRepository) CreateWebhook(webhook *entity.Webhook) (*entity.Webhook, error) {
+	args := m.Called(webhook)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Webhook), args.Error(1)
+}
+
+func (m *mockWebhookRepository) GetWebhookByID(userID int, webhookID int) (*entity.Webhook, error) {
+	args := m.Called(userID, webhookID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Webhook), args.Error(1)
+}
+
+func (m *mockWebhookRepository) GetWebhooksByUserID(userID int) ([]entity.Webhook, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.Webhook), args.Error(1)
+}
+
+func (m *mockWebhookRepository) UpdateWebhook(webhook *entity.Webhook) (*entity.Webhook, error) {
+	args := m.Called(webhook)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Webhook), args.Error(1)
+}
+
+func (m *mockWebhookRepository) DeleteWebhook(userID int, webhookID int) error {
+	args := m.Called(userID, webhookID)
+	return args.Error(0)
+}
+
+func (m *mockWebhookRepository) CreateDelivery(delivery *entity.WebhookDelivery) (*entity.WebhookDelivery, error) {
+	args := m.Called(delivery)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.WebhookDelivery), args.Error(1)
+}
+
+func (m *mockWebhookRepository) GetDeliveryByID(webhookID int, deliveryID int) (*entity.WebhookDelivery, error) {
+	args := m.Called(webhookID, deliveryID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.WebhookDelivery), args.Error(1)
+}
+
+func (m *mockWebhookRepository) GetDeliveriesByWebhookID(webhookID int) ([]entity.WebhookDelivery, error) {
+	args := m.Called(webhookID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.WebhookDelivery), args.Error(1)
+}
+
+func (m *mockWebhookRepository) HasSuccessfulDelivery(webhookID int, eventID int) (bool, error) {
+	args := m.Called(webhookID, eventID)
+	return args.Bool(0), args.Error(1)
+}
+
+type mockWebhookSender struct {
+	mock.Mock
+}
+
+func (m *mockWebhookSender) ValidateURL(rawURL string) error {
+	args := m.Called(rawURL)
+	return args.Error(0)
+}
+
+func (m *mockWebhookSender) Send(url string, headers map[string]string, body []byte) (int, error) {
+	args := m.Called(url, headers, body)
+	return args.Int(0), args.Error(1)
+}
+
+type WebhookUseCaseSuite struct {
+	suite.Suite
+	webhookRepository *mockWebhookRepository
+	outboxRepository  *mockOutboxRepository
+	webhookSender     *mockWebhookSender
+	webhookUseCase    usecase.WebhookUseCase
+}
+
+func TestWebhookUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(WebhookUseCaseSuite))
+}
+
+func (suite *WebhookUseCaseSuite) SetupTest() {
+	suite.webhookRepository = NewMockWebhookRepository()
+	suite.outboxRepository = NewMockOutboxRepository()
+	suite.webhookSender = new(mockWebhookSender)
+	suite.webhookUseCase = usecase.NewWebhookUseCase(suite.webhookRepository, suite.outboxRepository, suite.webhookSender, 5, time.Minute)
+}
+
+func (suite *WebhookUseCaseSuite) TestCreateWebhookGeneratesSecret() {
+	webhook := &entity.Webhook{
+		UserID: 1,
+		URL:    "https://example.com/hook",
+		Events: "transaction.created,category.deleted",
+		Active: true,
+	}
+	suite.webhookSender.On("ValidateURL", "https://example.com/hook").Return(nil)
+	suite.webhookRepository.On("CreateWebhook", webhook).Return(webhook, nil)
+
+	createdWebhook, err := suite.webhookUseCase.CreateWebhook(webhook)
+	suite.Assert().Nil(err)
+	suite.Assert().Len(createdWebhook.Secret, 64)
+}
+
+func (suite *WebhookUseCaseSuite) TestCreateWebhookValidation() {
+	_, err := suite.webhookUseCase.CreateWebhook(&entity.Webhook{UserID: 1, URL: "ftp://example.com", Events: "*"})
+	suite.Assert().ErrorIs(err, usecase.ErrInvalidWebhookURL)
+
+	suite.webhookSender.On("ValidateURL", "https://example.com").Return(nil)
+	_, err = suite.webhookUseCase.CreateWebhook(&entity.Webhook{UserID: 1, URL: "https://example.com", Events: "user.created"})
+	suite.Assert().ErrorIs(err, usecase.ErrInvalidWebhookEvent)
+
+	suite.webhookRepository.AssertNotCalled(suite.T(), "CreateWebhook", mock.Anything)
+}
+
+func (suite *WebhookUseCaseSuite) TestCreateWebhookRejectsInternalAddress() {
+	suite.webhookSender.On("ValidateURL", "http://169.254.169.254/latest/meta-data").Return(gateway.ErrWebhookAddressNotAllowed)
+
+	_, err := suite.webhookUseCase.CreateWebhook(&entity.Webhook{UserID: 1, URL: "http://169.254.169.254/latest/meta-data", Events: "*"})
+	suite.Assert().ErrorIs(err, usecase.ErrWebhookURLNotAllowed)
+
+	suite.webhookSender.On("ValidateURL", "http://10.0.0.1/hook").Return(gateway.ErrWebhookAddressNotAllowed)
+	_, err = suite.webhookUseCase.UpdateWebhook(&entity.Webhook{ID: 1, UserID: 1, URL: "http://10.0.0.1/hook", Events: "*"})
+	suite.Assert().ErrorIs(err, usecase.ErrWebhookURLNotAllowed)
+
+	suite.webhookRepository.AssertNotCalled(suite.T(), "CreateWebhook", mock.Anything)
+	suite.webhookRepository.AssertNotCalled(suite.T(), "UpdateWebhook", mock.Anything)
+}
+
+func (suite *WebhookUseCaseSuite) TestDispatchPendingEvents() {
+	events := []entity.OutboxEvent{
+		{ID: 10, UserID: 1, Type: entity.EventTransactionCreated, Payload: `{"id":3}`},
+	}
+	webhooks := []entity.Webhook{
+		{ID: 1, UserID: 1, URL: "https://example.com/a", Secret: "secret", Events: "transaction.created", Active: true},
+		{ID: 2, UserID: 1, URL: "https://example.com/b", Secret: "secret", Events: "category.deleted", Active: true},
+		{ID: 3, UserID: 1, URL: "https://example.com/c", Secret: "secret", Events: "*", Active: false},
+	}
+
+	suite.outboxRepository.On("ClaimPendingEvents", 100, 5, time.Minute).Return(events, nil)
+	suite.webhookRepository.On("GetWebhooksByUserID", 1).Return(webhooks, nil)
+	suite.webhookRepository.On("HasSuccessfulDelivery", 1, 10).Return(false, nil)
+	suite.webhookSender.On("Send", "https://example.com/a", mock.MatchedBy(func(headers map[string]string) bool {
+		return headers[usecase.WebhookEventHeader] == entity.EventTransactionCreated &&
+			headers[usecase.WebhookEventIDHeader] == "10"
+	}), mock.Anything).Return(200, nil)
+	suite.webhookRepository.On("CreateDelivery", mock.MatchedBy(func(delivery *entity.WebhookDelivery) bool {
+		return delivery.WebhookID == 1 && delivery.EventID == 10 && delivery.Success
+	})).Return(&entity.WebhookDelivery{ID: 1}, nil)
+	suite.outboxRepository.On("MarkEventProcessed", 10).Return(nil)
+
+	processed, err := suite.webhookUseCase.DispatchPendingEvents(100)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(1, processed)
+	suite.webhookSender.AssertNumberOfCalls(suite.T(), "Send", 1)
+	suite.outboxRepository.AssertExpectations(suite.T())
+}
+
+func (suite *WebhookUseCaseSuite) TestDispatchPendingEventsFailureIsRetried() {
+	events := []entity.OutboxEvent{
+		{ID: 10, UserID: 1, Type: entity.EventCategoryDeleted, Payload: `{"id":3}`},
+	}
+	webhooks := []entity.Webhook{
+		{ID: 1, UserID: 1, URL: "https://example.com/a", Secret: "secret", Events: "*", Active: true},
+	}
+
+	suite.outboxRepository.On("ClaimPendingEvents", 100, 5, time.Minute).Return(events, nil)
+	suite.webhookRepository.On("GetWebhooksByUserID", 1).Return(webhooks, nil)
+	suite.webhookRepository.On("HasSuccessfulDelivery", 1, 10).Return(false, nil)
+	suite.webhookSender.On("Send", mock.Anything, mock.Anything, mock.Anything).Return(0, errors.New("connection refused"))
+	suite.webhookRepository.On("CreateDelivery", mock.MatchedBy(func(delivery *entity.WebhookDelivery) bool {
+		return !delivery.Success && delivery.Error == "connection refused"
+	})).Return(&entity.WebhookDelivery{ID: 1}, nil)
+	suite.outboxRepository.On("IncrementEventAttempts", 10).Return(nil)
+
+	_, err := suite.webhookUseCase.DispatchPendingEvents(100)
+	suite.Assert().Nil(err)
+	suite.outboxRepository.AssertNotCalled(suite.T(), "MarkEventProcessed", 10)
+	suite.outboxRepository.AssertExpectations(suite.T())
+}
+
+func (suite *WebhookUseCaseSuite) TestReplayDelivery() {
+	webhook := &entity.Webhook{ID: 1, UserID: 1, URL: "https://example.com/a", Secret: "secret", Events: "*", Active: true}
+	delivery := &entity.WebhookDelivery{ID: 5, WebhookID: 1, EventID: 10, EventType: entity.EventTransactionUpdated, Payload: `{"id":10}`}
+
+	suite.webhookRepository.On("GetWebhookByID", 1, 1).Return(webhook, nil)
+	suite.webhookRepository.On("GetDeliveryByID", 1, 5).Return(delivery, nil)
+	suite.webhookSender.On("Send", webhook.URL, mock.MatchedBy(func(headers map[string]string) bool {
+		return headers[usecase.WebhookSignatureHeader] == "sha256="+usecase.SignWebhookPayload("secret", []byte(`{"id":10}`))
+	}), []byte(`{"id":10}`)).Return(204, nil)
+	suite.webhookRepository.On("CreateDelivery", mock.MatchedBy(func(d *entity.WebhookDelivery) bool {
+		return d.Replay && d.Success && d.StatusCode == 204
+	})).Return(&entity.WebhookDelivery{ID: 6, Replay: true, Success: true}, nil)
+
+	replayed, err := suite.webhookUseCase.ReplayDelivery(1, 1, 5)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(6, replayed.ID)
+}
+
+func (suite *WebhookUseCaseSuite) TestGetDeliveriesOfOtherUser() {
+	suite.webhookRepository.On("GetWebhookByID", 2, 1).Return(nil, errors.New("record not found"))
+
+	deliveries, err := suite.webhookUseCase.GetDeliveries(2, 1)
+	suite.Assert().Nil(deliveries)
+	suite.Assert().NotNil(err)
+	suite.webhookRepository.AssertNotCalled(suite.T(), "GetDeliveriesByWebhookID", 1)
+}
@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"household-account-backend/entity"
+)
+
+// TransactionSignPolicy は取引の金額の符号の規約
+type TransactionSignPolicy string
+
+const (
+	// SignPolicyPositive は金額を常に正の値で表し、収入か支出かはカテゴリーの種類で決める
+	// 支出のカテゴリーの取引は正の金額を出金として数える
+	SignPolicyPositive TransactionSignPolicy = "positive"
+	// SignPolicySigned は収入を正、支出を負の金額で表す
+	SignPolicySigned TransactionSignPolicy = "signed"
+)
+
+// NewTransactionSignPolicy は設定値から規約を返す。不明な値の場合はSignPolicyPositiveになる
+func NewTransactionSignPolicy(value string) TransactionSignPolicy {
+	if TransactionSignPolicy(value) == SignPolicySigned {
+		return SignPolicySigned
+	}
+	return SignPolicyPositive
+}
+
+// AmountError は金額の符号がカテゴリーの種類と規約に合わない場合に理由を返す
+// categoryがnilの場合は種類によらない条件のみを確認する
+func (p TransactionSignPolicy) AmountError(amount float32, category *entity.Category) string {
+	if p != SignPolicySigned {
+		if amount <= 0 {
+			return "must be greater than 0"
+		}
+		return ""
+	}
+
+	if amount == 0 {
+		return "must not be 0"
+	}
+	if category == nil {
+		return ""
+	}
+	switch {
+	case category.Type == entity.CategoryTypeIncome && amount < 0:
+		return "must be greater than 0 for income categories"
+	case category.Type == entity.CategoryTypeExpense && amount > 0:
+		return "must be less than 0 for expense categories"
+	}
+	return ""
+}
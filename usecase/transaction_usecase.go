@@ -1,44 +1,254 @@
 package usecase
 
 import (
+	"errors"
+	"fmt"
+
 	"household-account-backend/adapter/gateway"
 	"household-account-backend/entity"
 )
 
+// MaxBulkTransactionOperations は一括操作で一度に受け付ける操作数の上限
+const MaxBulkTransactionOperations = 500
+
+var (
+	ErrInvalidBulkOperation = newFieldValidationError("invalid bulk operation", "operations", fmt.Sprintf("1 to %d operations of create, update or delete are required", MaxBulkTransactionOperations))
+	ErrBulkOperationFailed  = errors.New("bulk operation failed and was rolled back")
+	ErrBulkVersionRequired  = newFieldValidationError("invalid bulk operation", "version", "version is required for update and delete")
+	ErrTransactionNotFound  = &NotFoundError{Message: "transaction not found"}
+)
+
 type TransactionUseCase interface {
 	CreateTransaction(transaction *entity.Transaction) (*entity.Transaction, error)
 	GetTransactionByID(userID int, transactionID int) (*entity.Transaction, error)
 	GetTransactionsByUserID(userID int) ([]entity.Transaction, error)
+	FindTransactions(userID int, filter entity.TransactionFilter) ([]entity.Transaction, error)
 	UpdateTransaction(transaction *entity.Transaction) (*entity.Transaction, error)
-	DeleteTransaction(userID int, transactionID int) error
+	DeleteTransaction(userID int, transactionID int, version int) error
+	BulkTransactions(userID int, operations []entity.TransactionOperation, atomic bool) ([]entity.TransactionOperationResult, error)
 }
 
 type transactionUseCase struct {
 	transactionRepository gateway.TransactionRepository
+	categoryRepository    gateway.CategoryRepository
+	signPolicy            TransactionSignPolicy
+	eventPublisher        EventPublisher
 }
 
-func NewTransactionUseCase(transactionRepository gateway.TransactionRepository) TransactionUseCase {
+func NewTransactionUseCase(transactionRepository gateway.TransactionRepository, categoryRepository gateway.CategoryRepository, signPolicy TransactionSignPolicy, eventPublisher EventPublisher) TransactionUseCase {
 	return &transactionUseCase{
 		transactionRepository: transactionRepository,
+		categoryRepository:    categoryRepository,
+		signPolicy:            signPolicy,
+		eventPublisher:        eventPublisher,
 	}
 }
 
+// validateTransaction は取引の項目と、カテゴリーが取引のユーザーのものであることを確認する
+// 金額の符号はカテゴリーの種類と符号の規約から確認する
+func (tu *transactionUseCase) validateTransaction(transaction *entity.Transaction) error {
+	errs := validateTransactionFields(transaction)
+	var category *entity.Category
+	if transaction.CategoryID > 0 {
+		var err error
+		category, err = tu.categoryRepository.GetCategoryByID(transaction.UserID, transaction.CategoryID)
+		if err != nil {
+			if !errors.Is(err, gateway.ErrRecordNotFound) {
+				return err
+			}
+			// 他のユーザーのカテゴリーは存在しないものと同じく扱い、存在を明かさない
+			errs.add("category_id", "must be one of your categories")
+		}
+	}
+	if reason := tu.signPolicy.AmountError(transaction.Amount, category); reason != "" {
+		errs.add("amount", reason)
+	}
+	return errs.err("invalid transaction")
+}
+
 func (tu *transactionUseCase) CreateTransaction(transaction *entity.Transaction) (*entity.Transaction, error) {
-	return tu.transactionRepository.CreateTransaction(transaction)
+	if err := tu.validateTransaction(transaction); err != nil {
+		return nil, err
+	}
+	events := newEventRecorder(tu.eventPublisher)
+	var createdTransaction *entity.Transaction
+	err := tu.transactionRepository.RunInTransaction(func(repository gateway.TransactionRepository, outbox gateway.OutboxRepository) error {
+		var err error
+		if createdTransaction, err = repository.CreateTransaction(transaction); err != nil {
+			return err
+		}
+		return events.record(outbox, createdTransaction.UserID, entity.EventTransactionCreated, createdTransaction)
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.notify()
+	return createdTransaction, nil
 }
 
 func (tu *transactionUseCase) GetTransactionByID(userID int, transactionID int) (*entity.Transaction, error) {
-	return tu.transactionRepository.GetTransactionByID(userID, transactionID)
+	transaction, err := tu.transactionRepository.GetTransactionByID(userID, transactionID)
+	if err != nil {
+		return nil, notFoundOr(err, ErrTransactionNotFound)
+	}
+	return transaction, nil
 }
 
 func (tu *transactionUseCase) GetTransactionsByUserID(userID int) ([]entity.Transaction, error) {
 	return tu.transactionRepository.GetTransactionsByUserID(userID)
 }
 
+func (tu *transactionUseCase) FindTransactions(userID int, filter entity.TransactionFilter) ([]entity.Transaction, error) {
+	return tu.transactionRepository.FindTransactions(userID, filter)
+}
+
 func (tu *transactionUseCase) UpdateTransaction(transaction *entity.Transaction) (*entity.Transaction, error) {
-	return tu.transactionRepository.UpdateTransaction(transaction)
+	if err := tu.validateTransaction(transaction); err != nil {
+		return nil, err
+	}
+	events := newEventRecorder(tu.eventPublisher)
+	var updatedTransaction *entity.Transaction
+	err := tu.transactionRepository.RunInTransaction(func(repository gateway.TransactionRepository, outbox gateway.OutboxRepository) error {
+		var err error
+		if updatedTransaction, err = repository.UpdateTransaction(transaction); err != nil {
+			return err
+		}
+		return events.record(outbox, updatedTransaction.UserID, entity.EventTransactionUpdated, updatedTransaction)
+	})
+	if err != nil {
+		return nil, notFoundOr(err, ErrTransactionNotFound)
+	}
+	events.notify()
+	return updatedTransaction, nil
+}
+
+func (tu *transactionUseCase) DeleteTransaction(userID int, transactionID int, version int) error {
+	events := newEventRecorder(tu.eventPublisher)
+	err := tu.transactionRepository.RunInTransaction(func(repository gateway.TransactionRepository, outbox gateway.OutboxRepository) error {
+		if err := repository.DeleteTransaction(userID, transactionID, version); err != nil {
+			return err
+		}
+		return events.record(outbox, userID, entity.EventTransactionDeleted, map[string]int{"id": transactionID})
+	})
+	if err != nil {
+		return notFoundOr(err, ErrTransactionNotFound)
+	}
+	events.notify()
+	return nil
 }
 
-func (tu *transactionUseCase) DeleteTransaction(userID int, transactionID int) error {
-	return tu.transactionRepository.DeleteTransaction(userID, transactionID)
+// BulkTransactions は複数の作成・更新・削除を1つのDBトランザクションで実行する
+// atomicがtrueの場合は1件でも失敗すると全てロールバックしErrBulkOperationFailedを返す
+// falseの場合は失敗した操作のみをセーブポイントまでロールバックし、残りの操作はコミットする
+func (tu *transactionUseCase) BulkTransactions(userID int, operations []entity.TransactionOperation, atomic bool) ([]entity.TransactionOperationResult, error) {
+	if len(operations) == 0 || len(operations) > MaxBulkTransactionOperations {
+		return nil, ErrInvalidBulkOperation
+	}
+	for _, operation := range operations {
+		switch operation.Op {
+		case entity.TransactionOperationCreate:
+		case entity.TransactionOperationUpdate, entity.TransactionOperationDelete:
+			// 一括操作では "*" に相当する指定を受け付けず、他の更新を上書きしないようバージョンを必須にする
+			if operation.Transaction.Version <= 0 {
+				return nil, ErrBulkVersionRequired
+			}
+		default:
+			return nil, ErrInvalidBulkOperation
+		}
+	}
+
+	results := make([]entity.TransactionOperationResult, len(operations))
+	events := newEventRecorder(tu.eventPublisher)
+	err := tu.transactionRepository.RunInTransaction(func(repository gateway.TransactionRepository, outbox gateway.OutboxRepository) error {
+		for i, operation := range operations {
+			results[i] = entity.TransactionOperationResult{Index: i, Op: operation.Op}
+
+			var applied *entity.Transaction
+			err := tu.validateOperation(userID, operation)
+			switch {
+			case err != nil:
+			case atomic:
+				applied, err = tu.applyTransactionOperation(repository, outbox, events, userID, operation)
+			default:
+				// イベントも同じセーブポイントで保存し、失敗した操作のイベントは残さない
+				err = repository.RunInTransaction(func(savepoint gateway.TransactionRepository, savepointOutbox gateway.OutboxRepository) error {
+					applied, err = tu.applyTransactionOperation(savepoint, savepointOutbox, events, userID, operation)
+					return err
+				})
+			}
+
+			if err != nil {
+				err = notFoundOr(err, ErrTransactionNotFound)
+				results[i].Error = err.Error()
+				results[i].Err = err
+				if atomic {
+					return fmt.Errorf("operation %d: %w", i, err)
+				}
+				continue
+			}
+			results[i].Success = true
+			results[i].Transaction = applied
+		}
+		return nil
+	})
+
+	if err != nil {
+		if atomic {
+			// ロールバックされたため成功扱いの操作も結果から取り消す
+			for i := range results {
+				results[i].Success = false
+				results[i].Transaction = nil
+			}
+			return results, fmt.Errorf("%w: %s", ErrBulkOperationFailed, err.Error())
+		}
+		return nil, err
+	}
+
+	// コミット後に成功した操作のイベントを購読者へ通知する
+	events.notify()
+
+	return results, nil
+}
+
+// validateOperation は作成・更新の操作の取引を検証する
+func (tu *transactionUseCase) validateOperation(userID int, operation entity.TransactionOperation) error {
+	if operation.Op == entity.TransactionOperationDelete {
+		return nil
+	}
+	transaction := operation.Transaction
+	transaction.UserID = userID
+	return tu.validateTransaction(&transaction)
+}
+
+// applyTransactionOperation は操作を適用し、そのイベントを同じトランザクションのoutboxに保存する
+func (tu *transactionUseCase) applyTransactionOperation(repository gateway.TransactionRepository, outbox gateway.OutboxRepository, events *eventRecorder, userID int, operation entity.TransactionOperation) (*entity.Transaction, error) {
+	transaction := operation.Transaction
+	transaction.UserID = userID
+
+	switch operation.Op {
+	case entity.TransactionOperationCreate:
+		transaction.ID = 0
+		created, err := repository.CreateTransaction(&transaction)
+		if err != nil {
+			return nil, err
+		}
+		return created, events.record(outbox, userID, entity.EventTransactionCreated, created)
+	case entity.TransactionOperationUpdate:
+		updated, err := repository.UpdateTransaction(&transaction)
+		if err != nil {
+			return nil, err
+		}
+		return updated, events.record(outbox, userID, entity.EventTransactionUpdated, updated)
+	case entity.TransactionOperationDelete:
+		// 存在しない取引の削除は失敗として扱う
+		deleted, err := repository.GetTransactionByID(userID, transaction.ID)
+		if err != nil {
+			return nil, err
+		}
+		if err := repository.DeleteTransaction(userID, transaction.ID, transaction.Version); err != nil {
+			return nil, err
+		}
+		return deleted, events.record(outbox, userID, entity.EventTransactionDeleted, map[string]int{"id": deleted.ID})
+	}
+	return nil, ErrInvalidBulkOperation
 }
@@ -0,0 +1,228 @@
+package usecase
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/entity"
+	"household-account-backend/pkg/totp"
+)
+
+// RecoveryCodeCount は一度に発行するリカバリーコードの数
+const RecoveryCodeCount = 10
+
+var (
+	ErrTOTPAlreadyEnabled = &ConflictError{Message: "two-factor authentication is already enabled"}
+	ErrTOTPNotEnabled     = &ConflictError{Message: "two-factor authentication is not enabled"}
+	ErrTOTPNotEnrolled    = &ConflictError{Message: "two-factor authentication enrollment has not been started"}
+	ErrInvalidTOTPCode    = newFieldValidationError("invalid authentication code", "code", "the code is invalid or has already been used")
+)
+
+// TOTPEnrollment は認証アプリに登録するためのシークレット
+type TOTPEnrollment struct {
+	Secret string
+	URI    string
+}
+
+type TwoFactorUseCase interface {
+	Enabled(userID int) (bool, error)
+	BeginEnrollment(userID int) (*TOTPEnrollment, error)
+	// ConfirmEnrollment は認証アプリのコードを確認して二要素認証を有効にし、リカバリーコードを返す
+	ConfirmEnrollment(userID int, code string) ([]string, error)
+	// Disable はTOTPコードかリカバリーコードを確認して二要素認証を無効にする
+	// コードの誤りはログインと同じ失敗回数として記録し、ロック中は *LoginThrottledError を返す
+	Disable(userID int, code string, clientIP string) error
+	RegenerateRecoveryCodes(userID int, code string, clientIP string) ([]string, error)
+	// VerifyCode はTOTPコードかリカバリーコードを検証する。使用したコードは再利用できない
+	VerifyCode(userID int, code string) error
+}
+
+type twoFactorUseCase struct {
+	userRepository      gateway.UserRepository
+	twoFactorRepository gateway.TwoFactorRepository
+	loginAttemptUseCase LoginAttemptUseCase
+	issuer              string
+}
+
+func NewTwoFactorUseCase(userRepository gateway.UserRepository, twoFactorRepository gateway.TwoFactorRepository, loginAttemptUseCase LoginAttemptUseCase, issuer string) TwoFactorUseCase {
+	return &twoFactorUseCase{
+		userRepository:      userRepository,
+		twoFactorRepository: twoFactorRepository,
+		loginAttemptUseCase: loginAttemptUseCase,
+		issuer:              issuer,
+	}
+}
+
+func (tu *twoFactorUseCase) Enabled(userID int) (bool, error) {
+	credential, err := tu.twoFactorRepository.GetCredential(userID)
+	if err != nil {
+		return false, err
+	}
+	return credential != nil && credential.Enabled(), nil
+}
+
+func (tu *twoFactorUseCase) BeginEnrollment(userID int) (*TOTPEnrollment, error) {
+	enabled, err := tu.Enabled(userID)
+	if err != nil {
+		return nil, err
+	}
+	if enabled {
+		return nil, ErrTOTPAlreadyEnabled
+	}
+
+	user, err := tu.userRepository.GetCurrentUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tu.twoFactorRepository.SaveCredential(&entity.TOTPCredential{
+		UserID: userID,
+		Secret: secret,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &TOTPEnrollment{
+		Secret: secret,
+		URI:    totp.URI(tu.issuer, user.Email, secret),
+	}, nil
+}
+
+func (tu *twoFactorUseCase) ConfirmEnrollment(userID int, code string) ([]string, error) {
+	credential, err := tu.twoFactorRepository.GetCredential(userID)
+	if err != nil {
+		return nil, err
+	}
+	if credential == nil {
+		return nil, ErrTOTPNotEnrolled
+	}
+	if credential.Enabled() {
+		return nil, ErrTOTPAlreadyEnabled
+	}
+	if err := tu.verifyTOTPCode(credential, code); err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := tu.twoFactorRepository.EnableCredential(userID, time.Now(), hashes); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+func (tu *twoFactorUseCase) Disable(userID int, code string, clientIP string) error {
+	if err := tu.verifyCodeWithThrottle(userID, code, clientIP); err != nil {
+		return err
+	}
+	return tu.twoFactorRepository.DeleteCredential(userID)
+}
+
+func (tu *twoFactorUseCase) RegenerateRecoveryCodes(userID int, code string, clientIP string) ([]string, error) {
+	if err := tu.verifyCodeWithThrottle(userID, code, clientIP); err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := tu.twoFactorRepository.ReplaceRecoveryCodes(userID, hashes); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// verifyCodeWithThrottle はセッションを奪われた場合にコードを総当たりされないよう、ログインと同じ失敗回数で制限してコードを検証する
+func (tu *twoFactorUseCase) verifyCodeWithThrottle(userID int, code string, clientIP string) error {
+	user, err := tu.userRepository.GetCurrentUser(userID)
+	if err != nil {
+		return notFoundOr(err, ErrUserNotFound)
+	}
+	if err := tu.loginAttemptUseCase.Check(user.Email, clientIP); err != nil {
+		return err
+	}
+
+	if err := tu.VerifyCode(userID, code); err != nil {
+		if errors.Is(err, ErrInvalidTOTPCode) {
+			if _, err := tu.loginAttemptUseCase.RecordFailure(user.Email, clientIP); err != nil {
+				return err
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+func (tu *twoFactorUseCase) VerifyCode(userID int, code string) error {
+	credential, err := tu.twoFactorRepository.GetCredential(userID)
+	if err != nil {
+		return err
+	}
+	if credential == nil || !credential.Enabled() {
+		return ErrTOTPNotEnabled
+	}
+
+	code = strings.TrimSpace(code)
+	if len(code) == totp.Digits {
+		return tu.verifyTOTPCode(credential, code)
+	}
+
+	used, err := tu.twoFactorRepository.UseRecoveryCode(userID, HashRecoveryCode(code), time.Now())
+	if err != nil {
+		return err
+	}
+	if !used {
+		return ErrInvalidTOTPCode
+	}
+	return nil
+}
+
+func (tu *twoFactorUseCase) verifyTOTPCode(credential *entity.TOTPCredential, code string) error {
+	step, ok := totp.Validate(credential.Secret, strings.TrimSpace(code), time.Now())
+	if !ok {
+		return ErrInvalidTOTPCode
+	}
+	// 一度受け付けたコードは有効期間内でも再利用させない
+	updated, err := tu.twoFactorRepository.UpdateLastUsedStep(credential.UserID, step)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return ErrInvalidTOTPCode
+	}
+	return nil
+}
+
+// HashRecoveryCode はDBに保存するリカバリーコードのハッシュを返す
+// 入力の揺れを吸収するため、ハイフンと空白を除いて小文字にしてからハッシュする
+func HashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	return HashUserToken(normalized)
+}
+
+// generateRecoveryCodes はユーザーに表示するリカバリーコードと保存するハッシュを返す
+func generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, RecoveryCodeCount)
+	hashes := make([]string, 0, RecoveryCodeCount)
+	for i := 0; i < RecoveryCodeCount; i++ {
+		b := make([]byte, 5)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, err
+		}
+		raw := hex.EncodeToString(b)
+		code := raw[:5] + "-" + raw[5:]
+		codes = append(codes, code)
+		hashes = append(hashes, HashRecoveryCode(code))
+	}
+	return codes, hashes, nil
+}
@@ -1,11 +1,15 @@
 package usecase
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"household-account-backend/adapter/gateway"
 	"household-account-backend/entity"
+	"household-account-backend/pkg/jwtkeys"
 	"household-account-backend/pkg/logger"
-	"os"
 	"time"
 
 	"github.com/golang-jwt/jwt/v4"
@@ -14,19 +18,71 @@ import (
 
 type UserUseCase interface {
 	Signup(user *entity.User) (*entity.User, error)
-	Login(user *entity.Credentials) (string, error)
+	// Login は二要素認証が有効なユーザーの場合、認証トークンの代わりにTOTPコード入力用のチャレンジを返す
+	// 失敗が続いたアカウントやIPアドレスからのログインは *LoginThrottledError を返す
+	Login(user *entity.Credentials, clientIP string) (*LoginResult, error)
+	// LoginWithTOTP はコードの誤りもLoginの失敗回数に数え、ロック中は *LoginThrottledError を返す
+	LoginWithTOTP(challenge string, code string, clientIP string) (string, error)
+	// CompleteLogin はパスワードや外部プロバイダで本人確認できたユーザーのログインを完了する
+	// 二要素認証が有効な場合はTOTPコード入力用のチャレンジを返す
+	CompleteLogin(userId int) (*LoginResult, error)
 	GetCurrentUser(userId int) (*entity.User, error)
 	UpdateUser(*entity.User) (*entity.User, error)
-	DeleteUser(userId int) error
+	VerifyEmail(token string) error
+	// RequestPasswordReset は登録の有無を推測されないよう、未登録のメールアドレスでもエラーを返さない
+	RequestPasswordReset(email string) error
+	ResetPassword(token string, newPassword string) error
+	// UnlockAccount はロック時にメールで送ったトークンでアカウントのロックを解除する
+	UnlockAccount(token string) error
+}
+
+// MaxLoginChallengeAttempts はひとつのログインチャレンジでTOTPコードを試行できる回数
+const MaxLoginChallengeAttempts = 5
+
+var (
+	ErrInvalidCredentials     = &UnauthorizedError{Message: "invalid credentials"}
+	ErrInvalidUserToken       = newFieldValidationError("invalid or expired token", "token", "the token is invalid, expired or already used")
+	ErrInvalidPassword        = newFieldValidationError("invalid password", "password", "the password must not be empty")
+	ErrAccountDisabled        = &ForbiddenError{Message: "account is disabled"}
+	ErrEmailAlreadyRegistered = &ConflictError{Message: "email address is already registered"}
+)
+
+// LoginResult はパスワード認証の結果
+// 二要素認証が有効な場合はAuthTokenは空で、TOTPChallengeをLoginWithTOTPに渡す
+type LoginResult struct {
+	AuthToken     string
+	TOTPChallenge string
+}
+
+// UserTokenConfig はメール確認・パスワード再設定トークンの設定
+type UserTokenConfig struct {
+	EmailVerificationTTL time.Duration
+	PasswordResetTTL     time.Duration
+	LoginChallengeTTL    time.Duration
+	AccountUnlockTTL     time.Duration
+	// FrontendURL はメール本文に記載するリンクのベースURL
+	FrontendURL string
 }
 
 type userUseCase struct {
-	userRepository gateway.UserRepository
+	userRepository      gateway.UserRepository
+	userTokenRepository gateway.UserTokenRepository
+	mailSender          gateway.MailSender
+	twoFactorUseCase    TwoFactorUseCase
+	loginAttemptUseCase LoginAttemptUseCase
+	keyManager          *jwtkeys.KeyManager
+	tokenConfig         UserTokenConfig
 }
 
-func NewUserUseCase(userRepository gateway.UserRepository) UserUseCase {
+func NewUserUseCase(userRepository gateway.UserRepository, userTokenRepository gateway.UserTokenRepository, mailSender gateway.MailSender, twoFactorUseCase TwoFactorUseCase, loginAttemptUseCase LoginAttemptUseCase, keyManager *jwtkeys.KeyManager, tokenConfig UserTokenConfig) UserUseCase {
 	return &userUseCase{
-		userRepository: userRepository,
+		userRepository:      userRepository,
+		userTokenRepository: userTokenRepository,
+		mailSender:          mailSender,
+		twoFactorUseCase:    twoFactorUseCase,
+		loginAttemptUseCase: loginAttemptUseCase,
+		keyManager:          keyManager,
+		tokenConfig:         tokenConfig,
 	}
 }
 
@@ -37,10 +93,22 @@ func (uu *userUseCase) Signup(user *entity.User) (*entity.User, error) {
 	}
 	user.Password = hashedPassword
 
-	return uu.userRepository.Signup(user)
+	createdUser, err := uu.userRepository.Signup(user)
+	if errors.Is(err, gateway.ErrDuplicateKey) {
+		return nil, ErrEmailAlreadyRegistered
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// 確認メールの送信に失敗してもユーザー登録は成功として扱う
+	if err := uu.sendEmailVerification(createdUser); err != nil {
+		logger.Warn("failed to send verification email", "user_id", createdUser.ID, "error", err.Error())
+	}
+	return createdUser, nil
 }
 
-func (uu *userUseCase) Login(user *entity.Credentials) (string, error) {
+func (uu *userUseCase) Login(user *entity.Credentials, clientIP string) (*LoginResult, error) {
 	// メールアドレスでユーザーを検索
 	// 入力されたパスワードとDBに保存されているハッシュ化されたパスワードを比較
 	// 以下のコードはテストの時エラーになる
@@ -49,42 +117,319 @@ func (uu *userUseCase) Login(user *entity.Credentials) (string, error) {
 	// 	return "", errors.New("invalid credentials")
 	// }
 
+	if err := uu.loginAttemptUseCase.Check(user.Email, clientIP); err != nil {
+		return nil, err
+	}
+
 	storedUser, err := uu.userRepository.GetUserByEmail(user.Email)
 	if err != nil || !CheckPasswordHash(user.Password, storedUser.Password) {
-		return "", errors.New("invalid credentials")
+		if storedUser == nil {
+			// 登録されていないメールアドレスでも同じように失敗を記録する
+			storedUser = &entity.User{Email: user.Email}
+		}
+		if err := uu.recordLoginFailure(storedUser, clientIP); err != nil {
+			return nil, err
+		}
+		return nil, ErrInvalidCredentials
+	}
+
+	// 無効にされていることはパスワードが正しい場合のみ伝える
+	if storedUser.Disabled() {
+		return nil, ErrAccountDisabled
+	}
+	result, err := uu.CompleteLogin(storedUser.ID)
+	if err != nil {
+		return nil, err
+	}
+	// 二要素認証が必要な場合は、コードの検証に成功するまで失敗回数をリセットしない
+	if result.TOTPChallenge == "" {
+		if err := uu.loginAttemptUseCase.RecordSuccess(user.Email); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// recordLoginFailure はパスワードまたは二要素認証のコードの誤りを記録し、アカウントがロックされた場合は解除用のメールを送る
+func (uu *userUseCase) recordLoginFailure(user *entity.User, clientIP string) error {
+	locked, err := uu.loginAttemptUseCase.RecordFailure(user.Email, clientIP)
+	if err != nil {
+		return err
+	}
+	if locked && user.ID != 0 {
+		if err := uu.sendAccountUnlock(user); err != nil {
+			logger.Error("failed to send account unlock email", "user_id", user.ID, "error", err.Error())
+		}
+	}
+	return nil
+}
+
+func (uu *userUseCase) CompleteLogin(userId int) (*LoginResult, error) {
+	totpEnabled, err := uu.twoFactorUseCase.Enabled(userId)
+	if err != nil {
+		return nil, err
+	}
+	if totpEnabled {
+		challenge, err := uu.issueToken(userId, entity.UserTokenPurposeLoginChallenge, uu.tokenConfig.LoginChallengeTTL)
+		if err != nil {
+			return nil, err
+		}
+		return &LoginResult{TOTPChallenge: challenge}, nil
+	}
+
+	tokenString, err := uu.issueAuthToken(userId)
+	if err != nil {
+		return nil, err
 	}
+	return &LoginResult{AuthToken: tokenString}, nil
+}
 
-	// ペイロードの作成
-	claims := &jwt.MapClaims{
-		"user_id": storedUser.ID,
-		"exp": time.Now().Add(time.Hour * 24).Unix(),
+func (uu *userUseCase) LoginWithTOTP(challenge string, code string, clientIP string) (string, error) {
+	if challenge == "" {
+		return "", ErrInvalidUserToken
+	}
+	userToken, err := uu.userTokenRepository.GetTokenByHash(entity.UserTokenPurposeLoginChallenge, HashUserToken(challenge))
+	if err != nil {
+		return "", err
+	}
+	if userToken == nil || !userToken.Usable(time.Now()) || userToken.Attempts >= MaxLoginChallengeAttempts {
+		return "", ErrInvalidUserToken
+	}
+
+	storedUser, err := uu.userRepository.GetCurrentUser(userToken.UserID)
+	if err != nil {
+		return "", err
+	}
+	// チャレンジを取り直してもコードを試し続けられないよう、パスワードと同じ失敗回数で制限する
+	if err := uu.loginAttemptUseCase.Check(storedUser.Email, clientIP); err != nil {
+		return "", err
+	}
+
+	if err := uu.twoFactorUseCase.VerifyCode(userToken.UserID, code); err != nil {
+		if errors.Is(err, ErrInvalidTOTPCode) {
+			if err := uu.userTokenRepository.IncrementTokenAttempts(userToken.ID); err != nil {
+				logger.Error("failed to increment login challenge attempts", "token_id", userToken.ID, "error", err.Error())
+			}
+			if err := uu.recordLoginFailure(storedUser, clientIP); err != nil {
+				return "", err
+			}
+		}
+		return "", err
 	}
 
-	// トークン生成
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	
-	secret := os.Getenv("SECRET")
-	logger.Info("os.Getenv(SECRET): " + secret)
-	
-	// トークンに署名を付与
-	tokenString, err := token.SignedString([]byte(secret))
-	logger.Info("tokenString: " + tokenString)
+	used, err := uu.userTokenRepository.MarkTokenUsed(userToken.ID, time.Now())
 	if err != nil {
 		return "", err
 	}
-	return tokenString, nil
+	if !used {
+		return "", ErrInvalidUserToken
+	}
+	if err := uu.loginAttemptUseCase.RecordSuccess(storedUser.Email); err != nil {
+		return "", err
+	}
+	return uu.issueAuthToken(userToken.UserID)
+}
+
+// issueAuthToken は認証Cookieに設定するJWTを発行する
+func (uu *userUseCase) issueAuthToken(userID int) (string, error) {
+	return uu.keyManager.Sign(jwt.MapClaims{
+		"user_id": userID,
+		"exp":     time.Now().Add(time.Hour * 24).Unix(),
+	})
 }
 
 func (uu *userUseCase) GetCurrentUser(userId int) (*entity.User, error) {
-	return uu.userRepository.GetCurrentUser(userId)
+	user, err := uu.userRepository.GetCurrentUser(userId)
+	if err != nil {
+		return nil, notFoundOr(err, ErrUserNotFound)
+	}
+	return user, nil
 }
 
 func (uu *userUseCase) UpdateUser(user *entity.User) (*entity.User, error) {
-	return uu.userRepository.UpdateUser(user)
+	currentUser, err := uu.userRepository.GetCurrentUser(user.ID)
+	if err != nil {
+		return nil, notFoundOr(err, ErrUserNotFound)
+	}
+
+	updatedUser, err := uu.userRepository.UpdateUser(user)
+	if errors.Is(err, gateway.ErrDuplicateKey) {
+		return nil, ErrEmailAlreadyRegistered
+	}
+	if err != nil {
+		return nil, notFoundOr(err, ErrUserNotFound)
+	}
+
+	// メールアドレスを変更した場合は確認済み状態が解除されるため、旧アドレス宛ての確認トークンを無効化して新しいアドレスに確認メールを送る
+	if user.Email != "" && user.Email != currentUser.Email {
+		if err := uu.userTokenRepository.InvalidateTokens(updatedUser.ID, entity.UserTokenPurposeEmailVerification, time.Now()); err != nil {
+			return nil, err
+		}
+		if err := uu.sendEmailVerification(updatedUser); err != nil {
+			logger.Warn("failed to send verification email", "user_id", updatedUser.ID, "error", err.Error())
+		}
+	}
+	return updatedUser, nil
+}
+
+func (uu *userUseCase) VerifyEmail(token string) error {
+	userToken, err := uu.consumeToken(entity.UserTokenPurposeEmailVerification, token)
+	if err != nil {
+		return err
+	}
+	return uu.userRepository.MarkEmailVerified(userToken.UserID, time.Now())
+}
+
+func (uu *userUseCase) RequestPasswordReset(email string) error {
+	user, err := uu.userRepository.GetUserByEmail(email)
+	if err != nil {
+		logger.Info("password reset requested for unknown email", "error", err.Error())
+		return nil
+	}
+
+	// 新しいトークンを発行したら以前のトークンは使えなくする
+	if err := uu.userTokenRepository.InvalidateTokens(user.ID, entity.UserTokenPurposePasswordReset, time.Now()); err != nil {
+		return err
+	}
+	token, err := uu.issueToken(user.ID, entity.UserTokenPurposePasswordReset, uu.tokenConfig.PasswordResetTTL)
+	if err != nil {
+		return err
+	}
+
+	body := fmt.Sprintf("以下のリンクからパスワードを再設定してください。\n%s/password-reset?token=%s\n\n有効期限: %s",
+		uu.tokenConfig.FrontendURL, token, uu.tokenConfig.PasswordResetTTL)
+	if err := uu.mailSender.Send(user.Email, "パスワード再設定のご案内", body); err != nil {
+		// 送信失敗をレスポンスに反映すると登録の有無が分かるためログのみ
+		logger.Error("failed to send password reset email", "user_id", user.ID, "error", err.Error())
+	}
+	return nil
+}
+
+func (uu *userUseCase) ResetPassword(token string, newPassword string) error {
+	if newPassword == "" {
+		return ErrInvalidPassword
+	}
+
+	userToken, err := uu.consumeToken(entity.UserTokenPurposePasswordReset, token)
+	if err != nil {
+		return err
+	}
+
+	hashedPassword, err := HashPassword(newPassword)
+	if err != nil {
+		return err
+	}
+	// パスワードを知った第三者のセッションとトークンも使えないようにする
+	now := time.Now()
+	if err := uu.userRepository.ResetPassword(userToken.UserID, hashedPassword, now); err != nil {
+		return err
+	}
+	return uu.userTokenRepository.InvalidateTokens(userToken.UserID, entity.UserTokenPurposePasswordReset, now)
+}
+
+func (uu *userUseCase) UnlockAccount(token string) error {
+	userToken, err := uu.consumeToken(entity.UserTokenPurposeAccountUnlock, token)
+	if err != nil {
+		return err
+	}
+	user, err := uu.userRepository.GetCurrentUser(userToken.UserID)
+	if err != nil {
+		return err
+	}
+	return uu.loginAttemptUseCase.Unlock(user.Email)
+}
+
+func (uu *userUseCase) sendAccountUnlock(user *entity.User) error {
+	if err := uu.userTokenRepository.InvalidateTokens(user.ID, entity.UserTokenPurposeAccountUnlock, time.Now()); err != nil {
+		return err
+	}
+	token, err := uu.issueToken(user.ID, entity.UserTokenPurposeAccountUnlock, uu.tokenConfig.AccountUnlockTTL)
+	if err != nil {
+		return err
+	}
+
+	body := fmt.Sprintf("ログインの失敗が続いたため、アカウントを一時的にロックしました。\n心当たりがある場合は以下のリンクからロックを解除できます。\n%s/unlock-account?token=%s\n\n有効期限: %s",
+		uu.tokenConfig.FrontendURL, token, uu.tokenConfig.AccountUnlockTTL)
+	return uu.mailSender.Send(user.Email, "アカウントのロックについて", body)
 }
 
-func (uu *userUseCase) DeleteUser(userId int) error {
-	return uu.userRepository.DeleteUser(userId)
+func (uu *userUseCase) sendEmailVerification(user *entity.User) error {
+	token, err := uu.issueToken(user.ID, entity.UserTokenPurposeEmailVerification, uu.tokenConfig.EmailVerificationTTL)
+	if err != nil {
+		return err
+	}
+
+	body := fmt.Sprintf("以下のリンクからメールアドレスを確認してください。\n%s/verify-email?token=%s\n\n有効期限: %s",
+		uu.tokenConfig.FrontendURL, token, uu.tokenConfig.EmailVerificationTTL)
+	return uu.mailSender.Send(user.Email, "メールアドレスの確認", body)
+}
+
+// issueToken はトークンを発行してハッシュを保存し、メールで送るトークン本体を返す
+func (uu *userUseCase) issueToken(userID int, purpose string, ttl time.Duration) (string, error) {
+	return issueUserToken(uu.userTokenRepository, userID, purpose, ttl)
+}
+
+// consumeToken はトークンを検証して使用済みにする
+func (uu *userUseCase) consumeToken(purpose string, token string) (*entity.UserToken, error) {
+	return consumeUserToken(uu.userTokenRepository, purpose, token)
+}
+
+// issueUserToken はトークンを発行してハッシュを保存し、メールで送るトークン本体を返す
+func issueUserToken(userTokenRepository gateway.UserTokenRepository, userID int, purpose string, ttl time.Duration) (string, error) {
+	token, err := generateUserToken()
+	if err != nil {
+		return "", err
+	}
+	_, err = userTokenRepository.CreateToken(&entity.UserToken{
+		UserID:    userID,
+		Purpose:   purpose,
+		TokenHash: HashUserToken(token),
+		ExpiresAt: time.Now().Add(ttl),
+	})
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// consumeUserToken はトークンを検証して使用済みにする
+func consumeUserToken(userTokenRepository gateway.UserTokenRepository, purpose string, token string) (*entity.UserToken, error) {
+	if token == "" {
+		return nil, ErrInvalidUserToken
+	}
+
+	userToken, err := userTokenRepository.GetTokenByHash(purpose, HashUserToken(token))
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if userToken == nil || !userToken.Usable(now) {
+		return nil, ErrInvalidUserToken
+	}
+
+	used, err := userTokenRepository.MarkTokenUsed(userToken.ID, now)
+	if err != nil {
+		return nil, err
+	}
+	if !used {
+		// 同時に使用された場合
+		return nil, ErrInvalidUserToken
+	}
+	return userToken, nil
+}
+
+// HashUserToken はDBに保存するトークンのハッシュを返す
+func HashUserToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+func generateUserToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
 }
 
 func HashPassword(password string) (string, error) {
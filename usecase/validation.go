@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"household-account-backend/entity"
+)
+
+// MaxCategoryNameLength はカテゴリー名の最大文字数
+const MaxCategoryNameLength = 20
+
+// yearMonthPattern は月次集計の対象月(YYYY-MM)の形式
+var yearMonthPattern = regexp.MustCompile(`^\d{4}-(0[1-9]|1[0-2])$`)
+
+// fieldErrors は入力値の検証で見つかった項目ごとのエラーを集める
+type fieldErrors []FieldError
+
+func (f *fieldErrors) add(field string, message string) {
+	*f = append(*f, FieldError{Field: field, Message: message})
+}
+
+// err は項目のエラーがあればValidationErrorにまとめて返す
+// 一括操作の結果などでも理由が分かるよう、メッセージには各項目の理由を含める
+func (f fieldErrors) err(message string) error {
+	if len(f) == 0 {
+		return nil
+	}
+	reasons := make([]string, 0, len(f))
+	for _, field := range f {
+		reasons = append(reasons, field.Field+" "+field.Message)
+	}
+	return &ValidationError{
+		Message: message + ": " + strings.Join(reasons, ", "),
+		Fields:  f,
+	}
+}
+
+func validateCategory(category *entity.Category) error {
+	var errs fieldErrors
+	if name := strings.TrimSpace(category.Name); name == "" || utf8.RuneCountInString(name) > MaxCategoryNameLength {
+		errs.add("name", fmt.Sprintf("must be 1 to %d characters", MaxCategoryNameLength))
+	}
+	if category.Type != entity.CategoryTypeIncome && category.Type != entity.CategoryTypeExpense {
+		errs.add("type", "must be income or expense")
+	}
+	return errs.err("invalid category")
+}
+
+// validateTransactionFields は取引の項目を検証する
+// 金額の符号とカテゴリーの所有者はカテゴリーを参照するため、呼び出し元で確認する
+func validateTransactionFields(transaction *entity.Transaction) fieldErrors {
+	var errs fieldErrors
+	if transaction.CategoryID <= 0 {
+		errs.add("category_id", "is required")
+	}
+	if transaction.Date.IsZero() {
+		errs.add("date", "is required")
+	}
+	return errs
+}
+
+func validateMonthlySummary(summary *entity.MonthlySummary) error {
+	var errs fieldErrors
+	if !yearMonthPattern.MatchString(summary.YearMonth) {
+		errs.add("year_month", "must be in YYYY-MM format")
+	}
+	if summary.Income < 0 {
+		errs.add("income", "must be 0 or greater")
+	}
+	if summary.Expense < 0 {
+		errs.add("expense", "must be 0 or greater")
+	}
+	return errs.err("invalid monthly summary")
+}
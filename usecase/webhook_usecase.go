@@ -0,0 +1,282 @@
+package usecase
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strconv"
+	"time"
+
+	"household-account-backend/adapter/gateway"
+	"household-account-backend/entity"
+	"household-account-backend/pkg/logger"
+)
+
+// Webhookリクエストに付与するヘッダー
+const (
+	WebhookSignatureHeader = "X-Webhook-Signature"
+	WebhookEventHeader     = "X-Webhook-Event"
+	WebhookEventIDHeader   = "X-Webhook-Event-Id"
+)
+
+var (
+	ErrInvalidWebhookURL    = newFieldValidationError("invalid webhook url", "url", "an absolute http or https url is required")
+	ErrWebhookURLNotAllowed = newFieldValidationError("webhook url not allowed", "url", "the url must resolve to a public address")
+	ErrInvalidWebhookEvent  = newFieldValidationError("invalid webhook event", "events", "at least one known event is required")
+	ErrWebhookNotFound      = &NotFoundError{Message: "webhook not found"}
+	ErrDeliveryNotFound     = &NotFoundError{Message: "webhook delivery not found"}
+)
+
+type WebhookUseCase interface {
+	CreateWebhook(webhook *entity.Webhook) (*entity.Webhook, error)
+	GetWebhookByID(userID int, webhookID int) (*entity.Webhook, error)
+	GetWebhooksByUserID(userID int) ([]entity.Webhook, error)
+	UpdateWebhook(webhook *entity.Webhook) (*entity.Webhook, error)
+	DeleteWebhook(userID int, webhookID int) error
+	GetDeliveries(userID int, webhookID int) ([]entity.WebhookDelivery, error)
+	ReplayDelivery(userID int, webhookID int, deliveryID int) (*entity.WebhookDelivery, error)
+	DispatchPendingEvents(limit int) (int, error)
+}
+
+type webhookUseCase struct {
+	webhookRepository gateway.WebhookRepository
+	outboxRepository  gateway.OutboxRepository
+	webhookSender     gateway.WebhookSender
+	maxAttempts       int
+	claimTTL          time.Duration
+}
+
+func NewWebhookUseCase(
+	webhookRepository gateway.WebhookRepository,
+	outboxRepository gateway.OutboxRepository,
+	webhookSender gateway.WebhookSender,
+	maxAttempts int,
+	claimTTL time.Duration,
+) WebhookUseCase {
+	return &webhookUseCase{
+		webhookRepository: webhookRepository,
+		outboxRepository:  outboxRepository,
+		webhookSender:     webhookSender,
+		maxAttempts:       maxAttempts,
+		claimTTL:          claimTTL,
+	}
+}
+
+// webhookPayload はWebhookのエンドポイントへ送信するリクエストボディ
+type webhookPayload struct {
+	ID        int             `json:"id"`
+	Type      string          `json:"type"`
+	CreatedAt time.Time       `json:"created_at"`
+	Data      json.RawMessage `json:"data"`
+}
+
+func (wu *webhookUseCase) CreateWebhook(webhook *entity.Webhook) (*entity.Webhook, error) {
+	if err := wu.validateWebhook(webhook); err != nil {
+		return nil, err
+	}
+
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+	webhook.Secret = secret
+
+	return wu.webhookRepository.CreateWebhook(webhook)
+}
+
+func (wu *webhookUseCase) GetWebhookByID(userID int, webhookID int) (*entity.Webhook, error) {
+	webhook, err := wu.webhookRepository.GetWebhookByID(userID, webhookID)
+	if err != nil {
+		return nil, notFoundOr(err, ErrWebhookNotFound)
+	}
+	return webhook, nil
+}
+
+func (wu *webhookUseCase) GetWebhooksByUserID(userID int) ([]entity.Webhook, error) {
+	return wu.webhookRepository.GetWebhooksByUserID(userID)
+}
+
+func (wu *webhookUseCase) UpdateWebhook(webhook *entity.Webhook) (*entity.Webhook, error) {
+	if err := wu.validateWebhook(webhook); err != nil {
+		return nil, err
+	}
+	// シークレットは作成時に発行したものから変更しない
+	webhook.Secret = ""
+	updatedWebhook, err := wu.webhookRepository.UpdateWebhook(webhook)
+	if err != nil {
+		return nil, notFoundOr(err, ErrWebhookNotFound)
+	}
+	return updatedWebhook, nil
+}
+
+func (wu *webhookUseCase) DeleteWebhook(userID int, webhookID int) error {
+	return notFoundOr(wu.webhookRepository.DeleteWebhook(userID, webhookID), ErrWebhookNotFound)
+}
+
+func (wu *webhookUseCase) GetDeliveries(userID int, webhookID int) ([]entity.WebhookDelivery, error) {
+	// 他のユーザーのWebhookの配信履歴は参照させない
+	if _, err := wu.GetWebhookByID(userID, webhookID); err != nil {
+		return nil, err
+	}
+	return wu.webhookRepository.GetDeliveriesByWebhookID(webhookID)
+}
+
+func (wu *webhookUseCase) ReplayDelivery(userID int, webhookID int, deliveryID int) (*entity.WebhookDelivery, error) {
+	webhook, err := wu.GetWebhookByID(userID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery, err := wu.webhookRepository.GetDeliveryByID(webhookID, deliveryID)
+	if err != nil {
+		return nil, notFoundOr(err, ErrDeliveryNotFound)
+	}
+
+	replayed := wu.send(webhook, delivery.EventID, delivery.EventType, []byte(delivery.Payload))
+	replayed.Replay = true
+	return wu.webhookRepository.CreateDelivery(replayed)
+}
+
+// DispatchPendingEvents はアウトボックスに溜まったイベントを購読中のWebhookへ配信し、処理したイベント数を返す
+// 取得したイベントは他のワーカーから取得されないため、複数のワーカーが同時に動いても重複して送信しない
+func (wu *webhookUseCase) DispatchPendingEvents(limit int) (int, error) {
+	events, err := wu.outboxRepository.ClaimPendingEvents(limit, wu.maxAttempts, wu.claimTTL)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, event := range events {
+		if err := wu.dispatchEvent(&event); err != nil {
+			logger.Warn("webhook dispatch failed", "event_id", event.ID, "error", err.Error())
+			if err := wu.outboxRepository.IncrementEventAttempts(event.ID); err != nil {
+				return 0, err
+			}
+			continue
+		}
+		if err := wu.outboxRepository.MarkEventProcessed(event.ID); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(events), nil
+}
+
+func (wu *webhookUseCase) dispatchEvent(event *entity.OutboxEvent) error {
+	webhooks, err := wu.webhookRepository.GetWebhooksByUserID(event.UserID)
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(&webhookPayload{
+		ID:        event.ID,
+		Type:      event.Type,
+		CreatedAt: event.CreatedAt,
+		Data:      json.RawMessage(event.Payload),
+	})
+	if err != nil {
+		return err
+	}
+
+	var failed bool
+	for _, webhook := range webhooks {
+		if !webhook.Active || !webhook.Subscribes(event.Type) {
+			continue
+		}
+
+		// 再試行時は既に配信済みのWebhookへ重複して送らない
+		delivered, err := wu.webhookRepository.HasSuccessfulDelivery(webhook.ID, event.ID)
+		if err != nil {
+			return err
+		}
+		if delivered {
+			continue
+		}
+
+		delivery := wu.send(&webhook, event.ID, event.Type, payload)
+		if _, err := wu.webhookRepository.CreateDelivery(delivery); err != nil {
+			return err
+		}
+		if !delivery.Success {
+			failed = true
+		}
+	}
+
+	if failed {
+		return errors.New("one or more webhook deliveries failed")
+	}
+	return nil
+}
+
+func (wu *webhookUseCase) send(webhook *entity.Webhook, eventID int, eventType string, payload []byte) *entity.WebhookDelivery {
+	headers := map[string]string{
+		WebhookSignatureHeader: "sha256=" + SignWebhookPayload(webhook.Secret, payload),
+		WebhookEventHeader:     eventType,
+		WebhookEventIDHeader:   strconv.Itoa(eventID),
+	}
+
+	delivery := &entity.WebhookDelivery{
+		WebhookID: webhook.ID,
+		EventID:   eventID,
+		EventType: eventType,
+		Payload:   string(payload),
+	}
+
+	statusCode, err := wu.webhookSender.Send(webhook.URL, headers, payload)
+	delivery.StatusCode = statusCode
+	if err != nil {
+		delivery.Error = err.Error()
+		return delivery
+	}
+	delivery.Success = statusCode >= 200 && statusCode < 300
+	return delivery
+}
+
+// SignWebhookPayload はWebhookのシークレットでペイロードのHMAC-SHA256署名を計算する
+func SignWebhookPayload(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (wu *webhookUseCase) validateWebhook(webhook *entity.Webhook) error {
+	u, err := url.Parse(webhook.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ErrInvalidWebhookURL
+	}
+	// 内部ネットワークのアドレスへのリクエストをサーバーから送信させない
+	if err := wu.webhookSender.ValidateURL(webhook.URL); err != nil {
+		return ErrWebhookURLNotAllowed
+	}
+
+	events := webhook.EventList()
+	if len(events) == 0 {
+		return ErrInvalidWebhookEvent
+	}
+	for _, event := range events {
+		if event != entity.WebhookEventAll && !isEventType(event) {
+			return ErrInvalidWebhookEvent
+		}
+	}
+	return nil
+}
+
+func isEventType(eventType string) bool {
+	for _, t := range entity.EventTypes() {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func generateWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}